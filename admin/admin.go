@@ -35,6 +35,8 @@ type Options struct {
 	ScaleDownConstraint        int
 	AllowMockBilling           bool
 	StoppedDeploymentRetention time.Duration
+	ProjectSnapshotsCron       string
+	ProjectSnapshotRetention   time.Duration
 }
 
 type Service struct {
@@ -57,6 +59,8 @@ type Service struct {
 	ScaleDownConstraint        int
 	AllowMockBilling           bool
 	StoppedDeploymentRetention time.Duration
+	ProjectSnapshotsCron       string
+	ProjectSnapshotRetention   time.Duration
 	Biller                     billing.Biller
 	PaymentProvider            payment.Provider
 }
@@ -142,6 +146,8 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		ScaleDownConstraint:        opts.ScaleDownConstraint,
 		AllowMockBilling:           opts.AllowMockBilling,
 		StoppedDeploymentRetention: opts.StoppedDeploymentRetention,
+		ProjectSnapshotsCron:       opts.ProjectSnapshotsCron,
+		ProjectSnapshotRetention:   opts.ProjectSnapshotRetention,
 		Biller:                     biller,
 		PaymentProvider:            p,
 	}, nil
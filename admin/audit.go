@@ -0,0 +1,233 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+// Actions recorded in the audit log.
+const (
+	AuditActionOrgMemberAdd               = "org.member.add"
+	AuditActionOrgMemberRemove            = "org.member.remove"
+	AuditActionOrgMemberRoleUpdate        = "org.member.role_update"
+	AuditActionOrgUsergroupAdd            = "org.usergroup.add"
+	AuditActionOrgUsergroupRemove         = "org.usergroup.remove"
+	AuditActionOrgUsergroupRoleUpdate     = "org.usergroup.role_update"
+	AuditActionProjectMemberAdd           = "project.member.add"
+	AuditActionProjectMemberRemove        = "project.member.remove"
+	AuditActionProjectMemberRoleUpdate    = "project.member.role_update"
+	AuditActionProjectUsergroupAdd        = "project.usergroup.add"
+	AuditActionProjectUsergroupRemove     = "project.usergroup.remove"
+	AuditActionProjectUsergroupRoleUpdate = "project.usergroup.role_update"
+	AuditActionProjectVariablesUpdate     = "project.variables.update"
+	AuditActionUsergroupDelete            = "usergroup.delete"
+	AuditActionUsergroupMemberAdd         = "usergroup.member.add"
+	AuditActionUsergroupMemberRemove      = "usergroup.member.remove"
+	AuditActionServiceCreate              = "service.create"
+	AuditActionServiceUpdate              = "service.update"
+	AuditActionServiceDelete              = "service.delete"
+	AuditActionTokenIssue                 = "token.issue"
+	AuditActionTokenRevoke                = "token.revoke"
+	AuditActionDeploymentCreate           = "deployment.create"
+	AuditActionDeploymentStart            = "deployment.start"
+	AuditActionDeploymentStop             = "deployment.stop"
+	AuditActionDeploymentUpdate           = "deployment.update"
+	AuditActionDeploymentDelete           = "deployment.delete"
+	AuditActionDeploymentRestore          = "deployment.restore"
+)
+
+// Target types recorded in the audit log.
+const (
+	AuditTargetUser           = "user"
+	AuditTargetUsergroup      = "usergroup"
+	AuditTargetProject        = "project"
+	AuditTargetDeployment     = "deployment"
+	AuditTargetService        = "service"
+	AuditTargetServiceToken   = "service_token"
+	AuditTargetUserToken      = "user_token"
+	AuditTargetMagicAuthToken = "magic_auth_token"
+)
+
+// AuditActorTypeSystem is the actor type used for audit events that were not triggered by an API caller, such as background jobs.
+const AuditActorTypeSystem = "system"
+
+// AuditActor identifies the caller that performed an audited action.
+type AuditActor struct {
+	// Type is the type of caller, such as "user" or "service".
+	Type            string
+	ID              string
+	AssumedByUserID string
+	ClientIP        string
+	UserAgent       string
+}
+
+// auditActorContextKey is used to set and get an AuditActor on a request context.
+type auditActorContextKey struct{}
+
+// WithAuditActor returns a copy of ctx with the provided actor attached.
+// Audit events recorded with the returned context are attributed to the actor.
+func WithAuditActor(ctx context.Context, actor *AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorContextKey{}, actor)
+}
+
+// AuditEvent describes a change to record in an organization's audit log.
+type AuditEvent struct {
+	OrgID      string
+	ProjectID  string
+	Action     string
+	TargetType string
+	TargetID   string
+	TargetName string
+	// Before and After hold the relevant state of the target before and after the change.
+	// They must not contain secrets.
+	Before map[string]any
+	After  map[string]any
+}
+
+// RecordAuditEvent appends an event to an organization's audit log.
+// The event is attributed to the actor set on ctx with WithAuditActor, or to the system if there is none.
+// Where possible, it should be called in the same transaction as the change it records.
+func (s *Service) RecordAuditEvent(ctx context.Context, e *AuditEvent) error {
+	opts := &database.InsertAuditEventOptions{
+		OrgID:      e.OrgID,
+		ActorType:  AuditActorTypeSystem,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		TargetName: e.TargetName,
+		Before:     e.Before,
+		After:      e.After,
+	}
+	if e.ProjectID != "" {
+		opts.ProjectID = &e.ProjectID
+	}
+
+	actor, ok := ctx.Value(auditActorContextKey{}).(*AuditActor)
+	if ok && actor != nil && actor.Type != "" {
+		opts.ActorType = actor.Type
+		opts.ActorID = actor.ID
+		opts.AssumedByUserID = actor.AssumedByUserID
+		opts.ClientIP = actor.ClientIP
+		opts.UserAgent = actor.UserAgent
+
+		// Store the user's email so the event stays meaningful after the user is deleted.
+		if actor.Type == "user" && actor.ID != "" {
+			user, err := s.DB.FindUser(ctx, actor.ID)
+			if err != nil && !errors.Is(err, database.ErrNotFound) {
+				return err
+			}
+			if user != nil {
+				opts.ActorEmail = user.Email
+			}
+		}
+	}
+
+	_, err := s.DB.InsertAuditEvent(ctx, opts)
+	return err
+}
+
+// recordUserMemberAuditEvent records an audit event targeting a user's membership of an org or project.
+func (s *Service) recordUserMemberAuditEvent(ctx context.Context, orgID, projectID, userID, action string, before, after map[string]any) error {
+	var email string
+	user, err := s.DB.FindUser(ctx, userID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+	if user != nil {
+		email = user.Email
+	}
+
+	return s.RecordAuditEvent(ctx, &AuditEvent{
+		OrgID:      orgID,
+		ProjectID:  projectID,
+		Action:     action,
+		TargetType: AuditTargetUser,
+		TargetID:   userID,
+		TargetName: email,
+		Before:     before,
+		After:      after,
+	})
+}
+
+// recordDeploymentAuditEvent records an audit event targeting a deployment.
+func (s *Service) recordDeploymentAuditEvent(ctx context.Context, depl *database.Deployment, action string, before, after map[string]any) error {
+	proj, err := s.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	return s.RecordAuditEvent(ctx, &AuditEvent{
+		OrgID:      proj.OrganizationID,
+		ProjectID:  proj.ID,
+		Action:     action,
+		TargetType: AuditTargetDeployment,
+		TargetID:   depl.ID,
+		TargetName: fmt.Sprintf("%s/%s", proj.Name, depl.Environment),
+		Before:     before,
+		After:      after,
+	})
+}
+
+// RecordUserAuthTokenAuditEvent records an audit event targeting a user auth token.
+// User auth tokens are not scoped to an org, so the event is recorded in every org that the token's owner is a member of.
+// For representative tokens, the owner is the represented user.
+func (s *Service) RecordUserAuthTokenAuditEvent(ctx context.Context, tkn *database.UserAuthToken, action string) error {
+	ownerID := tkn.UserID
+	state := map[string]any{"user_id": tkn.UserID, "expires_on": tkn.ExpiresOn}
+	if tkn.RepresentingUserID != nil {
+		ownerID = *tkn.RepresentingUserID
+		state["representing_user_id"] = *tkn.RepresentingUserID
+	}
+
+	var before, after map[string]any
+	if action == AuditActionTokenRevoke {
+		before = state
+	} else {
+		after = state
+	}
+
+	return s.forEachUserOrganization(ctx, ownerID, func(orgID string) error {
+		return s.RecordAuditEvent(ctx, &AuditEvent{
+			OrgID:      orgID,
+			Action:     action,
+			TargetType: AuditTargetUserToken,
+			TargetID:   tkn.ID,
+			TargetName: tkn.DisplayName,
+			Before:     before,
+			After:      after,
+		})
+	})
+}
+
+// RecordUserAuditEvent records an audit event targeting a user in every org that the user is a member of.
+// It is used for changes that are not scoped to an org, such as revoking all of a user's auth tokens.
+func (s *Service) RecordUserAuditEvent(ctx context.Context, userID, action string, before, after map[string]any) error {
+	return s.forEachUserOrganization(ctx, userID, func(orgID string) error {
+		return s.recordUserMemberAuditEvent(ctx, orgID, "", userID, action, before, after)
+	})
+}
+
+// forEachUserOrganization calls fn with the ID of every org that the user is a member of.
+func (s *Service) forEachUserOrganization(ctx context.Context, userID string, fn func(orgID string) error) error {
+	const pageSize = 100
+	afterName := ""
+	for {
+		orgs, err := s.DB.FindOrganizationsForUser(ctx, userID, afterName, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			err = fn(org.ID)
+			if err != nil {
+				return err
+			}
+		}
+		if len(orgs) < pageSize {
+			return nil
+		}
+		afterName = orgs[len(orgs)-1].Name
+	}
+}
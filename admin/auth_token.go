@@ -48,6 +48,7 @@ func (t *userAuthToken) OwnerID() string {
 }
 
 // IssueUserAuthToken generates and persists a new auth token for a user.
+// Issuing a representative token is recorded in the audit log of the represented user's orgs.
 func (s *Service) IssueUserAuthToken(ctx context.Context, userID, clientID, displayName string, representingUserID *string, ttl *time.Duration, refresh bool) (AuthToken, error) {
 	tkn := authtoken.NewRandom(authtoken.TypeUser)
 
@@ -57,6 +58,12 @@ func (s *Service) IssueUserAuthToken(ctx context.Context, userID, clientID, disp
 		expiresOn = &t
 	}
 
+	ctx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	uat, err := s.DB.InsertUserAuthToken(ctx, &database.InsertUserAuthTokenOptions{
 		ID:                 tkn.ID.String(),
 		SecretHash:         tkn.SecretHash(),
@@ -71,6 +78,18 @@ func (s *Service) IssueUserAuthToken(ctx context.Context, userID, clientID, disp
 		return nil, err
 	}
 
+	if representingUserID != nil {
+		err = s.RecordUserAuthTokenAuditEvent(ctx, uat, AuditActionTokenIssue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &userAuthToken{model: uat, token: tkn}, nil
 }
 
@@ -102,6 +121,17 @@ func (s *Service) IssueServiceAuthToken(ctx context.Context, serviceID string, t
 		expiresOn = &t
 	}
 
+	service, err := s.DB.FindService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	sat, err := s.DB.InsertServiceAuthToken(ctx, &database.InsertServiceAuthTokenOptions{
 		ID:         tkn.ID.String(),
 		SecretHash: tkn.SecretHash(),
@@ -112,6 +142,23 @@ func (s *Service) IssueServiceAuthToken(ctx context.Context, serviceID string, t
 		return nil, err
 	}
 
+	err = s.RecordAuditEvent(ctx, &AuditEvent{
+		OrgID:      service.OrgID,
+		Action:     AuditActionTokenIssue,
+		TargetType: AuditTargetServiceToken,
+		TargetID:   sat.ID,
+		TargetName: service.Name,
+		After:      map[string]any{"service_id": service.ID, "expires_on": expiresOn},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &serviceAuthToken{model: sat, token: tkn}, nil
 }
 
@@ -204,6 +251,17 @@ func (s *Service) IssueMagicAuthToken(ctx context.Context, opts *IssueMagicAuthT
 		expiresOn = &t
 	}
 
+	proj, err := s.DB.FindProject(ctx, opts.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	dat, err := s.DB.InsertMagicAuthToken(ctx, &database.InsertMagicAuthTokenOptions{
 		ID:                     tkn.ID.String(),
 		SecretHash:             tkn.SecretHash(),
@@ -223,6 +281,27 @@ func (s *Service) IssueMagicAuthToken(ctx context.Context, opts *IssueMagicAuthT
 		return nil, err
 	}
 
+	// Internal tokens are issued by Rill itself, e.g. for links in alert and report notifications, so they are not audited.
+	if !opts.Internal {
+		err = s.RecordAuditEvent(ctx, &AuditEvent{
+			OrgID:      proj.OrganizationID,
+			ProjectID:  proj.ID,
+			Action:     AuditActionTokenIssue,
+			TargetType: AuditTargetMagicAuthToken,
+			TargetID:   dat.ID,
+			TargetName: dat.DisplayName,
+			After:      map[string]any{"resources": opts.Resources, "expires_on": expiresOn},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &magicAuthToken{model: dat, token: tkn}, nil
 }
 
@@ -29,6 +29,25 @@ const CreditTrialLowBalanceThreshold = 50
 
 var ErrNotFound = errors.New("not found")
 
+// CounterMetrics are billable metrics whose period total is sum(value) rather than max(value).
+// Generic usage events ("query", "request_time_ms", "tool_call") carry a "source" attribute (and request_time_ms also
+// carries embed/user_id); the metrics project applies the billing-specific filtering and distinct counting downstream.
+// They are also the metrics an organization can set a usage budget on.
+var CounterMetrics = map[string]bool{
+	"slot_seconds_spend":  true,
+	"query":               true,
+	"tool_call":           true,
+	"input_tokens":        true,
+	"cached_input_tokens": true,
+	"output_tokens":       true,
+}
+
+// BudgetPeriodStart returns the start of the budget period containing t. Budgets are evaluated per calendar month in UTC.
+func BudgetPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
 type Biller interface {
 	Name() string
 	// DefaultQuotas returns the default quotas to assign to orgs before billing has been initialized.
@@ -67,6 +86,9 @@ type Biller interface {
 	// GetCustomerCreditBalance returns the customer's current credit balance in the given currency.
 	GetCustomerCreditBalance(ctx context.Context, customerID, currency string) (float64, error)
 
+	// GetCustomerSpend returns the customer's total billed amount for usage between start and end, including amounts covered by credits.
+	GetCustomerSpend(ctx context.Context, customerID string, start, end time.Time) (float64, error)
+
 	// CreateSubscription creates a subscription for the given organization. Subscription starts immediately.
 	CreateSubscription(ctx context.Context, customerID string, plan *Plan) (*Subscription, error)
 	// GetActiveSubscription returns the active subscription for the given organization
@@ -89,6 +89,10 @@ func (n noop) GetCustomerCreditBalance(ctx context.Context, customerID, currency
 	return 0, nil
 }
 
+func (n noop) GetCustomerSpend(ctx context.Context, customerID string, start, end time.Time) (float64, error) {
+	return 0, nil
+}
+
 func (n noop) CreateSubscription(ctx context.Context, customerID string, plan *Plan) (*Subscription, error) {
 	return &Subscription{Customer: &Customer{}, Plan: &Plan{Quotas: Quotas{}}}, nil
 }
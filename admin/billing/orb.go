@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -316,6 +317,26 @@ func (o *Orb) GetCustomerCreditBalance(ctx context.Context, customerID, currency
 	return total, nil
 }
 
+// GetCustomerSpend returns the customer's total cost between start and end, summed across the cost buckets returned by Orb.
+func (o *Orb) GetCustomerSpend(ctx context.Context, customerID string, start, end time.Time) (float64, error) {
+	res, err := o.client.Customers.Costs.ListByExternalID(ctx, customerID, orb.CustomerCostListByExternalIDParams{
+		TimeframeStart: orb.F(start),
+		TimeframeEnd:   orb.F(end),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("fetching customer costs: %w", err)
+	}
+	var total float64
+	for i := range res.Data {
+		v, err := strconv.ParseFloat(res.Data[i].Total, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing customer cost %q: %w", res.Data[i].Total, err)
+		}
+		total += v
+	}
+	return total, nil
+}
+
 func (o *Orb) CreateSubscription(ctx context.Context, customerID string, plan *Plan) (*Subscription, error) {
 	return o.createSubscription(ctx, customerID, plan)
 }
@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// CheckOrganizationBudget evaluates an organization's budget against its spend or usage in the current period.
+// It notifies the org's admins when a new warning threshold is crossed.
+// If the budget has a hard cap and is exceeded, it stops the org's non-production deployments and notifies the admins the first time it happens in the period.
+// Deployments started after the cap was enforced are stopped on the next evaluation until the budget is raised, removed or reset at the start of the next period.
+func (s *Service) CheckOrganizationBudget(ctx context.Context, budget *database.OrganizationBudget) (*database.OrganizationBudget, error) {
+	org, err := s.DB.FindOrganization(ctx, budget.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	periodStart := billing.BudgetPeriodStart(now)
+
+	// The notification and cap state is reset at the start of each period.
+	notifiedThreshold := budget.NotifiedThreshold
+	capped := budget.Capped
+	if budget.PeriodStart == nil || !budget.PeriodStart.Equal(periodStart) {
+		notifiedThreshold = 0
+		capped = false
+	}
+
+	var value float64
+	if budget.Metric == "" {
+		if org.BillingCustomerID != "" {
+			value, err = s.Biller.GetCustomerSpend(ctx, org.BillingCustomerID, periodStart, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		value, err = s.DB.FindOrganizationUsage(ctx, org.ID, budget.Metric, periodStart, periodStart.AddDate(0, 1, 0))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Find the highest warning threshold that has been reached
+	pct := value / budget.Amount * 100
+	threshold := 0
+	for _, t := range budget.WarningThresholds {
+		if pct >= float64(t) && t > threshold {
+			threshold = t
+		}
+	}
+	if threshold > notifiedThreshold {
+		err = s.sendBudgetEmails(ctx, org, func(admin *database.OrganizationMemberUser) error {
+			return s.Email.SendBudgetThresholdReached(&email.BudgetThresholdReached{
+				ToEmail:    admin.Email,
+				ToName:     admin.DisplayName,
+				OrgName:    org.Name,
+				BillingURL: s.URLs.Billing(org.Name, false),
+				Metric:     budget.Metric,
+				Threshold:  threshold,
+				Current:    value,
+				Amount:     budget.Amount,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		notifiedThreshold = threshold
+	}
+
+	if budget.HardCap && value > budget.Amount {
+		n, err := s.stopNonProdDeployments(ctx, org)
+		if err != nil {
+			return nil, err
+		}
+
+		if !capped {
+			s.Logger.Warn("budget exceeded: stopped non-production deployments", zap.String("org_id", org.ID), zap.String("org_name", org.Name), zap.Int("deployments", n), observability.ZapCtx(ctx))
+
+			err = s.sendBudgetEmails(ctx, org, func(admin *database.OrganizationMemberUser) error {
+				return s.Email.SendBudgetCapReached(&email.BudgetCapReached{
+					ToEmail:    admin.Email,
+					ToName:     admin.DisplayName,
+					OrgName:    org.Name,
+					BillingURL: s.URLs.Billing(org.Name, false),
+					Metric:     budget.Metric,
+					Amount:     budget.Amount,
+				})
+			})
+			if err != nil {
+				return nil, err
+			}
+			capped = true
+		}
+	}
+
+	return s.DB.UpdateOrganizationBudgetState(ctx, org.ID, &database.UpdateOrganizationBudgetStateOptions{
+		PeriodStart:       periodStart,
+		CurrentValue:      value,
+		NotifiedThreshold: notifiedThreshold,
+		Capped:            capped,
+		EvaluatedOn:       now,
+	})
+}
+
+// stopNonProdDeployments stops all of the org's deployments that are not in the prod environment. It returns the number of deployments stopped.
+func (s *Service) stopNonProdDeployments(ctx context.Context, org *database.Organization) (int, error) {
+	n := 0
+	limit := 100
+	afterProjectName := ""
+	for {
+		projs, err := s.DB.FindProjectsForOrganization(ctx, org.ID, afterProjectName, limit)
+		if err != nil {
+			return n, err
+		}
+
+		for _, proj := range projs {
+			depls, err := s.DB.FindDeploymentsForProject(ctx, proj.ID, "", "")
+			if err != nil {
+				return n, err
+			}
+			for _, depl := range depls {
+				if depl.Environment == "prod" || depl.DesiredStatus == database.DeploymentStatusStopped {
+					continue
+				}
+				err = s.StopDeployment(ctx, depl)
+				if err != nil {
+					return n, fmt.Errorf("failed to stop deployment %q of project %q: %w", depl.ID, proj.Name, err)
+				}
+				n++
+			}
+		}
+
+		if len(projs) < limit {
+			break
+		}
+		afterProjectName = projs[len(projs)-1].Name
+	}
+	return n, nil
+}
+
+// sendBudgetEmails calls send for each of the org's admins. Failures are logged, so one bad address doesn't prevent the others from being notified.
+func (s *Service) sendBudgetEmails(ctx context.Context, org *database.Organization, send func(admin *database.OrganizationMemberUser) error) error {
+	admins, err := s.DB.FindOrganizationMembersWithManageUsersRole(ctx, org.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, admin := range admins {
+		err := send(admin)
+		if err != nil {
+			s.Logger.Error("failed to send budget email", zap.String("org_id", org.ID), zap.String("email", admin.Email), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+	return nil
+}
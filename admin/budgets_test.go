@@ -0,0 +1,161 @@
+package admin_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/testadmin"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOrganizationBudget(t *testing.T) {
+	ctx := context.Background()
+	fix := testadmin.New(t)
+	sender := fix.Admin.Email.Sender.(*email.TestSender)
+
+	u1, c1 := fix.NewUser(t)
+	org, err := c1.CreateOrganization(ctx, &adminv1.CreateOrganizationRequest{Name: "budget-test"})
+	require.NoError(t, err)
+	orgID := org.Organization.Id
+	proj, err := c1.CreateProject(ctx, &adminv1.CreateProjectRequest{
+		Org:        org.Organization.Name,
+		Project:    "proj1",
+		ProdSlots:  1,
+		SkipDeploy: true,
+	})
+	require.NoError(t, err)
+
+	// Create a prod and a dev deployment
+	depls := make(map[string]*database.Deployment)
+	for _, env := range []string{"prod", "dev"} {
+		depl, err := fix.Admin.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+			ProjectID:         proj.Project.Id,
+			Environment:       env,
+			RuntimeInstanceID: env,
+			Status:            database.DeploymentStatusRunning,
+			DesiredStatus:     database.DeploymentStatusRunning,
+		})
+		require.NoError(t, err)
+		depls[env] = depl
+	}
+
+	// The org's admin receives the budget emails
+	emails := func(subject string) int {
+		var n int
+		for _, e := range sender.Emails {
+			if e.ToEmail == u1.Email && strings.Contains(e.Subject, subject) {
+				n++
+			}
+		}
+		return n
+	}
+	desiredStatus := func(env string) database.DeploymentStatus {
+		depl, err := fix.Admin.DB.FindDeployment(ctx, depls[env].ID)
+		require.NoError(t, err)
+		return depl.DesiredStatus
+	}
+
+	periodStart := billing.BudgetPeriodStart(time.Now())
+	setUsage := func(value float64) {
+		require.NoError(t, fix.Admin.DB.UpsertOrganizationUsage(ctx, orgID, "events", periodStart, value))
+	}
+	check := func() *database.OrganizationBudget {
+		budget, err := fix.Admin.DB.FindOrganizationBudget(ctx, orgID)
+		require.NoError(t, err)
+		budget, err = fix.Admin.CheckOrganizationBudget(ctx, budget)
+		require.NoError(t, err)
+		return budget
+	}
+
+	_, err = fix.Admin.DB.UpsertOrganizationBudget(ctx, orgID, &database.UpsertOrganizationBudgetOptions{
+		Metric:            "events",
+		Amount:            100,
+		WarningThresholds: []int{50, 80},
+		HardCap:           true,
+	})
+	require.NoError(t, err)
+
+	t.Run("threshold emails", func(t *testing.T) {
+		// Below the thresholds
+		setUsage(40)
+		budget := check()
+		require.Equal(t, 40.0, budget.CurrentValue)
+		require.Equal(t, 0, budget.NotifiedThreshold)
+		require.Equal(t, periodStart, budget.PeriodStart.UTC())
+		require.Equal(t, 0, emails("monthly budget"))
+
+		// Crossing a threshold is notified once
+		setUsage(60)
+		budget = check()
+		require.Equal(t, 50, budget.NotifiedThreshold)
+		require.Equal(t, 1, emails("reached 50%"))
+		check()
+		require.Equal(t, 1, emails("reached 50%"))
+
+		// Crossing the next threshold is notified too
+		setUsage(90)
+		budget = check()
+		require.Equal(t, 80, budget.NotifiedThreshold)
+		require.Equal(t, 1, emails("reached 80%"))
+		require.False(t, budget.Capped)
+		require.Equal(t, database.DeploymentStatusRunning, desiredStatus("dev"))
+	})
+
+	t.Run("hard cap", func(t *testing.T) {
+		// Exceeding the budget stops the non-prod deployments and is notified once
+		setUsage(120)
+		budget := check()
+		require.True(t, budget.Capped)
+		require.Equal(t, 1, emails("exceeded its monthly budget"))
+		require.Equal(t, database.DeploymentStatusStopped, desiredStatus("dev"))
+		require.Equal(t, database.DeploymentStatusRunning, desiredStatus("prod"))
+
+		// Deployments started after the cap was enforced are stopped again without another email
+		_, err := fix.Admin.DB.UpdateDeploymentDesiredStatus(ctx, depls["dev"].ID, database.DeploymentStatusRunning)
+		require.NoError(t, err)
+		budget = check()
+		require.True(t, budget.Capped)
+		require.Equal(t, 1, emails("exceeded its monthly budget"))
+		require.Equal(t, database.DeploymentStatusStopped, desiredStatus("dev"))
+	})
+
+	t.Run("period reset", func(t *testing.T) {
+		// Simulate that the state was recorded in the previous period
+		_, err := fix.Admin.DB.UpdateOrganizationBudgetState(ctx, orgID, &database.UpdateOrganizationBudgetStateOptions{
+			PeriodStart:       periodStart.AddDate(0, -1, 0),
+			CurrentValue:      120,
+			NotifiedThreshold: 80,
+			Capped:            true,
+			EvaluatedOn:       periodStart.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		// The notifications are sent again in the new period
+		budget := check()
+		require.Equal(t, periodStart, budget.PeriodStart.UTC())
+		require.Equal(t, 80, budget.NotifiedThreshold)
+		require.True(t, budget.Capped)
+		require.Equal(t, 2, emails("reached 80%"))
+		require.Equal(t, 2, emails("exceeded its monthly budget"))
+	})
+
+	t.Run("spend without billing customer", func(t *testing.T) {
+		// Budgets on spend are evaluated against the biller, which reports no spend for orgs without a billing customer
+		_, err := fix.Admin.DB.UpsertOrganizationBudget(ctx, orgID, &database.UpsertOrganizationBudgetOptions{
+			Amount:            100,
+			WarningThresholds: []int{50},
+			HardCap:           true,
+		})
+		require.NoError(t, err)
+		budget := check()
+		require.Equal(t, 0.0, budget.CurrentValue)
+		require.Equal(t, 0, budget.NotifiedThreshold)
+		require.False(t, budget.Capped)
+	})
+}
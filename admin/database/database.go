@@ -126,6 +126,23 @@ type DB interface {
 	// ResolveStaticRuntimeSlotsUsed returns the current slot usage for each runtime host as tracked by UpsertStaticRuntimeAssignment.
 	ResolveStaticRuntimeSlotsUsed(ctx context.Context) ([]*StaticRuntimeSlotsUsed, error)
 
+	// FindRuntimeHosts returns the runtime hosts in the pool managed by the "vm" runtime provisioner, including their current resource usage.
+	FindRuntimeHosts(ctx context.Context) ([]*RuntimeHost, error)
+	FindRuntimeHost(ctx context.Context, id string) (*RuntimeHost, error)
+	FindRuntimeHostByHost(ctx context.Context, host string) (*RuntimeHost, error)
+	// UpsertRuntimeHost registers a runtime host or updates its address and capacity. It does not change its draining or health state.
+	UpsertRuntimeHost(ctx context.Context, opts *UpsertRuntimeHostOptions) (*RuntimeHost, error)
+	UpdateRuntimeHostDraining(ctx context.Context, id string, draining bool) (*RuntimeHost, error)
+	UpdateRuntimeHostHealth(ctx context.Context, id string, opts *UpdateRuntimeHostHealthOptions) (*RuntimeHost, error)
+	// FindRuntimeHostAssignment returns the runtime host a provisioner resource is assigned to.
+	FindRuntimeHostAssignment(ctx context.Context, resourceID string) (*RuntimeHostAssignment, error)
+	// UpsertRuntimeHostAssignment assigns a provisioner resource to a runtime host, replacing any existing assignment.
+	// It returns a validation error if the host doesn't have room for the assignment, which may happen if another resource was assigned to it concurrently.
+	UpsertRuntimeHostAssignment(ctx context.Context, opts *UpsertRuntimeHostAssignmentOptions) (*RuntimeHostAssignment, error)
+	// DeleteRuntimeHostAssignment removes the assignment for a provisioner resource.
+	// The implementation should be idempotent.
+	DeleteRuntimeHostAssignment(ctx context.Context, resourceID string) error
+
 	FindUsers(ctx context.Context) ([]*User, error)
 	FindUsersByEmailPattern(ctx context.Context, emailPattern, afterEmail string, limit int) ([]*User, error)
 	FindUser(ctx context.Context, id string) (*User, error)
@@ -144,6 +161,7 @@ type DB interface {
 	IncrementCurrentTrialOrgCount(ctx context.Context, userID string) error
 
 	FindUsergroupsForOrganizationAndUser(ctx context.Context, orgID, userID, afterName string, limit int) ([]*Usergroup, error)
+	FindUsergroup(ctx context.Context, groupID string) (*Usergroup, error)
 	FindUsergroupByName(ctx context.Context, orgName, name string) (*Usergroup, error)
 	CheckUsergroupExists(ctx context.Context, groupID string) (bool, error)
 	InsertManagedUsergroups(ctx context.Context, orgID string) error
@@ -361,6 +379,35 @@ type DB interface {
 
 	FindGitRepoTransfer(ctx context.Context, remote string) (*GitRepoTransfer, error)
 	InsertGitRepoTransfer(ctx context.Context, fromRemote, toRemote string) (*GitRepoTransfer, error)
+
+	// FindAuditEvents returns an organization's audit events matching the filters in opts, ordered from newest to oldest.
+	FindAuditEvents(ctx context.Context, opts *FindAuditEventsOptions) ([]*AuditEvent, error)
+	// InsertAuditEvent appends an event to an organization's audit log. Audit events can't be updated once inserted.
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
+	DeleteExpiredAuditEvents(ctx context.Context, retention time.Duration) error
+
+	// FindProjectSnapshots returns a project's snapshots ordered from newest to oldest.
+	// If beforeCreatedOn is not zero, only snapshots created before (beforeCreatedOn, beforeID) are returned.
+	FindProjectSnapshots(ctx context.Context, projectID string, beforeCreatedOn time.Time, beforeID string, limit int) ([]*ProjectSnapshot, error)
+	FindProjectSnapshot(ctx context.Context, id string) (*ProjectSnapshot, error)
+	// FindExpiredProjectSnapshots returns snapshots created more than retention ago.
+	FindExpiredProjectSnapshots(ctx context.Context, retention time.Duration, limit int) ([]*ProjectSnapshot, error)
+	InsertProjectSnapshot(ctx context.Context, opts *InsertProjectSnapshotOptions) (*ProjectSnapshot, error)
+	UpdateProjectSnapshot(ctx context.Context, id string, opts *UpdateProjectSnapshotOptions) (*ProjectSnapshot, error)
+	DeleteProjectSnapshot(ctx context.Context, id string) error
+
+	FindOrganizationBudgets(ctx context.Context, afterOrgID string, limit int) ([]*OrganizationBudget, error)
+	FindOrganizationBudget(ctx context.Context, orgID string) (*OrganizationBudget, error)
+	// UpsertOrganizationBudget creates or updates an organization's budget. It resets the budget's notification and cap state.
+	UpsertOrganizationBudget(ctx context.Context, orgID string, opts *UpsertOrganizationBudgetOptions) (*OrganizationBudget, error)
+	UpdateOrganizationBudgetState(ctx context.Context, orgID string, opts *UpdateOrganizationBudgetStateOptions) (*OrganizationBudget, error)
+	DeleteOrganizationBudget(ctx context.Context, orgID string) error
+
+	// FindOrganizationUsage returns the sum of an organization's values for a usage metric in the grains starting in [startTime, endTime). It returns 0 if no usage has been recorded.
+	FindOrganizationUsage(ctx context.Context, orgID, metric string, startTime, endTime time.Time) (float64, error)
+	// UpsertOrganizationUsage sets an organization's value for a usage metric in the grain starting at startTime, replacing any previous value for the grain.
+	UpsertOrganizationUsage(ctx context.Context, orgID, metric string, startTime time.Time, value float64) error
+	DeleteOrganizationUsageBefore(ctx context.Context, startTime time.Time) error
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -667,6 +714,59 @@ type StaticRuntimeSlotsUsed struct {
 	Slots int    `db:"slots"`
 }
 
+// RuntimeHost is a runtime host in the pool managed by the "vm" runtime provisioner.
+// The usage fields are computed from the host's assignments.
+type RuntimeHost struct {
+	ID            string     `db:"id"`
+	Host          string     `db:"host"`
+	Audience      string     `db:"audience"`
+	CPU           int        `db:"cpu"`
+	MemoryGB      int        `db:"memory_gb"`
+	Draining      bool       `db:"draining"`
+	Healthy       bool       `db:"healthy"`
+	FailedChecks  int        `db:"failed_checks"`
+	StatusMessage string     `db:"status_message"`
+	CheckedOn     *time.Time `db:"checked_on"`
+	CreatedOn     time.Time  `db:"created_on"`
+	UpdatedOn     time.Time  `db:"updated_on"`
+	CPUUsed       int        `db:"cpu_used"`
+	MemoryGBUsed  int        `db:"memory_gb_used"`
+	Instances     int        `db:"instances"`
+}
+
+// UpsertRuntimeHostOptions defines options for registering or updating a RuntimeHost.
+type UpsertRuntimeHostOptions struct {
+	Host     string `validate:"required"`
+	Audience string
+	CPU      int `validate:"gt=0"`
+	MemoryGB int `validate:"gt=0"`
+}
+
+// UpdateRuntimeHostHealthOptions defines options for updating the health state of a RuntimeHost.
+type UpdateRuntimeHostHealthOptions struct {
+	Healthy       bool
+	FailedChecks  int
+	StatusMessage string
+}
+
+// RuntimeHostAssignment is the assignment of a provisioner resource to a RuntimeHost.
+type RuntimeHostAssignment struct {
+	ResourceID string    `db:"resource_id"`
+	HostID     string    `db:"host_id"`
+	CPU        int       `db:"cpu"`
+	MemoryGB   int       `db:"memory_gb"`
+	CreatedOn  time.Time `db:"created_on"`
+	UpdatedOn  time.Time `db:"updated_on"`
+}
+
+// UpsertRuntimeHostAssignmentOptions defines options for assigning a provisioner resource to a RuntimeHost.
+type UpsertRuntimeHostAssignmentOptions struct {
+	ResourceID string `validate:"required"`
+	HostID     string `validate:"required"`
+	CPU        int    `validate:"gt=0"`
+	MemoryGB   int    `validate:"gt=0"`
+}
+
 // User is a person registered in Rill.
 // Users may belong to multiple organizations and projects.
 type User struct {
@@ -1532,3 +1632,152 @@ type GitRepoTransfer struct {
 	From string `db:"from_git_remote"`
 	To   string `db:"to_git_remote"`
 }
+
+// AuditEvent represents an entry in an organization's append-only audit log.
+type AuditEvent struct {
+	ID        string
+	OrgID     string  `db:"org_id"`
+	ProjectID *string `db:"project_id"`
+	// ActorType is the type of caller that performed the action (e.g. "user", "service" or "system").
+	ActorType       string `db:"actor_type"`
+	ActorID         string `db:"actor_id"`
+	ActorEmail      string `db:"actor_email"`
+	AssumedByUserID string `db:"assumed_by_user_id"`
+	// Action is a dot-separated name for the change, such as "org.member.role_update".
+	Action     string         `db:"action"`
+	TargetType string         `db:"target_type"`
+	TargetID   string         `db:"target_id"`
+	TargetName string         `db:"target_name"`
+	Before     map[string]any `db:"before_json"`
+	After      map[string]any `db:"after_json"`
+	ClientIP   string         `db:"client_ip"`
+	UserAgent  string         `db:"user_agent"`
+	CreatedOn  time.Time      `db:"created_on"`
+}
+
+// InsertAuditEventOptions defines options for inserting an AuditEvent.
+type InsertAuditEventOptions struct {
+	OrgID           string `validate:"required"`
+	ProjectID       *string
+	ActorType       string `validate:"required"`
+	ActorID         string
+	ActorEmail      string
+	AssumedByUserID string
+	Action          string `validate:"required"`
+	TargetType      string `validate:"required"`
+	TargetID        string
+	TargetName      string
+	Before          map[string]any
+	After           map[string]any
+	ClientIP        string
+	UserAgent       string
+}
+
+// FindAuditEventsOptions defines filters and pagination for FindAuditEvents.
+// Empty fields are not used for filtering.
+type FindAuditEventsOptions struct {
+	OrgID      string
+	ProjectID  string
+	ActorID    string
+	Actions    []string
+	TargetType string
+	TargetID   string
+	// Since and Until filter on the event's creation time. Since is inclusive and Until is exclusive.
+	Since time.Time
+	Until time.Time
+	// BeforeCreatedOn and BeforeID are a cursor for paginating backwards from the previous page's last event.
+	BeforeCreatedOn time.Time
+	BeforeID        string
+	Limit           int
+}
+
+// ProjectSnapshotStatus is an enum representing the state of a project snapshot.
+type ProjectSnapshotStatus int
+
+const (
+	ProjectSnapshotStatusUnspecified ProjectSnapshotStatus = 0
+	ProjectSnapshotStatusPending     ProjectSnapshotStatus = 1
+	ProjectSnapshotStatusOK          ProjectSnapshotStatus = 2
+	ProjectSnapshotStatusErrored     ProjectSnapshotStatus = 3
+)
+
+func (s ProjectSnapshotStatus) String() string {
+	switch s {
+	case ProjectSnapshotStatusPending:
+		return "Pending"
+	case ProjectSnapshotStatusOK:
+		return "OK"
+	case ProjectSnapshotStatusErrored:
+		return "Errored"
+	default:
+		return "Unspecified"
+	}
+}
+
+// ProjectSnapshot is a point-in-time copy of a deployment's OLAP data and catalog.
+// The snapshot data is stored by the deployment's runtime; this is the admin service's record of it.
+type ProjectSnapshot struct {
+	ID              string                `db:"id"`
+	ProjectID       string                `db:"project_id"`
+	DeploymentID    string                `db:"deployment_id"`
+	Status          ProjectSnapshotStatus `db:"status"`
+	StatusMessage   string                `db:"status_message"`
+	CreatedByUserID *string               `db:"created_by_user_id"`
+	SizeBytes       int64                 `db:"size_bytes"`
+	Tables          []string              `db:"tables"`
+	CreatedOn       time.Time             `db:"created_on"`
+	UpdatedOn       time.Time             `db:"updated_on"`
+}
+
+// InsertProjectSnapshotOptions defines options for inserting a ProjectSnapshot.
+type InsertProjectSnapshotOptions struct {
+	ProjectID       string `validate:"required"`
+	DeploymentID    string `validate:"required"`
+	Status          ProjectSnapshotStatus
+	CreatedByUserID *string
+}
+
+// UpdateProjectSnapshotOptions defines options for updating a ProjectSnapshot.
+type UpdateProjectSnapshotOptions struct {
+	Status        ProjectSnapshotStatus
+	StatusMessage string
+	SizeBytes     int64
+	Tables        []string
+}
+
+// OrganizationBudget is a monthly budget for an organization's spend or usage of a billable metric.
+// The state fields are maintained by the background job that evaluates the budget and are reset at the start of each period.
+type OrganizationBudget struct {
+	OrgID string `db:"org_id"`
+	// Metric is the billable usage metric the budget applies to. It is empty for budgets on the org's spend.
+	Metric string  `db:"metric"`
+	Amount float64 `db:"amount"`
+	// WarningThresholds are percentages of Amount at which the org's admins are notified.
+	WarningThresholds []int      `db:"warning_thresholds"`
+	HardCap           bool       `db:"hard_cap"`
+	PeriodStart       *time.Time `db:"period_start"`
+	CurrentValue      float64    `db:"current_value"`
+	// NotifiedThreshold is the highest warning threshold that has been notified in the current period.
+	NotifiedThreshold int        `db:"notified_threshold"`
+	Capped            bool       `db:"capped"`
+	EvaluatedOn       *time.Time `db:"evaluated_on"`
+	CreatedOn         time.Time  `db:"created_on"`
+	UpdatedOn         time.Time  `db:"updated_on"`
+}
+
+// UpsertOrganizationBudgetOptions defines options for creating or updating an OrganizationBudget.
+type UpsertOrganizationBudgetOptions struct {
+	Metric            string
+	Amount            float64 `validate:"gt=0"`
+	WarningThresholds []int   `validate:"dive,gt=0"`
+	HardCap           bool
+}
+
+// UpdateOrganizationBudgetStateOptions defines options for updating the evaluation state of an OrganizationBudget.
+type UpdateOrganizationBudgetStateOptions struct {
+	PeriodStart       time.Time
+	CurrentValue      float64
+	NotifiedThreshold int
+	Capped            bool
+	EvaluatedOn       time.Time
+}
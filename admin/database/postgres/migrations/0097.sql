@@ -0,0 +1,32 @@
+-- The org_id is not a foreign key so that an org's audit log outlives the org.
+CREATE TABLE audit_events (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    org_id UUID NOT NULL,
+    project_id UUID,
+    actor_type TEXT NOT NULL,
+    actor_id TEXT NOT NULL DEFAULT '',
+    actor_email TEXT NOT NULL DEFAULT '',
+    assumed_by_user_id TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL DEFAULT '',
+    target_name TEXT NOT NULL DEFAULT '',
+    before_json JSONB,
+    after_json JSONB,
+    client_ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX audit_events_org_id_created_on_idx ON audit_events (org_id, created_on DESC, id DESC);
+CREATE INDEX audit_events_created_on_idx ON audit_events (created_on);
+
+-- Audit events are append-only. Rows may only be removed by the retention job.
+CREATE FUNCTION audit_events_reject_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_reject_update BEFORE UPDATE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_reject_update();
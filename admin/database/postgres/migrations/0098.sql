@@ -0,0 +1,15 @@
+CREATE TABLE project_snapshots (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    deployment_id UUID NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
+    status INTEGER NOT NULL DEFAULT 0,
+    status_message TEXT NOT NULL DEFAULT '',
+    created_by_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    tables TEXT[] NOT NULL DEFAULT '{}'::TEXT[],
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX project_snapshots_project_id_created_on_idx ON project_snapshots (project_id, created_on DESC, id DESC);
+CREATE INDEX project_snapshots_created_on_idx ON project_snapshots (created_on);
@@ -0,0 +1,27 @@
+CREATE TABLE org_budgets (
+    org_id UUID PRIMARY KEY REFERENCES orgs (id) ON DELETE CASCADE,
+    metric TEXT NOT NULL DEFAULT '',
+    amount DOUBLE PRECISION NOT NULL,
+    warning_thresholds INTEGER[] NOT NULL DEFAULT '{}'::INTEGER[],
+    hard_cap BOOLEAN NOT NULL DEFAULT FALSE,
+    period_start TIMESTAMPTZ,
+    current_value DOUBLE PRECISION NOT NULL DEFAULT 0,
+    notified_threshold INTEGER NOT NULL DEFAULT 0,
+    capped BOOLEAN NOT NULL DEFAULT FALSE,
+    evaluated_on TIMESTAMPTZ,
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- Per-grain totals of the counter metrics reported by the billing reporter, used to evaluate usage budgets.
+-- Each row is overwritten when its grain is reported again, so retried reporting runs don't count usage twice.
+CREATE TABLE org_usage (
+    org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+    metric TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    value DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    PRIMARY KEY (org_id, metric, start_time)
+);
+
+CREATE INDEX org_usage_start_time_idx ON org_usage (start_time);
@@ -0,0 +1,29 @@
+-- Runtime hosts in the pool managed by the "vm" provisioner.
+CREATE TABLE runtime_hosts (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    host TEXT NOT NULL,
+    audience TEXT NOT NULL DEFAULT '',
+    cpu INTEGER NOT NULL,
+    memory_gb INTEGER NOT NULL,
+    draining BOOLEAN NOT NULL DEFAULT FALSE,
+    healthy BOOLEAN NOT NULL DEFAULT TRUE,
+    failed_checks INTEGER NOT NULL DEFAULT 0,
+    status_message TEXT NOT NULL DEFAULT '',
+    checked_on TIMESTAMPTZ,
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX runtime_hosts_host_idx ON runtime_hosts (lower(host));
+
+CREATE TABLE runtime_host_assignments (
+    -- This could be a foreign key to provisioner_resources.id, but we don't enforce it to avoid tight coupling with the provisioner's internal data model.
+    resource_id UUID PRIMARY KEY,
+    host_id UUID NOT NULL REFERENCES runtime_hosts (id) ON DELETE RESTRICT,
+    cpu INTEGER NOT NULL,
+    memory_gb INTEGER NOT NULL,
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX runtime_host_assignments_host_id_idx ON runtime_host_assignments (host_id);
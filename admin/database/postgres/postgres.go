@@ -827,6 +827,166 @@ func (c *connection) ResolveStaticRuntimeSlotsUsed(ctx context.Context) ([]*data
 	return res, nil
 }
 
+func (c *connection) FindRuntimeHosts(ctx context.Context) ([]*database.RuntimeHost, error) {
+	var res []*database.RuntimeHost
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT h.*, COALESCE(SUM(a.cpu), 0) AS cpu_used, COALESCE(SUM(a.memory_gb), 0) AS memory_gb_used, COUNT(a.resource_id) AS instances
+		FROM runtime_hosts h LEFT JOIN runtime_host_assignments a ON a.host_id = h.id
+		GROUP BY h.id
+		ORDER BY lower(h.host)
+	`)
+	if err != nil {
+		return nil, parseErr("runtime hosts", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindRuntimeHost(ctx context.Context, id string) (*database.RuntimeHost, error) {
+	res := &database.RuntimeHost{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT h.*, COALESCE(SUM(a.cpu), 0) AS cpu_used, COALESCE(SUM(a.memory_gb), 0) AS memory_gb_used, COUNT(a.resource_id) AS instances
+		FROM runtime_hosts h LEFT JOIN runtime_host_assignments a ON a.host_id = h.id
+		WHERE h.id = $1
+		GROUP BY h.id
+	`, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("runtime host", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindRuntimeHostByHost(ctx context.Context, host string) (*database.RuntimeHost, error) {
+	res := &database.RuntimeHost{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT h.*, COALESCE(SUM(a.cpu), 0) AS cpu_used, COALESCE(SUM(a.memory_gb), 0) AS memory_gb_used, COUNT(a.resource_id) AS instances
+		FROM runtime_hosts h LEFT JOIN runtime_host_assignments a ON a.host_id = h.id
+		WHERE lower(h.host) = lower($1)
+		GROUP BY h.id
+	`, host).StructScan(res)
+	if err != nil {
+		return nil, parseErr("runtime host", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpsertRuntimeHost(ctx context.Context, opts *database.UpsertRuntimeHostOptions) (*database.RuntimeHost, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var id string
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO runtime_hosts (host, audience, cpu, memory_gb)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (lower(host)) DO UPDATE SET
+			host=EXCLUDED.host,
+			audience=EXCLUDED.audience,
+			cpu=EXCLUDED.cpu,
+			memory_gb=EXCLUDED.memory_gb,
+			updated_on=now()
+		RETURNING id`,
+		opts.Host, opts.Audience, opts.CPU, opts.MemoryGB,
+	).Scan(&id)
+	if err != nil {
+		return nil, parseErr("runtime host", err)
+	}
+	return c.FindRuntimeHost(ctx, id)
+}
+
+func (c *connection) UpdateRuntimeHostDraining(ctx context.Context, id string, draining bool) (*database.RuntimeHost, error) {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE runtime_hosts SET draining=$1, updated_on=now() WHERE id=$2", draining, id)
+	if err := checkUpdateRow("runtime host", res, err); err != nil {
+		return nil, err
+	}
+	return c.FindRuntimeHost(ctx, id)
+}
+
+func (c *connection) UpdateRuntimeHostHealth(ctx context.Context, id string, opts *database.UpdateRuntimeHostHealthOptions) (*database.RuntimeHost, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE runtime_hosts SET healthy=$1, failed_checks=$2, status_message=$3, checked_on=now(), updated_on=now() WHERE id=$4`,
+		opts.Healthy, opts.FailedChecks, opts.StatusMessage, id,
+	)
+	if err := checkUpdateRow("runtime host", res, err); err != nil {
+		return nil, err
+	}
+	return c.FindRuntimeHost(ctx, id)
+}
+
+func (c *connection) FindRuntimeHostAssignment(ctx context.Context, resourceID string) (*database.RuntimeHostAssignment, error) {
+	res := &database.RuntimeHostAssignment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM runtime_host_assignments WHERE resource_id=$1", resourceID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("runtime host assignment", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpsertRuntimeHostAssignment(ctx context.Context, opts *database.UpsertRuntimeHostAssignmentOptions) (*database.RuntimeHostAssignment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := c.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Lock the host so concurrent assignments to it are serialized.
+	// The capacity check must be a separate statement so it sees assignments committed while waiting for the lock.
+	var cpu, memoryGB int
+	err = c.getDB(ctx).QueryRowxContext(ctx, "SELECT cpu, memory_gb FROM runtime_hosts WHERE id=$1 FOR UPDATE", opts.HostID).Scan(&cpu, &memoryGB)
+	if err != nil {
+		return nil, parseErr("runtime host", err)
+	}
+	var cpuUsed, memoryGBUsed int
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT COALESCE(SUM(cpu), 0), COALESCE(SUM(memory_gb), 0) FROM runtime_host_assignments WHERE host_id=$1 AND resource_id<>$2`,
+		opts.HostID, opts.ResourceID,
+	).Scan(&cpuUsed, &memoryGBUsed)
+	if err != nil {
+		return nil, parseErr("runtime host assignment", err)
+	}
+	if cpuUsed+opts.CPU > cpu || memoryGBUsed+opts.MemoryGB > memoryGB {
+		return nil, database.NewValidationError("runtime host does not have sufficient available capacity")
+	}
+
+	res := &database.RuntimeHostAssignment{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO runtime_host_assignments (resource_id, host_id, cpu, memory_gb)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (resource_id) DO UPDATE SET
+			host_id=EXCLUDED.host_id,
+			cpu=EXCLUDED.cpu,
+			memory_gb=EXCLUDED.memory_gb,
+			updated_on=now()
+		RETURNING *`,
+		opts.ResourceID, opts.HostID, opts.CPU, opts.MemoryGB,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("runtime host assignment", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteRuntimeHostAssignment(ctx context.Context, resourceID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM runtime_host_assignments WHERE resource_id=$1", resourceID)
+	if err != nil {
+		// Not using checkDeleteRow because this is operation must be idempotent, so the row may not exist.
+		return parseErr("runtime host assignment", err)
+	}
+	return nil
+}
+
 func (c *connection) FindUsers(ctx context.Context) ([]*database.User, error) {
 	var res []*database.User
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT u.* FROM users u")
@@ -1010,6 +1170,15 @@ func (c *connection) FindUsergroupsForOrganizationAndUser(ctx context.Context, o
 	return res, nil
 }
 
+func (c *connection) FindUsergroup(ctx context.Context, groupID string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE id=$1", groupID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindUsergroupByName(ctx context.Context, orgName, name string) (*database.Usergroup, error) {
 	res := &database.Usergroup{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
@@ -3477,6 +3646,317 @@ func (c *connection) InsertGitRepoTransfer(ctx context.Context, fromRemote, toRe
 	return res, nil
 }
 
+func (c *connection) FindAuditEvents(ctx context.Context, opts *database.FindAuditEventsOptions) ([]*database.AuditEvent, error) {
+	n := 1
+	where := fmt.Sprintf("org_id=$%d", n)
+	args := []any{opts.OrgID}
+	n++
+
+	if opts.ProjectID != "" {
+		where = fmt.Sprintf("%s AND project_id=$%d", where, n)
+		args = append(args, opts.ProjectID)
+		n++
+	}
+
+	if opts.ActorID != "" {
+		where = fmt.Sprintf("%s AND actor_id=$%d", where, n)
+		args = append(args, opts.ActorID)
+		n++
+	}
+
+	if len(opts.Actions) > 0 {
+		where = fmt.Sprintf("%s AND action=ANY($%d)", where, n)
+		args = append(args, opts.Actions)
+		n++
+	}
+
+	if opts.TargetType != "" {
+		where = fmt.Sprintf("%s AND target_type=$%d", where, n)
+		args = append(args, opts.TargetType)
+		n++
+	}
+
+	if opts.TargetID != "" {
+		where = fmt.Sprintf("%s AND target_id=$%d", where, n)
+		args = append(args, opts.TargetID)
+		n++
+	}
+
+	if !opts.Since.IsZero() {
+		where = fmt.Sprintf("%s AND created_on>=$%d", where, n)
+		args = append(args, opts.Since)
+		n++
+	}
+
+	if !opts.Until.IsZero() {
+		where = fmt.Sprintf("%s AND created_on<$%d", where, n)
+		args = append(args, opts.Until)
+		n++
+	}
+
+	if !opts.BeforeCreatedOn.IsZero() {
+		where = fmt.Sprintf("%s AND (created_on<$%d OR created_on=$%d AND id<$%d)", where, n, n, n+1)
+		args = append(args, opts.BeforeCreatedOn, opts.BeforeID)
+		n += 2
+	}
+
+	qry := fmt.Sprintf("SELECT * FROM audit_events WHERE %s ORDER BY created_on DESC, id DESC LIMIT $%d", where, n)
+	args = append(args, opts.Limit)
+
+	var dtos []*auditEventDTO
+	err := c.getDB(ctx).SelectContext(ctx, &dtos, qry, args...)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+
+	res := make([]*database.AuditEvent, len(dtos))
+	for i, dto := range dtos {
+		res[i], err = dto.auditEventFromDTO()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	before, err := marshalAuditEventState(opts.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := marshalAuditEventState(opts.After)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &auditEventDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, project_id, actor_type, actor_id, actor_email, assumed_by_user_id, action, target_type, target_id, target_name, before_json, after_json, client_ip, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING *`,
+		opts.OrgID, opts.ProjectID, opts.ActorType, opts.ActorID, opts.ActorEmail, opts.AssumedByUserID, opts.Action, opts.TargetType, opts.TargetID, opts.TargetName, before, after, opts.ClientIP, opts.UserAgent,
+	).StructScan(dto)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return dto.auditEventFromDTO()
+}
+
+func (c *connection) DeleteExpiredAuditEvents(ctx context.Context, retention time.Duration) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM audit_events WHERE created_on + $1 < now()", retention)
+	return parseErr("audit events", err)
+}
+
+func (c *connection) FindProjectSnapshots(ctx context.Context, projectID string, beforeCreatedOn time.Time, beforeID string, limit int) ([]*database.ProjectSnapshot, error) {
+	var res []*projectSnapshotDTO
+	var err error
+	if beforeCreatedOn.IsZero() {
+		err = c.getDB(ctx).SelectContext(ctx, &res, `
+			SELECT * FROM project_snapshots WHERE project_id=$1
+			ORDER BY created_on DESC, id DESC LIMIT $2`,
+			projectID, limit,
+		)
+	} else {
+		err = c.getDB(ctx).SelectContext(ctx, &res, `
+			SELECT * FROM project_snapshots WHERE project_id=$1 AND (created_on, id) < ($2, $3)
+			ORDER BY created_on DESC, id DESC LIMIT $4`,
+			projectID, beforeCreatedOn, beforeID, limit,
+		)
+	}
+	if err != nil {
+		return nil, parseErr("project snapshots", err)
+	}
+	return projectSnapshotsFromDTOs(res)
+}
+
+func (c *connection) FindProjectSnapshot(ctx context.Context, id string) (*database.ProjectSnapshot, error) {
+	res := &projectSnapshotDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_snapshots WHERE id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project snapshot", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) FindExpiredProjectSnapshots(ctx context.Context, retention time.Duration, limit int) ([]*database.ProjectSnapshot, error) {
+	var res []*projectSnapshotDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM project_snapshots WHERE created_on + $1 < now() ORDER BY created_on LIMIT $2", retention, limit)
+	if err != nil {
+		return nil, parseErr("project snapshots", err)
+	}
+	return projectSnapshotsFromDTOs(res)
+}
+
+func (c *connection) InsertProjectSnapshot(ctx context.Context, opts *database.InsertProjectSnapshotOptions) (*database.ProjectSnapshot, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &projectSnapshotDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO project_snapshots (project_id, deployment_id, status, created_by_user_id)
+		VALUES ($1, $2, $3, $4) RETURNING *`,
+		opts.ProjectID, opts.DeploymentID, opts.Status, opts.CreatedByUserID,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project snapshot", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) UpdateProjectSnapshot(ctx context.Context, id string, opts *database.UpdateProjectSnapshotOptions) (*database.ProjectSnapshot, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tables := opts.Tables
+	if tables == nil {
+		tables = []string{}
+	}
+
+	res := &projectSnapshotDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE project_snapshots SET status=$1, status_message=$2, size_bytes=$3, tables=$4, updated_on=now()
+		WHERE id=$5 RETURNING *`,
+		opts.Status, opts.StatusMessage, opts.SizeBytes, tables, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project snapshot", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) DeleteProjectSnapshot(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_snapshots WHERE id=$1", id)
+	return checkDeleteRow("project snapshot", res, err)
+}
+
+func (c *connection) FindOrganizationBudgets(ctx context.Context, afterOrgID string, limit int) ([]*database.OrganizationBudget, error) {
+	var res []*orgBudgetDTO
+	var err error
+	if afterOrgID != "" {
+		err = c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM org_budgets WHERE org_id > $1 ORDER BY org_id LIMIT $2", afterOrgID, limit)
+	} else {
+		err = c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM org_budgets ORDER BY org_id LIMIT $1", limit)
+	}
+	if err != nil {
+		return nil, parseErr("org budgets", err)
+	}
+	return orgBudgetsFromDTOs(res)
+}
+
+func (c *connection) FindOrganizationBudget(ctx context.Context, orgID string) (*database.OrganizationBudget, error) {
+	res := &orgBudgetDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_budgets WHERE org_id=$1", orgID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org budget", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) UpsertOrganizationBudget(ctx context.Context, orgID string, opts *database.UpsertOrganizationBudgetOptions) (*database.OrganizationBudget, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	warningThresholds := opts.WarningThresholds
+	if warningThresholds == nil {
+		warningThresholds = []int{}
+	}
+	thresholds := pgtype.Int4Array{}
+	if err := thresholds.Set(warningThresholds); err != nil {
+		return nil, err
+	}
+
+	res := &orgBudgetDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO org_budgets (org_id, metric, amount, warning_thresholds, hard_cap)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (org_id) DO UPDATE SET
+			metric=EXCLUDED.metric,
+			amount=EXCLUDED.amount,
+			warning_thresholds=EXCLUDED.warning_thresholds,
+			hard_cap=EXCLUDED.hard_cap,
+			notified_threshold=0,
+			capped=false,
+			updated_on=now()
+		RETURNING *`,
+		orgID, opts.Metric, opts.Amount, thresholds, opts.HardCap,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org budget", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) UpdateOrganizationBudgetState(ctx context.Context, orgID string, opts *database.UpdateOrganizationBudgetStateOptions) (*database.OrganizationBudget, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &orgBudgetDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE org_budgets SET period_start=$1, current_value=$2, notified_threshold=$3, capped=$4, evaluated_on=$5
+		WHERE org_id=$6 RETURNING *`,
+		opts.PeriodStart, opts.CurrentValue, opts.NotifiedThreshold, opts.Capped, opts.EvaluatedOn, orgID,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org budget", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) DeleteOrganizationBudget(ctx context.Context, orgID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM org_budgets WHERE org_id=$1", orgID)
+	return checkDeleteRow("org budget", res, err)
+}
+
+func (c *connection) FindOrganizationUsage(ctx context.Context, orgID, metric string, startTime, endTime time.Time) (float64, error) {
+	var res float64
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT COALESCE(SUM(value), 0) FROM org_usage WHERE org_id=$1 AND metric=$2 AND start_time >= $3 AND start_time < $4", orgID, metric, startTime, endTime).Scan(&res)
+	if err != nil {
+		return 0, parseErr("org usage", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpsertOrganizationUsage(ctx context.Context, orgID, metric string, startTime time.Time, value float64) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		INSERT INTO org_usage (org_id, metric, start_time, value)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (org_id, metric, start_time) DO UPDATE SET value=EXCLUDED.value, updated_on=now()`,
+		orgID, metric, startTime, value,
+	)
+	if err != nil {
+		return parseErr("org usage", err)
+	}
+	return nil
+}
+
+func (c *connection) DeleteOrganizationUsageBefore(ctx context.Context, startTime time.Time) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM org_usage WHERE start_time < $1", startTime)
+	if err != nil {
+		return parseErr("org usage", err)
+	}
+	return nil
+}
+
+// marshalAuditEventState serializes the before or after state of an audit event. A nil state is stored as NULL.
+func marshalAuditEventState(state map[string]any) (*string, error) {
+	if state == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize audit event state: %w", err)
+	}
+	res := string(data)
+	return &res, nil
+}
+
 // projectDTO wraps database.Project, using the pgtype package to handle types that pgx can't read directly into their native Go types.
 type projectDTO struct {
 	*database.Project
@@ -3796,6 +4276,54 @@ func (dto *authClientDTO) AsModel() (*database.AuthClient, error) {
 	return dto.AuthClient, nil
 }
 
+type projectSnapshotDTO struct {
+	*database.ProjectSnapshot
+	Tables pgtype.TextArray `db:"tables"`
+}
+
+func (dto *projectSnapshotDTO) AsModel() (*database.ProjectSnapshot, error) {
+	if err := dto.Tables.AssignTo(&dto.ProjectSnapshot.Tables); err != nil {
+		return nil, err
+	}
+	return dto.ProjectSnapshot, nil
+}
+
+func projectSnapshotsFromDTOs(dtos []*projectSnapshotDTO) ([]*database.ProjectSnapshot, error) {
+	res := make([]*database.ProjectSnapshot, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = dto.AsModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+type orgBudgetDTO struct {
+	*database.OrganizationBudget
+	WarningThresholds pgtype.Int4Array `db:"warning_thresholds"`
+}
+
+func (dto *orgBudgetDTO) AsModel() (*database.OrganizationBudget, error) {
+	if err := dto.WarningThresholds.AssignTo(&dto.OrganizationBudget.WarningThresholds); err != nil {
+		return nil, err
+	}
+	return dto.OrganizationBudget, nil
+}
+
+func orgBudgetsFromDTOs(dtos []*orgBudgetDTO) ([]*database.OrganizationBudget, error) {
+	res := make([]*database.OrganizationBudget, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = dto.AsModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 type billingIssueDTO struct {
 	ID               string                     `db:"id"`
 	OrgID            string                     `db:"org_id"`
@@ -3908,6 +4436,25 @@ func (d *projectMemberServiceWithProjectDTO) projectMemberServiceWithProjectFrom
 	return d.ProjectMemberServiceWithProject, nil
 }
 
+// auditEventDTO wraps database.AuditEvent, using the pgtype package to read the JSONB state columns.
+type auditEventDTO struct {
+	*database.AuditEvent
+	Before pgtype.JSON `db:"before_json"`
+	After  pgtype.JSON `db:"after_json"`
+}
+
+func (dto *auditEventDTO) auditEventFromDTO() (*database.AuditEvent, error) {
+	err := dto.Before.AssignTo(&dto.AuditEvent.Before)
+	if err != nil {
+		return nil, err
+	}
+	err = dto.After.AssignTo(&dto.AuditEvent.After)
+	if err != nil {
+		return nil, err
+	}
+	return dto.AuditEvent, nil
+}
+
 type organizationMemberUserDTO struct {
 	*database.OrganizationMemberUser
 	Attributes pgtype.JSON `db:"attributes"`
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
 	"github.com/stretchr/testify/require"
@@ -47,6 +48,10 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestOrganizationMemberUserAttributes", func(t *testing.T) { testOrganizationMemberUserAttributes(t, db) })
 	t.Run("TestOrganizationInviteAttributes", func(t *testing.T) { testOrganizationInviteAttributes(t, db) })
 	t.Run("TestAttributeValidation", func(t *testing.T) { testAttributeValidation(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
+	t.Run("TestProjectSnapshots", func(t *testing.T) { testProjectSnapshots(t, db) })
+	t.Run("TestOrganizationBudgets", func(t *testing.T) { testOrganizationBudgets(t, db) })
+	t.Run("TestRuntimeHosts", func(t *testing.T) { testRuntimeHosts(t, db) })
 
 	t.Run("TestOrgNameValidation", func(t *testing.T) {
 		cases := []struct {
@@ -914,6 +919,363 @@ func testAttributeValidation(t *testing.T, db database.DB) {
 	require.NoError(t, db.DeleteUser(ctx, user.ID))
 }
 
+func testAuditEvents(t *testing.T, db database.DB) {
+	orgID, projectID, userID := seed(t, db)
+
+	ctx := context.Background()
+	// insert some events
+	e1, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:      orgID,
+		ActorType:  "user",
+		ActorID:    userID,
+		Action:     "org.member.add",
+		TargetType: "user",
+		TargetID:   userID,
+		After:      map[string]any{"role": "admin"},
+		ClientIP:   "127.0.0.1",
+	})
+	require.NoError(t, err)
+	require.Nil(t, e1.ProjectID)
+	require.Nil(t, e1.Before)
+	require.Equal(t, map[string]any{"role": "admin"}, e1.After)
+
+	e2, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:      orgID,
+		ProjectID:  &projectID,
+		ActorType:  "user",
+		ActorID:    userID,
+		Action:     "project.member.role_update",
+		TargetType: "user",
+		TargetID:   userID,
+		Before:     map[string]any{"role": "viewer"},
+		After:      map[string]any{"role": "editor"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, projectID, *e2.ProjectID)
+
+	e3, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:      orgID,
+		ProjectID:  &projectID,
+		ActorType:  "system",
+		Action:     "deployment.stop",
+		TargetType: "deployment",
+	})
+	require.NoError(t, err)
+
+	// validation
+	_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: orgID, ActorType: "user"})
+	require.Error(t, err)
+
+	// find all events, newest first
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, e3.ID, events[0].ID)
+	require.Equal(t, e1.ID, events[2].ID)
+
+	// filters
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, ProjectID: projectID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, ActorID: userID, Actions: []string{"org.member.add"}, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, e1.ID, events[0].ID)
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, TargetType: "deployment", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, e3.ID, events[0].ID)
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, Until: e1.CreatedOn, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// pagination
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	last := events[1]
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, BeforeCreatedOn: last.CreatedOn, BeforeID: last.ID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, e1.ID, events[0].ID)
+
+	// events are append-only
+	_, err = db.(*connection).db.ExecContext(ctx, "UPDATE audit_events SET action='tampered' WHERE id=$1", e1.ID)
+	require.Error(t, err)
+
+	// retention
+	require.NoError(t, db.DeleteExpiredAuditEvents(ctx, time.Hour))
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	require.NoError(t, db.DeleteExpiredAuditEvents(ctx, 0))
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: orgID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// cleanup
+	require.NoError(t, db.DeleteProject(ctx, projectID))
+	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
+	require.NoError(t, db.DeleteUser(ctx, userID))
+}
+
+func testProjectSnapshots(t *testing.T, db database.DB) {
+	_, projectID, userID := seed(t, db)
+	ctx := context.Background()
+
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         projectID,
+		Environment:       "prod",
+		Branch:            "main",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "instance",
+		RuntimeAudience:   "http://localhost:9091",
+		Status:            database.DeploymentStatusRunning,
+		DesiredStatus:     database.DeploymentStatusRunning,
+	})
+	require.NoError(t, err)
+
+	s1, err := db.InsertProjectSnapshot(ctx, &database.InsertProjectSnapshotOptions{
+		ProjectID:    projectID,
+		DeploymentID: depl.ID,
+		Status:       database.ProjectSnapshotStatusPending,
+	})
+	require.NoError(t, err)
+	require.Empty(t, s1.Tables)
+	require.Nil(t, s1.CreatedByUserID)
+
+	s2, err := db.InsertProjectSnapshot(ctx, &database.InsertProjectSnapshotOptions{
+		ProjectID:       projectID,
+		DeploymentID:    depl.ID,
+		Status:          database.ProjectSnapshotStatusPending,
+		CreatedByUserID: &userID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, userID, *s2.CreatedByUserID)
+
+	// update
+	s1, err = db.UpdateProjectSnapshot(ctx, s1.ID, &database.UpdateProjectSnapshotOptions{
+		Status:    database.ProjectSnapshotStatusOK,
+		SizeBytes: 100,
+		Tables:    []string{"a", "b"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.ProjectSnapshotStatusOK, s1.Status)
+	require.Equal(t, []string{"a", "b"}, s1.Tables)
+
+	s1, err = db.FindProjectSnapshot(ctx, s1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), s1.SizeBytes)
+
+	// find newest first, with pagination
+	snapshots, err := db.FindProjectSnapshots(ctx, projectID, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, s2.ID, snapshots[0].ID)
+
+	snapshots, err = db.FindProjectSnapshots(ctx, projectID, s2.CreatedOn, s2.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, s1.ID, snapshots[0].ID)
+
+	// expiry
+	snapshots, err = db.FindExpiredProjectSnapshots(ctx, time.Hour, 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 0)
+	snapshots, err = db.FindExpiredProjectSnapshots(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+
+	// delete
+	require.NoError(t, db.DeleteProjectSnapshot(ctx, s1.ID))
+	_, err = db.FindProjectSnapshot(ctx, s1.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	require.ErrorIs(t, db.DeleteProjectSnapshot(ctx, s1.ID), database.ErrNotFound)
+
+	// snapshots are deleted with their deployment
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	_, err = db.FindProjectSnapshot(ctx, s2.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// cleanup
+	require.NoError(t, db.DeleteProject(ctx, projectID))
+	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
+	require.NoError(t, db.DeleteUser(ctx, userID))
+}
+
+func testOrganizationBudgets(t *testing.T, db database.DB) {
+	orgID, projectID, userID := seed(t, db)
+	ctx := context.Background()
+
+	_, err := db.FindOrganizationBudget(ctx, orgID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// validation
+	_, err = db.UpsertOrganizationBudget(ctx, orgID, &database.UpsertOrganizationBudgetOptions{Amount: 0})
+	require.ErrorIs(t, err, database.ErrValidation)
+
+	// insert
+	b, err := db.UpsertOrganizationBudget(ctx, orgID, &database.UpsertOrganizationBudgetOptions{
+		Amount:            100,
+		WarningThresholds: []int{50, 80, 100},
+		HardCap:           true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{50, 80, 100}, b.WarningThresholds)
+	require.Nil(t, b.PeriodStart)
+
+	// state
+	periodStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b, err = db.UpdateOrganizationBudgetState(ctx, orgID, &database.UpdateOrganizationBudgetStateOptions{
+		PeriodStart:       periodStart,
+		CurrentValue:      120,
+		NotifiedThreshold: 100,
+		Capped:            true,
+		EvaluatedOn:       time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 100, b.NotifiedThreshold)
+	require.True(t, b.Capped)
+
+	// update resets the notification and cap state
+	b, err = db.UpsertOrganizationBudget(ctx, orgID, &database.UpsertOrganizationBudgetOptions{
+		Metric: "managed_storage_bytes",
+		Amount: 1000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "managed_storage_bytes", b.Metric)
+	require.Empty(t, b.WarningThresholds)
+	require.Equal(t, 0, b.NotifiedThreshold)
+	require.False(t, b.Capped)
+	require.True(t, periodStart.Equal(*b.PeriodStart))
+
+	budgets, err := db.FindOrganizationBudgets(ctx, "", 10)
+	require.NoError(t, err)
+	require.Len(t, budgets, 1)
+	budgets, err = db.FindOrganizationBudgets(ctx, orgID, 10)
+	require.NoError(t, err)
+	require.Len(t, budgets, 0)
+
+	// usage
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	v, err := db.FindOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart, periodEnd)
+	require.NoError(t, err)
+	require.Equal(t, 0.0, v)
+	require.NoError(t, db.UpsertOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart, 10))
+	require.NoError(t, db.UpsertOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart.Add(time.Hour), 5))
+	require.NoError(t, db.UpsertOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodEnd, 100))
+	v, err = db.FindOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart, periodEnd)
+	require.NoError(t, err)
+	require.Equal(t, 15.0, v)
+
+	// re-reporting a grain replaces its value
+	require.NoError(t, db.UpsertOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart.Add(time.Hour), 5))
+	v, err = db.FindOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart, periodEnd)
+	require.NoError(t, err)
+	require.Equal(t, 15.0, v)
+
+	require.NoError(t, db.DeleteOrganizationUsageBefore(ctx, periodEnd))
+	v, err = db.FindOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodStart, periodEnd)
+	require.NoError(t, err)
+	require.Equal(t, 0.0, v)
+	v, err = db.FindOrganizationUsage(ctx, orgID, "managed_storage_bytes", periodEnd, periodEnd.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Equal(t, 100.0, v)
+
+	// delete
+	require.NoError(t, db.DeleteOrganizationBudget(ctx, orgID))
+	require.ErrorIs(t, db.DeleteOrganizationBudget(ctx, orgID), database.ErrNotFound)
+
+	// cleanup
+	require.NoError(t, db.DeleteProject(ctx, projectID))
+	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
+	require.NoError(t, db.DeleteUser(ctx, userID))
+}
+
+func testRuntimeHosts(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	_, err := db.FindRuntimeHostByHost(ctx, "http://vm-1:9090")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// validation
+	_, err = db.UpsertRuntimeHost(ctx, &database.UpsertRuntimeHostOptions{Host: "http://vm-1:9090"})
+	require.ErrorIs(t, err, database.ErrValidation)
+
+	// insert
+	h1, err := db.UpsertRuntimeHost(ctx, &database.UpsertRuntimeHostOptions{Host: "http://vm-1:9090", CPU: 8, MemoryGB: 32})
+	require.NoError(t, err)
+	require.True(t, h1.Healthy)
+	require.False(t, h1.Draining)
+	require.Equal(t, 0, h1.Instances)
+	h2, err := db.UpsertRuntimeHost(ctx, &database.UpsertRuntimeHostOptions{Host: "http://vm-2:9090", CPU: 4, MemoryGB: 16})
+	require.NoError(t, err)
+
+	// update keeps the draining and health state
+	h1, err = db.UpdateRuntimeHostDraining(ctx, h1.ID, true)
+	require.NoError(t, err)
+	require.True(t, h1.Draining)
+	h1, err = db.UpsertRuntimeHost(ctx, &database.UpsertRuntimeHostOptions{Host: "http://VM-1:9090", Audience: "aud", CPU: 16, MemoryGB: 64})
+	require.NoError(t, err)
+	require.Equal(t, "http://VM-1:9090", h1.Host)
+	require.Equal(t, 16, h1.CPU)
+	require.True(t, h1.Draining)
+
+	h2, err = db.UpdateRuntimeHostHealth(ctx, h2.ID, &database.UpdateRuntimeHostHealthOptions{Healthy: false, FailedChecks: 3, StatusMessage: "connection refused"})
+	require.NoError(t, err)
+	require.False(t, h2.Healthy)
+	require.Equal(t, 3, h2.FailedChecks)
+	require.NotNil(t, h2.CheckedOn)
+
+	// assignments
+	r1 := uuid.NewString()
+	r2 := uuid.NewString()
+	_, err = db.FindRuntimeHostAssignment(ctx, r1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	_, err = db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{ResourceID: r1, HostID: h1.ID, CPU: 2, MemoryGB: 8})
+	require.NoError(t, err)
+	_, err = db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{ResourceID: r2, HostID: h1.ID, CPU: 1, MemoryGB: 4})
+	require.NoError(t, err)
+
+	h1, err = db.FindRuntimeHost(ctx, h1.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, h1.CPUUsed)
+	require.Equal(t, 12, h1.MemoryGBUsed)
+	require.Equal(t, 2, h1.Instances)
+
+	// move an assignment
+	a, err := db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{ResourceID: r2, HostID: h2.ID, CPU: 1, MemoryGB: 4})
+	require.NoError(t, err)
+	require.Equal(t, h2.ID, a.HostID)
+
+	// over capacity
+	_, err = db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{ResourceID: uuid.NewString(), HostID: h2.ID, CPU: 4, MemoryGB: 4})
+	require.ErrorIs(t, err, database.ErrValidation)
+
+	// resizing an assignment doesn't count its current usage
+	_, err = db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{ResourceID: r2, HostID: h2.ID, CPU: 4, MemoryGB: 16})
+	require.NoError(t, err)
+
+	hosts, err := db.FindRuntimeHosts(ctx)
+	require.NoError(t, err)
+	require.Len(t, hosts, 2)
+	require.Equal(t, h1.ID, hosts[0].ID)
+	require.Equal(t, 1, hosts[0].Instances)
+	require.Equal(t, 1, hosts[1].Instances)
+
+	// delete is idempotent
+	require.NoError(t, db.DeleteRuntimeHostAssignment(ctx, r1))
+	require.NoError(t, db.DeleteRuntimeHostAssignment(ctx, r1))
+	require.NoError(t, db.DeleteRuntimeHostAssignment(ctx, r2))
+	h1, err = db.FindRuntimeHost(ctx, h1.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, h1.Instances)
+}
+
 func seed(t *testing.T, db database.DB) (orgID, projectID, userID string) {
 	ctx := context.Background()
 
@@ -36,8 +36,14 @@ type CreateDeploymentOptions struct {
 }
 
 func (s *Service) CreateDeployment(ctx context.Context, opts *CreateDeploymentOptions) (*database.Deployment, error) {
+	txCtx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	// Create the deployment
-	depl, err := s.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+	depl, err := s.DB.InsertDeployment(txCtx, &database.InsertDeploymentOptions{
 		ProjectID:         opts.ProjectID,
 		OwnerUserID:       opts.OwnerUserID,
 		Environment:       opts.Environment,
@@ -54,6 +60,16 @@ func (s *Service) CreateDeployment(ctx context.Context, opts *CreateDeploymentOp
 		return nil, err
 	}
 
+	err = s.recordDeploymentAuditEvent(txCtx, depl, AuditActionDeploymentCreate, nil, map[string]any{"environment": depl.Environment, "branch": depl.Branch, "editable": depl.Editable})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	// Trigger reconcile deployment job
 	err = s.triggerDeploymentReconcileJob(ctx, depl.ID)
 	if err != nil {
@@ -64,8 +80,24 @@ func (s *Service) CreateDeployment(ctx context.Context, opts *CreateDeploymentOp
 }
 
 func (s *Service) StartDeployment(ctx context.Context, depl *database.Deployment) (*database.Deployment, error) {
+	txCtx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	// Update the desired deployment status to running
-	depl1, err := s.DB.UpdateDeploymentDesiredStatus(ctx, depl.ID, database.DeploymentStatusRunning)
+	depl1, err := s.DB.UpdateDeploymentDesiredStatus(txCtx, depl.ID, database.DeploymentStatusRunning)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recordDeploymentAuditEvent(txCtx, depl, AuditActionDeploymentStart, map[string]any{"desired_status": depl.DesiredStatus.String()}, map[string]any{"desired_status": depl1.DesiredStatus.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +112,24 @@ func (s *Service) StartDeployment(ctx context.Context, depl *database.Deployment
 }
 
 func (s *Service) StopDeployment(ctx context.Context, depl *database.Deployment) error {
+	txCtx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	// Update the deployment desired status to stopped
-	_, err := s.DB.UpdateDeploymentDesiredStatus(ctx, depl.ID, database.DeploymentStatusStopped)
+	_, err = s.DB.UpdateDeploymentDesiredStatus(txCtx, depl.ID, database.DeploymentStatusStopped)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordDeploymentAuditEvent(txCtx, depl, AuditActionDeploymentStop, map[string]any{"desired_status": depl.DesiredStatus.String()}, map[string]any{"desired_status": database.DeploymentStatusStopped.String()})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
@@ -97,19 +145,33 @@ func (s *Service) StopDeployment(ctx context.Context, depl *database.Deployment)
 
 func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deployment, branch string) error {
 	// Update the deployment with the new branch (or existing branch) and set existing desired status to retrigger reconcile flow
-	var err error
+	txCtx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	if branch != depl.Branch {
-		_, err = s.DB.UpdateDeploymentSafe(ctx, depl.ID, &database.UpdateDeploymentSafeOptions{
+		_, err = s.DB.UpdateDeploymentSafe(txCtx, depl.ID, &database.UpdateDeploymentSafeOptions{
 			DesiredStatus: depl.DesiredStatus,
 			Branch:        branch,
 		})
+		if err == nil {
+			// Only branch changes are audited since the deployment is otherwise just restarted with its current config.
+			err = s.recordDeploymentAuditEvent(txCtx, depl, AuditActionDeploymentUpdate, map[string]any{"branch": depl.Branch}, map[string]any{"branch": branch})
+		}
 	} else {
-		_, err = s.DB.UpdateDeploymentDesiredStatus(ctx, depl.ID, depl.DesiredStatus)
+		_, err = s.DB.UpdateDeploymentDesiredStatus(txCtx, depl.ID, depl.DesiredStatus)
 	}
 	if err != nil {
 		return err
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
 	// Trigger reconcile deployment job
 	err = s.triggerDeploymentReconcileJob(ctx, depl.ID)
 	if err != nil {
@@ -120,8 +182,24 @@ func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deploymen
 }
 
 func (s *Service) TeardownDeployment(ctx context.Context, depl *database.Deployment) error {
+	txCtx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	// Update the desired deployment status to deleted
-	_, err := s.DB.UpdateDeploymentDesiredStatus(ctx, depl.ID, database.DeploymentStatusDeleted)
+	_, err = s.DB.UpdateDeploymentDesiredStatus(txCtx, depl.ID, database.DeploymentStatusDeleted)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordDeploymentAuditEvent(txCtx, depl, AuditActionDeploymentDelete, map[string]any{"desired_status": depl.DesiredStatus.String()}, map[string]any{"desired_status": database.DeploymentStatusDeleted.String()})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
@@ -571,6 +649,22 @@ func (s *Service) UpdateDeploymentInner(ctx context.Context, d *database.Deploym
 		}
 	}
 
+	// The check or reprovision may have moved the runtime to a different host (e.g. when the "vm" provisioner migrates instances off an unhealthy or draining host).
+	// If so, we move the instance from the old host to the new host.
+	pr, ok, err = s.FindProvisionedRuntimeResource(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	if ok {
+		cfg, err := provisioner.NewRuntimeConfig(pr.Config)
+		if err != nil {
+			return err
+		}
+		if cfg.Host != "" && cfg.Host != d.RuntimeHost {
+			return s.migrateDeploymentInner(ctx, d, cfg.Host)
+		}
+	}
+
 	// Connect to the runtime and call ReloadConfig.
 	// The runtime will pull the latest variables, annotations, and frontend_url from the admin service,
 	// and will also force a repo pull if the deployment config has changed.
@@ -584,12 +678,73 @@ func (s *Service) UpdateDeploymentInner(ctx context.Context, d *database.Deploym
 		InstanceId: d.RuntimeInstanceID,
 	})
 	if err != nil {
+		// The instance may be missing if a migration to a new runtime host was interrupted after the old instance was stopped.
+		if status.Code(err) == codes.NotFound {
+			return s.StartDeploymentInner(ctx, d)
+		}
 		return err
 	}
 
 	return nil
 }
 
+// migrateDeploymentInner moves a deployment's instance to the runtime host its provisioned runtime is now assigned to.
+// It stops the instance on the old host before creating it on the new host, so the two hosts never run the instance's controller concurrently.
+// The old instance is deleted with KeepStorage set since the new instance reuses the same instance ID and thereby the same remote storage.
+// If the old host can't be reached and is known to be unhealthy, we create the instance on the new host anyway.
+func (s *Service) migrateDeploymentInner(ctx context.Context, d *database.Deployment, newHost string) error {
+	s.Logger.Info("migrating deployment to new runtime host", zap.String("deployment_id", d.ID), zap.String("runtime_instance_id", d.RuntimeInstanceID), zap.String("old_host", d.RuntimeHost), zap.String("new_host", newHost), observability.ZapCtx(ctx))
+
+	// Stop the instance on the old host without purging its remote storage.
+	if d.RuntimeHost != "" && d.RuntimeInstanceID != "" {
+		err := s.stopInstanceForMigration(ctx, d)
+		if err != nil {
+			healthy, herr := s.runtimeHostHealthy(ctx, d.RuntimeHost)
+			if herr != nil {
+				return errors.Join(err, herr)
+			}
+			if healthy {
+				return fmt.Errorf("failed to stop instance on old runtime host %q: %w", d.RuntimeHost, err)
+			}
+			s.Logger.Warn("failed to stop instance on unhealthy old runtime host, migrating anyway", zap.String("deployment_id", d.ID), zap.String("runtime_instance_id", d.RuntimeInstanceID), zap.String("old_host", d.RuntimeHost), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	// Create the instance on the new host and point the deployment to it.
+	// If this fails, the next reconcile will find the instance missing on the new host and create it (see UpdateDeploymentInner).
+	return s.StartDeploymentInner(ctx, d)
+}
+
+// stopInstanceForMigration deletes a deployment's instance from its current runtime host, keeping its remote storage.
+func (s *Service) stopInstanceForMigration(ctx context.Context, d *database.Deployment) error {
+	rt, err := s.OpenRuntimeClient(d)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	_, err = rt.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{
+		InstanceId:  d.RuntimeInstanceID,
+		KeepStorage: true,
+	})
+	return err
+}
+
+// runtimeHostHealthy returns false if the host is registered as a runtime host and has been marked as unhealthy.
+// Hosts that are not registered (e.g. hosts managed by other provisioners) are considered healthy.
+func (s *Service) runtimeHostHealthy(ctx context.Context, host string) (bool, error) {
+	h, err := s.DB.FindRuntimeHostByHost(ctx, host)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+	return h.Healthy, nil
+}
+
 // CheckDeploymentInner health checks all provisioner resources for a deployment.
 // The check is drift-aware (it only reprovisions when the underlying resource has actually drifted) and is
 // therefore safe to run periodically. It must only be called from within the reconcile loop so that provisioner
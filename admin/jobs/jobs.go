@@ -42,10 +42,16 @@ type Client interface {
 	DeleteExpiredDeviceAuthCodes(ctx context.Context) (*InsertResult, error)
 	DeleteExpiredTokens(ctx context.Context) (*InsertResult, error)
 	DeleteExpiredVirtualFiles(ctx context.Context) (*InsertResult, error)
+	DeleteExpiredAuditEvents(ctx context.Context) (*InsertResult, error)
 	DeleteUnusedAssets(ctx context.Context) (*InsertResult, error)
 	DeploymentsHealthCheck(ctx context.Context) (*InsertResult, error)
 	HibernateExpiredDeployments(ctx context.Context) (*InsertResult, error)
 	RunAutoscaler(ctx context.Context) (*InsertResult, error)
+
+	// project snapshot jobs
+	ScheduleProjectSnapshots(ctx context.Context) (*InsertResult, error)
+	CreateProjectSnapshot(ctx context.Context, snapshotID string) (*InsertResult, error)
+	DeleteExpiredProjectSnapshots(ctx context.Context) (*InsertResult, error)
 }
 
 type InsertResult struct {
@@ -120,6 +120,10 @@ func (n *noop) DeleteExpiredVirtualFiles(ctx context.Context) (*InsertResult, er
 	return nil, nil
 }
 
+func (n *noop) DeleteExpiredAuditEvents(ctx context.Context) (*InsertResult, error) {
+	return nil, nil
+}
+
 func (n *noop) DeleteUnusedAssets(ctx context.Context) (*InsertResult, error) {
 	return nil, nil
 }
@@ -135,3 +139,15 @@ func (n *noop) HibernateExpiredDeployments(ctx context.Context) (*InsertResult,
 func (n *noop) RunAutoscaler(ctx context.Context) (*InsertResult, error) {
 	return nil, nil
 }
+
+func (n *noop) ScheduleProjectSnapshots(ctx context.Context) (*InsertResult, error) {
+	return nil, nil
+}
+
+func (n *noop) CreateProjectSnapshot(ctx context.Context, snapshotID string) (*InsertResult, error) {
+	return nil, nil
+}
+
+func (n *noop) DeleteExpiredProjectSnapshots(ctx context.Context) (*InsertResult, error) {
+	return nil, nil
+}
@@ -7,22 +7,11 @@ import (
 
 	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/admin/metrics"
 	"github.com/riverqueue/river"
 	"go.uber.org/zap"
 )
 
-// counterMetrics are billable metrics whose period total is sum(value) rather than max(value).
-// Generic usage events ("query", "request_time_ms", "tool_call") carry a "source" attribute (and request_time_ms also
-// carries embed/user_id); the metrics project applies the billing-specific filtering and distinct counting downstream.
-var counterMetrics = map[string]bool{
-	"slot_seconds_spend":  true,
-	"query":               true,
-	"tool_call":           true,
-	"input_tokens":        true,
-	"cached_input_tokens": true,
-	"output_tokens":       true,
-}
-
 // orgUsageMetric computes a billable usage value for an organization from the admin database.
 // Add entries here to report additional admin-derived billable metrics; they are reported by the billing
 // reporter alongside the runtime-derived metrics for every org that reported usage (see Work).
@@ -106,6 +95,7 @@ func (w *BillingReporterWorker) Work(ctx context.Context, job *river.Job[Billing
 	}
 
 	reportedOrgs := make(map[string]string) // org ID -> billing customer ID
+	orgUsage := make(map[orgUsageKey]float64)
 	stop := false
 	limit := 10000
 	afterTime := time.Time{}
@@ -152,7 +142,7 @@ func (w *BillingReporterWorker) Work(ctx context.Context, job *river.Job[Billing
 			reportedOrgs[m.OrgID] = customerID
 
 			value := m.MaxValue
-			if counterMetrics[m.EventName] {
+			if billing.CounterMetrics[m.EventName] {
 				value = m.SumValue
 			}
 			usage = append(usage, &billing.Usage{
@@ -171,6 +161,8 @@ func (w *BillingReporterWorker) Work(ctx context.Context, job *river.Job[Billing
 			return fmt.Errorf("failed to report usage: %w", err)
 		}
 
+		w.recordOrgUsage(ctx, orgUsage, u)
+
 		if afterTime.After(checkPoint) {
 			checkPoint = afterTime
 			err = w.admin.DB.UpdateBillingUsageReportedOn(ctx, checkPoint)
@@ -223,6 +215,36 @@ func (w *BillingReporterWorker) Work(ctx context.Context, job *river.Job[Billing
 	return nil
 }
 
+// orgUsageKey identifies an org's total for a counter metric in a reporting grain.
+type orgUsageKey struct {
+	orgID     string
+	metric    string
+	startTime time.Time
+}
+
+// recordOrgUsage records the orgs' per-grain totals of counter metrics, which are used to evaluate usage budgets.
+// The totals are accumulated in the passed map across the pages of a reporting run, so a grain split across pages is written with its full value.
+// Since a retried run reports every grain from its start and the stored totals are overwritten, retries don't count usage twice.
+// It is best-effort: a failure is logged and does not fail the usage reporting.
+func (w *BillingReporterWorker) recordOrgUsage(ctx context.Context, totals map[orgUsageKey]float64, u []*metrics.Usage) {
+	updated := make(map[orgUsageKey]bool)
+	for _, m := range u {
+		if !billing.CounterMetrics[m.EventName] {
+			continue
+		}
+		k := orgUsageKey{orgID: m.OrgID, metric: m.EventName, startTime: m.StartTime}
+		totals[k] += m.SumValue
+		updated[k] = true
+	}
+
+	for k := range updated {
+		err := w.admin.DB.UpsertOrganizationUsage(ctx, k.orgID, k.metric, k.startTime, totals[k])
+		if err != nil {
+			w.logger.Warn("failed to record org usage", zap.String("org_id", k.orgID), zap.String("metric", k.metric), zap.Error(err))
+		}
+	}
+}
+
 // reportOrgUsageMetrics reports the admin-database-derived billable metrics (orgUsageMetrics) for the given orgs.
 // It is best-effort: a failure for one org or metric is logged and skipped so the rest still get reported.
 func (w *BillingReporterWorker) reportOrgUsageMetrics(ctx context.Context, reportedOrgs map[string]string, startTime, endTime time.Time) {
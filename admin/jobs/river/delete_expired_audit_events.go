@@ -0,0 +1,28 @@
+package river
+
+import (
+	"context"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/riverqueue/river"
+)
+
+type DeleteExpiredAuditEventsArgs struct{}
+
+func (DeleteExpiredAuditEventsArgs) Kind() string { return "delete_expired_audit_events" }
+
+type DeleteExpiredAuditEventsWorker struct {
+	river.WorkerDefaults[DeleteExpiredAuditEventsArgs]
+	admin *admin.Service
+}
+
+func (w *DeleteExpiredAuditEventsWorker) Work(ctx context.Context, job *river.Job[DeleteExpiredAuditEventsArgs]) error {
+	// Delete audit events older than one year
+	retention := 365 * 24 * time.Hour
+	err := w.admin.DB.DeleteExpiredAuditEvents(ctx, retention)
+	if err != nil {
+		return err
+	}
+	return nil
+}
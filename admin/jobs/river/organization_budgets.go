@@ -0,0 +1,51 @@
+package river
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+type CheckOrganizationBudgetsArgs struct{}
+
+func (CheckOrganizationBudgetsArgs) Kind() string { return "check_organization_budgets" }
+
+type CheckOrganizationBudgetsWorker struct {
+	river.WorkerDefaults[CheckOrganizationBudgetsArgs]
+	admin  *admin.Service
+	logger *zap.Logger
+}
+
+// Work evaluates every organization budget against the org's spend or usage in the current period.
+// It also deletes the usage totals of previous periods, which are no longer needed to evaluate budgets.
+func (w *CheckOrganizationBudgetsWorker) Work(ctx context.Context, job *river.Job[CheckOrganizationBudgetsArgs]) error {
+	limit := 100
+	afterOrgID := ""
+	for {
+		budgets, err := w.admin.DB.FindOrganizationBudgets(ctx, afterOrgID, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, budget := range budgets {
+			_, err := w.admin.CheckOrganizationBudget(ctx, budget)
+			if err != nil {
+				// We log the error, but continue to the next budget
+				w.logger.Error("check organization budgets: failed to check budget", zap.String("org_id", budget.OrgID), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		}
+
+		if len(budgets) < limit {
+			break
+		}
+		afterOrgID = budgets[len(budgets)-1].OrgID
+	}
+
+	// Keep the previous period's totals around in case usage for it is still being reported.
+	periodStart := billing.BudgetPeriodStart(job.CreatedAt)
+	return w.admin.DB.DeleteOrganizationUsageBefore(ctx, periodStart.AddDate(0, -1, 0))
+}
@@ -0,0 +1,147 @@
+package river
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/riverqueue/river"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type ScheduleProjectSnapshotsArgs struct{}
+
+func (ScheduleProjectSnapshotsArgs) Kind() string { return "schedule_project_snapshots" }
+
+type ScheduleProjectSnapshotsWorker struct {
+	river.WorkerDefaults[ScheduleProjectSnapshotsArgs]
+	admin  *admin.Service
+	logger *zap.Logger
+}
+
+// Work schedules a snapshot of every project with a running primary deployment.
+func (w *ScheduleProjectSnapshotsWorker) Work(ctx context.Context, job *river.Job[ScheduleProjectSnapshotsArgs]) error {
+	limit := 100
+	afterID := ""
+	for {
+		projs, err := w.admin.DB.FindProjects(ctx, afterID, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, proj := range projs {
+			_, err := w.admin.CreateProjectSnapshot(ctx, proj, nil)
+			if err != nil {
+				if errors.Is(err, admin.ErrDeploymentNotReady) {
+					// Hibernated or still provisioning, nothing to snapshot
+					continue
+				}
+				// We log the error, but continue to the next project
+				w.logger.Error("schedule project snapshots: failed to schedule snapshot", zap.String("project_id", proj.ID), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		}
+
+		if len(projs) < limit {
+			break
+		}
+		afterID = projs[len(projs)-1].ID
+	}
+
+	return nil
+}
+
+type CreateProjectSnapshotArgs struct {
+	SnapshotID string
+}
+
+func (CreateProjectSnapshotArgs) Kind() string { return "create_project_snapshot" }
+
+type CreateProjectSnapshotWorker struct {
+	river.WorkerDefaults[CreateProjectSnapshotArgs]
+	admin  *admin.Service
+	logger *zap.Logger
+}
+
+// Work takes a snapshot that was scheduled with admin.CreateProjectSnapshot.
+func (w *CreateProjectSnapshotWorker) Work(ctx context.Context, job *river.Job[CreateProjectSnapshotArgs]) error {
+	observability.AddRequestAttributes(ctx, attribute.String("args.snapshot_id", job.Args.SnapshotID))
+
+	snapshot, err := w.admin.DB.FindProjectSnapshot(ctx, job.Args.SnapshotID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// The snapshot or its deployment was deleted in the meantime
+			return nil
+		}
+		return err
+	}
+	if snapshot.Status != database.ProjectSnapshotStatusPending {
+		return nil
+	}
+
+	_, err = w.admin.RunProjectSnapshot(ctx, snapshot)
+	if err != nil {
+		if !errors.Is(err, admin.ErrSnapshotsNotSupported) {
+			// On the last attempt, mark the snapshot as errored so it doesn't stay pending after the job is discarded.
+			// We use a context without cancellation since the job's context may have timed out.
+			if job.Attempt >= job.MaxAttempts {
+				_, updateErr := w.admin.DB.UpdateProjectSnapshot(context.WithoutCancel(ctx), snapshot.ID, &database.UpdateProjectSnapshotOptions{
+					Status:        database.ProjectSnapshotStatusErrored,
+					StatusMessage: err.Error(),
+				})
+				if updateErr != nil && !errors.Is(updateErr, database.ErrNotFound) {
+					return errors.Join(err, updateErr)
+				}
+			}
+			return err
+		}
+
+		// Don't keep errored scheduled snapshots for projects that don't support snapshots, since they would be recreated on every run.
+		// Snapshots requested by a user are kept, so the user can see why the snapshot failed.
+		if snapshot.CreatedByUserID == nil {
+			err = w.admin.DB.DeleteProjectSnapshot(ctx, snapshot.ID)
+			if err != nil && !errors.Is(err, database.ErrNotFound) {
+				return err
+			}
+		}
+		w.logger.Info("create project snapshot: snapshots not supported for project", zap.String("project_id", snapshot.ProjectID), zap.String("snapshot_id", snapshot.ID), observability.ZapCtx(ctx))
+		return nil
+	}
+
+	return nil
+}
+
+type DeleteExpiredProjectSnapshotsArgs struct{}
+
+func (DeleteExpiredProjectSnapshotsArgs) Kind() string { return "delete_expired_project_snapshots" }
+
+type DeleteExpiredProjectSnapshotsWorker struct {
+	river.WorkerDefaults[DeleteExpiredProjectSnapshotsArgs]
+	admin  *admin.Service
+	logger *zap.Logger
+}
+
+// Work deletes snapshots older than the configured retention.
+// Snapshots that can't be deleted, e.g. because their deployment is hibernated, are retried on the next run.
+// They are also deleted when their deployment is deleted.
+func (w *DeleteExpiredProjectSnapshotsWorker) Work(ctx context.Context, job *river.Job[DeleteExpiredProjectSnapshotsArgs]) error {
+	if w.admin.ProjectSnapshotRetention == 0 {
+		return nil
+	}
+
+	snapshots, err := w.admin.DB.FindExpiredProjectSnapshots(ctx, w.admin.ProjectSnapshotRetention, 1000)
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		err := w.admin.DeleteProjectSnapshot(ctx, snapshot)
+		if err != nil {
+			w.logger.Warn("delete expired project snapshots: failed to delete snapshot", zap.String("project_id", snapshot.ProjectID), zap.String("snapshot_id", snapshot.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	return nil
+}
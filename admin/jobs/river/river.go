@@ -116,30 +116,38 @@ func New(ctx context.Context, dsn string, adm *admin.Service) (jobs.Client, erro
 	river.AddWorker(workers, &DeleteExpiredDeviceAuthCodesWorker{admin: adm, logger: adm.Logger})
 	river.AddWorker(workers, &DeleteExpiredTokensWorker{admin: adm})
 	river.AddWorker(workers, &DeleteExpiredVirtualFilesWorker{admin: adm})
+	river.AddWorker(workers, &DeleteExpiredAuditEventsWorker{admin: adm})
 	river.AddWorker(workers, &DeleteUnusedAssetsWorker{admin: adm})
 	river.AddWorker(workers, &DeploymentsHealthCheckWorker{admin: adm, logger: adm.Logger})
 	river.AddWorker(workers, &HibernateExpiredDeploymentsWorker{admin: adm, logger: adm.Logger})
 	river.AddWorker(workers, &RunAutoscalerWorker{admin: adm, logger: adm.Logger})
+	river.AddWorker(workers, &ScheduleProjectSnapshotsWorker{admin: adm, logger: adm.Logger})
+	river.AddWorker(workers, &CreateProjectSnapshotWorker{admin: adm, logger: adm.Logger})
+	river.AddWorker(workers, &DeleteExpiredProjectSnapshotsWorker{admin: adm, logger: adm.Logger})
+	river.AddWorker(workers, &CheckOrganizationBudgetsWorker{admin: adm, logger: adm.Logger})
 
 	jobConfigs := []periodicJobConfig{
-		{&ValidateDeploymentsArgs{}, "*/30 * * * *", true},         // half-hourly
-		{&PaymentFailedGracePeriodCheckArgs{}, "0 1 * * *", true},  // daily at 1am UTC
-		{&TrialEndingSoonArgs{}, "5 1 * * *", true},                // daily at 1:05am UTC
-		{&TrialEndCheckArgs{}, "10 1 * * *", true},                 // daily at 1:10am UTC
-		{&TrialGracePeriodCheckArgs{}, "15 1 * * *", true},         // daily at 1:15am UTC
-		{&SubscriptionCancellationCheckArgs{}, "20 1 * * *", true}, // daily at 1:20am UTC
-		{&DeleteUnusedUserTokenArgs{}, "0 */12 * * *", true},       // every 12 hours
-		{&DeleteUnusedServiceTokenArgs{}, "0 */12 * * *", true},    // every 12 hours
-		{&deleteUnusedGithubReposArgs{}, "0 */6 * * *", true},      // every 6 hours
-		{&HibernateInactiveOrgsArgs{}, "0 7 * * 1", true},          // Monday at 7:00am UTC
-		{&CheckProvisionersArgs{}, "0 */15 * * *", true},           // every 15 minutes
-		{&DeleteExpiredAuthCodesArgs{}, "0 */6 * * *", true},       // every 6 hours
-		{&DeleteExpiredDeviceAuthCodesArgs{}, "0 */6 * * *", true}, // every 6 hours
-		{&DeleteExpiredTokensArgs{}, "0 */6 * * *", true},          // every 6 hours
-		{&DeleteExpiredVirtualFilesArgs{}, "0 */6 * * *", true},    // every 6 hours
-		{&DeleteUnusedAssetsArgs{}, "0 */6 * * *", true},           // every 6 hours
-		{&DeploymentsHealthCheckArgs{}, "0 */10 * * *", true},      // every 10 minutes
-		{&HibernateExpiredDeploymentsArgs{}, "*/15 * * * *", true}, // every 15 minutes
+		{&ValidateDeploymentsArgs{}, "*/30 * * * *", true},          // half-hourly
+		{&PaymentFailedGracePeriodCheckArgs{}, "0 1 * * *", true},   // daily at 1am UTC
+		{&TrialEndingSoonArgs{}, "5 1 * * *", true},                 // daily at 1:05am UTC
+		{&TrialEndCheckArgs{}, "10 1 * * *", true},                  // daily at 1:10am UTC
+		{&TrialGracePeriodCheckArgs{}, "15 1 * * *", true},          // daily at 1:15am UTC
+		{&SubscriptionCancellationCheckArgs{}, "20 1 * * *", true},  // daily at 1:20am UTC
+		{&DeleteUnusedUserTokenArgs{}, "0 */12 * * *", true},        // every 12 hours
+		{&DeleteUnusedServiceTokenArgs{}, "0 */12 * * *", true},     // every 12 hours
+		{&deleteUnusedGithubReposArgs{}, "0 */6 * * *", true},       // every 6 hours
+		{&HibernateInactiveOrgsArgs{}, "0 7 * * 1", true},           // Monday at 7:00am UTC
+		{&CheckProvisionersArgs{}, "0 */15 * * *", true},            // every 15 minutes
+		{&DeleteExpiredAuthCodesArgs{}, "0 */6 * * *", true},        // every 6 hours
+		{&DeleteExpiredDeviceAuthCodesArgs{}, "0 */6 * * *", true},  // every 6 hours
+		{&DeleteExpiredTokensArgs{}, "0 */6 * * *", true},           // every 6 hours
+		{&DeleteExpiredVirtualFilesArgs{}, "0 */6 * * *", true},     // every 6 hours
+		{&DeleteExpiredAuditEventsArgs{}, "30 2 * * *", true},       // daily at 2:30am UTC
+		{&DeleteUnusedAssetsArgs{}, "0 */6 * * *", true},            // every 6 hours
+		{&DeploymentsHealthCheckArgs{}, "0 */10 * * *", true},       // every 10 minutes
+		{&HibernateExpiredDeploymentsArgs{}, "*/15 * * * *", true},  // every 15 minutes
+		{&DeleteExpiredProjectSnapshotsArgs{}, "0 */6 * * *", true}, // every 6 hours
+		{&CheckOrganizationBudgetsArgs{}, "45 * * * *", true},       // hourly at minute 45
 	}
 
 	var periodicJobs []*river.PeriodicJob
@@ -163,6 +171,15 @@ func New(ctx context.Context, dsn string, adm *admin.Service) (jobs.Client, erro
 		})
 	}
 
+	if adm.ProjectSnapshotsCron != "" {
+		// configured by the admin project snapshots service
+		jobConfigs = append(jobConfigs, periodicJobConfig{
+			&ScheduleProjectSnapshotsArgs{},
+			adm.ProjectSnapshotsCron,
+			false,
+		})
+	}
+
 	// Create all periodic jobs
 	for _, config := range jobConfigs {
 		job, err := newPeriodicJob(config.args, config.cron, config.runOnStart)
@@ -296,8 +313,14 @@ func (c *Client) EnqueueByKind(ctx context.Context, kind string) (*jobs.InsertRe
 		return c.DeleteExpiredTokens(ctx)
 	case "delete_expired_virtual_files":
 		return c.DeleteExpiredVirtualFiles(ctx)
+	case "delete_expired_audit_events":
+		return c.DeleteExpiredAuditEvents(ctx)
 	case "delete_unused_assets":
 		return c.DeleteUnusedAssets(ctx)
+	case "schedule_project_snapshots":
+		return c.ScheduleProjectSnapshots(ctx)
+	case "delete_expired_project_snapshots":
+		return c.DeleteExpiredProjectSnapshots(ctx)
 	}
 
 	// Jobs that just need simple insertion with empty args
@@ -321,6 +344,8 @@ func (c *Client) EnqueueByKind(ctx context.Context, kind string) (*jobs.InsertRe
 		jobArgs = DeleteUnusedServiceTokenArgs{}
 	case "delete_unused_github_repos":
 		jobArgs = deleteUnusedGithubReposArgs{}
+	case "check_organization_budgets":
+		jobArgs = CheckOrganizationBudgetsArgs{}
 	default:
 		return nil, fmt.Errorf("unknown job kind: %s", kind)
 	}
@@ -786,6 +811,26 @@ func (c *Client) DeleteExpiredVirtualFiles(ctx context.Context) (*jobs.InsertRes
 	}, nil
 }
 
+func (c *Client) DeleteExpiredAuditEvents(ctx context.Context) (*jobs.InsertResult, error) {
+	res, err := c.riverClient.Insert(ctx, DeleteExpiredAuditEventsArgs{}, &river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.UniqueSkippedAsDuplicate {
+		c.logger.Debug("DeleteExpiredAuditEvents job skipped as duplicate")
+	}
+
+	return &jobs.InsertResult{
+		ID:        res.Job.ID,
+		Duplicate: res.UniqueSkippedAsDuplicate,
+	}, nil
+}
+
 func (c *Client) DeleteUnusedAssets(ctx context.Context) (*jobs.InsertResult, error) {
 	res, err := c.riverClient.Insert(ctx, DeleteUnusedAssetsArgs{}, &river.InsertOpts{
 		UniqueOpts: river.UniqueOpts{
@@ -866,6 +911,68 @@ func (c *Client) RunAutoscaler(ctx context.Context) (*jobs.InsertResult, error)
 	}, nil
 }
 
+func (c *Client) ScheduleProjectSnapshots(ctx context.Context) (*jobs.InsertResult, error) {
+	res, err := c.riverClient.Insert(ctx, ScheduleProjectSnapshotsArgs{}, &river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.UniqueSkippedAsDuplicate {
+		c.logger.Debug("ScheduleProjectSnapshots job skipped as duplicate")
+	}
+
+	return &jobs.InsertResult{
+		ID:        res.Job.ID,
+		Duplicate: res.UniqueSkippedAsDuplicate,
+	}, nil
+}
+
+func (c *Client) CreateProjectSnapshot(ctx context.Context, snapshotID string) (*jobs.InsertResult, error) {
+	res, err := c.riverClient.Insert(ctx, CreateProjectSnapshotArgs{
+		SnapshotID: snapshotID,
+	}, &river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.UniqueSkippedAsDuplicate {
+		c.logger.Debug("CreateProjectSnapshot job skipped as duplicate")
+	}
+
+	return &jobs.InsertResult{
+		ID:        res.Job.ID,
+		Duplicate: res.UniqueSkippedAsDuplicate,
+	}, nil
+}
+
+func (c *Client) DeleteExpiredProjectSnapshots(ctx context.Context) (*jobs.InsertResult, error) {
+	res, err := c.riverClient.Insert(ctx, DeleteExpiredProjectSnapshotsArgs{}, &river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.UniqueSkippedAsDuplicate {
+		c.logger.Debug("DeleteExpiredProjectSnapshots job skipped as duplicate")
+	}
+
+	return &jobs.InsertResult{
+		ID:        res.Job.ID,
+		Duplicate: res.UniqueSkippedAsDuplicate,
+	}, nil
+}
+
 type ErrorHandler struct {
 	logger *zap.Logger
 }
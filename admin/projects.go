@@ -285,6 +285,25 @@ func (s *Service) UpdateProjectVariables(ctx context.Context, project *database.
 		}
 	}
 
+	// Audit the names of the changed variables. Values are not recorded since they may contain secrets.
+	setNames := make([]string, 0, len(vars))
+	for k := range vars {
+		setNames = append(setNames, k)
+	}
+	slices.Sort(setNames)
+	err = s.RecordAuditEvent(txCtx, &AuditEvent{
+		OrgID:      project.OrganizationID,
+		ProjectID:  project.ID,
+		Action:     AuditActionProjectVariablesUpdate,
+		TargetType: AuditTargetProject,
+		TargetID:   project.ID,
+		TargetName: project.Name,
+		After:      map[string]any{"environment": environment, "set": setNames, "unset": unsetVars},
+	})
+	if err != nil {
+		return err
+	}
+
 	// Commit transaction
 	err = tx.Commit()
 	if err != nil {
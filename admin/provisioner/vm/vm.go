@@ -0,0 +1,521 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/provisioner"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+const (
+	// Resources allocated per slot.
+	cpuPerSlot       = 1
+	memoryGBPerSlot  = 4
+	storageGBPerSlot = 40
+
+	defaultUnhealthyThreshold = 3
+	healthCheckTimeout        = 10 * time.Second
+)
+
+func init() {
+	provisioner.Register("vm", NewVM)
+}
+
+// VMSpec configures a pool of self-hosted runtime hosts, such as plain VMs.
+type VMSpec struct {
+	Hosts []*VMHostSpec `json:"hosts"`
+	// UnhealthyThreshold is the number of consecutive failed health checks after which a host is considered unhealthy.
+	// Instances on unhealthy hosts are migrated to other hosts. Defaults to 3.
+	UnhealthyThreshold int `json:"unhealthy_threshold"`
+}
+
+type VMHostSpec struct {
+	Host     string `json:"host"`
+	Audience string `json:"audience_url"`
+	CPU      int    `json:"cpu"`
+	MemoryGB int    `json:"memory_gb"`
+}
+
+// VMProvisioner assigns runtime resources to a pool of runtime hosts tracked in the admin database.
+// Instances are bin-packed onto hosts by CPU and memory. Hosts that fail health checks or are marked as draining
+// stop receiving new instances, and their instances are migrated to other hosts when their resources are checked.
+type VMProvisioner struct {
+	Spec   *VMSpec
+	db     database.DB
+	logger *zap.Logger
+	client *http.Client
+}
+
+var _ provisioner.Provisioner = (*VMProvisioner)(nil)
+
+func NewVM(spec []byte, db database.DB, logger *zap.Logger) (provisioner.Provisioner, error) {
+	vms := &VMSpec{}
+	err := json.Unmarshal(spec, vms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provisioner spec: %w", err)
+	}
+
+	if len(vms.Hosts) == 0 {
+		return nil, fmt.Errorf("vm provisioner spec must have at least one host")
+	}
+	for _, h := range vms.Hosts {
+		if h.Host == "" || h.CPU <= 0 || h.MemoryGB <= 0 {
+			return nil, fmt.Errorf("vm provisioner spec: each host must have a host, cpu and memory_gb")
+		}
+	}
+	if vms.UnhealthyThreshold <= 0 {
+		vms.UnhealthyThreshold = defaultUnhealthyThreshold
+	}
+
+	return &VMProvisioner{
+		Spec:   vms,
+		db:     db,
+		logger: logger,
+		client: &http.Client{Timeout: healthCheckTimeout},
+	}, nil
+}
+
+func (p *VMProvisioner) Type() string {
+	return "vm"
+}
+
+func (p *VMProvisioner) Supports(rt provisioner.ResourceType) bool {
+	return rt == provisioner.ResourceTypeRuntime
+}
+
+func (p *VMProvisioner) Close() error {
+	return nil
+}
+
+func (p *VMProvisioner) Provision(ctx context.Context, r *provisioner.Resource, opts *provisioner.ResourceOptions) (*provisioner.Resource, error) {
+	// Parse args
+	args, err := provisioner.NewRuntimeArgs(opts.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse state (if it's an update)
+	state, err := newRuntimeState(r.State)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := p.syncHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cpu := cpuPerSlot * args.Slots
+	memoryGB := memoryGBPerSlot * args.Slots
+
+	// Keep the current host if it's still schedulable and has room for the (possibly resized) resource.
+	var target *database.RuntimeHost
+	a, err := p.db.FindRuntimeHostAssignment(ctx, r.ID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+	if a != nil {
+		for _, h := range hosts {
+			if h.ID == a.HostID && p.schedulable(h) && fits(h, cpu-a.CPU, memoryGB-a.MemoryGB) {
+				target = h
+				break
+			}
+		}
+	}
+
+	// Otherwise, find a new host
+	if target == nil {
+		excludeID := ""
+		if a != nil {
+			excludeID = a.HostID
+		}
+		target = bestFit(p.schedulableHosts(hosts, excludeID), cpu, memoryGB)
+		if target == nil {
+			return nil, fmt.Errorf("no runtime hosts found with sufficient available capacity for %d CPU and %d GB memory", cpu, memoryGB)
+		}
+	}
+
+	// Track resources used.
+	// This fails if the host was filled up by a concurrent provision. The error is returned so the caller can retry.
+	_, err = p.db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{
+		ResourceID: r.ID,
+		HostID:     target.ID,
+		CPU:        cpu,
+		MemoryGB:   memoryGB,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute storage. If an override is provided on the project, it takes precedence over the slot-based default.
+	storageBytes := int64(args.Slots) * storageGBPerSlot * int64(datasize.GB)
+	if args.OverrideDiskGB != nil && *args.OverrideDiskGB > 0 {
+		storageBytes = *args.OverrideDiskGB * int64(datasize.GB)
+	}
+
+	// Build resource
+	cfg := &provisioner.RuntimeConfig{
+		Host:         target.Host,
+		Audience:     target.Audience,
+		CPU:          cpu,
+		MemoryGB:     memoryGB,
+		StorageBytes: storageBytes,
+	}
+	state = &runtimeState{
+		HostID:  target.ID,
+		Slots:   args.Slots,
+		Version: opts.RillVersion,
+	}
+	return &provisioner.Resource{
+		ID:     r.ID,
+		Type:   r.Type,
+		State:  state.AsMap(),
+		Config: cfg.AsMap(),
+	}, nil
+}
+
+func (p *VMProvisioner) Hibernate(ctx context.Context, r *provisioner.Resource) (*provisioner.Resource, error) {
+	// The hosts are shared and not torn down here. We keep the assignment so the instance's data is still on the host when it's resumed.
+	return r, nil
+}
+
+func (p *VMProvisioner) Deprovision(ctx context.Context, r *provisioner.Resource) error {
+	// Check it's a runtime resource
+	if r.Type != provisioner.ResourceTypeRuntime {
+		return fmt.Errorf("unexpected resource type %q", r.Type)
+	}
+
+	// Remove the assignment
+	return p.db.DeleteRuntimeHostAssignment(ctx, r.ID)
+}
+
+func (p *VMProvisioner) AwaitReady(ctx context.Context, r *provisioner.Resource) error {
+	cfg, err := provisioner.NewRuntimeConfig(r.Config)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the host can be reached. We retry on failure to account for hosts that are briefly unavailable, e.g. during a restart.
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 5
+	retryClient.RetryWaitMin = 2 * time.Second
+	retryClient.RetryWaitMax = 10 * time.Second
+	retryClient.Logger = nil // Disable inbuilt logger
+	pingURL, err := url.JoinPath(cfg.Host, "/v1/ping")
+	if err != nil {
+		return err
+	}
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, pingURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := retryClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// Check health checks the hosts in the pool and reports the pool's capacity.
+// A host is marked unhealthy after UnhealthyThreshold consecutive failed health checks, and healthy again after a successful health check.
+func (p *VMProvisioner) Check(ctx context.Context) error {
+	hosts, err := p.syncHosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var cpuTotal, cpuUsed, memoryGBTotal, memoryGBUsed, healthy, unhealthy, draining, removed, awaitingMigration int
+	for _, h := range hosts {
+		// We also health check hosts that have been removed from the spec,
+		// so their instances can be migrated without stopping them first once the host is gone (see admin.migrateDeploymentInner).
+		h, err = p.checkHost(ctx, h)
+		if err != nil {
+			return err
+		}
+
+		if p.hostSpec(h) == nil {
+			removed++
+			awaitingMigration += h.Instances
+			continue
+		}
+
+		if h.Healthy {
+			healthy++
+		} else {
+			unhealthy++
+		}
+		if h.Draining {
+			draining++
+		}
+		if !p.schedulable(h) {
+			awaitingMigration += h.Instances
+			continue
+		}
+
+		cpuTotal += h.CPU
+		cpuUsed += h.CPUUsed
+		memoryGBTotal += h.MemoryGB
+		memoryGBUsed += h.MemoryGBUsed
+	}
+
+	// Log info status
+	fields := []zap.Field{
+		zap.Int("hosts_healthy", healthy),
+		zap.Int("hosts_unhealthy", unhealthy),
+		zap.Int("hosts_draining", draining),
+		zap.Int("hosts_removed", removed),
+		zap.Int("cpu_total", cpuTotal),
+		zap.Int("cpu_used", cpuUsed),
+		zap.Int("memory_gb_total", memoryGBTotal),
+		zap.Int("memory_gb_used", memoryGBUsed),
+		zap.Int("instances_awaiting_migration", awaitingMigration),
+		observability.ZapCtx(ctx),
+	}
+	p.logger.Info("vm check: status", fields...)
+
+	// Check there's at least 20% free capacity on the schedulable hosts
+	if cpuTotal == 0 || float64(cpuUsed)/float64(cpuTotal) >= 0.8 || float64(memoryGBUsed)/float64(memoryGBTotal) >= 0.8 {
+		p.logger.Warn("vm check: +80% of schedulable capacity used", fields...)
+	}
+
+	return nil
+}
+
+// CheckResource migrates the resource to another host if its current host is unhealthy, draining or has been removed from the spec.
+func (p *VMProvisioner) CheckResource(ctx context.Context, r *provisioner.Resource, opts *provisioner.ResourceOptions) (*provisioner.Resource, error) {
+	state, err := newRuntimeState(r.State)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Version != opts.RillVersion {
+		// TODO: Instead of always updating the version, we should poll the runtime to check its current version.
+		state.Version = opts.RillVersion
+	}
+
+	res := &provisioner.Resource{
+		ID:     r.ID,
+		Type:   r.Type,
+		State:  state.AsMap(),
+		Config: r.Config,
+	}
+
+	a, err := p.db.FindRuntimeHostAssignment(ctx, r.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// Not assigned, so there's nothing to migrate. It will be assigned when it's next provisioned.
+			return res, nil
+		}
+		return nil, err
+	}
+
+	hosts, err := p.syncHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var current *database.RuntimeHost
+	for _, h := range hosts {
+		if h.ID == a.HostID {
+			current = h
+			break
+		}
+	}
+	if current != nil && p.schedulable(current) {
+		return res, nil
+	}
+
+	// The current host is unavailable, so we move the resource to a new host.
+	target := bestFit(p.schedulableHosts(hosts, a.HostID), a.CPU, a.MemoryGB)
+	if target == nil {
+		return nil, fmt.Errorf("runtime host is unavailable and no other host has sufficient available capacity for %d CPU and %d GB memory", a.CPU, a.MemoryGB)
+	}
+
+	_, err = p.db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{
+		ResourceID: r.ID,
+		HostID:     target.ID,
+		CPU:        a.CPU,
+		MemoryGB:   a.MemoryGB,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := provisioner.NewRuntimeConfig(r.Config)
+	if err != nil {
+		return nil, err
+	}
+	p.logger.Info("vm: moved runtime to new host", zap.String("resource_id", r.ID), zap.String("old_host", cfg.Host), zap.String("new_host", target.Host), observability.ZapCtx(ctx))
+
+	cfg.Host = target.Host
+	cfg.Audience = target.Audience
+	state.HostID = target.ID
+	res.State = state.AsMap()
+	res.Config = cfg.AsMap()
+	return res, nil
+}
+
+// syncHosts registers the hosts in the spec in the database (or updates their address and capacity) and returns all known hosts.
+func (p *VMProvisioner) syncHosts(ctx context.Context) ([]*database.RuntimeHost, error) {
+	hosts, err := p.db.FindRuntimeHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	for _, spec := range p.Spec.Hosts {
+		var existing *database.RuntimeHost
+		for _, h := range hosts {
+			if strings.EqualFold(h.Host, spec.Host) {
+				existing = h
+				break
+			}
+		}
+		if existing != nil && existing.Host == spec.Host && existing.Audience == spec.Audience && existing.CPU == spec.CPU && existing.MemoryGB == spec.MemoryGB {
+			continue
+		}
+
+		_, err := p.db.UpsertRuntimeHost(ctx, &database.UpsertRuntimeHostOptions{
+			Host:     spec.Host,
+			Audience: spec.Audience,
+			CPU:      spec.CPU,
+			MemoryGB: spec.MemoryGB,
+		})
+		if err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+
+	if !changed {
+		return hosts, nil
+	}
+	return p.db.FindRuntimeHosts(ctx)
+}
+
+// checkHost pings the host and updates its health state.
+func (p *VMProvisioner) checkHost(ctx context.Context, h *database.RuntimeHost) (*database.RuntimeHost, error) {
+	opts := &database.UpdateRuntimeHostHealthOptions{Healthy: true}
+	if err := p.ping(ctx, h.Host); err != nil {
+		opts.FailedChecks = h.FailedChecks + 1
+		opts.Healthy = h.Healthy && opts.FailedChecks < p.Spec.UnhealthyThreshold
+		opts.StatusMessage = err.Error()
+	}
+
+	if h.Healthy && !opts.Healthy {
+		p.logger.Warn("vm check: host is unhealthy", zap.String("host", h.Host), zap.Int("failed_checks", opts.FailedChecks), zap.String("error", opts.StatusMessage), observability.ZapCtx(ctx))
+	} else if !h.Healthy && opts.Healthy {
+		p.logger.Info("vm check: host is healthy again", zap.String("host", h.Host), observability.ZapCtx(ctx))
+	}
+
+	return p.db.UpdateRuntimeHostHealth(ctx, h.ID, opts)
+}
+
+func (p *VMProvisioner) ping(ctx context.Context, host string) error {
+	pingURL, err := url.JoinPath(host, "/v1/ping")
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pingURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ping returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// hostSpec returns the spec for the host, or nil if it has been removed from the spec.
+func (p *VMProvisioner) hostSpec(h *database.RuntimeHost) *VMHostSpec {
+	for _, spec := range p.Spec.Hosts {
+		if strings.EqualFold(spec.Host, h.Host) {
+			return spec
+		}
+	}
+	return nil
+}
+
+// schedulable returns true if new instances can be assigned to the host.
+func (p *VMProvisioner) schedulable(h *database.RuntimeHost) bool {
+	return h.Healthy && !h.Draining && p.hostSpec(h) != nil
+}
+
+// schedulableHosts returns the schedulable hosts, excluding the host with the given ID (if any).
+func (p *VMProvisioner) schedulableHosts(hosts []*database.RuntimeHost, excludeID string) []*database.RuntimeHost {
+	var res []*database.RuntimeHost
+	for _, h := range hosts {
+		if h.ID != excludeID && p.schedulable(h) {
+			res = append(res, h)
+		}
+	}
+	return res
+}
+
+// fits returns true if the host has room for the given additional CPU and memory.
+func fits(h *database.RuntimeHost, cpu, memoryGB int) bool {
+	return h.CPUUsed+cpu <= h.CPU && h.MemoryGBUsed+memoryGB <= h.MemoryGB
+}
+
+// bestFit returns the host with the least capacity left over after assigning the given CPU and memory to it.
+// Packing hosts tightly keeps larger blocks of capacity free for bigger instances.
+// It returns nil if no host has room. Ties are broken by the order of the hosts.
+func bestFit(hosts []*database.RuntimeHost, cpu, memoryGB int) *database.RuntimeHost {
+	var res *database.RuntimeHost
+	var resLeft float64
+	for _, h := range hosts {
+		if !fits(h, cpu, memoryGB) {
+			continue
+		}
+		// Normalize by the host's size so CPU and memory weigh equally
+		left := float64(h.CPU-h.CPUUsed-cpu)/float64(h.CPU) + float64(h.MemoryGB-h.MemoryGBUsed-memoryGB)/float64(h.MemoryGB)
+		if res == nil || left < resLeft {
+			res = h
+			resLeft = left
+		}
+	}
+	return res
+}
+
+// runtimeState describes the vm provisioner's state for a provisioned runtime resource.
+type runtimeState struct {
+	HostID  string `mapstructure:"host_id"`
+	Slots   int    `mapstructure:"slots"`
+	Version string `mapstructure:"version"`
+}
+
+func newRuntimeState(state map[string]any) (*runtimeState, error) {
+	res := &runtimeState{}
+	err := mapstructure.Decode(state, res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vm runtime state: %w", err)
+	}
+	return res, nil
+}
+
+func (r *runtimeState) AsMap() map[string]any {
+	res := make(map[string]any)
+	err := mapstructure.Decode(r, &res)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
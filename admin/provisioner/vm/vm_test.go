@@ -0,0 +1,206 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/admin/provisioner"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/admin/database/postgres"
+)
+
+func TestBestFit(t *testing.T) {
+	hosts := []*database.RuntimeHost{
+		{ID: "a", CPU: 8, MemoryGB: 32, CPUUsed: 2, MemoryGBUsed: 8},
+		{ID: "b", CPU: 8, MemoryGB: 32, CPUUsed: 6, MemoryGBUsed: 24},
+		{ID: "c", CPU: 16, MemoryGB: 32, CPUUsed: 0, MemoryGBUsed: 28},
+	}
+
+	tests := []struct {
+		name     string
+		cpu      int
+		memoryGB int
+		want     string
+	}{
+		{name: "tightest fit", cpu: 1, memoryGB: 4, want: "b"},
+		{name: "exact fit", cpu: 2, memoryGB: 8, want: "b"},
+		{name: "skips full hosts", cpu: 4, memoryGB: 16, want: "a"},
+		{name: "memory bound", cpu: 1, memoryGB: 28, want: ""},
+		{name: "none applicable", cpu: 10, memoryGB: 40, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := bestFit(hosts, tt.cpu, tt.memoryGB)
+			if tt.want == "" {
+				require.Nil(t, h)
+				return
+			}
+			require.NotNil(t, h)
+			require.Equal(t, tt.want, h.ID)
+		})
+	}
+}
+
+func TestProvision(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	db, err := database.Open("postgres", pg.DatabaseURL, "")
+	require.NoError(t, err)
+	require.NotNil(t, db)
+	defer db.Close()
+
+	ctx := context.Background()
+	require.NoError(t, db.Migrate(ctx))
+
+	spec := &VMSpec{
+		Hosts: []*VMHostSpec{
+			{Host: "host_1", CPU: 4, MemoryGB: 16},
+			{Host: "host_2", CPU: 8, MemoryGB: 32},
+		},
+	}
+	specJSON, err := json.Marshal(spec)
+	require.NoError(t, err)
+	p, err := NewVM(specJSON, db, zap.NewNop())
+	require.NoError(t, err)
+
+	provision := func(id string, slots int) (*provisioner.Resource, error) {
+		return p.Provision(ctx, &provisioner.Resource{ID: id, Type: provisioner.ResourceTypeRuntime}, &provisioner.ResourceOptions{
+			Args: (&provisioner.RuntimeArgs{Slots: slots}).AsMap(),
+		})
+	}
+	host := func(r *provisioner.Resource) string {
+		cfg, err := provisioner.NewRuntimeConfig(r.Config)
+		require.NoError(t, err)
+		return cfg.Host
+	}
+
+	// Bin-packs onto the host with the least capacity left over
+	r1, err := provision(uuid.NewString(), 3)
+	require.NoError(t, err)
+	require.Equal(t, "host_1", host(r1))
+	r2, err := provision(uuid.NewString(), 4)
+	require.NoError(t, err)
+	require.Equal(t, "host_2", host(r2))
+	r3, err := provision(uuid.NewString(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "host_1", host(r3))
+
+	// No room for 5 slots
+	_, err = provision(uuid.NewString(), 5)
+	require.Error(t, err)
+
+	// Resizing stays on the same host when it fits
+	r2, err = provision(r2.ID, 6)
+	require.NoError(t, err)
+	require.Equal(t, "host_2", host(r2))
+
+	// Draining host_1 migrates its resources when checked
+	h1, err := db.FindRuntimeHostByHost(ctx, "host_1")
+	require.NoError(t, err)
+	_, err = db.UpdateRuntimeHostDraining(ctx, h1.ID, true)
+	require.NoError(t, err)
+
+	r3, err = p.CheckResource(ctx, r3, &provisioner.ResourceOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "host_2", host(r3))
+
+	// No room for r1 on host_2, so it stays on host_1
+	_, err = p.CheckResource(ctx, r1, &provisioner.ResourceOptions{})
+	require.Error(t, err)
+
+	// Resources on healthy hosts are left alone
+	out, err := p.CheckResource(ctx, r2, &provisioner.ResourceOptions{})
+	require.NoError(t, err)
+	require.Equal(t, r2.Config, out.Config)
+
+	// Deprovisioning frees up capacity
+	require.NoError(t, p.Deprovision(ctx, r2))
+	r1, err = p.CheckResource(ctx, r1, &provisioner.ResourceOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "host_2", host(r1))
+
+	h1, err = db.FindRuntimeHost(ctx, h1.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, h1.Instances)
+
+	// Assignments that would overfill a host are rejected, e.g. if another resource was assigned to it concurrently
+	h2, err := db.FindRuntimeHostByHost(ctx, "host_2")
+	require.NoError(t, err)
+	_, err = db.UpsertRuntimeHostAssignment(ctx, &database.UpsertRuntimeHostAssignmentOptions{ResourceID: uuid.NewString(), HostID: h2.ID, CPU: 5, MemoryGB: 20})
+	require.ErrorIs(t, err, database.ErrValidation)
+}
+
+func TestCheckResourceMigration(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	db, err := database.Open("postgres", pg.DatabaseURL, "")
+	require.NoError(t, err)
+	require.NotNil(t, db)
+	defer db.Close()
+
+	ctx := context.Background()
+	require.NoError(t, db.Migrate(ctx))
+
+	// The first host can't be reached, so its health checks fail
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	newVM := func(hosts ...string) *VMProvisioner {
+		spec := &VMSpec{UnhealthyThreshold: 1}
+		for _, h := range hosts {
+			spec.Hosts = append(spec.Hosts, &VMHostSpec{Host: h, CPU: 8, MemoryGB: 32})
+		}
+		specJSON, err := json.Marshal(spec)
+		require.NoError(t, err)
+		p, err := NewVM(specJSON, db, zap.NewNop())
+		require.NoError(t, err)
+		return p.(*VMProvisioner)
+	}
+	host := func(r *provisioner.Resource) string {
+		cfg, err := provisioner.NewRuntimeConfig(r.Config)
+		require.NoError(t, err)
+		return cfg.Host
+	}
+
+	p := newVM("http://localhost:1", srv.URL)
+	r, err := p.Provision(ctx, &provisioner.Resource{ID: uuid.NewString(), Type: provisioner.ResourceTypeRuntime}, &provisioner.ResourceOptions{
+		Args: (&provisioner.RuntimeArgs{Slots: 2}).AsMap(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:1", host(r))
+
+	// Remove the host from the spec. It's still health checked, so the admin service can tell it's gone when migrating its instances.
+	p = newVM(srv.URL)
+	require.NoError(t, p.Check(ctx))
+	h1, err := db.FindRuntimeHostByHost(ctx, "http://localhost:1")
+	require.NoError(t, err)
+	require.False(t, h1.Healthy)
+	require.Equal(t, 1, h1.FailedChecks)
+
+	// The resource is moved to the remaining host
+	r, err = p.CheckResource(ctx, r, &provisioner.ResourceOptions{})
+	require.NoError(t, err)
+	require.Equal(t, srv.URL, host(r))
+	state, err := newRuntimeState(r.State)
+	require.NoError(t, err)
+	h2, err := db.FindRuntimeHostByHost(ctx, srv.URL)
+	require.NoError(t, err)
+	require.True(t, h2.Healthy)
+	require.Equal(t, h2.ID, state.HostID)
+	require.Equal(t, 1, h2.Instances)
+
+	// Once the remaining host is unhealthy too, there's nowhere to move the resource
+	srv.Close()
+	require.NoError(t, p.Check(ctx))
+	_, err = p.CheckResource(ctx, r, &provisioner.ResourceOptions{})
+	require.Error(t, err)
+}
@@ -546,6 +546,9 @@ func (s *Server) yamlForManagedAlert(opts *adminv1.AlertOptions, ownerUserID str
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
 	res.Notify.Slack.Webhooks = opts.SlackWebhooks
+	if opts.SnoozeUntil != nil {
+		res.SnoozeUntil = opts.SnoozeUntil.AsTime().UTC().Format(time.RFC3339)
+	}
 	res.Annotations.AdminOwnerUserID = ownerUserID
 	res.Annotations.AdminManaged = true
 	res.Annotations.AdminNonce = time.Now().Format(time.RFC3339Nano)
@@ -589,6 +592,9 @@ func (s *Server) yamlForCommittedAlert(opts *adminv1.AlertOptions) ([]byte, erro
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
 	res.Notify.Slack.Webhooks = opts.SlackWebhooks
+	if opts.SnoozeUntil != nil {
+		res.SnoozeUntil = opts.SnoozeUntil.AsTime().UTC().Format(time.RFC3339)
+	}
 	res.Annotations.WebOpenPath = opts.WebOpenPath
 	res.Annotations.WebOpenState = opts.WebOpenState
 	return yaml.Marshal(res)
@@ -632,6 +638,7 @@ type alertYAML struct {
 			Webhooks []string `yaml:"webhooks"`
 		}
 	}
+	SnoozeUntil string           `yaml:"snooze_until,omitempty"`
 	Annotations alertAnnotations `yaml:"annotations,omitempty"`
 }
 
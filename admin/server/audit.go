@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Org),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.actor_email", req.ActorEmail),
+		attribute.StringSlice("args.actions", req.Actions),
+		attribute.String("args.target_type", req.TargetType),
+		attribute.String("args.target_id", req.TargetId),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Org)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read the org audit log")
+	}
+
+	opts := &database.FindAuditEventsOptions{
+		OrgID:      org.ID,
+		Actions:    req.Actions,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+		Limit:      validPageSize(req.PageSize),
+	}
+
+	if req.Project != "" {
+		proj, err := s.admin.DB.FindProjectByName(ctx, req.Org, req.Project)
+		if err != nil {
+			return nil, err
+		}
+		opts.ProjectID = proj.ID
+	}
+
+	if req.ActorEmail != "" {
+		user, err := s.admin.DB.FindUserByEmail(ctx, req.ActorEmail)
+		if err != nil {
+			if !errors.Is(err, database.ErrNotFound) {
+				return nil, err
+			}
+			// The user may have been deleted, in which case they have no events attributable by ID.
+			return &adminv1.ListAuditEventsResponse{}, nil
+		}
+		opts.ActorID = user.ID
+	}
+
+	if req.Since != nil {
+		opts.Since = req.Since.AsTime()
+	}
+	if req.Until != nil {
+		opts.Until = req.Until.AsTime()
+	}
+
+	if req.PageToken != "" {
+		token, err := unmarshalStringTimestampPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if token.Ts != nil {
+			opts.BeforeCreatedOn = token.Ts.AsTime()
+			opts.BeforeID = token.Str
+		}
+	}
+
+	events, err := s.admin.DB.FindAuditEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	nextToken := ""
+	if len(events) >= opts.Limit {
+		e := events[len(events)-1]
+		nextToken = marshalStringTimestampPageToken(e.ID, e.CreatedOn)
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, e := range events {
+		dtos[i], err = auditEventToDTO(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// withAuditActor attaches the caller's identity to the request context.
+// Audit events recorded while handling the request are attributed to the caller.
+func (s *Server) withAuditActor(ctx context.Context) (context.Context, error) {
+	return contextWithAuditActor(ctx, observability.GrpcPeer(ctx), metautils.ExtractIncoming(ctx).Get("user-agent")), nil
+}
+
+// contextWithAuditActor attaches the identity of the claims in the context to the context as an audit actor.
+// It is shared by the gRPC and HTTP handlers.
+func contextWithAuditActor(ctx context.Context, clientIP, userAgent string) context.Context {
+	claims := auth.GetClaims(ctx)
+	if claims == nil {
+		return ctx
+	}
+
+	assumedByUserID, _ := claims.AssumedByUserID()
+	return admin.WithAuditActor(ctx, &admin.AuditActor{
+		Type:            string(claims.OwnerType()),
+		ID:              claims.OwnerID(),
+		AssumedByUserID: assumedByUserID,
+		ClientIP:        clientIP,
+		UserAgent:       userAgent,
+	})
+}
+
+func auditEventToDTO(e *database.AuditEvent) (*adminv1.AuditEvent, error) {
+	var before, after *structpb.Struct
+	var err error
+	if e.Before != nil {
+		before, err = structpb.NewStruct(e.Before)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if e.After != nil {
+		after, err = structpb.NewStruct(e.After)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &adminv1.AuditEvent{
+		Id:              e.ID,
+		OrgId:           e.OrgID,
+		ProjectId:       safeStr(e.ProjectID),
+		ActorType:       e.ActorType,
+		ActorId:         e.ActorID,
+		ActorEmail:      e.ActorEmail,
+		AssumedByUserId: e.AssumedByUserID,
+		Action:          e.Action,
+		TargetType:      e.TargetType,
+		TargetId:        e.TargetID,
+		TargetName:      e.TargetName,
+		Before:          before,
+		After:           after,
+		ClientIp:        e.ClientIP,
+		UserAgent:       e.UserAgent,
+		CreatedOn:       timestamppb.New(e.CreatedOn.In(time.UTC)),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/testadmin"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	fix := testadmin.New(t)
+
+	u1, c1 := fix.NewUser(t)
+	u2, c2 := fix.NewUser(t)
+	org, err := c1.CreateOrganization(ctx, &adminv1.CreateOrganizationRequest{Name: randomName()})
+	require.NoError(t, err)
+	orgName := org.Organization.Name
+
+	// Make some audited changes
+	_, err = c1.AddOrganizationMemberUser(ctx, &adminv1.AddOrganizationMemberUserRequest{Org: orgName, Email: u2.Email, Role: "viewer"})
+	require.NoError(t, err)
+	_, err = c1.CreateUsergroup(ctx, &adminv1.CreateUsergroupRequest{Org: orgName, Name: "group1"})
+	require.NoError(t, err)
+	_, err = c1.AddOrganizationMemberUsergroup(ctx, &adminv1.AddOrganizationMemberUsergroupRequest{Org: orgName, Usergroup: "group1", Role: "viewer"})
+	require.NoError(t, err)
+	_, err = c1.IssueUserAuthToken(ctx, &adminv1.IssueUserAuthTokenRequest{UserId: "current", ClientId: database.AuthClientIDRillManual, DisplayName: "token1"})
+	require.NoError(t, err)
+
+	t.Run("all events", func(t *testing.T) {
+		res, err := c1.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName})
+		require.NoError(t, err)
+
+		// Events are returned newest first
+		var actions []string
+		for _, e := range res.Events {
+			actions = append(actions, e.Action)
+		}
+		require.Equal(t, []string{admin.AuditActionTokenIssue, admin.AuditActionOrgUsergroupAdd, admin.AuditActionOrgMemberAdd}, actions[:3])
+
+		e := res.Events[2]
+		require.Equal(t, org.Organization.Id, e.OrgId)
+		require.Equal(t, "user", e.ActorType)
+		require.Equal(t, u1.ID, e.ActorId)
+		require.Equal(t, u1.Email, e.ActorEmail)
+		require.Equal(t, admin.AuditTargetUser, e.TargetType)
+		require.Equal(t, u2.ID, e.TargetId)
+		require.Equal(t, "viewer", e.After.AsMap()["role"])
+
+		e = res.Events[0]
+		require.Equal(t, admin.AuditTargetUserToken, e.TargetType)
+		require.Equal(t, "token1", e.TargetName)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		res, err := c1.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName, Actions: []string{admin.AuditActionOrgUsergroupAdd}})
+		require.NoError(t, err)
+		require.Len(t, res.Events, 1)
+		require.Equal(t, "group1", res.Events[0].TargetName)
+
+		res, err = c1.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName, TargetType: admin.AuditTargetUser, TargetId: u2.ID})
+		require.NoError(t, err)
+		require.Len(t, res.Events, 1)
+		require.Equal(t, admin.AuditActionOrgMemberAdd, res.Events[0].Action)
+
+		res, err = c1.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName, ActorEmail: u2.Email})
+		require.NoError(t, err)
+		require.Empty(t, res.Events)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		all, err := c1.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName})
+		require.NoError(t, err)
+
+		var ids []string
+		pageToken := ""
+		for {
+			res, err := c1.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName, PageSize: 2, PageToken: pageToken})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Events), 2)
+			for _, e := range res.Events {
+				ids = append(ids, e.Id)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			pageToken = res.NextPageToken
+		}
+
+		require.Len(t, ids, len(all.Events))
+		for i, e := range all.Events {
+			require.Equal(t, e.Id, ids[i])
+		}
+	})
+
+	t.Run("requires manage org", func(t *testing.T) {
+		_, err := c2.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Org: orgName})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
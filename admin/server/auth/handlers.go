@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/urlutil"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
@@ -523,7 +524,14 @@ func (a *Authenticator) authAssumeOpen(w http.ResponseWriter, r *http.Request) {
 
 	// Issue a new token for the representing user.
 	// We use tokenMdl.UserID here instead of claims.OwnerID() because OwnerID() could return the representing user's ID if the token is already an assumed token.
-	newAuthToken, err := a.admin.IssueUserAuthToken(r.Context(), tokenMdl.UserID, database.AuthClientIDRillSupport, fmt.Sprintf("Support for %s", representEmail), representingUserID, ttl, false)
+	// Attribute the representative token in the audit log to the superuser assuming the identity.
+	auditCtx := admin.WithAuditActor(ctx, &admin.AuditActor{
+		Type:      string(OwnerTypeUser),
+		ID:        tokenMdl.UserID,
+		ClientIP:  observability.HTTPPeer(r),
+		UserAgent: r.UserAgent(),
+	})
+	newAuthToken, err := a.admin.IssueUserAuthToken(auditCtx, tokenMdl.UserID, database.AuthClientIDRillSupport, fmt.Sprintf("Support for %s", representEmail), representingUserID, ttl, false)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to issue API token: %s", err), http.StatusInternalServerError)
 		return
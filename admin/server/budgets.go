@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetOrganizationBudget(ctx context.Context, req *adminv1.GetOrganizationBudgetRequest) (*adminv1.GetOrganizationBudgetResponse, error) {
+	observability.AddRequestAttributes(ctx, attribute.String("args.org", req.Org))
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Org)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read org budget")
+	}
+
+	budget, err := s.admin.DB.FindOrganizationBudget(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return &adminv1.GetOrganizationBudgetResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &adminv1.GetOrganizationBudgetResponse{
+		Budget: organizationBudgetToDTO(budget),
+	}, nil
+}
+
+func (s *Server) UpdateOrganizationBudget(ctx context.Context, req *adminv1.UpdateOrganizationBudgetRequest) (*adminv1.UpdateOrganizationBudgetResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Org),
+		attribute.String("args.metric", req.Metric),
+		attribute.Float64("args.amount", req.Amount),
+		attribute.Bool("args.hard_cap", req.HardCap),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Org)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to update org budget")
+	}
+
+	if req.Metric != "" && !billing.CounterMetrics[req.Metric] {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("budgets are not supported for metric %q", req.Metric))
+	}
+
+	thresholds := make([]int, len(req.WarningThresholds))
+	for i, t := range req.WarningThresholds {
+		thresholds[i] = int(t)
+	}
+
+	budget, err := s.admin.DB.UpsertOrganizationBudget(ctx, org.ID, &database.UpsertOrganizationBudgetOptions{
+		Metric:            req.Metric,
+		Amount:            req.Amount,
+		WarningThresholds: thresholds,
+		HardCap:           req.HardCap,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.UpdateOrganizationBudgetResponse{
+		Budget: organizationBudgetToDTO(budget),
+	}, nil
+}
+
+func (s *Server) DeleteOrganizationBudget(ctx context.Context, req *adminv1.DeleteOrganizationBudgetRequest) (*adminv1.DeleteOrganizationBudgetResponse, error) {
+	observability.AddRequestAttributes(ctx, attribute.String("args.org", req.Org))
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Org)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to delete org budget")
+	}
+
+	err = s.admin.DB.DeleteOrganizationBudget(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.DeleteOrganizationBudgetResponse{}, nil
+}
+
+func organizationBudgetToDTO(b *database.OrganizationBudget) *adminv1.OrganizationBudget {
+	thresholds := make([]int32, len(b.WarningThresholds))
+	for i, t := range b.WarningThresholds {
+		thresholds[i] = int32(t)
+	}
+
+	res := &adminv1.OrganizationBudget{
+		Metric:            b.Metric,
+		Amount:            b.Amount,
+		WarningThresholds: thresholds,
+		HardCap:           b.HardCap,
+		CurrentValue:      b.CurrentValue,
+		NotifiedThreshold: int32(b.NotifiedThreshold),
+		Capped:            b.Capped,
+		CreatedOn:         timestamppb.New(b.CreatedOn),
+		UpdatedOn:         timestamppb.New(b.UpdatedOn),
+	}
+	if b.PeriodStart != nil {
+		res.PeriodStart = timestamppb.New(*b.PeriodStart)
+	}
+	if b.EvaluatedOn != nil {
+		res.EvaluatedOn = timestamppb.New(*b.EvaluatedOn)
+	}
+	return res
+}
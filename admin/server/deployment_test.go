@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/testadmin"
 	"github.com/rilldata/rill/cli/testcli"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -133,6 +134,56 @@ func TestDeploymentJWTs(t *testing.T) {
 	})
 }
 
+// TestDeploymentMigration checks that moving a deployment to a new runtime host stops the old instance before creating the new one.
+// The old and new hosts are both addresses of the test runtime, so deleting the old instance after creating the new one would leave it without an instance.
+func TestDeploymentMigration(t *testing.T) {
+	testmode.Expensive(t)
+
+	adm := testadmin.NewWithOptionalRuntime(t, true)
+	ctx := t.Context()
+
+	_, _ = adm.NewUser(t)
+	_, u1Client := adm.NewUser(t)
+
+	projectDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "rill.yaml"), []byte("olap_connector: duckdb\n"), 0644))
+
+	owner := testcli.New(t, adm, u1Client.Token)
+	res := owner.Run(t, "org", "create", "migrate-test")
+	require.Equal(t, 0, res.ExitCode, res.Output)
+	res = owner.Run(t, "project", "deploy", "--interactive=false", "--org=migrate-test", "--project=migrate-project", "--path="+projectDir)
+	require.Equal(t, 0, res.ExitCode, res.Output)
+	depl := adm.TriggerDeployment(t, "migrate-test", "migrate-project")
+	require.NotEmpty(t, depl.RuntimeInstanceID)
+	newHost := depl.RuntimeHost
+
+	// Pretend the deployment currently lives on another host
+	oldHost := strings.Replace(newHost, "localhost", "127.0.0.1", 1)
+	require.NotEqual(t, newHost, oldHost)
+	depl, err := adm.Admin.DB.UpdateDeploymentUnsafe(ctx, depl.ID, &database.UpdateDeploymentUnsafeOptions{
+		RuntimeHost:       oldHost,
+		RuntimeInstanceID: depl.RuntimeInstanceID,
+		RuntimeAudience:   depl.RuntimeAudience,
+		Status:            depl.Status,
+		StatusMessage:     depl.StatusMessage,
+	})
+	require.NoError(t, err)
+
+	// Migrate to the provisioned host
+	require.NoError(t, adm.Admin.UpdateDeploymentInner(ctx, depl))
+	depl, err = adm.Admin.DB.FindDeployment(ctx, depl.ID)
+	require.NoError(t, err)
+	require.Equal(t, newHost, depl.RuntimeHost)
+	_, err = adm.Runtime.Instance(ctx, depl.RuntimeInstanceID)
+	require.NoError(t, err)
+
+	// An interrupted migration leaves the instance missing on the new host; the next update recreates it
+	require.NoError(t, adm.Runtime.DeleteInstance(ctx, depl.RuntimeInstanceID, true))
+	require.NoError(t, adm.Admin.UpdateDeploymentInner(ctx, depl))
+	_, err = adm.Runtime.Instance(ctx, depl.RuntimeInstanceID)
+	require.NoError(t, err)
+}
+
 // jwtPayload mirrors the subset of the runtime JWT payload that the assertions touch.
 type jwtPayload struct {
 	jwt.RegisteredClaims
@@ -249,11 +249,36 @@ func (s *Server) RevokeMagicAuthToken(ctx context.Context, req *adminv1.RevokeMa
 		}
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteMagicAuthToken(ctx, tkn.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	if !tkn.Internal {
+		err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:      proj.OrganizationID,
+			ProjectID:  proj.ID,
+			Action:     admin.AuditActionTokenRevoke,
+			TargetType: admin.AuditTargetMagicAuthToken,
+			TargetID:   tkn.ID,
+			TargetName: tkn.DisplayName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RevokeMagicAuthTokenResponse{}, nil
 }
 
@@ -1317,7 +1317,7 @@ func (s *Server) AddProjectMemberUser(ctx context.Context, req *adminv1.AddProje
 			restrictResources = member.RestrictResources
 			resources = member.Resources
 		}
-		if err := s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID, restrictResources, resources); err != nil {
+		if err := s.admin.UpdateProjectMemberUserRole(ctx, proj.OrganizationID, proj.ID, user.ID, role.ID, restrictResources, resources); err != nil {
 			return nil, err
 		}
 	}
@@ -1395,7 +1395,7 @@ func (s *Server) RemoveProjectMemberUser(ctx context.Context, req *adminv1.Remov
 		}
 	}
 
-	err = s.admin.DB.DeleteProjectMemberUser(ctx, proj.ID, user.ID)
+	err = s.admin.DeleteProjectMemberUser(ctx, proj.OrganizationID, proj.ID, user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -1514,7 +1514,7 @@ func (s *Server) SetProjectMemberUserRole(ctx context.Context, req *adminv1.SetP
 		resources = resourceNamesFromProto(req.Resources)
 	}
 
-	err = s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID, restrictResources, resources)
+	err = s.admin.UpdateProjectMemberUserRole(ctx, proj.OrganizationID, proj.ID, user.ID, role.ID, restrictResources, resources)
 	if err != nil {
 		return nil, err
 	}
@@ -1728,7 +1728,7 @@ func (s *Server) ApproveProjectAccess(ctx context.Context, req *adminv1.ApproveP
 			return nil, err
 		}
 
-		err = s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID, member.RestrictResources, member.Resources)
+		err = s.admin.UpdateProjectMemberUserRole(ctx, proj.OrganizationID, proj.ID, user.ID, role.ID, member.RestrictResources, member.Resources)
 		if err != nil {
 			return nil, err
 		}
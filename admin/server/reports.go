@@ -168,6 +168,7 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 
 	return &adminv1.GetReportMetaResponse{
 		DeliveryMeta: delivery,
+		OwnerAttrs:   ownerAttrs,
 	}, nil
 }
 
@@ -996,6 +997,10 @@ func exportFormatYAML(f runtimev1.ExportFormat) string {
 		return "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PDF:
 		return "pdf"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return "jsonl"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return "arrow"
 	default:
 		return f.String()
 	}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SudoListRuntimeHosts(ctx context.Context, req *adminv1.SudoListRuntimeHostsRequest) (*adminv1.SudoListRuntimeHostsResponse, error) {
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only superusers can list runtime hosts")
+	}
+
+	hosts, err := s.admin.DB.FindRuntimeHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*adminv1.RuntimeHost, len(hosts))
+	for i, h := range hosts {
+		dtos[i] = runtimeHostToDTO(h)
+	}
+
+	return &adminv1.SudoListRuntimeHostsResponse{
+		Hosts: dtos,
+	}, nil
+}
+
+func (s *Server) SudoUpdateRuntimeHost(ctx context.Context, req *adminv1.SudoUpdateRuntimeHostRequest) (*adminv1.SudoUpdateRuntimeHostResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.host", req.Host),
+		attribute.Bool("args.draining", req.Draining),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only superusers can update runtime hosts")
+	}
+
+	host, err := s.admin.DB.FindRuntimeHostByHost(ctx, req.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err = s.admin.DB.UpdateRuntimeHostDraining(ctx, host.ID, req.Draining)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.SudoUpdateRuntimeHostResponse{
+		Host: runtimeHostToDTO(host),
+	}, nil
+}
+
+func runtimeHostToDTO(h *database.RuntimeHost) *adminv1.RuntimeHost {
+	res := &adminv1.RuntimeHost{
+		Id:            h.ID,
+		Host:          h.Host,
+		Audience:      h.Audience,
+		Cpu:           int32(h.CPU),
+		MemoryGb:      int32(h.MemoryGB),
+		CpuUsed:       int32(h.CPUUsed),
+		MemoryGbUsed:  int32(h.MemoryGBUsed),
+		Instances:     int32(h.Instances),
+		Draining:      h.Draining,
+		Healthy:       h.Healthy,
+		FailedChecks:  int32(h.FailedChecks),
+		StatusMessage: h.StatusMessage,
+		CreatedOn:     timestamppb.New(h.CreatedOn),
+		UpdatedOn:     timestamppb.New(h.UpdatedOn),
+	}
+	if h.CheckedOn != nil {
+		res.CheckedOn = timestamppb.New(*h.CheckedOn)
+	}
+	return res
+}
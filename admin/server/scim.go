@@ -0,0 +1,1315 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// This file implements a SCIM 2.0 provisioning API (RFC 7643 and RFC 7644) for an org's members and usergroups.
+// It enables identity providers like Okta and Entra ID to manage an org's users and usergroups.
+// It must be called with a service token that has permission to manage the org's members.
+//
+// SCIM users map to org members, and the primary value of a user's "roles" attribute maps to the member's org role.
+// SCIM groups map to the org's (non-managed) usergroups.
+// Deactivating a user removes them from the org. Their auth tokens are also revoked if they are not a member of any other org.
+
+const (
+	scimSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+const (
+	scimContentType     = "application/scim+json"
+	scimDefaultCount    = 100
+	scimMaxCount        = 1000
+	scimDefaultRoleName = database.OrganizationRoleNameViewer
+)
+
+// scimFilterRegexp matches the simple equality filters sent by identity providers, such as `userName eq "jane@example.com"`.
+var scimFilterRegexp = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// scimMembersFilterRegexp matches a path selecting a group member, such as `members[value eq "<id>"]`.
+var scimMembersFilterRegexp = regexp.MustCompile(`(?i)^\s*members\s*\[\s*value\s+eq\s+"([^"]*)"\s*\]\s*$`)
+
+// scimInvalidNameRegexp matches runs of characters that are not allowed in usergroup names.
+var scimInvalidNameRegexp = regexp.MustCompile(`[^-_a-zA-Z0-9]+`)
+
+type scimUser struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	UserName    string           `json:"userName"`
+	Name        *scimName        `json:"name,omitempty"`
+	DisplayName string           `json:"displayName,omitempty"`
+	Emails      []scimMultiValue `json:"emails,omitempty"`
+	Active      *bool            `json:"active,omitempty"`
+	Roles       []scimMultiValue `json:"roles,omitempty"`
+	Groups      []scimMultiValue `json:"groups,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []scimMultiValue `json:"members,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+type scimMultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// scimHandlerFunc is a SCIM endpoint handler. It is called after the caller has been authorized to manage the org's members.
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, org *database.Organization) error
+
+// registerSCIMEndpoints registers the SCIM 2.0 provisioning API on /v1/orgs/{org}/scim/v2.
+func (s *Server) registerSCIMEndpoints(mux *http.ServeMux) {
+	inner := http.NewServeMux()
+	handle := func(pattern string, fn scimHandlerFunc) {
+		observability.MuxHandle(inner, pattern, s.authenticator.HTTPMiddleware(s.scimHandler(fn)))
+	}
+	handle("GET /v1/orgs/{org}/scim/v2/ServiceProviderConfig", s.scimServiceProviderConfig)
+	handle("GET /v1/orgs/{org}/scim/v2/Users", s.scimListUsers)
+	handle("POST /v1/orgs/{org}/scim/v2/Users", s.scimCreateUser)
+	handle("GET /v1/orgs/{org}/scim/v2/Users/{id}", s.scimGetUser)
+	handle("PUT /v1/orgs/{org}/scim/v2/Users/{id}", s.scimReplaceUser)
+	handle("PATCH /v1/orgs/{org}/scim/v2/Users/{id}", s.scimPatchUser)
+	handle("DELETE /v1/orgs/{org}/scim/v2/Users/{id}", s.scimDeleteUser)
+	handle("GET /v1/orgs/{org}/scim/v2/Groups", s.scimListGroups)
+	handle("POST /v1/orgs/{org}/scim/v2/Groups", s.scimCreateGroup)
+	handle("GET /v1/orgs/{org}/scim/v2/Groups/{id}", s.scimGetGroup)
+	handle("PUT /v1/orgs/{org}/scim/v2/Groups/{id}", s.scimReplaceGroup)
+	handle("PATCH /v1/orgs/{org}/scim/v2/Groups/{id}", s.scimPatchGroup)
+	handle("DELETE /v1/orgs/{org}/scim/v2/Groups/{id}", s.scimDeleteGroup)
+	mux.Handle("/v1/orgs/{org}/scim/v2/", observability.Middleware("admin", s.logger, inner))
+}
+
+// scimHandler wraps a SCIM endpoint handler with authorization checks and SCIM-formatted error responses.
+func (s *Server) scimHandler(fn scimHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		org, err := s.scimAuthorize(r)
+		if err == nil {
+			ctx := contextWithAuditActor(r.Context(), observability.HTTPPeer(r), r.UserAgent())
+			err = fn(w, r.WithContext(ctx), org)
+		}
+		if err != nil {
+			s.writeSCIMError(r.Context(), w, err)
+		}
+	})
+}
+
+// scimAuthorize checks that the request was made with a service token that can manage the members of the org in the request path.
+func (s *Server) scimAuthorize(r *http.Request) (*database.Organization, error) {
+	ctx := r.Context()
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeService {
+		return nil, httputil.Errorf(http.StatusUnauthorized, "SCIM requests must be authenticated with a service token")
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, r.PathValue("org"))
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return nil, httputil.Errorf(http.StatusForbidden, "not allowed to manage org members")
+	}
+
+	return org, nil
+}
+
+func (s *Server) scimServiceProviderConfig(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	return writeSCIM(w, http.StatusOK, map[string]any{
+		"schemas":          []string{scimSchemaServiceProviderConfig},
+		"patch":            map[string]any{"supported": true},
+		"bulk":             map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":           map[string]any{"supported": true, "maxResults": scimMaxCount},
+		"changePassword":   map[string]any{"supported": false},
+		"sort":             map[string]any{"supported": false},
+		"etag":             map[string]any{"supported": false},
+		"documentationUri": "https://docs.rilldata.com/guide/administration/users-and-access/scim-provisioning",
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Service token",
+			"description": "Authentication with a Rill service token passed as a bearer token",
+			"primary":     true,
+		}},
+		"meta": map[string]any{
+			"resourceType": "ServiceProviderConfig",
+			"location":     s.admin.URLs.SCIM(org.Name) + "/ServiceProviderConfig",
+		},
+	})
+}
+
+func (s *Server) scimListUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	startIndex, count, err := parseSCIMPagination(r)
+	if err != nil {
+		return err
+	}
+
+	// Identity providers look up users by userName before creating them.
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		attr, val, err := parseSCIMFilter(filter)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(attr, "userName") && !strings.EqualFold(attr, "emails.value") {
+			return scimErrorf(http.StatusBadRequest, "invalidFilter", "filtering on %q is not supported", attr)
+		}
+
+		var resources []any
+		member, err := s.scimFindMemberByEmail(ctx, org.ID, val)
+		if err != nil {
+			return err
+		}
+		if member != nil {
+			res, err := s.scimUserFromMember(ctx, org, member)
+			if err != nil {
+				return err
+			}
+			resources = append(resources, res)
+		}
+		return writeSCIMList(w, resources, len(resources), startIndex)
+	}
+
+	total, err := s.admin.DB.CountOrganizationMemberUsers(ctx, org.ID, "", "", false)
+	if err != nil {
+		return err
+	}
+
+	// SCIM uses offset-based pagination, so we skip the members before startIndex.
+	members, err := s.admin.DB.FindOrganizationMemberUsers(ctx, org.ID, "", false, "", startIndex-1+count, "")
+	if err != nil {
+		return err
+	}
+	if len(members) < startIndex-1 {
+		members = nil
+	} else {
+		members = members[startIndex-1:]
+	}
+
+	resources := make([]any, 0, len(members))
+	for _, m := range members {
+		res, err := s.scimUserFromMember(ctx, org, m)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, res)
+	}
+	return writeSCIMList(w, resources, total, startIndex)
+}
+
+func (s *Server) scimCreateUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimUser{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+	if req.UserName == "" {
+		return scimErrorf(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+
+	// Create the Rill user if it doesn't exist.
+	// We don't update existing users since they may be members of other orgs.
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.UserName)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		user, err = s.admin.CreateOrUpdateUser(ctx, req.UserName, scimDisplayName(req), "")
+		if err != nil {
+			return scimError400(err)
+		}
+	}
+
+	member, err := s.scimFindMember(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		return scimErrorf(http.StatusConflict, "uniqueness", "user %q is already a member of the org", user.Email)
+	}
+
+	active := req.Active == nil || *req.Active
+	err = s.scimApplyUser(ctx, org, user, nil, active, scimPrimaryValue(req.Roles))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimUser(ctx, org, user)
+	if err != nil {
+		return err
+	}
+	res.ExternalID = req.ExternalID
+	return writeSCIM(w, http.StatusCreated, res)
+}
+
+func (s *Server) scimGetUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	member, err := s.scimFindMember(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return scimErrorf(http.StatusNotFound, "", "user not found")
+	}
+
+	res, err := s.scimUserFromMember(ctx, org, member)
+	if err != nil {
+		return err
+	}
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+func (s *Server) scimReplaceUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	user, member, err := s.scimFindUser(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimUser{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+	if req.UserName != "" && !strings.EqualFold(req.UserName, user.Email) {
+		return scimErrorf(http.StatusBadRequest, "mutability", "userName cannot be changed")
+	}
+
+	active := req.Active == nil || *req.Active
+	err = s.scimApplyUser(ctx, org, user, member, active, scimPrimaryValue(req.Roles))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimUser(ctx, org, user)
+	if err != nil {
+		return err
+	}
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+func (s *Server) scimPatchUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	user, member, err := s.scimFindUser(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimPatchRequest{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	// Compute the desired state by applying the operations to the current state.
+	// Only the "active" and "roles" attributes can be changed. Changes to other attributes, such as the user's name, are ignored since Rill users are not scoped to an org.
+	active := true
+	var roleName string
+	for _, op := range req.Operations {
+		if !strings.EqualFold(op.Op, "add") && !strings.EqualFold(op.Op, "replace") {
+			continue
+		}
+
+		values := make(map[string]json.RawMessage)
+		if op.Path == "" {
+			if err := json.Unmarshal(op.Value, &values); err != nil {
+				return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid patch value: %s", err)
+			}
+		} else {
+			values[op.Path] = op.Value
+		}
+
+		for path, val := range values {
+			switch strings.ToLower(path) {
+			case "active":
+				active, err = parseSCIMBool(val)
+				if err != nil {
+					return err
+				}
+			case "roles":
+				var roles []scimMultiValue
+				if err := json.Unmarshal(val, &roles); err != nil {
+					return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid roles: %s", err)
+				}
+				roleName = scimPrimaryValue(roles)
+			}
+		}
+	}
+
+	err = s.scimApplyUser(ctx, org, user, member, active, roleName)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimUser(ctx, org, user)
+	if err != nil {
+		return err
+	}
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+func (s *Server) scimDeleteUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	user, member, err := s.scimFindUser(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	err = s.scimApplyUser(ctx, org, user, member, false, "")
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimApplyUser updates a user's org membership to match the state requested by the identity provider.
+// If roleName is empty, an existing member keeps their current role and a new member gets the default role.
+func (s *Server) scimApplyUser(ctx context.Context, org *database.Organization, user *database.User, member *database.OrganizationMemberUser, active bool, roleName string) error {
+	claims := auth.GetClaims(ctx)
+
+	if !active {
+		if member == nil {
+			return nil
+		}
+
+		isAdmin, isLastAdmin, err := s.admin.DB.FindOrganizationMemberUserAdminStatus(ctx, org.ID, user.ID)
+		if err != nil {
+			return err
+		}
+		if isAdmin && !claims.OrganizationPermissions(ctx, org.ID).ManageOrgAdmins {
+			return scimErrorf(http.StatusForbidden, "", "not allowed to remove an admin member")
+		}
+		if isLastAdmin {
+			return scimErrorf(http.StatusBadRequest, "mutability", "cannot remove the last admin member")
+		}
+
+		return s.admin.DeprovisionOrganizationMemberUser(ctx, org.ID, user.ID)
+	}
+
+	if roleName == "" {
+		if member != nil {
+			return nil
+		}
+		roleName = scimDefaultRoleName
+	}
+
+	role, err := s.admin.DB.FindOrganizationRole(ctx, roleName)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return scimErrorf(http.StatusBadRequest, "invalidValue", "unknown org role %q", roleName)
+		}
+		return err
+	}
+	if role.Admin && !claims.OrganizationPermissions(ctx, org.ID).ManageOrgAdmins {
+		return scimErrorf(http.StatusForbidden, "", "not allowed to assign an admin role")
+	}
+
+	if member == nil {
+		// Enforce the seat quota (see AddOrganizationMemberUser).
+		seats, err := s.admin.DB.CountOrganizationMemberUsers(ctx, org.ID, "", "%@"+billing.InternalEmailDomain, true)
+		if err != nil {
+			return err
+		}
+		if org.QuotaSeats >= 0 && seats >= org.QuotaSeats && !strings.HasSuffix(user.Email, "@"+billing.InternalEmailDomain) {
+			return scimErrorf(http.StatusForbidden, "", "quota exceeded: org %q is limited to %d seats", org.Name, org.QuotaSeats)
+		}
+
+		return s.admin.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID, nil, false)
+	}
+
+	if strings.EqualFold(member.RoleName, role.Name) {
+		return nil
+	}
+
+	isAdmin, isLastAdmin, err := s.admin.DB.FindOrganizationMemberUserAdminStatus(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if isAdmin && !claims.OrganizationPermissions(ctx, org.ID).ManageOrgAdmins {
+		return scimErrorf(http.StatusForbidden, "", "not allowed to change the role of an admin member")
+	}
+	if isLastAdmin && !role.Admin {
+		return scimErrorf(http.StatusBadRequest, "mutability", "cannot change the role of the last admin member")
+	}
+
+	return s.admin.UpdateOrganizationMemberUserRole(ctx, org.ID, user.ID, role.ID)
+}
+
+// scimFindUser finds a member of the org by user ID along with the user.
+// It returns a 404 error if the user is not a member of the org, so users in other orgs can't be looked up or changed through the org's SCIM API.
+// Users that were deactivated are no longer members, so the identity provider must create them again to reactivate them.
+func (s *Server) scimFindUser(ctx context.Context, orgID, userID string) (*database.User, *database.OrganizationMemberUser, error) {
+	member, err := s.scimFindMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if member == nil {
+		return nil, nil, scimErrorf(http.StatusNotFound, "", "user not found")
+	}
+
+	user, err := s.admin.DB.FindUser(ctx, member.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, member, nil
+}
+
+// scimFindMember finds a member of the org by user ID. It returns nil if the user is not a member of the org.
+func (s *Server) scimFindMember(ctx context.Context, orgID, userID string) (*database.OrganizationMemberUser, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, nil
+	}
+
+	member, err := s.admin.DB.FindOrganizationMemberUser(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+// scimFindMemberByEmail finds a member of the org by email. It returns nil if there is no such member.
+func (s *Server) scimFindMemberByEmail(ctx context.Context, orgID, email string) (*database.OrganizationMemberUser, error) {
+	user, err := s.admin.DB.FindUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s.scimFindMember(ctx, orgID, user.ID)
+}
+
+// scimUser builds the SCIM representation of a user's current state in the org.
+func (s *Server) scimUser(ctx context.Context, org *database.Organization, user *database.User) (*scimUser, error) {
+	member, err := s.scimFindMember(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil {
+		return s.scimUserFromMember(ctx, org, member)
+	}
+
+	active := false
+	return &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          user.ID,
+		UserName:    user.Email,
+		DisplayName: user.DisplayName,
+		Emails:      []scimMultiValue{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta:        s.scimMeta(org, "User", "Users", user.ID, user.CreatedOn, user.UpdatedOn),
+	}, nil
+}
+
+// scimUserFromMember builds the SCIM representation of an org member.
+func (s *Server) scimUserFromMember(ctx context.Context, org *database.Organization, member *database.OrganizationMemberUser) (*scimUser, error) {
+	groups, err := s.admin.DB.FindUsergroupsForUser(ctx, member.ID, org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupValues []scimMultiValue
+	for _, g := range groups {
+		if g.Managed {
+			continue
+		}
+		groupValues = append(groupValues, scimMultiValue{Value: g.ID, Display: g.Name})
+	}
+
+	active := true
+	return &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          member.ID,
+		UserName:    member.Email,
+		DisplayName: member.DisplayName,
+		Emails:      []scimMultiValue{{Value: member.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Roles:       []scimMultiValue{{Value: member.RoleName, Primary: true}},
+		Groups:      groupValues,
+		Meta:        s.scimMeta(org, "User", "Users", member.ID, member.CreatedOn, member.UpdatedOn),
+	}, nil
+}
+
+func (s *Server) scimListGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	startIndex, count, err := parseSCIMPagination(r)
+	if err != nil {
+		return err
+	}
+	withMembers := !scimExcludesAttribute(r, "members")
+
+	var groups []*database.MemberUsergroup
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		attr, val, err := parseSCIMFilter(filter)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(attr, "displayName") {
+			return scimErrorf(http.StatusBadRequest, "invalidFilter", "filtering on %q is not supported", attr)
+		}
+
+		group, err := s.admin.DB.FindUsergroupByName(ctx, org.Name, scimUsergroupName(val))
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		if group != nil && !group.Managed {
+			groups = append(groups, &database.MemberUsergroup{ID: group.ID, Name: group.Name, CreatedOn: group.CreatedOn, UpdatedOn: group.UpdatedOn})
+		}
+	} else {
+		groups, err = s.scimFindUsergroups(ctx, org.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	total := len(groups)
+	if len(groups) < startIndex-1 {
+		groups = nil
+	} else {
+		groups = groups[startIndex-1:]
+	}
+	if len(groups) > count {
+		groups = groups[:count]
+	}
+
+	resources := make([]any, 0, len(groups))
+	for _, g := range groups {
+		res, err := s.scimGroup(ctx, org, &database.Usergroup{ID: g.ID, OrgID: org.ID, Name: g.Name, CreatedOn: g.CreatedOn, UpdatedOn: g.UpdatedOn}, withMembers)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, res)
+	}
+	return writeSCIMList(w, resources, total, startIndex)
+}
+
+func (s *Server) scimCreateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimGroup{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	name := scimUsergroupName(req.DisplayName)
+	if name == "" {
+		return scimErrorf(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{
+		OrgID: org.ID,
+		Name:  name,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range req.Members {
+		err = s.scimAddUsergroupMember(ctx, group, m.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(ctx, org, group, true)
+	if err != nil {
+		return err
+	}
+	res.ExternalID = req.ExternalID
+	return writeSCIM(w, http.StatusCreated, res)
+}
+
+func (s *Server) scimGetGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	group, err := s.scimFindGroup(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(ctx, org, group, !scimExcludesAttribute(r, "members"))
+	if err != nil {
+		return err
+	}
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+func (s *Server) scimReplaceGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	group, err := s.scimFindGroup(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimGroup{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err = s.scimRenameGroup(ctx, group, req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]string, len(req.Members))
+	for i, m := range req.Members {
+		userIDs[i] = m.Value
+	}
+	err = s.scimSetUsergroupMembers(ctx, group, userIDs)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroup(ctx, org, group, true)
+	if err != nil {
+		return err
+	}
+	return writeSCIM(w, http.StatusOK, res)
+}
+
+func (s *Server) scimPatchGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	group, err := s.scimFindGroup(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimPatchRequest{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			replace := strings.EqualFold(op.Op, "replace")
+
+			values := make(map[string]json.RawMessage)
+			if op.Path == "" {
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid patch value: %s", err)
+				}
+			} else {
+				values[op.Path] = op.Value
+			}
+
+			for path, val := range values {
+				switch strings.ToLower(path) {
+				case "displayname":
+					var name string
+					if err := json.Unmarshal(val, &name); err != nil {
+						return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid displayName: %s", err)
+					}
+					group, err = s.scimRenameGroup(ctx, group, name)
+					if err != nil {
+						return err
+					}
+				case "members":
+					var members []scimMultiValue
+					if err := json.Unmarshal(val, &members); err != nil {
+						return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid members: %s", err)
+					}
+					if replace {
+						userIDs := make([]string, len(members))
+						for i, m := range members {
+							userIDs[i] = m.Value
+						}
+						err = s.scimSetUsergroupMembers(ctx, group, userIDs)
+					} else {
+						for _, m := range members {
+							err = s.scimAddUsergroupMember(ctx, group, m.Value)
+							if err != nil {
+								break
+							}
+						}
+					}
+					if err != nil {
+						return err
+					}
+				}
+			}
+		case "remove":
+			// Supports removing a single member with `members[value eq "<id>"]`, a list of members passed as the value, or all members.
+			if m := scimMembersFilterRegexp.FindStringSubmatch(op.Path); m != nil {
+				err = s.scimRemoveUsergroupMember(ctx, group, m[1])
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if !strings.EqualFold(op.Path, "members") {
+				return scimErrorf(http.StatusBadRequest, "invalidPath", "unsupported path %q for remove operation", op.Path)
+			}
+
+			var members []scimMultiValue
+			if len(op.Value) > 0 {
+				if err := json.Unmarshal(op.Value, &members); err != nil {
+					return scimErrorf(http.StatusBadRequest, "invalidValue", "invalid members: %s", err)
+				}
+			}
+			if len(members) == 0 {
+				err = s.scimSetUsergroupMembers(ctx, group, nil)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			for _, m := range members {
+				err = s.scimRemoveUsergroupMember(ctx, group, m.Value)
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return scimErrorf(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", op.Op)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) scimDeleteGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	group, err := s.scimFindGroup(ctx, org.ID, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteUsergroup(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      group.OrgID,
+		Action:     admin.AuditActionUsergroupDelete,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   group.ID,
+		TargetName: group.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimFindGroup finds a non-managed usergroup in the org by ID.
+func (s *Server) scimFindGroup(ctx context.Context, orgID, groupID string) (*database.Usergroup, error) {
+	if _, err := uuid.Parse(groupID); err != nil {
+		return nil, scimErrorf(http.StatusNotFound, "", "group not found")
+	}
+
+	group, err := s.admin.DB.FindUsergroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.OrgID != orgID || group.Managed {
+		return nil, scimErrorf(http.StatusNotFound, "", "group not found")
+	}
+	return group, nil
+}
+
+// scimFindUsergroups returns all non-managed usergroups in the org, ordered by name.
+func (s *Server) scimFindUsergroups(ctx context.Context, orgID string) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	afterName := ""
+	for {
+		groups, err := s.admin.DB.FindOrganizationMemberUsergroups(ctx, orgID, "", false, afterName, scimMaxCount)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			if !g.Managed {
+				res = append(res, g)
+			}
+		}
+		if len(groups) < scimMaxCount {
+			return res, nil
+		}
+		afterName = groups[len(groups)-1].Name
+	}
+}
+
+// scimGroup builds the SCIM representation of a usergroup.
+func (s *Server) scimGroup(ctx context.Context, org *database.Organization, group *database.Usergroup, withMembers bool) (*scimGroup, error) {
+	res := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Meta:        s.scimMeta(org, "Group", "Groups", group.ID, group.CreatedOn, group.UpdatedOn),
+	}
+	if !withMembers {
+		return res, nil
+	}
+
+	members, err := s.scimFindUsergroupMembers(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		res.Members = append(res.Members, scimMultiValue{Value: m.ID, Display: m.Email})
+	}
+	return res, nil
+}
+
+// scimFindUsergroupMembers returns all members of a usergroup, ordered by email.
+func (s *Server) scimFindUsergroupMembers(ctx context.Context, groupID string) ([]*database.UsergroupMemberUser, error) {
+	var res []*database.UsergroupMemberUser
+	afterEmail := ""
+	for {
+		members, err := s.admin.DB.FindUsergroupMemberUsers(ctx, groupID, afterEmail, scimMaxCount)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, members...)
+		if len(members) < scimMaxCount {
+			return res, nil
+		}
+		afterEmail = members[len(members)-1].Email
+	}
+}
+
+// scimRenameGroup renames a usergroup to match a SCIM display name. It is a no-op if the name is unchanged.
+func (s *Server) scimRenameGroup(ctx context.Context, group *database.Usergroup, displayName string) (*database.Usergroup, error) {
+	name := scimUsergroupName(displayName)
+	if name == "" || strings.EqualFold(name, group.Name) {
+		return group, nil
+	}
+	return s.admin.DB.UpdateUsergroupName(ctx, name, group.ID)
+}
+
+// scimSetUsergroupMembers sets the members of a usergroup to the given users.
+func (s *Server) scimSetUsergroupMembers(ctx context.Context, group *database.Usergroup, userIDs []string) error {
+	current, err := s.scimFindUsergroupMembers(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		keep[id] = true
+	}
+
+	existing := make(map[string]bool, len(current))
+	for _, m := range current {
+		existing[m.ID] = true
+		if keep[m.ID] {
+			continue
+		}
+		err = s.scimRemoveUsergroupMember(ctx, group, m.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range userIDs {
+		if existing[id] {
+			continue
+		}
+		err = s.scimAddUsergroupMember(ctx, group, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scimAddUsergroupMember adds an org member to a usergroup. It is a no-op if the user is already a member of the usergroup.
+func (s *Server) scimAddUsergroupMember(ctx context.Context, group *database.Usergroup, userID string) error {
+	member, err := s.scimFindMember(ctx, group.OrgID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return scimErrorf(http.StatusBadRequest, "invalidValue", "user %q is not a member of the org", userID)
+	}
+
+	// Check for an existing membership instead of relying on a unique violation since it would abort the surrounding transaction.
+	groups, err := s.admin.DB.FindUsergroupsForUser(ctx, member.ID, group.OrgID)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g.ID == group.ID {
+			return nil
+		}
+	}
+
+	err = s.admin.DB.InsertUsergroupMemberUser(ctx, group.ID, member.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      group.OrgID,
+		Action:     admin.AuditActionUsergroupMemberAdd,
+		TargetType: admin.AuditTargetUser,
+		TargetID:   member.ID,
+		TargetName: member.Email,
+		After:      map[string]any{"usergroup": group.Name},
+	})
+}
+
+// scimRemoveUsergroupMember removes a user from a usergroup. It is a no-op if the user is not a member of the usergroup.
+func (s *Server) scimRemoveUsergroupMember(ctx context.Context, group *database.Usergroup, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil
+	}
+
+	err := s.admin.DB.DeleteUsergroupMemberUser(ctx, group.ID, userID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	var email string
+	user, err := s.admin.DB.FindUser(ctx, userID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+	if user != nil {
+		email = user.Email
+	}
+
+	return s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      group.OrgID,
+		Action:     admin.AuditActionUsergroupMemberRemove,
+		TargetType: admin.AuditTargetUser,
+		TargetID:   userID,
+		TargetName: email,
+		Before:     map[string]any{"usergroup": group.Name},
+	})
+}
+
+func (s *Server) scimMeta(org *database.Organization, resourceType, endpoint, id string, created, updated time.Time) *scimMeta {
+	return &scimMeta{
+		ResourceType: resourceType,
+		Created:      created,
+		LastModified: updated,
+		Location:     fmt.Sprintf("%s/%s/%s", s.admin.URLs.SCIM(org.Name), endpoint, id),
+	}
+}
+
+// writeSCIMError writes an error as a SCIM error response.
+func (s *Server) writeSCIMError(ctx context.Context, w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	var scimType string
+	var serr *scimStatusError
+	var herr httputil.HTTPError
+	switch {
+	case errors.As(err, &serr):
+		code = serr.code
+		scimType = serr.scimType
+	case errors.As(err, &herr):
+		code = herr.StatusCode
+	case errors.Is(err, database.ErrNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, database.ErrNotUnique):
+		code = http.StatusConflict
+		scimType = "uniqueness"
+	case errors.Is(err, database.ErrValidation):
+		code = http.StatusBadRequest
+		scimType = "invalidValue"
+	}
+
+	detail := err.Error()
+	if code == http.StatusInternalServerError {
+		s.logger.Error("scim: request failed", zap.Error(err), observability.ZapCtx(ctx))
+		detail = "internal server error"
+	}
+
+	_ = writeSCIM(w, code, &scimError{
+		Schemas:  []string{scimSchemaError},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// scimStatusError is an error with a HTTP status code and SCIM error type.
+type scimStatusError struct {
+	code     int
+	scimType string
+	msg      string
+}
+
+func (e *scimStatusError) Error() string {
+	return e.msg
+}
+
+func scimErrorf(code int, scimType, format string, args ...any) error {
+	return &scimStatusError{code: code, scimType: scimType, msg: fmt.Sprintf(format, args...)}
+}
+
+// scimError400 wraps an error returned for invalid input in a SCIM bad request error.
+func scimError400(err error) error {
+	return scimErrorf(http.StatusBadRequest, "invalidValue", "%s", err.Error())
+}
+
+func readSCIM(r *http.Request, v any) error {
+	dec := json.NewDecoder(io.LimitReader(r.Body, 10<<20))
+	if err := dec.Decode(v); err != nil {
+		return scimErrorf(http.StatusBadRequest, "invalidSyntax", "invalid request body: %s", err)
+	}
+	return nil
+}
+
+func writeSCIM(w http.ResponseWriter, code int, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(code)
+	_, err = w.Write(data)
+	return err
+}
+
+func writeSCIMList(w http.ResponseWriter, resources []any, total, startIndex int) error {
+	if resources == nil {
+		resources = []any{}
+	}
+	return writeSCIM(w, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// parseSCIMPagination parses the 1-based startIndex and count query parameters.
+func parseSCIMPagination(r *http.Request) (startIndex, count int, err error) {
+	startIndex, count = 1, scimDefaultCount
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		startIndex, err = strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", v)
+		}
+		if startIndex < 1 {
+			startIndex = 1
+		}
+	}
+	if v := r.URL.Query().Get("count"); v != "" {
+		count, err = strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid count %q", v)
+		}
+		if count < 0 {
+			count = 0
+		}
+		if count > scimMaxCount {
+			count = scimMaxCount
+		}
+	}
+	return startIndex, count, nil
+}
+
+// parseSCIMFilter parses a filter of the form `<attribute> eq "<value>"`. Other filter expressions are not supported.
+func parseSCIMFilter(filter string) (attr, val string, err error) {
+	m := scimFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return "", "", scimErrorf(http.StatusBadRequest, "invalidFilter", "unsupported filter %q", filter)
+	}
+	val, err = strconv.Unquote(`"` + m[2] + `"`)
+	if err != nil {
+		return "", "", scimErrorf(http.StatusBadRequest, "invalidFilter", "unsupported filter %q", filter)
+	}
+	return m[1], val, nil
+}
+
+// parseSCIMBool parses a boolean patch value.
+// Some identity providers, notably Entra ID, send booleans as strings like "False".
+func parseSCIMBool(val json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(val, &b); err == nil {
+		return b, nil
+	}
+	var str string
+	if err := json.Unmarshal(val, &str); err == nil {
+		if b, err := strconv.ParseBool(strings.ToLower(str)); err == nil {
+			return b, nil
+		}
+	}
+	return false, scimErrorf(http.StatusBadRequest, "invalidValue", "invalid boolean value %s", string(val))
+}
+
+// scimExcludesAttribute returns true if the attribute is listed in the request's excludedAttributes query parameter.
+func scimExcludesAttribute(r *http.Request, attr string) bool {
+	for _, v := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(v), attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// scimPrimaryValue returns the primary value of a multi-valued attribute, or the first value if none are marked as primary.
+func scimPrimaryValue(vals []scimMultiValue) string {
+	for _, v := range vals {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(vals) > 0 {
+		return vals[0].Value
+	}
+	return ""
+}
+
+// scimDisplayName returns the display name to use for a new user created from a SCIM user.
+func scimDisplayName(u *scimUser) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+// scimUsergroupName converts a SCIM group display name to a valid usergroup name.
+// For example, "Data Team (EU)" becomes "data-team-eu".
+func scimUsergroupName(displayName string) string {
+	name := scimInvalidNameRegexp.ReplaceAllString(strings.ToLower(displayName), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 40 {
+		name = strings.TrimRight(name[:40], "-")
+	}
+	return name
+}
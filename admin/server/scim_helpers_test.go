@@ -0,0 +1,44 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	attr, val, err := parseSCIMFilter(`userName eq "jane@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, "userName", attr)
+	require.Equal(t, "jane@example.com", val)
+
+	attr, val, err = parseSCIMFilter(`displayName EQ "Data \"Team\""`)
+	require.NoError(t, err)
+	require.Equal(t, "displayName", attr)
+	require.Equal(t, `Data "Team"`, val)
+
+	_, _, err = parseSCIMFilter(`userName sw "jane"`)
+	require.Error(t, err)
+	_, _, err = parseSCIMFilter(`userName eq "a" and active eq true`)
+	require.Error(t, err)
+}
+
+func TestParseSCIMBool(t *testing.T) {
+	for raw, want := range map[string]bool{`true`: true, `false`: false, `"True"`: true, `"False"`: false} {
+		got, err := parseSCIMBool(json.RawMessage(raw))
+		require.NoError(t, err, raw)
+		require.Equal(t, want, got, raw)
+	}
+
+	_, err := parseSCIMBool(json.RawMessage(`"no"`))
+	require.Error(t, err)
+}
+
+func TestSCIMUsergroupName(t *testing.T) {
+	require.Equal(t, "data-team", scimUsergroupName("Data Team"))
+	require.Equal(t, "data-team-eu", scimUsergroupName("  Data Team (EU) "))
+	require.Equal(t, "sales_ops", scimUsergroupName("Sales_Ops"))
+	require.Equal(t, "", scimUsergroupName("!!!"))
+	require.Equal(t, "a-very-long-group-name-that-exceeds-the", scimUsergroupName("a very long group name that exceeds the maximum length"))
+}
@@ -0,0 +1,256 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/testadmin"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSCIM(t *testing.T) {
+	ctx := t.Context()
+	fix := testadmin.New(t)
+
+	// Create an org with a SCIM service token
+	_, c1 := fix.NewUser(t)
+	r1, err := c1.CreateOrganization(ctx, &adminv1.CreateOrganizationRequest{Name: randomName()})
+	require.NoError(t, err)
+	org := r1.Organization.Name
+	r2, err := c1.CreateService(ctx, &adminv1.CreateServiceRequest{
+		Name:        "scim",
+		Org:         org,
+		OrgRoleName: database.OrganizationRoleNameAdmin,
+	})
+	require.NoError(t, err)
+	r3, err := c1.IssueServiceAuthToken(ctx, &adminv1.IssueServiceAuthTokenRequest{
+		Org:         org,
+		ServiceName: r2.Service.Name,
+	})
+	require.NoError(t, err)
+	scim := &scimClient{t: t, baseURL: fmt.Sprintf("%s/v1/orgs/%s/scim/v2", fix.ExternalURL(), org), token: r3.Token}
+
+	// u2 is also a member of another org, u3 is only a member of the SCIM org
+	u2, c2 := fix.NewUser(t)
+	u3, c3 := fix.NewUser(t)
+	r4, err := c1.CreateOrganization(ctx, &adminv1.CreateOrganizationRequest{Name: randomName()})
+	require.NoError(t, err)
+	_, err = c1.AddOrganizationMemberUser(ctx, &adminv1.AddOrganizationMemberUserRequest{
+		Org:   r4.Organization.Name,
+		Email: u2.Email,
+		Role:  database.OrganizationRoleNameViewer,
+	})
+	require.NoError(t, err)
+
+	t.Run("Create users", func(t *testing.T) {
+		code, res := scim.do(http.MethodPost, "/Users", map[string]any{
+			"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+			"userName": u2.Email,
+			"roles":    []map[string]any{{"value": database.OrganizationRoleNameEditor, "primary": true}},
+		})
+		require.Equal(t, http.StatusCreated, code, res)
+		require.Equal(t, u2.ID, res["id"])
+		require.Equal(t, true, res["active"])
+
+		member, err := fix.Admin.DB.FindOrganizationMemberUser(ctx, r1.Organization.Id, u2.ID)
+		require.NoError(t, err)
+		require.Equal(t, database.OrganizationRoleNameEditor, member.RoleName)
+
+		// Without a role, the user gets the default role
+		code, res = scim.do(http.MethodPost, "/Users", map[string]any{"userName": u3.Email})
+		require.Equal(t, http.StatusCreated, code, res)
+		member, err = fix.Admin.DB.FindOrganizationMemberUser(ctx, r1.Organization.Id, u3.ID)
+		require.NoError(t, err)
+		require.Equal(t, database.OrganizationRoleNameViewer, member.RoleName)
+
+		// Creating an existing member is a conflict
+		code, res = scim.do(http.MethodPost, "/Users", map[string]any{"userName": u2.Email})
+		require.Equal(t, http.StatusConflict, code, res)
+
+		// Identity providers look up users by userName
+		code, res = scim.do(http.MethodGet, fmt.Sprintf("/Users?filter=%s", url.QueryEscape(fmt.Sprintf("userName eq %q", u3.Email))), nil)
+		require.Equal(t, http.StatusOK, code, res)
+		require.EqualValues(t, 1, res["totalResults"])
+	})
+
+	t.Run("Group membership", func(t *testing.T) {
+		code, res := scim.do(http.MethodPost, "/Groups", map[string]any{
+			"displayName": "Data Team",
+			"members":     []map[string]any{{"value": u2.ID}},
+		})
+		require.Equal(t, http.StatusCreated, code, res)
+		require.Equal(t, "data-team", res["displayName"])
+		groupID := res["id"].(string)
+		require.ElementsMatch(t, []string{u2.ID}, scimMemberIDs(res))
+
+		// Add a member
+		code, res = scim.do(http.MethodPatch, "/Groups/"+groupID, map[string]any{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]any{{"value": u3.ID}}}},
+		})
+		require.Equal(t, http.StatusNoContent, code)
+		code, res = scim.do(http.MethodGet, "/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusOK, code, res)
+		require.ElementsMatch(t, []string{u2.ID, u3.ID}, scimMemberIDs(res))
+
+		// Remove a member
+		code, res = scim.do(http.MethodPatch, "/Groups/"+groupID, map[string]any{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]any{{"op": "remove", "path": fmt.Sprintf("members[value eq %q]", u2.ID)}},
+		})
+		require.Equal(t, http.StatusNoContent, code)
+		code, res = scim.do(http.MethodGet, "/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusOK, code, res)
+		require.ElementsMatch(t, []string{u3.ID}, scimMemberIDs(res))
+
+		// The user's groups are included in the user resource
+		code, res = scim.do(http.MethodGet, "/Users/"+u3.ID, nil)
+		require.Equal(t, http.StatusOK, code, res)
+		groups := res["groups"].([]any)
+		require.Len(t, groups, 1)
+		require.Equal(t, groupID, groups[0].(map[string]any)["value"])
+
+		// Members must be members of the org
+		code, res = scim.do(http.MethodPatch, "/Groups/"+groupID, map[string]any{
+			"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]any{{"value": u3.ID}, {"value": "00000000-0000-0000-0000-000000000000"}}}},
+		})
+		require.Equal(t, http.StatusBadRequest, code, res)
+	})
+
+	t.Run("Deactivate users", func(t *testing.T) {
+		deactivate := map[string]any{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]any{{"op": "replace", "value": map[string]any{"active": false}}},
+		}
+
+		// Deactivating u2 removes them from the org, but keeps their tokens since they're a member of another org
+		code, res := scim.do(http.MethodPatch, "/Users/"+u2.ID, deactivate)
+		require.Equal(t, http.StatusOK, code, res)
+		require.Equal(t, false, res["active"])
+		_, err := fix.Admin.DB.FindOrganizationMemberUser(ctx, r1.Organization.Id, u2.ID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+		fix.Admin.PurgeAuthTokenCache()
+		_, err = c2.GetCurrentUser(ctx, &adminv1.GetCurrentUserRequest{})
+		require.NoError(t, err)
+
+		// Deactivating u3 removes them from the org and its groups, and revokes their tokens
+		code, res = scim.do(http.MethodPatch, "/Users/"+u3.ID, deactivate)
+		require.Equal(t, http.StatusOK, code, res)
+		require.Equal(t, false, res["active"])
+		_, err = fix.Admin.DB.FindOrganizationMemberUser(ctx, r1.Organization.Id, u3.ID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+		groups, err := fix.Admin.DB.FindUsergroupsForUser(ctx, u3.ID, r1.Organization.Id)
+		require.NoError(t, err)
+		require.Empty(t, groups)
+		fix.Admin.PurgeAuthTokenCache()
+		_, err = c3.GetCurrentUser(ctx, &adminv1.GetCurrentUserRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// Deactivated users are no longer members, so they can't be looked up or patched
+		code, res = scim.do(http.MethodGet, "/Users/"+u2.ID, nil)
+		require.Equal(t, http.StatusNotFound, code, res)
+		code, res = scim.do(http.MethodPatch, "/Users/"+u2.ID, map[string]any{
+			"Operations": []map[string]any{{"op": "replace", "path": "active", "value": true}},
+		})
+		require.Equal(t, http.StatusNotFound, code, res)
+
+		// Creating the user again adds them back to the org
+		code, res = scim.do(http.MethodPost, "/Users", map[string]any{"userName": u2.Email})
+		require.Equal(t, http.StatusCreated, code, res)
+		require.Equal(t, true, res["active"])
+		_, err = fix.Admin.DB.FindOrganizationMemberUser(ctx, r1.Organization.Id, u2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Users of other orgs are not found", func(t *testing.T) {
+		u4, _ := fix.NewUser(t)
+		code, res := scim.do(http.MethodGet, "/Users/"+u4.ID, nil)
+		require.Equal(t, http.StatusNotFound, code, res)
+		code, res = scim.do(http.MethodPatch, "/Users/"+u4.ID, map[string]any{
+			"Operations": []map[string]any{{"op": "replace", "path": "active", "value": true}},
+		})
+		require.Equal(t, http.StatusNotFound, code, res)
+		_, err := fix.Admin.DB.FindOrganizationMemberUser(ctx, r1.Organization.Id, u4.ID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+
+	t.Run("Delete group", func(t *testing.T) {
+		code, res := scim.do(http.MethodPost, "/Groups", map[string]any{"displayName": "Temp"})
+		require.Equal(t, http.StatusCreated, code, res)
+		groupID := res["id"].(string)
+
+		code, res = scim.do(http.MethodDelete, "/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusNoContent, code, res)
+		code, res = scim.do(http.MethodGet, "/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusNotFound, code, res)
+
+		events, err := fix.Admin.DB.FindAuditEvents(ctx, &database.FindAuditEventsOptions{
+			OrgID:    r1.Organization.Id,
+			Actions:  []string{admin.AuditActionUsergroupDelete},
+			TargetID: groupID,
+			Limit:    10,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "service", events[0].ActorType)
+	})
+
+	t.Run("Requires a service token", func(t *testing.T) {
+		tkn, err := fix.Admin.IssueUserAuthToken(ctx, u2.ID, database.AuthClientIDRillWeb, "Test session", nil, nil, false)
+		require.NoError(t, err)
+		userSCIM := &scimClient{t: t, baseURL: scim.baseURL, token: tkn.Token().String()}
+		code, _ := userSCIM.do(http.MethodGet, "/Users", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+}
+
+// scimClient makes requests to an org's SCIM API.
+type scimClient struct {
+	t       *testing.T
+	baseURL string
+	token   string
+}
+
+// do makes a SCIM request and returns the response status code and decoded response body (if any).
+func (c *scimClient) do(method, path string, body any) (int, map[string]any) {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		require.NoError(c.t, err)
+	}
+
+	req, err := http.NewRequestWithContext(c.t.Context(), method, c.baseURL+path, bytes.NewReader(data))
+	require.NoError(c.t, err)
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/scim+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+
+	var res map[string]any
+	if resp.StatusCode != http.StatusNoContent {
+		require.NoError(c.t, json.NewDecoder(resp.Body).Decode(&res))
+	}
+	return resp.StatusCode, res
+}
+
+// scimMemberIDs returns the IDs of the members in a SCIM group resource.
+func scimMemberIDs(group map[string]any) []string {
+	members, _ := group["members"].([]any)
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.(map[string]any)["value"].(string)
+	}
+	return ids
+}
@@ -170,6 +170,7 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 			grpc_validator.StreamServerInterceptor(),
 			s.authenticator.StreamServerInterceptor(),
 			grpc_auth.StreamServerInterceptor(s.checkRateLimit),
+			grpc_auth.StreamServerInterceptor(s.withAuditActor),
 		),
 		grpc.ChainUnaryInterceptor(
 			middleware.TimeoutUnaryServerInterceptor(timeoutSelector),
@@ -179,6 +180,7 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 			grpc_validator.UnaryServerInterceptor(),
 			s.authenticator.UnaryServerInterceptor(),
 			grpc_auth.UnaryServerInterceptor(s.checkRateLimit),
+			grpc_auth.UnaryServerInterceptor(s.withAuditActor),
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
@@ -250,6 +252,9 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add SCIM provisioning endpoints (not gRPC handlers, just regular endpoints on /v1/orgs/{org}/scim/v2/*)
+	s.registerSCIMEndpoints(mux)
+
 	// Add project assets endpoint.
 	mux.Handle("/v1/assets/{asset_id}/download", observability.Middleware("assets", s.logger, s.authenticator.HTTPMiddleware(httputil.Handler(s.assetHandler))))
 
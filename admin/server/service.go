@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/authtoken"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -96,6 +97,18 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		}
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      org.ID,
+		Action:     admin.AuditActionServiceCreate,
+		TargetType: admin.AuditTargetService,
+		TargetID:   service.ID,
+		TargetName: service.Name,
+		After:      map[string]any{"org_role": req.OrgRoleName, "project": req.Project, "project_role": req.ProjectRoleName},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -249,11 +262,37 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		updateOpts.Attributes = req.Attributes.AsMap()
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	oldName := service.Name
 	service, err = s.admin.DB.UpdateService(ctx, service.ID, updateOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	// Attributes are not recorded since they may be used to pass sensitive values to security policies.
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      org.ID,
+		Action:     admin.AuditActionServiceUpdate,
+		TargetType: admin.AuditTargetService,
+		TargetID:   service.ID,
+		TargetName: service.Name,
+		Before:     map[string]any{"name": oldName},
+		After:      map[string]any{"name": service.Name, "attributes_updated": req.Attributes != nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.UpdateServiceResponse{
 		Service: serviceToPB(service, org.Name),
 	}, nil
@@ -420,11 +459,33 @@ func (s *Server) DeleteService(ctx context.Context, req *adminv1.DeleteServiceRe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to delete service")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteService(ctx, service.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      org.ID,
+		Action:     admin.AuditActionServiceDelete,
+		TargetType: admin.AuditTargetService,
+		TargetID:   service.ID,
+		TargetName: service.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.DeleteServiceResponse{}, nil
 }
 
@@ -535,11 +596,32 @@ func (s *Server) RevokeServiceAuthToken(ctx context.Context, req *adminv1.Revoke
 		return nil, status.Error(codes.PermissionDenied, "not allowed to revoke auth token")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteServiceAuthToken(ctx, token.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      org.ID,
+		Action:     admin.AuditActionTokenRevoke,
+		TargetType: admin.AuditTargetServiceToken,
+		TargetID:   token.ID,
+		TargetName: service.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RevokeServiceAuthTokenResponse{}, nil
 }
 
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListProjectSnapshots(ctx context.Context, req *adminv1.ListProjectSnapshotsRequest) (*adminv1.ListProjectSnapshotsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Org),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Org, req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProd && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project snapshots")
+	}
+
+	token, err := unmarshalStringTimestampPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var beforeCreatedOn time.Time
+	if token.Ts != nil {
+		beforeCreatedOn = token.Ts.AsTime()
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	snapshots, err := s.admin.DB.FindProjectSnapshots(ctx, proj.ID, beforeCreatedOn, token.Str, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nextToken := ""
+	if len(snapshots) >= pageSize {
+		last := snapshots[len(snapshots)-1]
+		nextToken = marshalStringTimestampPageToken(last.ID, last.CreatedOn)
+	}
+
+	dtos := make([]*adminv1.ProjectSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		dtos[i] = projectSnapshotToDTO(snapshot)
+	}
+
+	return &adminv1.ListProjectSnapshotsResponse{
+		Snapshots:     dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+func (s *Server) CreateProjectSnapshot(ctx context.Context, req *adminv1.CreateProjectSnapshotRequest) (*adminv1.CreateProjectSnapshotResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Org),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Org, req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to create project snapshots")
+	}
+
+	var createdByUserID *string
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		id := claims.OwnerID()
+		createdByUserID = &id
+	}
+
+	snapshot, err := s.admin.CreateProjectSnapshot(ctx, proj, createdByUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.CreateProjectSnapshotResponse{
+		Snapshot: projectSnapshotToDTO(snapshot),
+	}, nil
+}
+
+func (s *Server) RestoreProjectSnapshot(ctx context.Context, req *adminv1.RestoreProjectSnapshotRequest) (*adminv1.RestoreProjectSnapshotResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Org),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.snapshot_id", req.SnapshotId),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Org, req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	forceAccess := claims.Superuser(ctx) && req.SuperuserForceAccess
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd && !forceAccess {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to restore project snapshots")
+	}
+
+	snapshot, err := s.admin.DB.FindProjectSnapshot(ctx, req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.ProjectID != proj.ID {
+		return nil, status.Error(codes.NotFound, "snapshot not found")
+	}
+
+	err = s.admin.RestoreProjectSnapshot(ctx, proj, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.RestoreProjectSnapshotResponse{}, nil
+}
+
+func projectSnapshotToDTO(s *database.ProjectSnapshot) *adminv1.ProjectSnapshot {
+	var st adminv1.ProjectSnapshotStatus
+	switch s.Status {
+	case database.ProjectSnapshotStatusUnspecified:
+		st = adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_UNSPECIFIED
+	case database.ProjectSnapshotStatusPending:
+		st = adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_PENDING
+	case database.ProjectSnapshotStatusOK:
+		st = adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_OK
+	case database.ProjectSnapshotStatusErrored:
+		st = adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_ERRORED
+	default:
+		panic(fmt.Errorf("unhandled project snapshot status %d", s.Status))
+	}
+
+	return &adminv1.ProjectSnapshot{
+		Id:              s.ID,
+		ProjectId:       s.ProjectID,
+		DeploymentId:    s.DeploymentID,
+		Status:          st,
+		StatusMessage:   s.StatusMessage,
+		CreatedByUserId: safeStr(s.CreatedByUserID),
+		SizeBytes:       s.SizeBytes,
+		Tables:          s.Tables,
+		CreatedOn:       timestamppb.New(s.CreatedOn),
+		UpdatedOn:       timestamppb.New(s.UpdatedOn),
+	}
+}
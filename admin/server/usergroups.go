@@ -5,6 +5,7 @@ import (
 	"errors"
 	"slices"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -276,11 +277,33 @@ func (s *Server) DeleteUsergroup(ctx context.Context, req *adminv1.DeleteUsergro
 		return nil, status.Error(codes.FailedPrecondition, "cannot edit managed user group")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteUsergroup(ctx, usergroup.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      usergroup.OrgID,
+		Action:     admin.AuditActionUsergroupDelete,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.DeleteUsergroupResponse{}, nil
 }
 
@@ -313,11 +336,34 @@ func (s *Server) AddOrganizationMemberUsergroup(ctx context.Context, req *adminv
 		return nil, status.Error(codes.PermissionDenied, "as a non-admin you are not allowed to assign an admin role")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.InsertOrganizationMemberUsergroup(ctx, usergroup.ID, usergroup.OrgID, role.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      usergroup.OrgID,
+		Action:     admin.AuditActionOrgUsergroupAdd,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+		After:      map[string]any{"role": role.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.AddOrganizationMemberUsergroupResponse{}, nil
 }
 
@@ -358,11 +404,35 @@ func (s *Server) SetOrganizationMemberUsergroupRole(ctx context.Context, req *ad
 		return nil, status.Error(codes.PermissionDenied, "as a non-admin you are not allowed to remove an admin role")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.UpdateOrganizationMemberUsergroup(ctx, usergroup.ID, usergroup.OrgID, role.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      usergroup.OrgID,
+		Action:     admin.AuditActionOrgUsergroupRoleUpdate,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+		Before:     auditRoleState(currentRole),
+		After:      map[string]any{"role": role.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.SetOrganizationMemberUsergroupRoleResponse{}, nil
 }
 
@@ -394,11 +464,34 @@ func (s *Server) RemoveOrganizationMemberUsergroup(ctx context.Context, req *adm
 		return nil, status.Error(codes.PermissionDenied, "as a non-admin you are not allowed to remove an admin role")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteOrganizationMemberUsergroup(ctx, usergroup.ID, usergroup.OrgID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      usergroup.OrgID,
+		Action:     admin.AuditActionOrgUsergroupRemove,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+		Before:     auditRoleState(currentRole),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RemoveOrganizationMemberUsergroupResponse{}, nil
 }
 
@@ -443,6 +536,12 @@ func (s *Server) AddProjectMemberUsergroup(ctx context.Context, req *adminv1.Add
 	restrictResources := valOrDefault(req.RestrictResources, false) || len(req.Resources) > 0
 	resources := resourceNamesFromProto(req.Resources)
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.InsertProjectMemberUsergroup(ctx, usergroup.ID, proj.ID, role.ID, restrictResources, resources)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotUnique) {
@@ -461,6 +560,24 @@ func (s *Server) AddProjectMemberUsergroup(ctx context.Context, req *adminv1.Add
 		}
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      proj.OrganizationID,
+		ProjectID:  proj.ID,
+		Action:     admin.AuditActionProjectUsergroupAdd,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+		After:      map[string]any{"role": role.Name, "restrict_resources": restrictResources, "resources": resources},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.AddProjectMemberUsergroupResponse{}, nil
 }
 
@@ -534,11 +651,36 @@ func (s *Server) SetProjectMemberUsergroupRole(ctx context.Context, req *adminv1
 		return nil, status.Error(codes.PermissionDenied, "as a non-admin you are not allowed to remove an admin role")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.UpdateProjectMemberUsergroup(ctx, usergroup.ID, proj.ID, role.ID, restrictResources, resources)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      proj.OrganizationID,
+		ProjectID:  proj.ID,
+		Action:     admin.AuditActionProjectUsergroupRoleUpdate,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+		Before:     map[string]any{"role": currentRole.Name},
+		After:      map[string]any{"role": role.Name, "restrict_resources": restrictResources, "resources": resources},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.SetProjectMemberUsergroupRoleResponse{}, nil
 }
 
@@ -572,11 +714,35 @@ func (s *Server) RemoveProjectMemberUsergroup(ctx context.Context, req *adminv1.
 		return nil, status.Error(codes.PermissionDenied, "as a non-admin you are not allowed to remove an admin role")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteProjectMemberUsergroup(ctx, usergroup.ID, proj.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      proj.OrganizationID,
+		ProjectID:  proj.ID,
+		Action:     admin.AuditActionProjectUsergroupRemove,
+		TargetType: admin.AuditTargetUsergroup,
+		TargetID:   usergroup.ID,
+		TargetName: usergroup.Name,
+		Before:     map[string]any{"role": currentRole.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RemoveProjectMemberUsergroupResponse{}, nil
 }
 
@@ -645,11 +811,34 @@ func (s *Server) AddUsergroupMemberUser(ctx context.Context, req *adminv1.AddUse
 		return nil, status.Error(codes.FailedPrecondition, "user is not a member of the organization")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.InsertUsergroupMemberUser(ctx, group.ID, user.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      group.OrgID,
+		Action:     admin.AuditActionUsergroupMemberAdd,
+		TargetType: admin.AuditTargetUser,
+		TargetID:   user.ID,
+		TargetName: user.Email,
+		After:      map[string]any{"usergroup": group.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.AddUsergroupMemberUserResponse{}, nil
 }
 
@@ -756,11 +945,34 @@ func (s *Server) RemoveUsergroupMemberUser(ctx context.Context, req *adminv1.Rem
 		return nil, err
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteUsergroupMemberUser(ctx, group.ID, user.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:      group.OrgID,
+		Action:     admin.AuditActionUsergroupMemberRemove,
+		TargetType: admin.AuditTargetUser,
+		TargetID:   user.ID,
+		TargetName: user.Email,
+		Before:     map[string]any{"usergroup": group.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RemoveUsergroupMemberUserResponse{}, nil
 }
 
@@ -796,3 +1008,11 @@ func memberUsergroupsToPB(groups []*database.MemberUsergroup) []*adminv1.MemberU
 	}
 	return dtos
 }
+
+// auditRoleState returns the audit log state for an optional role assignment.
+func auditRoleState(role *database.OrganizationRole) map[string]any {
+	if role == nil {
+		return nil
+	}
+	return map[string]any{"role": role.Name}
+}
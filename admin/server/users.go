@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/authtoken"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -297,11 +298,30 @@ func (s *Server) IssueUserAuthToken(ctx context.Context, req *adminv1.IssueUserA
 		representingUserID = &u.ID
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	authToken, err := s.admin.IssueUserAuthToken(ctx, userID, req.ClientId, req.DisplayName, representingUserID, ttl, false)
 	if err != nil {
 		return nil, err
 	}
 
+	// Representative tokens are already recorded by IssueUserAuthToken.
+	if representingUserID == nil {
+		err = s.admin.RecordUserAuthTokenAuditEvent(ctx, authToken.TokenModel().(*database.UserAuthToken), admin.AuditActionTokenIssue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.IssueUserAuthTokenResponse{
 		Token: authToken.Token().String(),
 	}, nil
@@ -333,7 +353,7 @@ func (s *Server) RevokeUserAuthToken(ctx context.Context, req *adminv1.RevokeUse
 		tokenID = token.ID
 	}
 
-	err := s.admin.DB.DeleteUserAuthToken(ctx, tokenID)
+	err := s.revokeUserAuthToken(ctx, tokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -360,11 +380,29 @@ func (s *Server) RevokeAllUserAuthTokens(ctx context.Context, req *adminv1.Revok
 		return nil, status.Error(codes.PermissionDenied, "not authorized to revoke auth tokens for other users")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	tokensRevoked, err := s.admin.DB.DeleteAllUserAuthTokens(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	if tokensRevoked > 0 {
+		err = s.admin.RecordUserAuditEvent(ctx, userID, admin.AuditActionTokenRevoke, map[string]any{"auth_tokens": tokensRevoked}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RevokeAllUserAuthTokensResponse{
 		TokensRevoked: int32(tokensRevoked),
 	}, nil
@@ -391,11 +429,27 @@ func (s *Server) RevokeRepresentativeAuthTokens(ctx context.Context, req *adminv
 		attribute.String("args.user_id", u.ID),
 	)
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteUserAuthTokensByUserAndRepresentingUser(ctx, claims.OwnerID(), u.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.admin.RecordUserAuditEvent(ctx, u.ID, admin.AuditActionTokenRevoke, map[string]any{"representative_tokens_of": claims.OwnerID()}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RevokeRepresentativeAuthTokensResponse{}, nil
 }
 
@@ -451,7 +505,7 @@ func (s *Server) RevokeCurrentAuthToken(ctx context.Context, req *adminv1.Revoke
 	}
 	tokenID := claims.AuthTokenID()
 
-	err := s.admin.DB.DeleteUserAuthToken(ctx, tokenID)
+	err := s.revokeUserAuthToken(ctx, tokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -459,6 +513,32 @@ func (s *Server) RevokeCurrentAuthToken(ctx context.Context, req *adminv1.Revoke
 	return &adminv1.RevokeCurrentAuthTokenResponse{}, nil
 }
 
+// revokeUserAuthToken deletes a user auth token and records its revocation in the audit log.
+func (s *Server) revokeUserAuthToken(ctx context.Context, tokenID string) error {
+	ctx, tx, err := s.admin.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	tkn, err := s.admin.DB.FindUserAuthToken(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteUserAuthToken(ctx, tkn.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.RecordUserAuthTokenAuditEvent(ctx, tkn, admin.AuditActionTokenRevoke)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (s *Server) SudoGetResource(ctx context.Context, req *adminv1.SudoGetResourceRequest) (*adminv1.SudoGetResourceResponse, error) {
 	claims := auth.GetClaims(ctx)
 	if !claims.Superuser(ctx) {
@@ -0,0 +1,157 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrSnapshotsNotSupported is returned when snapshots are requested for a deployment whose runtime instance does not support them.
+var ErrSnapshotsNotSupported = errors.New("snapshots are not supported for this project")
+
+// CreateProjectSnapshot schedules a snapshot of the project's primary deployment.
+// It returns a pending snapshot, which is populated by a background job once the snapshot has been taken.
+// The createdByUserID is nil for scheduled snapshots.
+func (s *Service) CreateProjectSnapshot(ctx context.Context, proj *database.Project, createdByUserID *string) (*database.ProjectSnapshot, error) {
+	if proj.PrimaryDeploymentID == nil {
+		return nil, fmt.Errorf("%w: project %q does not have a primary deployment", ErrDeploymentNotReady, proj.Name)
+	}
+	depl, err := s.DB.FindDeployment(ctx, *proj.PrimaryDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+	if depl.Status != database.DeploymentStatusRunning {
+		return nil, fmt.Errorf("%w: deployment %q is %s", ErrDeploymentNotReady, depl.ID, depl.Status)
+	}
+
+	snapshot, err := s.DB.InsertProjectSnapshot(ctx, &database.InsertProjectSnapshotOptions{
+		ProjectID:       proj.ID,
+		DeploymentID:    depl.ID,
+		Status:          database.ProjectSnapshotStatusPending,
+		CreatedByUserID: createdByUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.Jobs.CreateProjectSnapshot(ctx, snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// RunProjectSnapshot takes a pending snapshot on the snapshot's deployment and records the result.
+// It is called by the background job enqueued by CreateProjectSnapshot.
+// It returns ErrSnapshotsNotSupported if the deployment's runtime instance does not support snapshots, in which case the snapshot is marked as errored.
+func (s *Service) RunProjectSnapshot(ctx context.Context, snapshot *database.ProjectSnapshot) (*database.ProjectSnapshot, error) {
+	depl, err := s.DB.FindDeployment(ctx, snapshot.DeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := s.OpenRuntimeClient(depl)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Close()
+
+	res, err := rt.CreateSnapshot(ctx, &runtimev1.CreateSnapshotRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		SnapshotId: snapshot.ID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			_, updateErr := s.DB.UpdateProjectSnapshot(ctx, snapshot.ID, &database.UpdateProjectSnapshotOptions{
+				Status:        database.ProjectSnapshotStatusErrored,
+				StatusMessage: status.Convert(err).Message(),
+			})
+			return nil, errors.Join(ErrSnapshotsNotSupported, updateErr)
+		}
+		return nil, err
+	}
+
+	return s.DB.UpdateProjectSnapshot(ctx, snapshot.ID, &database.UpdateProjectSnapshotOptions{
+		Status:    database.ProjectSnapshotStatusOK,
+		SizeBytes: res.SizeBytes,
+		Tables:    res.Tables,
+	})
+}
+
+// RestoreProjectSnapshot restores the project's primary deployment to a snapshot.
+// The snapshot must have been taken on the current primary deployment, since snapshots are stored by the deployment's runtime.
+// Once the restore has started, it runs to completion and is recorded in the audit log even if ctx is cancelled.
+func (s *Service) RestoreProjectSnapshot(ctx context.Context, proj *database.Project, snapshot *database.ProjectSnapshot) error {
+	if snapshot.ProjectID != proj.ID {
+		return database.ErrNotFound
+	}
+	if snapshot.Status != database.ProjectSnapshotStatusOK {
+		return fmt.Errorf("%w: snapshot %q is %s", database.ErrValidation, snapshot.ID, snapshot.Status)
+	}
+	if proj.PrimaryDeploymentID == nil || *proj.PrimaryDeploymentID != snapshot.DeploymentID {
+		return fmt.Errorf("%w: snapshot %q was taken on a previous deployment of the project", ErrDeploymentNotReady, snapshot.ID)
+	}
+
+	depl, err := s.DB.FindDeployment(ctx, snapshot.DeploymentID)
+	if err != nil {
+		return err
+	}
+	if depl.Status != database.DeploymentStatusRunning {
+		return fmt.Errorf("%w: deployment %q is %s", ErrDeploymentNotReady, depl.ID, depl.Status)
+	}
+
+	rt, err := s.OpenRuntimeClient(depl)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	// The runtime completes a restore even if the request is cancelled, so we also wait for it to complete and record it in the audit log.
+	ctx = context.WithoutCancel(ctx)
+	_, err = rt.RestoreSnapshot(ctx, &runtimev1.RestoreSnapshotRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		SnapshotId: snapshot.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("restored project snapshot", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("snapshot_id", snapshot.ID))
+
+	return s.recordDeploymentAuditEvent(ctx, depl, AuditActionDeploymentRestore, nil, map[string]any{
+		"snapshot_id":         snapshot.ID,
+		"snapshot_created_on": snapshot.CreatedOn.String(),
+	})
+}
+
+// DeleteProjectSnapshot deletes a snapshot's data from the deployment's runtime and removes it from the database.
+func (s *Service) DeleteProjectSnapshot(ctx context.Context, snapshot *database.ProjectSnapshot) error {
+	// Pending and errored snapshots may have partial data, so we always try to delete the data.
+	depl, err := s.DB.FindDeployment(ctx, snapshot.DeploymentID)
+	if err != nil {
+		return err
+	}
+
+	rt, err := s.OpenRuntimeClient(depl)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	_, err = rt.DeleteSnapshot(ctx, &runtimev1.DeleteSnapshotRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		SnapshotId: snapshot.ID,
+	})
+	if err != nil && status.Code(err) != codes.FailedPrecondition {
+		return err
+	}
+
+	return s.DB.DeleteProjectSnapshot(ctx, snapshot.ID)
+}
@@ -322,6 +322,11 @@ func (u *URLs) Asset(assetID string) string {
 	return urlutil.MustJoinURL(u.External(), "/v1/assets", assetID, "download")
 }
 
+// SCIM returns the base URL of the SCIM 2.0 provisioning API for an org.
+func (u *URLs) SCIM(org string) string {
+	return urlutil.MustJoinURL(u.External(), "/v1/orgs", org, "scim/v2")
+}
+
 // Embed creates a URL for embedding the frontend in an iframe.
 func (u *URLs) Embed(query map[string]string) (string, error) {
 	return urlutil.WithQuery(urlutil.MustJoinURL(u.Frontend(), "-", "embed"), query)
@@ -33,6 +33,16 @@ func (s *Service) InsertOrganizationMemberUser(ctx context.Context, orgID, userI
 		if err != nil {
 			return err
 		}
+
+		member, err := s.DB.FindOrganizationMemberUser(ctx, orgID, userID)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordUserMemberAuditEvent(ctx, orgID, "", userID, AuditActionOrgMemberAdd, nil, map[string]any{"role": member.RoleName})
+		if err != nil {
+			return err
+		}
 	}
 
 	return tx.Commit()
@@ -59,6 +69,16 @@ func (s *Service) InsertProjectMemberUser(ctx context.Context, orgID, projectID,
 		return err
 	}
 
+	role, err := s.DB.FindProjectRoleByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordUserMemberAuditEvent(ctx, orgID, projectID, userID, AuditActionProjectMemberAdd, nil, map[string]any{"role": role.Name, "restrict_resources": restrictResources})
+	if err != nil {
+		return err
+	}
+
 	// All project-level members must also be org members.
 	// So if the user is not already a member of the organization, add them as a guest.
 	err = s.InsertOrganizationMemberUser(ctx, orgID, userID, guestRole.ID, attributes, true)
@@ -69,6 +89,68 @@ func (s *Service) InsertProjectMemberUser(ctx context.Context, orgID, projectID,
 	return tx.Commit()
 }
 
+// UpdateProjectMemberUserRole updates the role and resource restrictions of a user in a project.
+// It may be called with or without holding an existing transaction.
+func (s *Service) UpdateProjectMemberUserRole(ctx context.Context, orgID, projectID, userID, roleID string, restrictResources bool, resources []database.ResourceName) error {
+	ctx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	before, err := s.DB.FindProjectMemberUser(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.UpdateProjectMemberUserRole(ctx, projectID, userID, roleID, restrictResources, resources)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.DB.FindProjectRoleByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordUserMemberAuditEvent(ctx, orgID, projectID, userID, AuditActionProjectMemberRoleUpdate,
+		map[string]any{"role": before.RoleName, "restrict_resources": before.RestrictResources, "resources": before.Resources},
+		map[string]any{"role": role.Name, "restrict_resources": restrictResources, "resources": resources},
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteProjectMemberUser deletes a user as a member of a project.
+// It may be called with or without holding an existing transaction.
+func (s *Service) DeleteProjectMemberUser(ctx context.Context, orgID, projectID, userID string) error {
+	ctx, tx, err := s.DB.NewTx(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	member, err := s.DB.FindProjectMemberUser(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.DeleteProjectMemberUser(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordUserMemberAuditEvent(ctx, orgID, projectID, userID, AuditActionProjectMemberRemove, map[string]any{"role": member.RoleName}, nil)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 // DeleteOrganizationMemberUser deletes a user as a member of an organization.
 // It transactionally also removes the user from all user groups in the org and all projects in the org.
 // It may be called with or without holding an existing transaction.
@@ -79,6 +161,11 @@ func (s *Service) DeleteOrganizationMemberUser(ctx context.Context, orgID, userI
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	member, err := s.DB.FindOrganizationMemberUser(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
 	err = s.DB.DeleteOrganizationMemberUser(ctx, orgID, userID)
 	if err != nil {
 		return err
@@ -96,6 +183,52 @@ func (s *Service) DeleteOrganizationMemberUser(ctx context.Context, orgID, userI
 		return err
 	}
 
+	err = s.recordUserMemberAuditEvent(ctx, orgID, "", userID, AuditActionOrgMemberRemove, map[string]any{"role": member.RoleName}, nil)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeprovisionOrganizationMemberUser removes a user from an organization.
+// If the user is no longer a member of any organization, it also revokes all of the user's auth tokens.
+// Auth tokens are not scoped to an organization, so they are kept when the user is still a member of other organizations.
+// It is used when the user is deactivated in an identity provider that manages the organization's members.
+func (s *Service) DeprovisionOrganizationMemberUser(ctx context.Context, orgID, userID string) error {
+	ctx, tx, err := s.DB.NewTx(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.DeleteOrganizationMemberUser(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Keep the user's tokens if they still have access to other orgs.
+	orgs, err := s.DB.FindOrganizationsForUser(ctx, userID, "", 1)
+	if err != nil {
+		return err
+	}
+	if len(orgs) > 0 {
+		return tx.Commit()
+	}
+
+	// Revoke the user's tokens so existing sessions and API tokens stop working immediately.
+	n, err := s.DB.DeleteAllUserAuthTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		err = s.recordUserMemberAuditEvent(ctx, orgID, "", userID, AuditActionTokenRevoke, map[string]any{"auth_tokens": n}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
@@ -108,6 +241,11 @@ func (s *Service) UpdateOrganizationMemberUserRole(ctx context.Context, orgID, u
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	before, err := s.DB.FindOrganizationMemberUser(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
 	err = s.DB.UpdateOrganizationMemberUserRole(ctx, orgID, userID, roleID)
 	if err != nil {
 		return err
@@ -123,6 +261,16 @@ func (s *Service) UpdateOrganizationMemberUserRole(ctx context.Context, orgID, u
 		return err
 	}
 
+	after, err := s.DB.FindOrganizationMemberUser(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordUserMemberAuditEvent(ctx, orgID, "", userID, AuditActionOrgMemberRoleUpdate, map[string]any{"role": before.RoleName}, map[string]any{"role": after.RoleName})
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
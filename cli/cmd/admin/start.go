@@ -40,6 +40,7 @@ import (
 	_ "github.com/rilldata/rill/admin/provisioner/clickhousestatic"
 	_ "github.com/rilldata/rill/admin/provisioner/kubernetes"
 	_ "github.com/rilldata/rill/admin/provisioner/static"
+	_ "github.com/rilldata/rill/admin/provisioner/vm"
 	_ "github.com/rilldata/rill/runtime/drivers/claude"
 	_ "github.com/rilldata/rill/runtime/drivers/gemini"
 	_ "github.com/rilldata/rill/runtime/drivers/mock/ai"
@@ -112,6 +113,10 @@ type Config struct {
 	StripeWebhookSecret        string        `split_words:"true"`
 	PylonIdentitySecret        string        `split_words:"true"`
 	AllowMockBilling           bool          `default:"false" split_words:"true"` // set to allow sending mock usage for billing, should be false in prod env
+	// ProjectSnapshotsCron is the schedule for snapshotting the data of projects' primary deployments. Scheduled snapshots are disabled if empty.
+	ProjectSnapshotsCron string `default:"" split_words:"true"`
+	// ProjectSnapshotRetention is how long project snapshots are kept before they are deleted.
+	ProjectSnapshotRetention time.Duration `default:"168h" split_words:"true"`
 }
 
 // StartCmd starts an admin server. It only allows configuration using environment variables.
@@ -334,6 +339,8 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				ScaleDownConstraint:        conf.ScaleDownConstraint,
 				AllowMockBilling:           conf.AllowMockBilling,
 				StoppedDeploymentRetention: conf.StoppedDeploymentRetention,
+				ProjectSnapshotsCron:       conf.ProjectSnapshotsCron,
+				ProjectSnapshotRetention:   conf.ProjectSnapshotRetention,
 			}
 			adm, err := admin.New(cmd.Context(), admOpts, logger, issuer, emailClient, gh, aiService, assetsBucket, biller, p)
 			if err != nil {
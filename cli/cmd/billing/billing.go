@@ -1,6 +1,7 @@
 package billing
 
 import (
+	"github.com/rilldata/rill/cli/cmd/billing/budget"
 	"github.com/rilldata/rill/cli/cmd/billing/plan"
 	"github.com/rilldata/rill/cli/cmd/billing/subscription"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -16,6 +17,7 @@ func BillingCmd(ch *cmdutil.Helper) *cobra.Command {
 	billingCmd.AddCommand(subscription.SubscriptionCmd(ch))
 	billingCmd.AddCommand(plan.PlanCmd(ch))
 	billingCmd.AddCommand(ListIssuesCmd(ch))
+	billingCmd.AddCommand(budget.BudgetCmd(ch))
 
 	return billingCmd
 }
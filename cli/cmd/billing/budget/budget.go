@@ -0,0 +1,21 @@
+package budget
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func BudgetCmd(ch *cmdutil.Helper) *cobra.Command {
+	budgetCmd := &cobra.Command{
+		Use:               "budget",
+		Short:             "Manage organization budget",
+		PersistentPreRunE: cmdutil.CheckAuth(ch),
+	}
+
+	budgetCmd.PersistentFlags().StringVar(&ch.Org, "org", ch.Org, "Organization Name")
+	budgetCmd.AddCommand(ShowCmd(ch))
+	budgetCmd.AddCommand(SetCmd(ch))
+	budgetCmd.AddCommand(DeleteCmd(ch))
+
+	return budgetCmd
+}
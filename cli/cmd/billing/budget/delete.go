@@ -0,0 +1,39 @@
+package budget
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	var force bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete budget for an organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.DeleteOrganizationBudget(cmd.Context(), &adminv1.DeleteOrganizationBudgetRequest{
+				Org:                  ch.Org,
+				SuperuserForceAccess: force,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Deleted budget for organization %q\n", ch.Org)
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Allows superusers to bypass certain checks")
+	_ = deleteCmd.Flags().MarkHidden("force")
+
+	return deleteCmd
+}
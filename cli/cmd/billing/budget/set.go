@@ -0,0 +1,66 @@
+package budget
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func SetCmd(ch *cmdutil.Helper) *cobra.Command {
+	var amount float64
+	var metric string
+	var warnAt []int
+	var hardCap bool
+	var force bool
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set monthly budget for an organization",
+		Long: `Set a monthly budget on the organization's spend, or on its usage of a billable metric if --metric is provided.
+Organization admins are notified by email when usage reaches each of the warning thresholds.
+With --hard-cap, the organization's non-production deployments are hibernated when the budget is exceeded.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if amount <= 0 {
+				return fmt.Errorf("--amount must be greater than 0")
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			thresholds := make([]int32, len(warnAt))
+			for i, t := range warnAt {
+				thresholds[i] = int32(t)
+			}
+
+			resp, err := client.UpdateOrganizationBudget(cmd.Context(), &adminv1.UpdateOrganizationBudgetRequest{
+				Org:                  ch.Org,
+				Metric:               metric,
+				Amount:               amount,
+				WarningThresholds:    thresholds,
+				HardCap:              hardCap,
+				SuperuserForceAccess: force,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Updated budget for organization %q\n", ch.Org)
+			ch.PrintOrganizationBudget(resp.Budget)
+			return nil
+		},
+	}
+
+	setCmd.Flags().Float64Var(&amount, "amount", 0, "Monthly budget amount, in USD for spend budgets or in units of the metric for usage budgets")
+	setCmd.Flags().StringVar(&metric, "metric", "", "Billable usage metric to budget (defaults to spend)")
+	setCmd.Flags().IntSliceVar(&warnAt, "warn-at", []int{50, 80, 100}, "Percentages of the budget at which to notify organization admins")
+	setCmd.Flags().BoolVar(&hardCap, "hard-cap", false, "Hibernate non-production deployments when the budget is exceeded")
+	setCmd.Flags().BoolVar(&force, "force", false, "Allows superusers to bypass certain checks")
+	_ = setCmd.Flags().MarkHidden("force")
+
+	return setCmd
+}
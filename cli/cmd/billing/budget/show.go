@@ -0,0 +1,44 @@
+package budget
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ShowCmd(ch *cmdutil.Helper) *cobra.Command {
+	var force bool
+
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show budget for an organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetOrganizationBudget(cmd.Context(), &adminv1.GetOrganizationBudgetRequest{
+				Org:                  ch.Org,
+				SuperuserForceAccess: force,
+			})
+			if err != nil {
+				return err
+			}
+
+			if resp.Budget == nil {
+				ch.PrintfWarn("No budget found for organization %q\n", ch.Org)
+				return nil
+			}
+
+			ch.PrintOrganizationBudget(resp.Budget)
+			return nil
+		},
+	}
+
+	showCmd.Flags().BoolVar(&force, "force", false, "Allows superusers to bypass certain checks")
+	_ = showCmd.Flags().MarkHidden("force")
+
+	return showCmd
+}
@@ -0,0 +1,110 @@
+package org
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AuditLogCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, actor, targetType, targetID, since, until, pageToken string
+	var actions []string
+	var pageSize uint32
+	var all bool
+
+	auditLogCmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "List audit events for an organization",
+		Long: `List audit events for an organization, newest first.
+
+Use "--format csv" or "--format json" together with "--all" to export the full audit log.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			req := &adminv1.ListAuditEventsRequest{
+				Org:        ch.Org,
+				Project:    project,
+				ActorEmail: actor,
+				Actions:    actions,
+				TargetType: targetType,
+				TargetId:   targetID,
+				PageSize:   pageSize,
+				PageToken:  pageToken,
+			}
+
+			if since != "" {
+				t, err := parseAuditLogTime(since)
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+				req.Since = timestamppb.New(t)
+			}
+			if until != "" {
+				t, err := parseAuditLogTime(until)
+				if err != nil {
+					return fmt.Errorf("invalid --until: %w", err)
+				}
+				req.Until = timestamppb.New(t)
+			}
+
+			var events []*adminv1.AuditEvent
+			var nextPageToken string
+			for {
+				res, err := client.ListAuditEvents(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				events = append(events, res.Events...)
+				nextPageToken = res.NextPageToken
+
+				if !all || nextPageToken == "" {
+					break
+				}
+				req.PageToken = nextPageToken
+			}
+
+			if len(events) == 0 {
+				ch.PrintfWarn("No audit events found\n")
+				return nil
+			}
+
+			ch.PrintAuditEvents(events)
+
+			if nextPageToken != "" {
+				ch.Println()
+				ch.Printf("Next page token: %s\n", nextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditLogCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization name")
+	auditLogCmd.Flags().StringVar(&project, "project", "", "Only show events for this project")
+	auditLogCmd.Flags().StringVar(&actor, "actor", "", "Only show events performed by the user with this email")
+	auditLogCmd.Flags().StringSliceVar(&actions, "action", nil, `Only show events with these actions (e.g. "org.member.add")`)
+	auditLogCmd.Flags().StringVar(&targetType, "target-type", "", `Only show events for this target type (e.g. "user", "deployment")`)
+	auditLogCmd.Flags().StringVar(&targetID, "target-id", "", "Only show events for the target with this ID")
+	auditLogCmd.Flags().StringVar(&since, "since", "", `Only show events after this time (RFC3339 timestamp or duration such as "24h")`)
+	auditLogCmd.Flags().StringVar(&until, "until", "", `Only show events before this time (RFC3339 timestamp or duration such as "24h")`)
+	auditLogCmd.Flags().Uint32Var(&pageSize, "page-size", 100, "Number of events to return per page")
+	auditLogCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+	auditLogCmd.Flags().BoolVar(&all, "all", false, "Fetch all pages")
+
+	return auditLogCmd
+}
+
+// parseAuditLogTime parses an RFC3339 timestamp or a duration relative to the current time.
+func parseAuditLogTime(s string) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
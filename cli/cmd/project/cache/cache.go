@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func CacheCmd(ch *cmdutil.Helper) *cobra.Command {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage cached query results",
+	}
+
+	cacheCmd.AddCommand(FlushCmd(ch))
+	return cacheCmd
+}
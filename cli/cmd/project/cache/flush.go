@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/spf13/cobra"
+)
+
+func FlushCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, branch, metricsView string
+	var local bool
+
+	flushCmd := &cobra.Command{
+		Use:   "flush [<project>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Flush cached query results",
+		Long: "Evict cached query results for a metrics view or, if no metrics view is specified, for the whole project. " +
+			"Use this to force fresh results after the underlying data has changed outside of Rill (e.g. after a manual backfill).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			if !local && project == "" {
+				var err error
+				project, err = ch.InferProjectName(cmd.Context(), path, "use --project to specify the name or --local to target a local Rill process")
+				if err != nil {
+					return err
+				}
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(cmd.Context(), ch.Org, project, branch, local)
+			if err != nil {
+				return err
+			}
+
+			_, err = rt.InvalidateQueryCache(cmd.Context(), &runtimev1.InvalidateQueryCacheRequest{
+				InstanceId:  instanceID,
+				MetricsView: metricsView,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to flush query cache: %w", err)
+			}
+
+			if metricsView != "" {
+				ch.PrintfSuccess("Flushed cached query results for metrics view %q.\n", metricsView)
+			} else {
+				ch.PrintfSuccess("Flushed all cached query results.\n")
+			}
+
+			return nil
+		},
+	}
+
+	flushCmd.Flags().SortFlags = false
+	flushCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	flushCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	flushCmd.Flags().StringVar(&branch, "branch", "", "Target deployment by Git branch (default: primary deployment)")
+	flushCmd.Flags().StringVar(&metricsView, "metrics-view", "", "Only flush cached results for this metrics view")
+	flushCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+
+	return flushCmd
+}
@@ -0,0 +1,147 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/spf13/cobra"
+)
+
+func PlanCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, branch string
+	var local bool
+
+	planCmd := &cobra.Command{
+		Use:   "plan [<project>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Preview the changes that deploying local files would make",
+		Long: "Parse the project files in --path and show the resources that deploying them would create, update, rename or delete, " +
+			"and which models would be refreshed as a result. No changes are applied to the deployment.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			if !local && project == "" {
+				var err error
+				project, err = ch.InferProjectName(ctx, path, "use --project to specify the name or --local to target a local Rill process")
+				if err != nil {
+					return err
+				}
+			}
+
+			files, err := readProjectFiles(ctx, path)
+			if err != nil {
+				return fmt.Errorf("failed to read project files: %w", err)
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(ctx, ch.Org, project, branch, local)
+			if err != nil {
+				return err
+			}
+
+			res, err := rt.PlanProject(ctx, &runtimev1.PlanProjectRequest{
+				InstanceId: instanceID,
+				Files:      files,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to plan project: %w", err)
+			}
+
+			if len(res.ParseErrors) > 0 {
+				table := make([]*parseErrorTableRow, 0, len(res.ParseErrors))
+				for _, e := range res.ParseErrors {
+					lvl := "error"
+					if e.Warning {
+						lvl = "warning"
+					}
+					table = append(table, &parseErrorTableRow{
+						Path:  e.FilePath,
+						Level: lvl,
+						Error: e.Message,
+					})
+				}
+				ch.PrintfWarn("Parse issues\n\n")
+				ch.PrintData(table)
+				ch.Printf("\n")
+			}
+
+			if len(res.Changes) == 0 {
+				ch.PrintfSuccess("No changes. The deployment is up to date with the local files.\n")
+				return nil
+			}
+
+			table := make([]*planTableRow, 0, len(res.Changes))
+			for _, c := range res.Changes {
+				table = append(table, newPlanTableRow(c))
+			}
+			ch.PrintfSuccess("Planned changes\n\n")
+			ch.PrintData(table)
+
+			return nil
+		},
+	}
+
+	planCmd.Flags().SortFlags = false
+	planCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	planCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	planCmd.Flags().StringVar(&branch, "branch", "", "Target deployment by Git branch (default: primary deployment)")
+	planCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+
+	return planCmd
+}
+
+// readProjectFiles reads the files in a local project directory that are relevant for parsing.
+// It skips .env files to avoid sending secrets to the runtime; variables are taken from the deployment instead.
+func readProjectFiles(ctx context.Context, path string) (map[string]string, error) {
+	repo, _, err := cmdutil.RepoForProjectPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := repo.ListGlob(ctx, "**/*.{sql,yaml,yml}", true)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string, len(entries))
+	for _, e := range entries {
+		data, err := repo.Get(ctx, e.Path)
+		if err != nil {
+			return nil, err
+		}
+		files[e.Path] = data
+	}
+	return files, nil
+}
+
+type planTableRow struct {
+	Type        string `header:"type"`
+	Name        string `header:"name"`
+	Action      string `header:"action"`
+	Refresh     string `header:"refresh"`
+	RenamedFrom string `header:"renamed from"`
+	Files       string `header:"files"`
+}
+
+func newPlanTableRow(c *runtimev1.ResourceChange) *planTableRow {
+	row := &planTableRow{
+		Type:   runtime.PrettifyResourceKind(c.Name.Kind),
+		Name:   c.Name.Name,
+		Action: strings.ToLower(strings.TrimPrefix(c.Action.String(), "ACTION_")),
+		Files:  strings.Join(c.FilePaths, ", "),
+	}
+	if c.ModelRefresh != runtimev1.ResourceChange_MODEL_REFRESH_UNSPECIFIED {
+		row.Refresh = strings.ToLower(strings.TrimPrefix(c.ModelRefresh.String(), "MODEL_REFRESH_"))
+	}
+	if c.RenamedFrom != nil {
+		row.RenamedFrom = c.RenamedFrom.Name
+	}
+	return row
+}
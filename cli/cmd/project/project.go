@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/rilldata/rill/cli/cmd/project/cache"
 	"github.com/rilldata/rill/cli/cmd/project/deployment"
+	"github.com/rilldata/rill/cli/cmd/project/snapshots"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/spf13/cobra"
@@ -30,6 +32,9 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(LogsCmd(ch))
 	projectCmd.AddCommand(DescribeCmd(ch))
 	projectCmd.AddCommand(RefreshCmd(ch))
+	projectCmd.AddCommand(PlanCmd(ch))
+	projectCmd.AddCommand(cache.CacheCmd(ch))
+	projectCmd.AddCommand(snapshots.SnapshotsCmd(ch))
 	projectCmd.AddCommand(JwtCmd(ch))
 	projectCmd.AddCommand(CloneCmd(ch))
 	projectCmd.AddCommand(GitPushCmd(ch))
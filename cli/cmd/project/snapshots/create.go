@@ -0,0 +1,52 @@
+package snapshots
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path string
+
+	createCmd := &cobra.Command{
+		Use:   "create [<project>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Take a snapshot of a project's data",
+		Long:  "Take a snapshot of the data and catalog of a project's production deployment. The snapshot is taken in the background; use \"rill project snapshots list\" to check its status.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if project == "" {
+				project, err = ch.InferProjectName(cmd.Context(), path, "use --project to specify the name")
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.CreateProjectSnapshot(cmd.Context(), &adminv1.CreateProjectSnapshotRequest{
+				Org:     ch.Org,
+				Project: project,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Scheduled snapshot %s of project %q.\n", res.Snapshot.Id, project)
+			return nil
+		},
+	}
+
+	createCmd.Flags().SortFlags = false
+	createCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	createCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+
+	return createCmd
+}
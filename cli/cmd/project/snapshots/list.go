@@ -0,0 +1,61 @@
+package snapshots
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, pageToken string
+	var pageSize uint32
+
+	listCmd := &cobra.Command{
+		Use:   "list [<project>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "List snapshots of a project, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if project == "" {
+				project, err = ch.InferProjectName(cmd.Context(), path, "use --project to specify the name")
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.ListProjectSnapshots(cmd.Context(), &adminv1.ListProjectSnapshotsRequest{
+				Org:       ch.Org,
+				Project:   project,
+				PageSize:  pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintProjectSnapshots(res.Snapshots)
+
+			if res.NextPageToken != "" {
+				ch.Println()
+				ch.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	listCmd.Flags().SortFlags = false
+	listCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	listCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	listCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of snapshots to return per page")
+	listCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listCmd
+}
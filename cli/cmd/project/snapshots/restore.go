@@ -0,0 +1,64 @@
+package snapshots
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RestoreCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path string
+	var force bool
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore <snapshot-id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Restore a project's data to a snapshot",
+		Long: "Restore the data and catalog of a project's production deployment to a snapshot. " +
+			"Changes made to the data after the snapshot was taken are lost. " +
+			"The project's files are not changed, so resources that differ from the snapshot are reconciled again after the restore.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotID := args[0]
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if project == "" {
+				project, err = ch.InferProjectName(cmd.Context(), path, "use --project to specify the name")
+				if err != nil {
+					return err
+				}
+			}
+
+			if !force && ch.Interactive {
+				ch.PrintfWarn("Warn: Restoring snapshot %s will replace the current data of project %q\n", snapshotID, project)
+				if err := cmdutil.ConfirmPrompt("Do you want to continue?", false); err != nil {
+					return err
+				}
+			}
+
+			_, err = client.RestoreProjectSnapshot(cmd.Context(), &adminv1.RestoreProjectSnapshotRequest{
+				Org:        ch.Org,
+				Project:    project,
+				SnapshotId: snapshotID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			ch.PrintfSuccess("Restored project %q to snapshot %s.\n", project, snapshotID)
+			return nil
+		},
+	}
+
+	restoreCmd.Flags().SortFlags = false
+	restoreCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	restoreCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	restoreCmd.Flags().BoolVar(&force, "force", false, "Restore without confirmation")
+
+	return restoreCmd
+}
@@ -0,0 +1,20 @@
+package snapshots
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func SnapshotsCmd(ch *cmdutil.Helper) *cobra.Command {
+	snapshotsCmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage snapshots of a project's data",
+		Long: "Manage point-in-time snapshots of the data and catalog of a project's production deployment. " +
+			"Snapshots are only supported for projects that use DuckDB with backups enabled.",
+	}
+
+	snapshotsCmd.AddCommand(ListCmd(ch))
+	snapshotsCmd.AddCommand(CreateCmd(ch))
+	snapshotsCmd.AddCommand(RestoreCmd(ch))
+	return snapshotsCmd
+}
@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DrainCmd(ch *cmdutil.Helper) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain <host>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Drains a runtime host managed by the vm provisioner for maintenance",
+		Long:  "Drains a runtime host managed by the vm provisioner for maintenance. The host receives no new instances, and its existing instances are moved to other hosts the next time their deployments are reconciled.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateHostDraining(cmd, ch, args[0], true)
+		},
+	}
+
+	return cmd
+}
+
+func UndrainCmd(ch *cmdutil.Helper) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undrain <host>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Makes a drained runtime host available for new instances again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateHostDraining(cmd, ch, args[0], false)
+		},
+	}
+
+	return cmd
+}
+
+func updateHostDraining(cmd *cobra.Command, ch *cmdutil.Helper, host string, draining bool) error {
+	client, err := ch.Client()
+	if err != nil {
+		return err
+	}
+
+	res, err := client.SudoUpdateRuntimeHost(cmd.Context(), &adminv1.SudoUpdateRuntimeHostRequest{
+		Host:     host,
+		Draining: draining,
+	})
+	if err != nil {
+		return err
+	}
+
+	if draining {
+		ch.PrintfSuccess("Host %q is draining. Its instances will be moved to other hosts.\n", res.Host.Host)
+	} else {
+		ch.PrintfSuccess("Host %q is available for new instances\n", res.Host.Host)
+	}
+	ch.PrintRuntimeHosts([]*adminv1.RuntimeHost{res.Host})
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package runtime
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListHostsCmd(ch *cmdutil.Helper) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-hosts",
+		Args:  cobra.NoArgs,
+		Short: "Lists the runtime hosts managed by the vm provisioner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.SudoListRuntimeHosts(cmd.Context(), &adminv1.SudoListRuntimeHostsRequest{})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintRuntimeHosts(res.Hosts)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
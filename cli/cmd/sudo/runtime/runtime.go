@@ -14,6 +14,9 @@ func RuntimeCmd(ch *cmdutil.Helper) *cobra.Command {
 	cmd.AddCommand(ManagerTokenCmd(ch))
 	cmd.AddCommand(ListInstancesCmd(ch))
 	cmd.AddCommand(DeleteInstanceCmd(ch))
+	cmd.AddCommand(ListHostsCmd(ch))
+	cmd.AddCommand(DrainCmd(ch))
+	cmd.AddCommand(UndrainCmd(ch))
 
 	return cmd
 }
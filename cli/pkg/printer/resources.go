@@ -12,10 +12,12 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/c2h5oh/datasize"
 	"github.com/lensesio/tableprinter"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/metricsview"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func (p *Printer) PrintOrgs(orgs []*adminv1.Organization, defaultOrg string) {
@@ -717,6 +719,72 @@ type billingIssue struct {
 	EventTime    string `header:"event_time,timestamp(ms|utc|human)" json:"event_time"`
 }
 
+func (p *Printer) PrintAuditEvents(events []*adminv1.AuditEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	table := make([]*auditEvent, 0, len(events))
+	for _, e := range events {
+		table = append(table, toAuditEventRow(e))
+	}
+	p.PrintData(table)
+}
+
+func toAuditEventRow(e *adminv1.AuditEvent) *auditEvent {
+	actor := e.ActorEmail
+	if actor == "" {
+		actor = e.ActorId
+	}
+	if actor == "" {
+		actor = e.ActorType
+	} else if e.ActorType != "user" {
+		actor = fmt.Sprintf("%s:%s", e.ActorType, actor)
+	}
+
+	target := e.TargetName
+	if target == "" {
+		target = e.TargetId
+	}
+
+	return &auditEvent{
+		ID:         e.Id,
+		CreatedOn:  e.CreatedOn.AsTime().Local().Format(time.DateTime),
+		Actor:      actor,
+		AssumedBy:  e.AssumedByUserId,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		Target:     target,
+		Before:     formatAuditEventState(e.Before),
+		After:      formatAuditEventState(e.After),
+		ClientIP:   e.ClientIp,
+	}
+}
+
+func formatAuditEventState(s *structpb.Struct) string {
+	if s == nil {
+		return ""
+	}
+	data, err := json.Marshal(s.AsMap())
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+type auditEvent struct {
+	ID         string `header:"id" json:"id"`
+	CreatedOn  string `header:"time,timestamp(ms|utc|human)" json:"created_on"`
+	Actor      string `header:"actor" json:"actor"`
+	AssumedBy  string `header:"assumed by" json:"assumed_by_user_id"`
+	Action     string `header:"action" json:"action"`
+	TargetType string `header:"target type" json:"target_type"`
+	Target     string `header:"target" json:"target"`
+	Before     string `header:"before" json:"before"`
+	After      string `header:"after" json:"after"`
+	ClientIP   string `header:"client ip" json:"client_ip"`
+}
+
 func (p *Printer) PrintDeployments(deployments []*adminv1.Deployment) {
 	if len(deployments) == 0 {
 		p.PrintfWarn("No deployments found\n")
@@ -813,6 +881,141 @@ type deployment struct {
 	UsedOn      string `header:"last_used" json:"last_used"`
 }
 
+func (p *Printer) PrintProjectSnapshots(snapshots []*adminv1.ProjectSnapshot) {
+	if len(snapshots) == 0 {
+		p.PrintfWarn("No snapshots found\n")
+		return
+	}
+
+	res := make([]*projectSnapshot, 0, len(snapshots))
+	for _, s := range snapshots {
+		res = append(res, toProjectSnapshotRow(s))
+	}
+	p.PrintData(res)
+}
+
+func toProjectSnapshotRow(s *adminv1.ProjectSnapshot) *projectSnapshot {
+	status := formatProjectSnapshotStatus(s.Status)
+	if s.StatusMessage != "" {
+		status = fmt.Sprintf("%s: %s", status, s.StatusMessage)
+	}
+	trigger := "scheduled"
+	if s.CreatedByUserId != "" {
+		trigger = "manual"
+	}
+	return &projectSnapshot{
+		ID:        s.Id,
+		CreatedOn: s.CreatedOn.AsTime().Local().Format(time.DateTime),
+		Status:    status,
+		Trigger:   trigger,
+		Tables:    len(s.Tables),
+		Size:      datasize.ByteSize(s.SizeBytes).HumanReadable(),
+	}
+}
+
+func formatProjectSnapshotStatus(status adminv1.ProjectSnapshotStatus) string {
+	switch status {
+	case adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_PENDING:
+		return "Pending"
+	case adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_OK:
+		return "OK"
+	case adminv1.ProjectSnapshotStatus_PROJECT_SNAPSHOT_STATUS_ERRORED:
+		return "Errored"
+	default:
+		return "Unknown"
+	}
+}
+
+type projectSnapshot struct {
+	ID        string `header:"id" json:"id"`
+	CreatedOn string `header:"created on" json:"created_on"`
+	Status    string `header:"status" json:"status"`
+	Trigger   string `header:"trigger" json:"trigger"`
+	Tables    int    `header:"tables" json:"tables"`
+	Size      string `header:"size" json:"size"`
+}
+
+func (p *Printer) PrintOrganizationBudget(b *adminv1.OrganizationBudget) {
+	metric := b.Metric
+	if metric == "" {
+		metric = "spend"
+	}
+	thresholds := make([]string, len(b.WarningThresholds))
+	for i, t := range b.WarningThresholds {
+		thresholds[i] = fmt.Sprintf("%d%%", t)
+	}
+	evaluatedOn := ""
+	if b.EvaluatedOn != nil {
+		evaluatedOn = b.EvaluatedOn.AsTime().Local().Format(time.DateTime)
+	}
+
+	p.PrintData([]*organizationBudget{{
+		Metric:            metric,
+		Amount:            b.Amount,
+		WarningThresholds: strings.Join(thresholds, ", "),
+		HardCap:           b.HardCap,
+		CurrentValue:      b.CurrentValue,
+		Capped:            b.Capped,
+		EvaluatedOn:       evaluatedOn,
+	}})
+}
+
+type organizationBudget struct {
+	Metric            string  `header:"metric" json:"metric"`
+	Amount            float64 `header:"amount" json:"amount"`
+	WarningThresholds string  `header:"warning thresholds" json:"warning_thresholds"`
+	HardCap           bool    `header:"hard cap" json:"hard_cap"`
+	CurrentValue      float64 `header:"current value" json:"current_value"`
+	Capped            bool    `header:"capped" json:"capped"`
+	EvaluatedOn       string  `header:"evaluated on" json:"evaluated_on"`
+}
+
+func (p *Printer) PrintRuntimeHosts(hosts []*adminv1.RuntimeHost) {
+	if len(hosts) == 0 {
+		p.PrintfWarn("No runtime hosts found\n")
+		return
+	}
+
+	p.PrintData(toRuntimeHostsTable(hosts))
+}
+
+func toRuntimeHostsTable(hosts []*adminv1.RuntimeHost) []*runtimeHost {
+	res := make([]*runtimeHost, 0, len(hosts))
+	for _, h := range hosts {
+		res = append(res, toRuntimeHostRow(h))
+	}
+	return res
+}
+
+func toRuntimeHostRow(h *adminv1.RuntimeHost) *runtimeHost {
+	checkedOn := ""
+	if h.CheckedOn != nil {
+		checkedOn = h.CheckedOn.AsTime().Local().Format(time.DateTime)
+	}
+
+	return &runtimeHost{
+		Host:          h.Host,
+		Healthy:       h.Healthy,
+		Draining:      h.Draining,
+		Instances:     h.Instances,
+		CPU:           fmt.Sprintf("%d/%d", h.CpuUsed, h.Cpu),
+		MemoryGB:      fmt.Sprintf("%d/%d", h.MemoryGbUsed, h.MemoryGb),
+		StatusMessage: h.StatusMessage,
+		CheckedOn:     checkedOn,
+	}
+}
+
+type runtimeHost struct {
+	Host          string `header:"host" json:"host"`
+	Healthy       bool   `header:"healthy" json:"healthy"`
+	Draining      bool   `header:"draining" json:"draining"`
+	Instances     int32  `header:"instances" json:"instances"`
+	CPU           string `header:"cpu used" json:"cpu"`
+	MemoryGB      string `header:"memory gb used" json:"memory_gb"`
+	StatusMessage string `header:"status message" json:"status_message"`
+	CheckedOn     string `header:"checked on" json:"checked_on"`
+}
+
 // PrintQueryResponse prints the query response in the desired format (human, json, csv)
 func (p *Printer) PrintQueryResponse(res *runtimev1.QueryResolverResponse) {
 	if len(res.Data) == 0 {
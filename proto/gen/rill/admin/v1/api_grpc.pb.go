@@ -26,6 +26,7 @@ const (
 	AdminService_CreateOrganization_FullMethodName                     = "/rill.admin.v1.AdminService/CreateOrganization"
 	AdminService_DeleteOrganization_FullMethodName                     = "/rill.admin.v1.AdminService/DeleteOrganization"
 	AdminService_UpdateOrganization_FullMethodName                     = "/rill.admin.v1.AdminService/UpdateOrganization"
+	AdminService_ListAuditEvents_FullMethodName                        = "/rill.admin.v1.AdminService/ListAuditEvents"
 	AdminService_ListProjectsForOrganization_FullMethodName            = "/rill.admin.v1.AdminService/ListProjectsForOrganization"
 	AdminService_ListProjectsForOrganizationAndUser_FullMethodName     = "/rill.admin.v1.AdminService/ListProjectsForOrganizationAndUser"
 	AdminService_ListProjectsForFingerprint_FullMethodName             = "/rill.admin.v1.AdminService/ListProjectsForFingerprint"
@@ -41,6 +42,9 @@ const (
 	AdminService_CreateAsset_FullMethodName                            = "/rill.admin.v1.AdminService/CreateAsset"
 	AdminService_RedeployProject_FullMethodName                        = "/rill.admin.v1.AdminService/RedeployProject"
 	AdminService_HibernateProject_FullMethodName                       = "/rill.admin.v1.AdminService/HibernateProject"
+	AdminService_ListProjectSnapshots_FullMethodName                   = "/rill.admin.v1.AdminService/ListProjectSnapshots"
+	AdminService_CreateProjectSnapshot_FullMethodName                  = "/rill.admin.v1.AdminService/CreateProjectSnapshot"
+	AdminService_RestoreProjectSnapshot_FullMethodName                 = "/rill.admin.v1.AdminService/RestoreProjectSnapshot"
 	AdminService_ListDeployments_FullMethodName                        = "/rill.admin.v1.AdminService/ListDeployments"
 	AdminService_CreateDeployment_FullMethodName                       = "/rill.admin.v1.AdminService/CreateDeployment"
 	AdminService_GetDeployment_FullMethodName                          = "/rill.admin.v1.AdminService/GetDeployment"
@@ -121,6 +125,8 @@ const (
 	AdminService_SudoUpdateOrganizationCustomDomain_FullMethodName     = "/rill.admin.v1.AdminService/SudoUpdateOrganizationCustomDomain"
 	AdminService_SudoUpdateAnnotations_FullMethodName                  = "/rill.admin.v1.AdminService/SudoUpdateAnnotations"
 	AdminService_SudoIssueRuntimeManagerToken_FullMethodName           = "/rill.admin.v1.AdminService/SudoIssueRuntimeManagerToken"
+	AdminService_SudoListRuntimeHosts_FullMethodName                   = "/rill.admin.v1.AdminService/SudoListRuntimeHosts"
+	AdminService_SudoUpdateRuntimeHost_FullMethodName                  = "/rill.admin.v1.AdminService/SudoUpdateRuntimeHost"
 	AdminService_SudoDeleteOrganizationBillingIssue_FullMethodName     = "/rill.admin.v1.AdminService/SudoDeleteOrganizationBillingIssue"
 	AdminService_SudoUpdateOrganizationBillingMessage_FullMethodName   = "/rill.admin.v1.AdminService/SudoUpdateOrganizationBillingMessage"
 	AdminService_SudoDeleteOrganizationBillingMessage_FullMethodName   = "/rill.admin.v1.AdminService/SudoDeleteOrganizationBillingMessage"
@@ -180,6 +186,9 @@ const (
 	AdminService_RenewBillingSubscription_FullMethodName               = "/rill.admin.v1.AdminService/RenewBillingSubscription"
 	AdminService_GetPaymentsPortalURL_FullMethodName                   = "/rill.admin.v1.AdminService/GetPaymentsPortalURL"
 	AdminService_GetBillingCreditBalance_FullMethodName                = "/rill.admin.v1.AdminService/GetBillingCreditBalance"
+	AdminService_GetOrganizationBudget_FullMethodName                  = "/rill.admin.v1.AdminService/GetOrganizationBudget"
+	AdminService_UpdateOrganizationBudget_FullMethodName               = "/rill.admin.v1.AdminService/UpdateOrganizationBudget"
+	AdminService_DeleteOrganizationBudget_FullMethodName               = "/rill.admin.v1.AdminService/DeleteOrganizationBudget"
 	AdminService_ListPublicBillingPlans_FullMethodName                 = "/rill.admin.v1.AdminService/ListPublicBillingPlans"
 	AdminService_GetBillingProjectCredentials_FullMethodName           = "/rill.admin.v1.AdminService/GetBillingProjectCredentials"
 	AdminService_RequestProjectAccess_FullMethodName                   = "/rill.admin.v1.AdminService/RequestProjectAccess"
@@ -209,6 +218,8 @@ type AdminServiceClient interface {
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
 	// UpdateOrganization updates an organization
 	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error)
+	// ListAuditEvents lists the audit log of an organization, ordered from newest to oldest.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 	// ListProjectsForOrganization lists all the projects currently available for a given organization.
 	ListProjectsForOrganization(ctx context.Context, in *ListProjectsForOrganizationRequest, opts ...grpc.CallOption) (*ListProjectsForOrganizationResponse, error)
 	// ListProjectsForOrganizationAndUser lists all the projects that an organization member user has access to.
@@ -243,6 +254,14 @@ type AdminServiceClient interface {
 	RedeployProject(ctx context.Context, in *RedeployProjectRequest, opts ...grpc.CallOption) (*RedeployProjectResponse, error)
 	// HibernateProject hibernates a project by tearing down its deployments.
 	HibernateProject(ctx context.Context, in *HibernateProjectRequest, opts ...grpc.CallOption) (*HibernateProjectResponse, error)
+	// ListProjectSnapshots lists the snapshots of a project's data, ordered from newest to oldest.
+	ListProjectSnapshots(ctx context.Context, in *ListProjectSnapshotsRequest, opts ...grpc.CallOption) (*ListProjectSnapshotsResponse, error)
+	// CreateProjectSnapshot schedules a snapshot of the data of a project's production deployment.
+	// The snapshot is taken in the background. It has status PENDING until it completes.
+	CreateProjectSnapshot(ctx context.Context, in *CreateProjectSnapshotRequest, opts ...grpc.CallOption) (*CreateProjectSnapshotResponse, error)
+	// RestoreProjectSnapshot restores the data of a project's production deployment to a snapshot.
+	// Only snapshots taken on the current production deployment can be restored.
+	RestoreProjectSnapshot(ctx context.Context, in *RestoreProjectSnapshotRequest, opts ...grpc.CallOption) (*RestoreProjectSnapshotResponse, error)
 	// ListDeployments lists deployments for a project.
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
 	// CreateDeployment creates a new deployment for a project.
@@ -420,6 +439,11 @@ type AdminServiceClient interface {
 	SudoUpdateAnnotations(ctx context.Context, in *SudoUpdateAnnotationsRequest, opts ...grpc.CallOption) (*SudoUpdateAnnotationsResponse, error)
 	// SudoIssueRuntimeManagerToken returns a runtime JWT with full manager permissions for a runtime.
 	SudoIssueRuntimeManagerToken(ctx context.Context, in *SudoIssueRuntimeManagerTokenRequest, opts ...grpc.CallOption) (*SudoIssueRuntimeManagerTokenResponse, error)
+	// SudoListRuntimeHosts lists the runtime hosts in the pools managed by "vm" provisioners, including their health and resource usage.
+	SudoListRuntimeHosts(ctx context.Context, in *SudoListRuntimeHostsRequest, opts ...grpc.CallOption) (*SudoListRuntimeHostsResponse, error)
+	// SudoUpdateRuntimeHost updates a runtime host managed by a "vm" provisioner.
+	// A draining host receives no new instances, and its existing instances are moved to other hosts.
+	SudoUpdateRuntimeHost(ctx context.Context, in *SudoUpdateRuntimeHostRequest, opts ...grpc.CallOption) (*SudoUpdateRuntimeHostResponse, error)
 	// SudoDeleteOrganizationBillingIssue deletes a billing issue of a type for the organization
 	SudoDeleteOrganizationBillingIssue(ctx context.Context, in *SudoDeleteOrganizationBillingIssueRequest, opts ...grpc.CallOption) (*SudoDeleteOrganizationBillingIssueResponse, error)
 	// SudoUpdateOrganizationBillingMessage sets (overriding any existing) a custom message banner for the organization
@@ -538,6 +562,12 @@ type AdminServiceClient interface {
 	GetPaymentsPortalURL(ctx context.Context, in *GetPaymentsPortalURLRequest, opts ...grpc.CallOption) (*GetPaymentsPortalURLResponse, error)
 	// GetBillingCreditBalance returns the organization's remaining trial credit balance
 	GetBillingCreditBalance(ctx context.Context, in *GetBillingCreditBalanceRequest, opts ...grpc.CallOption) (*GetBillingCreditBalanceResponse, error)
+	// GetOrganizationBudget returns the organization's monthly budget, if one is configured
+	GetOrganizationBudget(ctx context.Context, in *GetOrganizationBudgetRequest, opts ...grpc.CallOption) (*GetOrganizationBudgetResponse, error)
+	// UpdateOrganizationBudget creates or replaces the organization's monthly budget
+	UpdateOrganizationBudget(ctx context.Context, in *UpdateOrganizationBudgetRequest, opts ...grpc.CallOption) (*UpdateOrganizationBudgetResponse, error)
+	// DeleteOrganizationBudget removes the organization's monthly budget
+	DeleteOrganizationBudget(ctx context.Context, in *DeleteOrganizationBudgetRequest, opts ...grpc.CallOption) (*DeleteOrganizationBudgetResponse, error)
 	// ListPublicBillingPlans lists all public billing plans
 	ListPublicBillingPlans(ctx context.Context, in *ListPublicBillingPlansRequest, opts ...grpc.CallOption) (*ListPublicBillingPlansResponse, error)
 	// GetBillingProjectCredentials returns credentials for the configured cloud metrics project filtered by request organization
@@ -628,6 +658,16 @@ func (c *adminServiceClient) UpdateOrganization(ctx context.Context, in *UpdateO
 	return out, nil
 }
 
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListProjectsForOrganization(ctx context.Context, in *ListProjectsForOrganizationRequest, opts ...grpc.CallOption) (*ListProjectsForOrganizationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProjectsForOrganizationResponse)
@@ -778,6 +818,36 @@ func (c *adminServiceClient) HibernateProject(ctx context.Context, in *Hibernate
 	return out, nil
 }
 
+func (c *adminServiceClient) ListProjectSnapshots(ctx context.Context, in *ListProjectSnapshotsRequest, opts ...grpc.CallOption) (*ListProjectSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectSnapshotsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateProjectSnapshot(ctx context.Context, in *CreateProjectSnapshotRequest, opts ...grpc.CallOption) (*CreateProjectSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProjectSnapshotResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateProjectSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreProjectSnapshot(ctx context.Context, in *RestoreProjectSnapshotRequest, opts ...grpc.CallOption) (*RestoreProjectSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProjectSnapshotResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreProjectSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListDeploymentsResponse)
@@ -1578,6 +1648,26 @@ func (c *adminServiceClient) SudoIssueRuntimeManagerToken(ctx context.Context, i
 	return out, nil
 }
 
+func (c *adminServiceClient) SudoListRuntimeHosts(ctx context.Context, in *SudoListRuntimeHostsRequest, opts ...grpc.CallOption) (*SudoListRuntimeHostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SudoListRuntimeHostsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SudoListRuntimeHosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SudoUpdateRuntimeHost(ctx context.Context, in *SudoUpdateRuntimeHostRequest, opts ...grpc.CallOption) (*SudoUpdateRuntimeHostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SudoUpdateRuntimeHostResponse)
+	err := c.cc.Invoke(ctx, AdminService_SudoUpdateRuntimeHost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) SudoDeleteOrganizationBillingIssue(ctx context.Context, in *SudoDeleteOrganizationBillingIssueRequest, opts ...grpc.CallOption) (*SudoDeleteOrganizationBillingIssueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SudoDeleteOrganizationBillingIssueResponse)
@@ -2168,6 +2258,36 @@ func (c *adminServiceClient) GetBillingCreditBalance(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *adminServiceClient) GetOrganizationBudget(ctx context.Context, in *GetOrganizationBudgetRequest, opts ...grpc.CallOption) (*GetOrganizationBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationBudgetResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetOrganizationBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateOrganizationBudget(ctx context.Context, in *UpdateOrganizationBudgetRequest, opts ...grpc.CallOption) (*UpdateOrganizationBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrganizationBudgetResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateOrganizationBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteOrganizationBudget(ctx context.Context, in *DeleteOrganizationBudgetRequest, opts ...grpc.CallOption) (*DeleteOrganizationBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrganizationBudgetResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteOrganizationBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListPublicBillingPlans(ctx context.Context, in *ListPublicBillingPlansRequest, opts ...grpc.CallOption) (*ListPublicBillingPlansResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPublicBillingPlansResponse)
@@ -2258,6 +2378,8 @@ type AdminServiceServer interface {
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
 	// UpdateOrganization updates an organization
 	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+	// ListAuditEvents lists the audit log of an organization, ordered from newest to oldest.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	// ListProjectsForOrganization lists all the projects currently available for a given organization.
 	ListProjectsForOrganization(context.Context, *ListProjectsForOrganizationRequest) (*ListProjectsForOrganizationResponse, error)
 	// ListProjectsForOrganizationAndUser lists all the projects that an organization member user has access to.
@@ -2292,6 +2414,14 @@ type AdminServiceServer interface {
 	RedeployProject(context.Context, *RedeployProjectRequest) (*RedeployProjectResponse, error)
 	// HibernateProject hibernates a project by tearing down its deployments.
 	HibernateProject(context.Context, *HibernateProjectRequest) (*HibernateProjectResponse, error)
+	// ListProjectSnapshots lists the snapshots of a project's data, ordered from newest to oldest.
+	ListProjectSnapshots(context.Context, *ListProjectSnapshotsRequest) (*ListProjectSnapshotsResponse, error)
+	// CreateProjectSnapshot schedules a snapshot of the data of a project's production deployment.
+	// The snapshot is taken in the background. It has status PENDING until it completes.
+	CreateProjectSnapshot(context.Context, *CreateProjectSnapshotRequest) (*CreateProjectSnapshotResponse, error)
+	// RestoreProjectSnapshot restores the data of a project's production deployment to a snapshot.
+	// Only snapshots taken on the current production deployment can be restored.
+	RestoreProjectSnapshot(context.Context, *RestoreProjectSnapshotRequest) (*RestoreProjectSnapshotResponse, error)
 	// ListDeployments lists deployments for a project.
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
 	// CreateDeployment creates a new deployment for a project.
@@ -2469,6 +2599,11 @@ type AdminServiceServer interface {
 	SudoUpdateAnnotations(context.Context, *SudoUpdateAnnotationsRequest) (*SudoUpdateAnnotationsResponse, error)
 	// SudoIssueRuntimeManagerToken returns a runtime JWT with full manager permissions for a runtime.
 	SudoIssueRuntimeManagerToken(context.Context, *SudoIssueRuntimeManagerTokenRequest) (*SudoIssueRuntimeManagerTokenResponse, error)
+	// SudoListRuntimeHosts lists the runtime hosts in the pools managed by "vm" provisioners, including their health and resource usage.
+	SudoListRuntimeHosts(context.Context, *SudoListRuntimeHostsRequest) (*SudoListRuntimeHostsResponse, error)
+	// SudoUpdateRuntimeHost updates a runtime host managed by a "vm" provisioner.
+	// A draining host receives no new instances, and its existing instances are moved to other hosts.
+	SudoUpdateRuntimeHost(context.Context, *SudoUpdateRuntimeHostRequest) (*SudoUpdateRuntimeHostResponse, error)
 	// SudoDeleteOrganizationBillingIssue deletes a billing issue of a type for the organization
 	SudoDeleteOrganizationBillingIssue(context.Context, *SudoDeleteOrganizationBillingIssueRequest) (*SudoDeleteOrganizationBillingIssueResponse, error)
 	// SudoUpdateOrganizationBillingMessage sets (overriding any existing) a custom message banner for the organization
@@ -2587,6 +2722,12 @@ type AdminServiceServer interface {
 	GetPaymentsPortalURL(context.Context, *GetPaymentsPortalURLRequest) (*GetPaymentsPortalURLResponse, error)
 	// GetBillingCreditBalance returns the organization's remaining trial credit balance
 	GetBillingCreditBalance(context.Context, *GetBillingCreditBalanceRequest) (*GetBillingCreditBalanceResponse, error)
+	// GetOrganizationBudget returns the organization's monthly budget, if one is configured
+	GetOrganizationBudget(context.Context, *GetOrganizationBudgetRequest) (*GetOrganizationBudgetResponse, error)
+	// UpdateOrganizationBudget creates or replaces the organization's monthly budget
+	UpdateOrganizationBudget(context.Context, *UpdateOrganizationBudgetRequest) (*UpdateOrganizationBudgetResponse, error)
+	// DeleteOrganizationBudget removes the organization's monthly budget
+	DeleteOrganizationBudget(context.Context, *DeleteOrganizationBudgetRequest) (*DeleteOrganizationBudgetResponse, error)
 	// ListPublicBillingPlans lists all public billing plans
 	ListPublicBillingPlans(context.Context, *ListPublicBillingPlansRequest) (*ListPublicBillingPlansResponse, error)
 	// GetBillingProjectCredentials returns credentials for the configured cloud metrics project filtered by request organization
@@ -2628,6 +2769,9 @@ func (UnimplementedAdminServiceServer) DeleteOrganization(context.Context, *Dele
 func (UnimplementedAdminServiceServer) UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization not implemented")
 }
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) ListProjectsForOrganization(context.Context, *ListProjectsForOrganizationRequest) (*ListProjectsForOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectsForOrganization not implemented")
 }
@@ -2673,6 +2817,15 @@ func (UnimplementedAdminServiceServer) RedeployProject(context.Context, *Redeplo
 func (UnimplementedAdminServiceServer) HibernateProject(context.Context, *HibernateProjectRequest) (*HibernateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HibernateProject not implemented")
 }
+func (UnimplementedAdminServiceServer) ListProjectSnapshots(context.Context, *ListProjectSnapshotsRequest) (*ListProjectSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectSnapshots not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateProjectSnapshot(context.Context, *CreateProjectSnapshotRequest) (*CreateProjectSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectSnapshot not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreProjectSnapshot(context.Context, *RestoreProjectSnapshotRequest) (*RestoreProjectSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProjectSnapshot not implemented")
+}
 func (UnimplementedAdminServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
 }
@@ -2913,6 +3066,12 @@ func (UnimplementedAdminServiceServer) SudoUpdateAnnotations(context.Context, *S
 func (UnimplementedAdminServiceServer) SudoIssueRuntimeManagerToken(context.Context, *SudoIssueRuntimeManagerTokenRequest) (*SudoIssueRuntimeManagerTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoIssueRuntimeManagerToken not implemented")
 }
+func (UnimplementedAdminServiceServer) SudoListRuntimeHosts(context.Context, *SudoListRuntimeHostsRequest) (*SudoListRuntimeHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoListRuntimeHosts not implemented")
+}
+func (UnimplementedAdminServiceServer) SudoUpdateRuntimeHost(context.Context, *SudoUpdateRuntimeHostRequest) (*SudoUpdateRuntimeHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoUpdateRuntimeHost not implemented")
+}
 func (UnimplementedAdminServiceServer) SudoDeleteOrganizationBillingIssue(context.Context, *SudoDeleteOrganizationBillingIssueRequest) (*SudoDeleteOrganizationBillingIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoDeleteOrganizationBillingIssue not implemented")
 }
@@ -3090,6 +3249,15 @@ func (UnimplementedAdminServiceServer) GetPaymentsPortalURL(context.Context, *Ge
 func (UnimplementedAdminServiceServer) GetBillingCreditBalance(context.Context, *GetBillingCreditBalanceRequest) (*GetBillingCreditBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBillingCreditBalance not implemented")
 }
+func (UnimplementedAdminServiceServer) GetOrganizationBudget(context.Context, *GetOrganizationBudgetRequest) (*GetOrganizationBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationBudget not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateOrganizationBudget(context.Context, *UpdateOrganizationBudgetRequest) (*UpdateOrganizationBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationBudget not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteOrganizationBudget(context.Context, *DeleteOrganizationBudgetRequest) (*DeleteOrganizationBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationBudget not implemented")
+}
 func (UnimplementedAdminServiceServer) ListPublicBillingPlans(context.Context, *ListPublicBillingPlansRequest) (*ListPublicBillingPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPublicBillingPlans not implemented")
 }
@@ -3258,6 +3426,24 @@ func _AdminService_UpdateOrganization_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListProjectsForOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectsForOrganizationRequest)
 	if err := dec(in); err != nil {
@@ -3528,6 +3714,60 @@ func _AdminService_HibernateProject_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListProjectSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectSnapshots(ctx, req.(*ListProjectSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateProjectSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProjectSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProjectSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProjectSnapshot(ctx, req.(*CreateProjectSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreProjectSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProjectSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreProjectSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreProjectSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreProjectSnapshot(ctx, req.(*RestoreProjectSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDeploymentsRequest)
 	if err := dec(in); err != nil {
@@ -4968,6 +5208,42 @@ func _AdminService_SudoIssueRuntimeManagerToken_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SudoListRuntimeHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SudoListRuntimeHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SudoListRuntimeHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SudoListRuntimeHosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SudoListRuntimeHosts(ctx, req.(*SudoListRuntimeHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SudoUpdateRuntimeHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SudoUpdateRuntimeHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SudoUpdateRuntimeHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SudoUpdateRuntimeHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SudoUpdateRuntimeHost(ctx, req.(*SudoUpdateRuntimeHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_SudoDeleteOrganizationBillingIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SudoDeleteOrganizationBillingIssueRequest)
 	if err := dec(in); err != nil {
@@ -6030,6 +6306,60 @@ func _AdminService_GetBillingCreditBalance_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetOrganizationBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetOrganizationBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetOrganizationBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetOrganizationBudget(ctx, req.(*GetOrganizationBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateOrganizationBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateOrganizationBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateOrganizationBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateOrganizationBudget(ctx, req.(*UpdateOrganizationBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteOrganizationBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteOrganizationBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteOrganizationBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteOrganizationBudget(ctx, req.(*DeleteOrganizationBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListPublicBillingPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPublicBillingPlansRequest)
 	if err := dec(in); err != nil {
@@ -6191,6 +6521,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrganization",
 			Handler:    _AdminService_UpdateOrganization_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "ListProjectsForOrganization",
 			Handler:    _AdminService_ListProjectsForOrganization_Handler,
@@ -6251,6 +6585,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HibernateProject",
 			Handler:    _AdminService_HibernateProject_Handler,
 		},
+		{
+			MethodName: "ListProjectSnapshots",
+			Handler:    _AdminService_ListProjectSnapshots_Handler,
+		},
+		{
+			MethodName: "CreateProjectSnapshot",
+			Handler:    _AdminService_CreateProjectSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreProjectSnapshot",
+			Handler:    _AdminService_RestoreProjectSnapshot_Handler,
+		},
 		{
 			MethodName: "ListDeployments",
 			Handler:    _AdminService_ListDeployments_Handler,
@@ -6571,6 +6917,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoIssueRuntimeManagerToken",
 			Handler:    _AdminService_SudoIssueRuntimeManagerToken_Handler,
 		},
+		{
+			MethodName: "SudoListRuntimeHosts",
+			Handler:    _AdminService_SudoListRuntimeHosts_Handler,
+		},
+		{
+			MethodName: "SudoUpdateRuntimeHost",
+			Handler:    _AdminService_SudoUpdateRuntimeHost_Handler,
+		},
 		{
 			MethodName: "SudoDeleteOrganizationBillingIssue",
 			Handler:    _AdminService_SudoDeleteOrganizationBillingIssue_Handler,
@@ -6807,6 +7161,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBillingCreditBalance",
 			Handler:    _AdminService_GetBillingCreditBalance_Handler,
 		},
+		{
+			MethodName: "GetOrganizationBudget",
+			Handler:    _AdminService_GetOrganizationBudget_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationBudget",
+			Handler:    _AdminService_UpdateOrganizationBudget_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationBudget",
+			Handler:    _AdminService_DeleteOrganizationBudget_Handler,
+		},
 		{
 			MethodName: "ListPublicBillingPlans",
 			Handler:    _AdminService_ListPublicBillingPlans_Handler,
@@ -2130,6 +2130,8 @@ func (m *DeleteInstanceRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for KeepStorage
+
 	if len(errors) > 0 {
 		return DeleteInstanceRequestMultiError(errors)
 	}
@@ -8600,22 +8602,22 @@ var _ interface {
 	ErrorName() string
 } = SkipModelPartitionsResponseValidationError{}
 
-// Validate checks the field values on CreateTriggerRequest with the rules
+// Validate checks the field values on GetColumnLineageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTriggerRequest) Validate() error {
+func (m *GetColumnLineageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTriggerRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetColumnLineageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTriggerRequestMultiError, or nil if none found.
-func (m *CreateTriggerRequest) ValidateAll() error {
+// GetColumnLineageRequestMultiError, or nil if none found.
+func (m *GetColumnLineageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTriggerRequest) validate(all bool) error {
+func (m *GetColumnLineageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8624,57 +8626,156 @@ func (m *CreateTriggerRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetColumnLineageRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CreateTriggerRequestValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
+				errors = append(errors, GetColumnLineageRequestValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetColumnLineageRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
+
+	// no validation rules for Column
 
+	if len(errors) > 0 {
+		return GetColumnLineageRequestMultiError(errors)
 	}
 
-	for idx, item := range m.GetModels() {
+	return nil
+}
+
+// GetColumnLineageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetColumnLineageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnLineageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnLineageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnLineageRequestMultiError) AllErrors() []error { return m }
+
+// GetColumnLineageRequestValidationError is the validation error returned by
+// GetColumnLineageRequest.Validate if the designated constraints aren't met.
+type GetColumnLineageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnLineageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnLineageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnLineageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnLineageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnLineageRequestValidationError) ErrorName() string {
+	return "GetColumnLineageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnLineageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnLineageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnLineageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnLineageRequestValidationError{}
+
+// Validate checks the field values on GetColumnLineageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnLineageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnLineageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnLineageResponseMultiError, or nil if none found.
+func (m *GetColumnLineageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnLineageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEdges() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Models[%v]", idx),
+					errors = append(errors, GetColumnLineageResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Models[%v]", idx),
+					errors = append(errors, GetColumnLineageResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8682,8 +8783,8 @@ func (m *CreateTriggerRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateTriggerRequestValidationError{
-					field:  fmt.Sprintf("Models[%v]", idx),
+				return GetColumnLineageResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8692,26 +8793,20 @@ func (m *CreateTriggerRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for Parser
-
-	// no validation rules for All
-
-	// no validation rules for AllFull
-
 	if len(errors) > 0 {
-		return CreateTriggerRequestMultiError(errors)
+		return GetColumnLineageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTriggerRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateTriggerRequest.ValidateAll() if the designated
+// GetColumnLineageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetColumnLineageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateTriggerRequestMultiError []error
+type GetColumnLineageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTriggerRequestMultiError) Error() string {
+func (m GetColumnLineageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8720,11 +8815,11 @@ func (m CreateTriggerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTriggerRequestMultiError) AllErrors() []error { return m }
+func (m GetColumnLineageResponseMultiError) AllErrors() []error { return m }
 
-// CreateTriggerRequestValidationError is the validation error returned by
-// CreateTriggerRequest.Validate if the designated constraints aren't met.
-type CreateTriggerRequestValidationError struct {
+// GetColumnLineageResponseValidationError is the validation error returned by
+// GetColumnLineageResponse.Validate if the designated constraints aren't met.
+type GetColumnLineageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8732,24 +8827,24 @@ type CreateTriggerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTriggerRequestValidationError) Field() string { return e.field }
+func (e GetColumnLineageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTriggerRequestValidationError) Reason() string { return e.reason }
+func (e GetColumnLineageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTriggerRequestValidationError) Cause() error { return e.cause }
+func (e GetColumnLineageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTriggerRequestValidationError) Key() bool { return e.key }
+func (e GetColumnLineageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTriggerRequestValidationError) ErrorName() string {
-	return "CreateTriggerRequestValidationError"
+func (e GetColumnLineageResponseValidationError) ErrorName() string {
+	return "GetColumnLineageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTriggerRequestValidationError) Error() string {
+func (e GetColumnLineageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8761,14 +8856,14 @@ func (e CreateTriggerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTriggerRequest.%s: %s%s",
+		"invalid %sGetColumnLineageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTriggerRequestValidationError{}
+var _ error = GetColumnLineageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8776,44 +8871,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTriggerRequestValidationError{}
+} = GetColumnLineageResponseValidationError{}
 
-// Validate checks the field values on CreateTriggerResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTriggerResponse) Validate() error {
+// Validate checks the field values on ColumnLineageEdge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnLineageEdge) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTriggerResponse with the rules
+// ValidateAll checks the field values on ColumnLineageEdge with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTriggerResponseMultiError, or nil if none found.
-func (m *CreateTriggerResponse) ValidateAll() error {
+// ColumnLineageEdgeMultiError, or nil if none found.
+func (m *ColumnLineageEdge) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTriggerResponse) validate(all bool) error {
+func (m *ColumnLineageEdge) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetFromResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnLineageEdgeValidationError{
+					field:  "FromResource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnLineageEdgeValidationError{
+					field:  "FromResource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnLineageEdgeValidationError{
+				field:  "FromResource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FromColumn
+
+	if all {
+		switch v := interface{}(m.GetToResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnLineageEdgeValidationError{
+					field:  "ToResource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnLineageEdgeValidationError{
+					field:  "ToResource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnLineageEdgeValidationError{
+				field:  "ToResource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ToColumn
+
 	if len(errors) > 0 {
-		return CreateTriggerResponseMultiError(errors)
+		return ColumnLineageEdgeMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTriggerResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateTriggerResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTriggerResponseMultiError []error
+// ColumnLineageEdgeMultiError is an error wrapping multiple validation errors
+// returned by ColumnLineageEdge.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnLineageEdgeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTriggerResponseMultiError) Error() string {
+func (m ColumnLineageEdgeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8822,11 +8979,11 @@ func (m CreateTriggerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTriggerResponseMultiError) AllErrors() []error { return m }
+func (m ColumnLineageEdgeMultiError) AllErrors() []error { return m }
 
-// CreateTriggerResponseValidationError is the validation error returned by
-// CreateTriggerResponse.Validate if the designated constraints aren't met.
-type CreateTriggerResponseValidationError struct {
+// ColumnLineageEdgeValidationError is the validation error returned by
+// ColumnLineageEdge.Validate if the designated constraints aren't met.
+type ColumnLineageEdgeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8834,24 +8991,24 @@ type CreateTriggerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTriggerResponseValidationError) Field() string { return e.field }
+func (e ColumnLineageEdgeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTriggerResponseValidationError) Reason() string { return e.reason }
+func (e ColumnLineageEdgeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTriggerResponseValidationError) Cause() error { return e.cause }
+func (e ColumnLineageEdgeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTriggerResponseValidationError) Key() bool { return e.key }
+func (e ColumnLineageEdgeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTriggerResponseValidationError) ErrorName() string {
-	return "CreateTriggerResponseValidationError"
+func (e ColumnLineageEdgeValidationError) ErrorName() string {
+	return "ColumnLineageEdgeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTriggerResponseValidationError) Error() string {
+func (e ColumnLineageEdgeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8863,14 +9020,14 @@ func (e CreateTriggerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTriggerResponse.%s: %s%s",
+		"invalid %sColumnLineageEdge.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTriggerResponseValidationError{}
+var _ error = ColumnLineageEdgeValidationError{}
 
 var _ interface {
 	Field() string
@@ -8878,7 +9035,1625 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTriggerResponseValidationError{}
+} = ColumnLineageEdgeValidationError{}
+
+// Validate checks the field values on CreateTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTriggerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTriggerRequestMultiError, or nil if none found.
+func (m *CreateTriggerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTriggerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTriggerRequestValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetModels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTriggerRequestValidationError{
+					field:  fmt.Sprintf("Models[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Parser
+
+	// no validation rules for All
+
+	// no validation rules for AllFull
+
+	if len(errors) > 0 {
+		return CreateTriggerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTriggerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTriggerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTriggerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTriggerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTriggerRequestMultiError) AllErrors() []error { return m }
+
+// CreateTriggerRequestValidationError is the validation error returned by
+// CreateTriggerRequest.Validate if the designated constraints aren't met.
+type CreateTriggerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTriggerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTriggerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTriggerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTriggerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTriggerRequestValidationError) ErrorName() string {
+	return "CreateTriggerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTriggerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTriggerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTriggerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTriggerRequestValidationError{}
+
+// Validate checks the field values on CreateTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTriggerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTriggerResponseMultiError, or nil if none found.
+func (m *CreateTriggerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTriggerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateTriggerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTriggerResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTriggerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTriggerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTriggerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTriggerResponseMultiError) AllErrors() []error { return m }
+
+// CreateTriggerResponseValidationError is the validation error returned by
+// CreateTriggerResponse.Validate if the designated constraints aren't met.
+type CreateTriggerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTriggerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTriggerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTriggerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTriggerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTriggerResponseValidationError) ErrorName() string {
+	return "CreateTriggerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTriggerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTriggerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTriggerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTriggerResponseValidationError{}
+
+// Validate checks the field values on InvalidateQueryCacheRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InvalidateQueryCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvalidateQueryCacheRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvalidateQueryCacheRequestMultiError, or nil if none found.
+func (m *InvalidateQueryCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvalidateQueryCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for MetricsView
+
+	if len(errors) > 0 {
+		return InvalidateQueryCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvalidateQueryCacheRequestMultiError is an error wrapping multiple
+// validation errors returned by InvalidateQueryCacheRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InvalidateQueryCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvalidateQueryCacheRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvalidateQueryCacheRequestMultiError) AllErrors() []error { return m }
+
+// InvalidateQueryCacheRequestValidationError is the validation error returned
+// by InvalidateQueryCacheRequest.Validate if the designated constraints
+// aren't met.
+type InvalidateQueryCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvalidateQueryCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvalidateQueryCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvalidateQueryCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvalidateQueryCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvalidateQueryCacheRequestValidationError) ErrorName() string {
+	return "InvalidateQueryCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvalidateQueryCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvalidateQueryCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvalidateQueryCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvalidateQueryCacheRequestValidationError{}
+
+// Validate checks the field values on InvalidateQueryCacheResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InvalidateQueryCacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvalidateQueryCacheResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvalidateQueryCacheResponseMultiError, or nil if none found.
+func (m *InvalidateQueryCacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvalidateQueryCacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InvalidateQueryCacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvalidateQueryCacheResponseMultiError is an error wrapping multiple
+// validation errors returned by InvalidateQueryCacheResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InvalidateQueryCacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvalidateQueryCacheResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvalidateQueryCacheResponseMultiError) AllErrors() []error { return m }
+
+// InvalidateQueryCacheResponseValidationError is the validation error returned
+// by InvalidateQueryCacheResponse.Validate if the designated constraints
+// aren't met.
+type InvalidateQueryCacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvalidateQueryCacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvalidateQueryCacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvalidateQueryCacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvalidateQueryCacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvalidateQueryCacheResponseValidationError) ErrorName() string {
+	return "InvalidateQueryCacheResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvalidateQueryCacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvalidateQueryCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvalidateQueryCacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvalidateQueryCacheResponseValidationError{}
+
+// Validate checks the field values on CreateSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSnapshotRequestMultiError, or nil if none found.
+func (m *CreateSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if !_CreateSnapshotRequest_SnapshotId_Pattern.MatchString(m.GetSnapshotId()) {
+		err := CreateSnapshotRequestValidationError{
+			field:  "SnapshotId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// CreateSnapshotRequestValidationError is the validation error returned by
+// CreateSnapshotRequest.Validate if the designated constraints aren't met.
+type CreateSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSnapshotRequestValidationError) ErrorName() string {
+	return "CreateSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSnapshotRequestValidationError{}
+
+var _CreateSnapshotRequest_SnapshotId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on CreateSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSnapshotResponseMultiError, or nil if none found.
+func (m *CreateSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Resources
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSnapshotResponseValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSnapshotResponseValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSnapshotResponseValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// CreateSnapshotResponseValidationError is the validation error returned by
+// CreateSnapshotResponse.Validate if the designated constraints aren't met.
+type CreateSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSnapshotResponseValidationError) ErrorName() string {
+	return "CreateSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSnapshotResponseValidationError{}
+
+// Validate checks the field values on RestoreSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSnapshotRequestMultiError, or nil if none found.
+func (m *RestoreSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if !_RestoreSnapshotRequest_SnapshotId_Pattern.MatchString(m.GetSnapshotId()) {
+		err := RestoreSnapshotRequestValidationError{
+			field:  "SnapshotId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// RestoreSnapshotRequestValidationError is the validation error returned by
+// RestoreSnapshotRequest.Validate if the designated constraints aren't met.
+type RestoreSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSnapshotRequestValidationError) ErrorName() string {
+	return "RestoreSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSnapshotRequestValidationError{}
+
+var _RestoreSnapshotRequest_SnapshotId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on RestoreSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSnapshotResponseMultiError, or nil if none found.
+func (m *RestoreSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RestoreSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// RestoreSnapshotResponseValidationError is the validation error returned by
+// RestoreSnapshotResponse.Validate if the designated constraints aren't met.
+type RestoreSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSnapshotResponseValidationError) ErrorName() string {
+	return "RestoreSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSnapshotResponseValidationError{}
+
+// Validate checks the field values on DeleteSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSnapshotRequestMultiError, or nil if none found.
+func (m *DeleteSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if !_DeleteSnapshotRequest_SnapshotId_Pattern.MatchString(m.GetSnapshotId()) {
+		err := DeleteSnapshotRequestValidationError{
+			field:  "SnapshotId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSnapshotRequestValidationError is the validation error returned by
+// DeleteSnapshotRequest.Validate if the designated constraints aren't met.
+type DeleteSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSnapshotRequestValidationError) ErrorName() string {
+	return "DeleteSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSnapshotRequestValidationError{}
+
+var _DeleteSnapshotRequest_SnapshotId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on DeleteSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSnapshotResponseMultiError, or nil if none found.
+func (m *DeleteSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSnapshotResponseValidationError is the validation error returned by
+// DeleteSnapshotResponse.Validate if the designated constraints aren't met.
+type DeleteSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSnapshotResponseValidationError) ErrorName() string {
+	return "DeleteSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSnapshotResponseValidationError{}
+
+// Validate checks the field values on PlanProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanProjectRequestMultiError, or nil if none found.
+func (m *PlanProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Files
+
+	if len(errors) > 0 {
+		return PlanProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by PlanProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PlanProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanProjectRequestMultiError) AllErrors() []error { return m }
+
+// PlanProjectRequestValidationError is the validation error returned by
+// PlanProjectRequest.Validate if the designated constraints aren't met.
+type PlanProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanProjectRequestValidationError) ErrorName() string {
+	return "PlanProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanProjectRequestValidationError{}
+
+// Validate checks the field values on PlanProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanProjectResponseMultiError, or nil if none found.
+func (m *PlanProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanProjectResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanProjectResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanProjectResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetParseErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanProjectResponseValidationError{
+						field:  fmt.Sprintf("ParseErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanProjectResponseValidationError{
+						field:  fmt.Sprintf("ParseErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanProjectResponseValidationError{
+					field:  fmt.Sprintf("ParseErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by PlanProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlanProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanProjectResponseMultiError) AllErrors() []error { return m }
+
+// PlanProjectResponseValidationError is the validation error returned by
+// PlanProjectResponse.Validate if the designated constraints aren't met.
+type PlanProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanProjectResponseValidationError) ErrorName() string {
+	return "PlanProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanProjectResponseValidationError{}
+
+// Validate checks the field values on ResourceChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResourceChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceChangeMultiError,
+// or nil if none found.
+func (m *ResourceChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceChangeValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceChangeValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceChangeValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetRenamedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceChangeValidationError{
+					field:  "RenamedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceChangeValidationError{
+					field:  "RenamedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRenamedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceChangeValidationError{
+				field:  "RenamedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ModelRefresh
+
+	if len(errors) > 0 {
+		return ResourceChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceChangeMultiError is an error wrapping multiple validation errors
+// returned by ResourceChange.ValidateAll() if the designated constraints
+// aren't met.
+type ResourceChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceChangeMultiError) AllErrors() []error { return m }
+
+// ResourceChangeValidationError is the validation error returned by
+// ResourceChange.Validate if the designated constraints aren't met.
+type ResourceChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceChangeValidationError) ErrorName() string { return "ResourceChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceChangeValidationError{}
 
 // Validate checks the field values on ConnectorDriver with the rules defined
 // in the proto definition for this message. If any rules are violated, the
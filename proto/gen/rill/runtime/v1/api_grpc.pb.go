@@ -49,7 +49,13 @@ const (
 	RuntimeService_GetExplore_FullMethodName              = "/rill.runtime.v1.RuntimeService/GetExplore"
 	RuntimeService_GetModelPartitions_FullMethodName      = "/rill.runtime.v1.RuntimeService/GetModelPartitions"
 	RuntimeService_SkipModelPartitions_FullMethodName     = "/rill.runtime.v1.RuntimeService/SkipModelPartitions"
+	RuntimeService_GetColumnLineage_FullMethodName        = "/rill.runtime.v1.RuntimeService/GetColumnLineage"
 	RuntimeService_CreateTrigger_FullMethodName           = "/rill.runtime.v1.RuntimeService/CreateTrigger"
+	RuntimeService_InvalidateQueryCache_FullMethodName    = "/rill.runtime.v1.RuntimeService/InvalidateQueryCache"
+	RuntimeService_CreateSnapshot_FullMethodName          = "/rill.runtime.v1.RuntimeService/CreateSnapshot"
+	RuntimeService_RestoreSnapshot_FullMethodName         = "/rill.runtime.v1.RuntimeService/RestoreSnapshot"
+	RuntimeService_DeleteSnapshot_FullMethodName          = "/rill.runtime.v1.RuntimeService/DeleteSnapshot"
+	RuntimeService_PlanProject_FullMethodName             = "/rill.runtime.v1.RuntimeService/PlanProject"
 	RuntimeService_ListConnectorDrivers_FullMethodName    = "/rill.runtime.v1.RuntimeService/ListConnectorDrivers"
 	RuntimeService_AnalyzeConnectors_FullMethodName       = "/rill.runtime.v1.RuntimeService/AnalyzeConnectors"
 	RuntimeService_ListNotifierConnectors_FullMethodName  = "/rill.runtime.v1.RuntimeService/ListNotifierConnectors"
@@ -147,9 +153,26 @@ type RuntimeServiceClient interface {
 	// SkipModelPartitions marks model partitions as skipped, excluding them from execution and from the model's error state.
 	// Skipped partitions remain skipped until they are explicitly triggered (e.g. via CreateTrigger).
 	SkipModelPartitions(ctx context.Context, in *SkipModelPartitionsRequest, opts ...grpc.CallOption) (*SkipModelPartitionsResponse, error)
+	// GetColumnLineage returns the column-level lineage between models, metrics views and explores.
+	// If a resource and column are provided, only the edges downstream of that column are returned (e.g. to find which dashboards break if the column is dropped).
+	GetColumnLineage(ctx context.Context, in *GetColumnLineageRequest, opts ...grpc.CallOption) (*GetColumnLineageResponse, error)
 	// CreateTrigger submits a refresh trigger, which will asynchronously refresh the specified resources.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error)
+	// InvalidateQueryCache evicts cached query results for a metrics view or, if no metrics view is specified, for the whole instance.
+	// It is useful for forcing fresh results after data has been changed outside of Rill (e.g. a manual backfill).
+	InvalidateQueryCache(ctx context.Context, in *InvalidateQueryCacheRequest, opts ...grpc.CallOption) (*InvalidateQueryCacheResponse, error)
+	// CreateSnapshot takes a point-in-time snapshot of an instance's OLAP data and catalog and stores it in the runtime's object storage.
+	// It is currently only supported for instances that use DuckDB with backups enabled.
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	// RestoreSnapshot replaces an instance's OLAP data and catalog with a snapshot created by CreateSnapshot.
+	// The instance's controller is stopped during the restore and restarted afterwards.
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	// DeleteSnapshot deletes a snapshot created by CreateSnapshot.
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	// PlanProject parses the project files and reports the changes that reconciling them would make to the catalog, without applying them.
+	// It is similar to "terraform plan" and is useful for previewing the impact of a change before committing it.
+	PlanProject(ctx context.Context, in *PlanProjectRequest, opts ...grpc.CallOption) (*PlanProjectResponse, error)
 	// ListConnectorDrivers returns a description of all the connector drivers registed in the runtime,
 	// including their configuration specs and the capabilities they support.
 	ListConnectorDrivers(ctx context.Context, in *ListConnectorDriversRequest, opts ...grpc.CallOption) (*ListConnectorDriversResponse, error)
@@ -545,6 +568,16 @@ func (c *runtimeServiceClient) SkipModelPartitions(ctx context.Context, in *Skip
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetColumnLineage(ctx context.Context, in *GetColumnLineageRequest, opts ...grpc.CallOption) (*GetColumnLineageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetColumnLineageResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetColumnLineage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTriggerResponse)
@@ -555,6 +588,56 @@ func (c *runtimeServiceClient) CreateTrigger(ctx context.Context, in *CreateTrig
 	return out, nil
 }
 
+func (c *runtimeServiceClient) InvalidateQueryCache(ctx context.Context, in *InvalidateQueryCacheRequest, opts ...grpc.CallOption) (*InvalidateQueryCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateQueryCacheResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_InvalidateQueryCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_CreateSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_RestoreSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_DeleteSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) PlanProject(ctx context.Context, in *PlanProjectRequest, opts ...grpc.CallOption) (*PlanProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanProjectResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_PlanProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListConnectorDrivers(ctx context.Context, in *ListConnectorDriversRequest, opts ...grpc.CallOption) (*ListConnectorDriversResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListConnectorDriversResponse)
@@ -884,9 +967,26 @@ type RuntimeServiceServer interface {
 	// SkipModelPartitions marks model partitions as skipped, excluding them from execution and from the model's error state.
 	// Skipped partitions remain skipped until they are explicitly triggered (e.g. via CreateTrigger).
 	SkipModelPartitions(context.Context, *SkipModelPartitionsRequest) (*SkipModelPartitionsResponse, error)
+	// GetColumnLineage returns the column-level lineage between models, metrics views and explores.
+	// If a resource and column are provided, only the edges downstream of that column are returned (e.g. to find which dashboards break if the column is dropped).
+	GetColumnLineage(context.Context, *GetColumnLineageRequest) (*GetColumnLineageResponse, error)
 	// CreateTrigger submits a refresh trigger, which will asynchronously refresh the specified resources.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error)
+	// InvalidateQueryCache evicts cached query results for a metrics view or, if no metrics view is specified, for the whole instance.
+	// It is useful for forcing fresh results after data has been changed outside of Rill (e.g. a manual backfill).
+	InvalidateQueryCache(context.Context, *InvalidateQueryCacheRequest) (*InvalidateQueryCacheResponse, error)
+	// CreateSnapshot takes a point-in-time snapshot of an instance's OLAP data and catalog and stores it in the runtime's object storage.
+	// It is currently only supported for instances that use DuckDB with backups enabled.
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	// RestoreSnapshot replaces an instance's OLAP data and catalog with a snapshot created by CreateSnapshot.
+	// The instance's controller is stopped during the restore and restarted afterwards.
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	// DeleteSnapshot deletes a snapshot created by CreateSnapshot.
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	// PlanProject parses the project files and reports the changes that reconciling them would make to the catalog, without applying them.
+	// It is similar to "terraform plan" and is useful for previewing the impact of a change before committing it.
+	PlanProject(context.Context, *PlanProjectRequest) (*PlanProjectResponse, error)
 	// ListConnectorDrivers returns a description of all the connector drivers registed in the runtime,
 	// including their configuration specs and the capabilities they support.
 	ListConnectorDrivers(context.Context, *ListConnectorDriversRequest) (*ListConnectorDriversResponse, error)
@@ -1045,9 +1145,27 @@ func (UnimplementedRuntimeServiceServer) GetModelPartitions(context.Context, *Ge
 func (UnimplementedRuntimeServiceServer) SkipModelPartitions(context.Context, *SkipModelPartitionsRequest) (*SkipModelPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SkipModelPartitions not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetColumnLineage(context.Context, *GetColumnLineageRequest) (*GetColumnLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetColumnLineage not implemented")
+}
 func (UnimplementedRuntimeServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
 }
+func (UnimplementedRuntimeServiceServer) InvalidateQueryCache(context.Context, *InvalidateQueryCacheRequest) (*InvalidateQueryCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateQueryCache not implemented")
+}
+func (UnimplementedRuntimeServiceServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedRuntimeServiceServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedRuntimeServiceServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedRuntimeServiceServer) PlanProject(context.Context, *PlanProjectRequest) (*PlanProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanProject not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListConnectorDrivers(context.Context, *ListConnectorDriversRequest) (*ListConnectorDriversResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListConnectorDrivers not implemented")
 }
@@ -1663,6 +1781,24 @@ func _RuntimeService_SkipModelPartitions_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetColumnLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetColumnLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetColumnLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetColumnLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetColumnLineage(ctx, req.(*GetColumnLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTriggerRequest)
 	if err := dec(in); err != nil {
@@ -1681,6 +1817,96 @@ func _RuntimeService_CreateTrigger_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_InvalidateQueryCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateQueryCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).InvalidateQueryCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_InvalidateQueryCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).InvalidateQueryCache(ctx, req.(*InvalidateQueryCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_DeleteSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_PlanProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PlanProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_PlanProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PlanProject(ctx, req.(*PlanProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListConnectorDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListConnectorDriversRequest)
 	if err := dec(in); err != nil {
@@ -2239,10 +2465,34 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SkipModelPartitions",
 			Handler:    _RuntimeService_SkipModelPartitions_Handler,
 		},
+		{
+			MethodName: "GetColumnLineage",
+			Handler:    _RuntimeService_GetColumnLineage_Handler,
+		},
 		{
 			MethodName: "CreateTrigger",
 			Handler:    _RuntimeService_CreateTrigger_Handler,
 		},
+		{
+			MethodName: "InvalidateQueryCache",
+			Handler:    _RuntimeService_InvalidateQueryCache_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _RuntimeService_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _RuntimeService_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _RuntimeService_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "PlanProject",
+			Handler:    _RuntimeService_PlanProject_Handler,
+		},
 		{
 			MethodName: "ListConnectorDrivers",
 			Handler:    _RuntimeService_ListConnectorDrivers_Handler,
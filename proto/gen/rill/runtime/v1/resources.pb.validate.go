@@ -2528,6 +2528,35 @@ func (m *ModelSpec) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetFreshness()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Freshness",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Freshness",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreshness()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "Freshness",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for Trigger
 
 	// no validation rules for TriggerFull
@@ -2787,6 +2816,37 @@ func (m *ModelState) validate(all bool) error {
 
 	// no validation rules for BytesTotal
 
+	if all {
+		switch v := interface{}(m.GetFreshnessWatermark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "FreshnessWatermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "FreshnessWatermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreshnessWatermark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "FreshnessWatermark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Stale
+
 	if len(errors) > 0 {
 		return ModelStateMultiError(errors)
 	}
@@ -2996,6 +3056,139 @@ var _ interface {
 	ErrorName() string
 } = ModelTestValidationError{}
 
+// Validate checks the field values on ModelFreshness with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelFreshness) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelFreshness with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelFreshnessMultiError,
+// or nil if none found.
+func (m *ModelFreshness) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelFreshness) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxStalenessSeconds
+
+	// no validation rules for WatermarkResolver
+
+	if all {
+		switch v := interface{}(m.GetWatermarkResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelFreshnessValidationError{
+					field:  "WatermarkResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelFreshnessValidationError{
+					field:  "WatermarkResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWatermarkResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelFreshnessValidationError{
+				field:  "WatermarkResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ModelFreshnessMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelFreshnessMultiError is an error wrapping multiple validation errors
+// returned by ModelFreshness.ValidateAll() if the designated constraints
+// aren't met.
+type ModelFreshnessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelFreshnessMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelFreshnessMultiError) AllErrors() []error { return m }
+
+// ModelFreshnessValidationError is the validation error returned by
+// ModelFreshness.Validate if the designated constraints aren't met.
+type ModelFreshnessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelFreshnessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelFreshnessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelFreshnessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelFreshnessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelFreshnessValidationError) ErrorName() string { return "ModelFreshnessValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelFreshnessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelFreshness.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelFreshnessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelFreshnessValidationError{}
+
 // Validate checks the field values on MetricsView with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -3712,6 +3905,47 @@ func (m *SecurityRule) validate(all bool) error {
 			}
 		}
 
+	case *SecurityRule_FieldMask:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFieldMask()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldMask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldMask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -4248,71 +4482,84 @@ var _ interface {
 	ErrorName() string
 } = SecurityRuleRowFilterValidationError{}
 
-// Validate checks the field values on SecurityRuleTransitiveAccess with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SecurityRuleFieldMask with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SecurityRuleTransitiveAccess) Validate() error {
+func (m *SecurityRuleFieldMask) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRuleTransitiveAccess with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SecurityRuleFieldMask with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SecurityRuleTransitiveAccessMultiError, or nil if none found.
-func (m *SecurityRuleTransitiveAccess) ValidateAll() error {
+// SecurityRuleFieldMaskMultiError, or nil if none found.
+func (m *SecurityRuleFieldMask) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRuleTransitiveAccess) validate(all bool) error {
+func (m *SecurityRuleFieldMask) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecurityRuleTransitiveAccessValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for ConditionExpression
+
+	for idx, item := range m.GetConditionResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleFieldMaskValidationError{
+						field:  fmt.Sprintf("ConditionResources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleFieldMaskValidationError{
+						field:  fmt.Sprintf("ConditionResources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SecurityRuleTransitiveAccessValidationError{
-					field:  "Resource",
+				return SecurityRuleFieldMaskValidationError{
+					field:  fmt.Sprintf("ConditionResources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SecurityRuleTransitiveAccessValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Type
+
+	// no validation rules for KeepLast
+
+	// no validation rules for BucketSize
+
 	if len(errors) > 0 {
-		return SecurityRuleTransitiveAccessMultiError(errors)
+		return SecurityRuleFieldMaskMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecurityRuleTransitiveAccessMultiError is an error wrapping multiple
-// validation errors returned by SecurityRuleTransitiveAccess.ValidateAll() if
-// the designated constraints aren't met.
-type SecurityRuleTransitiveAccessMultiError []error
+// SecurityRuleFieldMaskMultiError is an error wrapping multiple validation
+// errors returned by SecurityRuleFieldMask.ValidateAll() if the designated
+// constraints aren't met.
+type SecurityRuleFieldMaskMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecurityRuleTransitiveAccessMultiError) Error() string {
+func (m SecurityRuleFieldMaskMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4321,12 +4568,11 @@ func (m SecurityRuleTransitiveAccessMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecurityRuleTransitiveAccessMultiError) AllErrors() []error { return m }
+func (m SecurityRuleFieldMaskMultiError) AllErrors() []error { return m }
 
-// SecurityRuleTransitiveAccessValidationError is the validation error returned
-// by SecurityRuleTransitiveAccess.Validate if the designated constraints
-// aren't met.
-type SecurityRuleTransitiveAccessValidationError struct {
+// SecurityRuleFieldMaskValidationError is the validation error returned by
+// SecurityRuleFieldMask.Validate if the designated constraints aren't met.
+type SecurityRuleFieldMaskValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4334,7 +4580,139 @@ type SecurityRuleTransitiveAccessValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecurityRuleTransitiveAccessValidationError) Field() string { return e.field }
+func (e SecurityRuleFieldMaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityRuleFieldMaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityRuleFieldMaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityRuleFieldMaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityRuleFieldMaskValidationError) ErrorName() string {
+	return "SecurityRuleFieldMaskValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityRuleFieldMaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityRuleFieldMask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityRuleFieldMaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityRuleFieldMaskValidationError{}
+
+// Validate checks the field values on SecurityRuleTransitiveAccess with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecurityRuleTransitiveAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRuleTransitiveAccess with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecurityRuleTransitiveAccessMultiError, or nil if none found.
+func (m *SecurityRuleTransitiveAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRuleTransitiveAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecurityRuleTransitiveAccessValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecurityRuleTransitiveAccessValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecurityRuleTransitiveAccessValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SecurityRuleTransitiveAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleTransitiveAccessMultiError is an error wrapping multiple
+// validation errors returned by SecurityRuleTransitiveAccess.ValidateAll() if
+// the designated constraints aren't met.
+type SecurityRuleTransitiveAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityRuleTransitiveAccessMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityRuleTransitiveAccessMultiError) AllErrors() []error { return m }
+
+// SecurityRuleTransitiveAccessValidationError is the validation error returned
+// by SecurityRuleTransitiveAccess.Validate if the designated constraints
+// aren't met.
+type SecurityRuleTransitiveAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityRuleTransitiveAccessValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
 func (e SecurityRuleTransitiveAccessValidationError) Reason() string { return e.reason }
@@ -6636,6 +7014,37 @@ func (m *ReportSpec) validate(all bool) error {
 
 	// no validation rules for IntervalsCheckUnclosed
 
+	// no validation rules for ExportOutputConnector
+
+	if all {
+		switch v := interface{}(m.GetExportOutputProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "ExportOutputProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "ExportOutputProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportOutputProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "ExportOutputProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ReportSpecMultiError(errors)
 	}
@@ -7019,6 +7428,8 @@ func (m *ReportExecution) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for OutputUri
+
 	if len(errors) > 0 {
 		return ReportExecutionMultiError(errors)
 	}
@@ -7399,6 +7810,69 @@ func (m *AlertSpec) validate(all bool) error {
 
 	// no validation rules for Annotations
 
+	for idx, item := range m.GetMuteWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("MuteWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("MuteWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertSpecValidationError{
+					field:  fmt.Sprintf("MuteWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSnoozeUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "SnoozeUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "SnoozeUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnoozeUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "SnoozeUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch v := m.QueryFor.(type) {
 	case *AlertSpec_QueryForUserId:
 		if v == nil {
@@ -7676,6 +8150,112 @@ var _ interface {
 	ErrorName() string
 } = NotifierValidationError{}
 
+// Validate checks the field values on AlertMuteWindow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AlertMuteWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertMuteWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertMuteWindowMultiError, or nil if none found.
+func (m *AlertMuteWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertMuteWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cron
+
+	// no validation rules for TimeZone
+
+	// no validation rules for DurationSeconds
+
+	if len(errors) > 0 {
+		return AlertMuteWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertMuteWindowMultiError is an error wrapping multiple validation errors
+// returned by AlertMuteWindow.ValidateAll() if the designated constraints
+// aren't met.
+type AlertMuteWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertMuteWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertMuteWindowMultiError) AllErrors() []error { return m }
+
+// AlertMuteWindowValidationError is the validation error returned by
+// AlertMuteWindow.Validate if the designated constraints aren't met.
+type AlertMuteWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertMuteWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertMuteWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertMuteWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertMuteWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertMuteWindowValidationError) ErrorName() string { return "AlertMuteWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertMuteWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertMuteWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertMuteWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertMuteWindowValidationError{}
+
 // Validate checks the field values on AlertState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -7796,6 +8376,52 @@ func (m *AlertState) validate(all bool) error {
 
 	// no validation rules for ExecutionCount
 
+	{
+		sorted_keys := make([]string, len(m.GetGroups()))
+		i := 0
+		for key := range m.GetGroups() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetGroups()[key]
+			_ = val
+
+			// no validation rules for Groups[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, AlertStateValidationError{
+							field:  fmt.Sprintf("Groups[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, AlertStateValidationError{
+							field:  fmt.Sprintf("Groups[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return AlertStateValidationError{
+						field:  fmt.Sprintf("Groups[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return AlertStateMultiError(errors)
 	}
@@ -7873,6 +8499,224 @@ var _ interface {
 	ErrorName() string
 } = AlertStateValidationError{}
 
+// Validate checks the field values on AlertGroupState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AlertGroupState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertGroupState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertGroupStateMultiError, or nil if none found.
+func (m *AlertGroupState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertGroupState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertGroupStateValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetFailRow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "FailRow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "FailRow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFailRow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertGroupStateValidationError{
+				field:  "FailRow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFailingSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "FailingSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "FailingSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFailingSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertGroupStateValidationError{
+				field:  "FailingSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastNotifiedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "LastNotifiedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertGroupStateValidationError{
+					field:  "LastNotifiedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastNotifiedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertGroupStateValidationError{
+				field:  "LastNotifiedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AlertGroupStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertGroupStateMultiError is an error wrapping multiple validation errors
+// returned by AlertGroupState.ValidateAll() if the designated constraints
+// aren't met.
+type AlertGroupStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertGroupStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertGroupStateMultiError) AllErrors() []error { return m }
+
+// AlertGroupStateValidationError is the validation error returned by
+// AlertGroupState.Validate if the designated constraints aren't met.
+type AlertGroupStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertGroupStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertGroupStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertGroupStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertGroupStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertGroupStateValidationError) ErrorName() string { return "AlertGroupStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertGroupStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertGroupState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertGroupStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertGroupStateValidationError{}
+
 // Validate checks the field values on AlertExecution with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -8044,6 +8888,35 @@ func (m *AlertExecution) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetMutedUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "MutedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "MutedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMutedUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertExecutionValidationError{
+				field:  "MutedUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AlertExecutionMultiError(errors)
 	}
@@ -8177,6 +9050,42 @@ func (m *AssertionResult) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	for idx, item := range m.GetFailRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssertionResultValidationError{
+						field:  fmt.Sprintf("FailRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssertionResultValidationError{
+						field:  fmt.Sprintf("FailRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssertionResultValidationError{
+					field:  fmt.Sprintf("FailRows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FailRowsTruncated
+
 	if len(errors) > 0 {
 		return AssertionResultMultiError(errors)
 	}
@@ -11754,6 +12663,64 @@ func (m *APISpec) validate(all bool) error {
 
 	// no validation rules for SkipNestedSecurity
 
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APISpecValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APISpecValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return APISpecMultiError(errors)
 	}
@@ -11831,6 +12798,215 @@ var _ interface {
 	ErrorName() string
 } = APISpecValidationError{}
 
+// Validate checks the field values on APIRateLimit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIRateLimit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIRateLimit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIRateLimitMultiError, or
+// nil if none found.
+func (m *APIRateLimit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIRateLimit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Requests
+
+	// no validation rules for PeriodSeconds
+
+	// no validation rules for KeyAttribute
+
+	if len(errors) > 0 {
+		return APIRateLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIRateLimitMultiError is an error wrapping multiple validation errors
+// returned by APIRateLimit.ValidateAll() if the designated constraints aren't met.
+type APIRateLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIRateLimitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIRateLimitMultiError) AllErrors() []error { return m }
+
+// APIRateLimitValidationError is the validation error returned by
+// APIRateLimit.Validate if the designated constraints aren't met.
+type APIRateLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIRateLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIRateLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIRateLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIRateLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIRateLimitValidationError) ErrorName() string { return "APIRateLimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIRateLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIRateLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIRateLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIRateLimitValidationError{}
+
+// Validate checks the field values on APIPagination with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIPagination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIPagination with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIPaginationMultiError, or
+// nil if none found.
+func (m *APIPagination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIPagination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for MaxPageSize
+
+	if len(errors) > 0 {
+		return APIPaginationMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIPaginationMultiError is an error wrapping multiple validation errors
+// returned by APIPagination.ValidateAll() if the designated constraints
+// aren't met.
+type APIPaginationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIPaginationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIPaginationMultiError) AllErrors() []error { return m }
+
+// APIPaginationValidationError is the validation error returned by
+// APIPagination.Validate if the designated constraints aren't met.
+type APIPaginationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIPaginationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIPaginationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIPaginationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIPaginationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIPaginationValidationError) ErrorName() string { return "APIPaginationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIPaginationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIPagination.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIPaginationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIPaginationValidationError{}
+
 // Validate checks the field values on APIState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -19,6 +19,7 @@ type AdminService interface {
 
 type ReportMetadata struct {
 	ReportDelivery map[string]ReportDelivery
+	OwnerAttrs     map[string]any // user attrs of the report's owner, will be empty if the report has no owner
 }
 
 type ReportDelivery struct {
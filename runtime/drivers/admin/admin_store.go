@@ -45,6 +45,7 @@ func (h *Handle) GetReportMetadata(ctx context.Context, reportName, ownerID, web
 
 	return &drivers.ReportMetadata{
 		ReportDelivery: delivery,
+		OwnerAttrs:     res.OwnerAttrs.AsMap(),
 	}, nil
 }
 
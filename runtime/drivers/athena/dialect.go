@@ -1,6 +1,8 @@
 package athena
 
 import (
+	"fmt"
+
 	"github.com/rilldata/rill/runtime/drivers"
 )
 
@@ -13,3 +15,16 @@ var DialectAthena drivers.Dialect = func() drivers.Dialect {
 	d.BaseDialect = drivers.NewBaseDialect(drivers.DialectNameAthena, drivers.DoubleQuotesEscapeIdentifier, drivers.DoubleQuotesEscapeIdentifier)
 	return d
 }()
+
+func (d *dialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("lower(to_hex(md5(to_utf8(CAST(%s AS VARCHAR)))))", expr), nil
+}
+
+func (d *dialect) RedactExpression(expr string, keepLast uint32) (string, error) {
+	if keepLast == 0 {
+		return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE '****' END", expr), nil
+	}
+	// Trino doesn't have RIGHT, and substr with a negative start returns an empty string for values shorter than keepLast.
+	str := fmt.Sprintf("CAST(%s AS VARCHAR)", expr)
+	return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL WHEN length(%s) <= %d THEN concat('****', %s) ELSE concat('****', substr(%s, -%d)) END", expr, str, keepLast, str, str, keepLast), nil
+}
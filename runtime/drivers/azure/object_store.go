@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
@@ -118,6 +119,22 @@ func (c *Connection) DownloadFiles(ctx context.Context, path string) (drivers.Fi
 	})
 }
 
+// Upload implements drivers.ObjectStore.
+func (c *Connection) Upload(ctx context.Context, path string, r io.Reader) error {
+	url, err := c.parseBucketURL(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path %q: %w", path, err)
+	}
+
+	bucket, err := c.openBucket(ctx, url.Host, false)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return bucket.Upload(ctx, url.Path, r)
+}
+
 func (c *Connection) parseBucketURL(path string) (*globutil.URL, error) {
 	url, err := globutil.ParseBucketURL(path)
 	if err != nil {
@@ -48,6 +48,14 @@ func (d *dialect) GetTimeDimensionParameter(typeCode runtimev1.Type_Code) string
 	return "?"
 }
 
+func (d *dialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("TO_HEX(MD5(CAST(%s AS STRING)))", expr), nil
+}
+
+func (d *dialect) RedactExpression(expr string, keepLast uint32) (string, error) {
+	return d.RedactExpressionWithCast(expr, keepLast, "STRING"), nil
+}
+
 func (d *dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	return fmt.Sprintf("SAFE_DIVIDE(%s, CAST(%s AS FLOAT64))", numExpr, denExpr)
 }
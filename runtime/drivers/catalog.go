@@ -38,6 +38,8 @@ type CatalogStore interface {
 	UpdateResource(ctx context.Context, v int64, r Resource) error
 	DeleteResource(ctx context.Context, v int64, k, n string) error
 	DeleteResources(ctx context.Context) error
+	// ReplaceResources atomically replaces all resources and model partitions of the instance with the given resources and partitions (keyed by model ID).
+	ReplaceResources(ctx context.Context, v int64, rs []Resource, partitions map[string][]ModelPartition) error
 
 	FindModelPartitions(ctx context.Context, opts *FindModelPartitionsOptions) ([]ModelPartition, error)
 	FindModelPartitionsByKeys(ctx context.Context, modelID string, keys []string) ([]ModelPartition, error)
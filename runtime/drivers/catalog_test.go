@@ -13,6 +13,7 @@ import (
 
 func testCatalog(t *testing.T, catalog drivers.CatalogStore) {
 	t.Run("Partitions", func(t *testing.T) { testCatalogPartitions(t, catalog) })
+	t.Run("ReplaceResources", func(t *testing.T) { testCatalogReplaceResources(t, catalog) })
 }
 
 func testCatalogPartitions(t *testing.T, catalog drivers.CatalogStore) {
@@ -81,6 +82,55 @@ func testCatalogPartitions(t *testing.T, catalog drivers.CatalogStore) {
 	require.NoError(t, err)
 }
 
+func testCatalogReplaceResources(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+	modelID := uuid.NewString()
+
+	v, err := catalog.NextControllerVersion(ctx)
+	require.NoError(t, err)
+	err = catalog.CreateResource(ctx, v, drivers.Resource{Kind: "model", Name: "foo", Data: []byte("foo")})
+	require.NoError(t, err)
+	err = catalog.InsertModelPartition(ctx, modelID, drivers.ModelPartition{Key: "foo", DataJSON: []byte(`{}`)})
+	require.NoError(t, err)
+
+	// Fails without changes if the controller version is outdated
+	err = catalog.ReplaceResources(ctx, v-1, []drivers.Resource{{Kind: "model", Name: "bar", Data: []byte("bar")}}, nil)
+	require.ErrorIs(t, err, drivers.ErrInconsistentControllerVersion)
+
+	// Fails without changes if a resource can't be inserted
+	err = catalog.ReplaceResources(ctx, v, []drivers.Resource{{Kind: "model", Name: "bar"}, {Kind: "model", Name: "BAR"}}, nil)
+	require.Error(t, err)
+	rs, err := catalog.FindResources(ctx)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	require.Equal(t, "foo", rs[0].Name)
+
+	// Replaces all resources and partitions
+	newModelID := uuid.NewString()
+	err = catalog.ReplaceResources(ctx, v, []drivers.Resource{{Kind: "model", Name: "bar", Data: []byte("bar")}}, map[string][]drivers.ModelPartition{
+		newModelID: {{Key: "bar", DataJSON: []byte(`{}`), Index: 1}},
+	})
+	require.NoError(t, err)
+	rs, err = catalog.FindResources(ctx)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	require.Equal(t, "bar", rs[0].Name)
+	require.Equal(t, []byte("bar"), rs[0].Data)
+
+	partitions, err := catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: modelID})
+	require.NoError(t, err)
+	require.Len(t, partitions, 0)
+	partitions, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: newModelID})
+	require.NoError(t, err)
+	require.Len(t, partitions, 1)
+	require.Equal(t, "bar", partitions[0].Key)
+
+	err = catalog.DeleteResources(ctx)
+	require.NoError(t, err)
+	err = catalog.DeleteModelPartitions(ctx, newModelID)
+	require.NoError(t, err)
+}
+
 func requirePartitionEqual(t *testing.T, expected, actual drivers.ModelPartition) {
 	t.Helper()
 	require.Equal(t, expected.Key, actual.Key)
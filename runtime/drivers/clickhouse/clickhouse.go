@@ -555,9 +555,12 @@ func (c *Connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 	if opts.InputHandle.Driver() == "s3" || opts.InputHandle.Driver() == "gcs" {
 		return &objectStoreToSelfExecutor{opts.InputHandle, c}, nil
 	}
-	if opts.InputHandle.Driver() == "local_file" || opts.InputHandle.Driver() == "https" {
+	if opts.InputHandle.Driver() == "local_file" || opts.InputHandle.Driver() == "https" || opts.InputHandle.Driver() == "sftp" {
 		return &fileStoreToSelfExecutor{opts.InputHandle, c}, nil
 	}
+	if w, ok := opts.InputHandle.AsWarehouse(); ok {
+		return &warehouseToSelfExecutor{w, c}, nil
+	}
 	return nil, drivers.ErrNotImplemented
 }
 
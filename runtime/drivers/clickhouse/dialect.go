@@ -45,6 +45,10 @@ func (d *dialect) DimensionSelect(escapeTable string, dim *runtimev1.MetricsView
 	return fmt.Sprintf(`arrayJoin(%s) AS %s`, expr, alias), "", nil
 }
 
+func (d *dialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("lower(hex(MD5(toString(%s))))", expr), nil
+}
+
 func (d *dialect) MetricsViewDimensionExpression(dimension *runtimev1.MetricsViewSpec_Dimension) (string, error) {
 	if dimension.LookupTable != "" {
 		var keyExpr string
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	sqldriver "database/sql/driver"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -38,12 +40,7 @@ func (e *fileStoreToSelfExecutor) Concurrency(desired int) (int, bool) {
 }
 
 func (e *fileStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
-	from, ok := e.fileStore.AsFileStore()
-
 	driver := opts.InputHandle.Driver()
-	if !ok {
-		return nil, fmt.Errorf("input handle %q does not implement filestore", driver)
-	}
 
 	if opts.IncrementalRun {
 		return nil, fmt.Errorf("clickhouse: incremental models are not supported for %q connector", driver)
@@ -66,6 +63,10 @@ func (e *fileStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		warnings = append(warnings, fmt.Sprintf("Undefined fields %q in input properties. Will be ignored.", strings.Join(unused, ", ")))
 	}
 
+	if inputProps.Format == string(drivers.FileFormatDelta) {
+		return nil, fmt.Errorf("clickhouse: Delta Lake tables can't be read from the %q connector, use the s3 or gcs connector instead", driver)
+	}
+
 	inputPropsMap := map[string]any{}
 	if err := mapstructure.WeakDecode(inputProps, &inputPropsMap); err != nil {
 		return nil, fmt.Errorf("failed to parse input properties: %w", err)
@@ -101,10 +102,11 @@ func (e *fileStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 	}
 
 	// get the fileStore path
-	localPaths, err := from.FilePaths(ctx, inputPropsMap)
+	localPaths, release, err := e.localPaths(ctx, inputProps.Path, inputPropsMap)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 	if len(localPaths) == 0 {
 		return nil, fmt.Errorf("no files to ingest")
 	}
@@ -119,7 +121,7 @@ func (e *fileStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 
 	// Infer the schema if not provided
 	if outputProps.Columns == "" {
-		outputProps.Columns, err = e.inferColumns(ctx, opts, inputProps.Format, localPaths)
+		outputProps.Columns, err = inferColumns(ctx, opts.TempDir, inputProps.Format, localPaths)
 		if err != nil {
 			return nil, fmt.Errorf("failed to infer columns: %w", err)
 		}
@@ -197,8 +199,43 @@ func (e *fileStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 	}, nil
 }
 
-func (e *fileStoreToSelfExecutor) inferColumns(ctx context.Context, opts *drivers.ModelExecuteOptions, format string, localPaths []string) (string, error) {
-	tempDir, err := os.MkdirTemp(opts.TempDir, "duckdb")
+// localPaths returns the local paths of the files to ingest.
+// For object stores that ClickHouse can't read from natively (like SFTP), the files are downloaded to a temporary directory that is removed when release is called.
+func (e *fileStoreToSelfExecutor) localPaths(ctx context.Context, path string, props map[string]any) (paths []string, release func(), err error) {
+	if store, ok := e.fileStore.AsObjectStore(); ok {
+		iter, err := store.DownloadFiles(ctx, path)
+		if err != nil {
+			return nil, nil, err
+		}
+		iter.SetKeepFilesUntilClose()
+		for {
+			batch, err := iter.Next(ctx)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				_ = iter.Close()
+				return nil, nil, err
+			}
+			paths = append(paths, batch...)
+		}
+		return paths, func() { _ = iter.Close() }, nil
+	}
+
+	from, ok := e.fileStore.AsFileStore()
+	if !ok {
+		return nil, nil, fmt.Errorf("input handle %q does not implement filestore", e.fileStore.Driver())
+	}
+	paths, err = from.FilePaths(ctx, props)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paths, func() {}, nil
+}
+
+// inferColumns uses a temporary DuckDB database to infer a ClickHouse column definition for the given local files.
+func inferColumns(ctx context.Context, tempDir, format string, localPaths []string) (string, error) {
+	tempDir, err := os.MkdirTemp(tempDir, "duckdb")
 	if err != nil {
 		return "", fmt.Errorf("failed to create temp dir: %w", err)
 	}
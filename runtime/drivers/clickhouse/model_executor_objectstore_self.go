@@ -17,8 +17,9 @@ import (
 )
 
 type objectStoreInputProps struct {
-	Path   string             `mapstructure:"path"`
-	Format drivers.FileFormat `mapstructure:"format"`
+	Path    string                          `mapstructure:"path"`
+	Format  drivers.FileFormat              `mapstructure:"format"`
+	Iceberg *drivers.IcebergInputProperties `mapstructure:"iceberg"`
 }
 
 func (p *objectStoreInputProps) Validate() error {
@@ -55,29 +56,51 @@ func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.M
 		}
 		warnings = append(warnings, fmt.Sprintf("Undefined fields %q in input properties. Will be ignored.", strings.Join(unused, ", ")))
 	}
-	if err := inputProps.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid input properties: %w", err)
-	}
 
-	var glob string
-	if isGlob(inputProps.Path) {
-		glob = inputProps.Path
-	} else if filepath.Ext(inputProps.Path) != "" {
-		glob = inputProps.Path
-	} else {
-		if inputProps.Format == "" {
-			return nil, fmt.Errorf("clickhouse: format is required for non-glob paths")
+	// Build the model executor options with updated input and output properties
+	clone := *opts
+	newOpts := &clone
+
+	var sql string
+	var incrementalState map[string]any
+	if inputProps.Format == drivers.FileFormatIceberg || inputProps.Iceberg != nil {
+		sql, incrementalState, err = e.icebergSQL(ctx, newOpts)
+		if err != nil {
+			return nil, err
+		}
+	} else if inputProps.Format == drivers.FileFormatDelta {
+		if err := inputProps.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid input properties: %w", err)
 		}
-		var err error
-		glob, err = url.JoinPath(inputProps.Path, "**")
+		sql, incrementalState, err = e.deltaSQL(ctx, newOpts, inputProps.Path)
 		if err != nil {
 			return nil, err
 		}
-	}
+	} else {
+		if err := inputProps.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid input properties: %w", err)
+		}
 
-	sql, err := e.genSQL(glob, format(inputProps.Format))
-	if err != nil {
-		return nil, err
+		var glob string
+		if isGlob(inputProps.Path) {
+			glob = inputProps.Path
+		} else if filepath.Ext(inputProps.Path) != "" {
+			glob = inputProps.Path
+		} else {
+			if inputProps.Format == "" {
+				return nil, fmt.Errorf("clickhouse: format is required for non-glob paths")
+			}
+			var err error
+			glob, err = url.JoinPath(inputProps.Path, "**")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		sql, err = e.genSQL(glob, format(inputProps.Format))
+		if err != nil {
+			return nil, err
+		}
 	}
 	props := &ModelInputProperties{SQL: sql}
 	propsMap := make(map[string]any)
@@ -85,10 +108,7 @@ func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.M
 		return nil, err
 	}
 
-	// Build the model executor options with updated input and output properties
-	clone := *opts
-	clone.InputProperties = propsMap
-	newOpts := &clone
+	newOpts.InputProperties = propsMap
 
 	// Ensure materialize is true because the selfToSelfExecutor is not able to infer it independently.
 	outputProps := &ModelOutputProperties{}
@@ -112,9 +132,131 @@ func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.M
 		return nil, err
 	}
 	res.Warnings = append(res.Warnings, warnings...)
+	res.IncrementalState = incrementalState
 	return res, nil
 }
 
+// icebergSQL generates SQL that reads a snapshot of an Iceberg table using ClickHouse's iceberg table functions.
+// On incremental runs, only rows that were appended since the previously ingested snapshot are read.
+// If rows were deleted or overwritten, or the schema changed, it reads the whole snapshot and clears opts.IncrementalRun so it replaces the model's data.
+func (e *objectStoreToSelfExecutor) icebergSQL(ctx context.Context, opts *drivers.ModelExecuteOptions) (string, map[string]any, error) {
+	props := &drivers.ObjectStoreModelInputProperties{}
+	if err := props.Decode(opts.InputProperties); err != nil {
+		return "", nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	store, _ := e.objectStore.AsObjectStore()
+	t, err := drivers.ResolveIcebergTable(ctx, opts, store, props)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fn, err := e.genTableFunction("icebergS3", t.Location)
+	if err != nil {
+		return "", nil, err
+	}
+	// The snapshot is selected with a query-level setting, so each snapshot is read in a separate subquery.
+	scan := func(snapshotID int64) string {
+		return fmt.Sprintf("(SELECT * FROM %s SETTINGS iceberg_snapshot_id = %d)", fn, snapshotID)
+	}
+
+	var sql string
+	switch {
+	case t.Unchanged():
+		sql = fmt.Sprintf("SELECT * FROM %s LIMIT 0", scan(t.SnapshotID))
+	case t.PreviousSnapshotID != 0:
+		// Since only rows were appended, the difference between the snapshots is the appended rows.
+		sql = fmt.Sprintf("SELECT * FROM %s EXCEPT ALL SELECT * FROM %s", scan(t.SnapshotID), scan(t.PreviousSnapshotID))
+	default:
+		sql = "SELECT * FROM " + scan(t.SnapshotID)
+	}
+	if t.FullRefresh {
+		opts.IncrementalRun = false
+	}
+	return sql, t.IncrementalState(), nil
+}
+
+// deltaSQL generates SQL that reads a Delta Lake table using ClickHouse's deltaLake table function.
+// For incremental models, the table's log version is tracked so that incremental runs only read the data files that were added since the previously ingested version.
+// If rows were deleted or updated, or the schema changed, it reads the whole table and clears opts.IncrementalRun so it replaces the model's data.
+func (e *objectStoreToSelfExecutor) deltaSQL(ctx context.Context, opts *drivers.ModelExecuteOptions, path string) (string, map[string]any, error) {
+	fn, err := e.genTableFunction("deltaLake", path)
+	if err != nil {
+		return "", nil, err
+	}
+	if !opts.Incremental {
+		return "SELECT * FROM " + fn, nil, nil
+	}
+
+	store, _ := e.objectStore.AsObjectStore()
+	t, err := drivers.ResolveDeltaTable(ctx, opts, store, path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sql string
+	switch {
+	case t.Unchanged():
+		sql = fmt.Sprintf("SELECT * FROM %s LIMIT 0", fn)
+	case t.Incremental():
+		// Filtering on the _path virtual column prunes the files that are scanned.
+		// The _path of an object is its bucket and key.
+		files := make([]string, len(t.AddedFiles))
+		for i, f := range t.AddedFiles {
+			if _, rest, ok := strings.Cut(f, "://"); ok {
+				f = rest
+			}
+			files[i] = safeSQLString(f)
+		}
+		sql = fmt.Sprintf("SELECT * FROM %s WHERE _path IN (%s)", fn, strings.Join(files, ", "))
+	default:
+		sql = "SELECT * FROM " + fn
+	}
+	if t.FullRefresh {
+		opts.IncrementalRun = false
+	}
+	return sql, t.IncrementalState(), nil
+}
+
+// genTableFunction generates a call to a ClickHouse table function that reads a table format (like icebergS3 or deltaLake) from the object store.
+func (e *objectStoreToSelfExecutor) genTableFunction(name, location string) (string, error) {
+	var keyID, secret string
+	switch e.objectStore.Driver() {
+	case "s3":
+		props := &s3.ConfigProperties{}
+		if err := mapstructure.Decode(e.objectStore.Config(), props); err != nil {
+			return "", err
+		}
+		keyID, secret = props.AccessKeyID, props.SecretAccessKey
+	case "gcs":
+		props := &gcs.ConfigProperties{}
+		if err := mapstructure.Decode(e.objectStore.Config(), props); err != nil {
+			return "", err
+		}
+		keyID, secret = props.KeyID, props.Secret
+		// GCS is read through its S3-compatible API
+		if rest, ok := strings.CutPrefix(location, "gs://"); ok {
+			location = "https://storage.googleapis.com/" + rest
+		}
+	default:
+		return "", fmt.Errorf("internal error: unsupported object store: %s", e.objectStore.Driver())
+	}
+
+	// name(path, [id, secret])
+	var sb strings.Builder
+	sb.WriteString(name)
+	sb.WriteString("(")
+	sb.WriteString(safeSQLString(location))
+	if keyID != "" {
+		sb.WriteString(", ")
+		sb.WriteString(safeSQLString(keyID))
+		sb.WriteString(", ")
+		sb.WriteString(safeSQLString(secret))
+	}
+	sb.WriteString(")")
+	return sb.String(), nil
+}
+
 func (e *objectStoreToSelfExecutor) genSQL(glob, format string) (string, error) {
 	switch e.objectStore.Driver() {
 	case "s3":
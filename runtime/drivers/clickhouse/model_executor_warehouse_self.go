@@ -0,0 +1,144 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+)
+
+type warehouseToSelfExecutor struct {
+	w drivers.Warehouse
+	c *Connection
+}
+
+var _ drivers.ModelExecutor = &warehouseToSelfExecutor{}
+
+func (e *warehouseToSelfExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *warehouseToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	// Ensure materialize is true because the selfToSelfExecutor is not able to infer it independently.
+	outputProps := &ModelOutputProperties{}
+	err := mapstructure.WeakDecode(opts.OutputProperties, &outputProps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if outputProps.Materialize != nil && !*outputProps.Materialize {
+		return nil, fmt.Errorf("models must be materialized when fetching data from a warehouse")
+	}
+	outputProps.Materialize = boolPtr(true)
+
+	iter, err := e.w.QueryAsFiles(ctx, opts.InputProperties)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	// Batch all the files so the ingested data is inserted in one query.
+	iter.SetKeepFilesUntilClose()
+	var files []string
+	for {
+		batch, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		files = append(files, batch...)
+	}
+
+	tableName := outputProps.Table
+	if tableName == "" {
+		tableName = opts.ModelName
+	}
+
+	var sql string
+	if len(files) == 0 {
+		// Incremental runs of streaming warehouses (like Kafka) may not have any new data.
+		// In that case, we run an empty append against the existing table to preserve it and update the incremental state.
+		if !opts.IncrementalRun {
+			return nil, drivers.ErrNoRows
+		}
+		sql = fmt.Sprintf("SELECT * FROM %s LIMIT 0", safeSQLName(tableName))
+	} else {
+		// Ingest the files into a temporary table that the model's output is created from.
+		tmpTable := "__rill_tmp_ingest_" + tableName
+		err = e.ingestFiles(ctx, opts, tmpTable, files)
+		defer func() { _ = e.c.dropTable(context.Background(), tmpTable) }()
+		if err != nil {
+			return nil, err
+		}
+		sql = fmt.Sprintf("SELECT * FROM %s", safeSQLName(tmpTable))
+	}
+
+	props := &ModelInputProperties{SQL: sql}
+	propsMap := make(map[string]any)
+	if err := mapstructure.Decode(props, &propsMap); err != nil {
+		return nil, err
+	}
+
+	// Build the model executor options with updated input and output properties
+	clone := *opts
+	clone.InputProperties = propsMap
+	newOpts := &clone
+	err = mapstructure.WeakDecode(outputProps, &newOpts.OutputProperties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+
+	executor := &selfToSelfExecutor{c: e.c}
+	return executor.Execute(ctx, newOpts)
+}
+
+// ingestFiles creates a table with columns inferred from the files and inserts their contents into it.
+func (e *warehouseToSelfExecutor) ingestFiles(ctx context.Context, opts *drivers.ModelExecuteOptions, table string, files []string) error {
+	format, err := fileExtToFormat(fileutil.FullExt(files[0]))
+	if err != nil {
+		return fmt.Errorf("failed to infer format: %w", err)
+	}
+
+	columns, err := inferColumns(ctx, opts.TempDir, format, files)
+	if err != nil {
+		return fmt.Errorf("failed to infer columns: %w", err)
+	}
+
+	tmpProps := &ModelOutputProperties{
+		Typ:     "TABLE",
+		Columns: columns,
+		Engine:  "MergeTree",
+	}
+	if e.c.config.Cluster != "" {
+		tmpProps.Engine = "ReplicatedMergeTree"
+	}
+	err = e.c.createTable(ctx, table, "", tmpProps)
+	if err != nil {
+		return fmt.Errorf("failed to create ingest table: %w", err)
+	}
+
+	for _, path := range files {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read file %q: %w", path, err)
+		}
+
+		// Warehouses output timestamps in ISO 8601 format, which requires best effort parsing.
+		query := fmt.Sprintf("INSERT INTO %s SETTINGS date_time_input_format='best_effort' FORMAT %s\n", safeSQLName(table), format) + string(contents)
+		_, err = e.c.writeDB.DB.ExecContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to insert data: %w", err)
+		}
+	}
+
+	return nil
+}
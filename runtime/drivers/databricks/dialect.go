@@ -29,6 +29,14 @@ func DatabricksEscapeIdentifier(ident string) string {
 	return fmt.Sprintf("`%s`", strings.ReplaceAll(ident, "`", "``"))
 }
 
+func (d *dialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("MD5(CAST(%s AS STRING))", expr), nil
+}
+
+func (d *dialect) RedactExpression(expr string, keepLast uint32) (string, error) {
+	return d.RedactExpressionWithCast(expr, keepLast, "STRING"), nil
+}
+
 func (d *dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	return fmt.Sprintf("TRY_DIVIDE(%s, CAST(%s AS DOUBLE))", numExpr, denExpr)
 }
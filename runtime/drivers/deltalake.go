@@ -0,0 +1,175 @@
+package drivers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/rilldata/rill/runtime/pkg/deltalake"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"github.com/rilldata/rill/runtime/pkg/pagination"
+)
+
+// deltaVersionStateKey is the key of the table version in the incremental state of models that read Delta Lake tables.
+const deltaVersionStateKey = "delta_version"
+
+// DeltaTable is a resolved version of a Delta Lake table.
+type DeltaTable struct {
+	// Location is the table's base location.
+	Location string
+	// Version is the latest version of the table.
+	Version int64
+	// PreviousVersion is the version that was read in the previous execution of an incremental model.
+	// It is -1 if the model has not read the table before or if the table must be read in full (see FullRefresh).
+	PreviousVersion int64
+	// AddedFiles contains the full paths of the data files that were added after PreviousVersion.
+	// It is only set for incremental runs.
+	AddedFiles []string
+	// FullRefresh is true if the table changed since the previous execution of an incremental model in a way that can't be ingested incrementally,
+	// such as rows being deleted or the schema changing, or if the log of the changes was removed by log cleanup.
+	// The table must then be read in full and replace the model's data.
+	FullRefresh bool
+}
+
+// Incremental returns true if only the data files added since the previous incremental execution should be read.
+func (t *DeltaTable) Incremental() bool {
+	return t.PreviousVersion >= 0
+}
+
+// Unchanged returns true if no data was added to the table since the previous incremental execution.
+func (t *DeltaTable) Unchanged() bool {
+	return t.Incremental() && len(t.AddedFiles) == 0
+}
+
+// IncrementalState returns the incremental state to persist for models that have read the table.
+func (t *DeltaTable) IncrementalState() map[string]any {
+	return map[string]any{deltaVersionStateKey: strconv.FormatInt(t.Version, 10)}
+}
+
+// ResolveDeltaTable resolves the latest version of the Delta Lake table at the given location in an object store.
+// For incremental runs, it also resolves the data files that were added since the version that was read in the previous execution (see DeltaTable.FullRefresh).
+func ResolveDeltaTable(ctx context.Context, opts *ModelExecuteOptions, store ObjectStore, location string) (*DeltaTable, error) {
+	if store == nil {
+		return nil, fmt.Errorf("incremental reads of Delta Lake tables require an object store connector")
+	}
+
+	u, err := globutil.ParseBucketURL(deltalake.LogGlob(location))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Delta Lake table location %q: %w", location, err)
+	}
+	entries, err := pagination.CollectAll(ctx,
+		func(ctx context.Context, pz uint32, tk string) ([]ObjectStoreEntry, string, error) {
+			return store.ListObjectsForGlob(ctx, u.Host, u.Path, pz, tk, "", "")
+		},
+		1000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Delta Lake log files: %w", err)
+	}
+	paths := make([]string, 0, len(entries))
+	for _, e := range entries {
+		paths = append(paths, e.Path)
+	}
+
+	return resolveDeltaTable(opts, location, paths, func(version int64) ([]byte, error) {
+		return readObject(ctx, store, deltalake.CommitPath(location, version))
+	})
+}
+
+// ResolveLocalDeltaTable is similar to ResolveDeltaTable, but for a Delta Lake table in a local directory.
+func ResolveLocalDeltaTable(opts *ModelExecuteOptions, dir string) (*DeltaTable, error) {
+	paths, err := filepath.Glob(deltalake.LogGlob(dir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Delta Lake log files: %w", err)
+	}
+
+	return resolveDeltaTable(opts, dir, paths, func(version int64) ([]byte, error) {
+		data, err := os.ReadFile(deltalake.CommitPath(dir, version))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, errObjectNotFound
+		}
+		return data, err
+	})
+}
+
+// resolveDeltaTable resolves a Delta Lake table given the paths of its commit files and a function that reads the commit file of a version.
+// The read function must return errObjectNotFound if the commit file doesn't exist.
+func resolveDeltaTable(opts *ModelExecuteOptions, location string, paths []string, read func(version int64) ([]byte, error)) (*DeltaTable, error) {
+	version, ok := deltalake.LatestVersion(paths)
+	if !ok {
+		return nil, fmt.Errorf("no Delta Lake log files found at %q", location)
+	}
+
+	t := &DeltaTable{
+		Location:        location,
+		Version:         version,
+		PreviousVersion: -1,
+	}
+
+	if !opts.IncrementalRun || opts.IncrementalState == nil {
+		return t, nil
+	}
+	v, ok := opts.IncrementalState[deltaVersionStateKey].(string)
+	if !ok || v == "" {
+		return t, nil
+	}
+	prev, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Delta Lake version %q in incremental state: %w", v, err)
+	}
+
+	added, ok, err := deltaAddedFiles(location, prev, version, read)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if opts.PartitionRun {
+			// A full refresh would replace the data of all partitions.
+			return nil, fmt.Errorf("version %d of the Delta Lake table can't be ingested incrementally because rows were deleted or updated, the schema changed, or the log of the previously ingested version was removed; run a full refresh", version)
+		}
+		t.FullRefresh = true
+		return t, nil
+	}
+
+	t.PreviousVersion = prev
+	t.AddedFiles = added
+	return t, nil
+}
+
+// deltaAddedFiles collects the data files added by the commits after version prev up to and including version.
+// It returns false if the changes can't be ingested incrementally because a commit did more than append data,
+// because a commit file was removed by log cleanup, or because the table was recreated with fewer versions.
+func deltaAddedFiles(location string, prev, version int64, read func(version int64) ([]byte, error)) ([]string, bool, error) {
+	if prev > version {
+		return nil, false, nil
+	}
+
+	var res []string
+	for i := prev + 1; i <= version; i++ {
+		data, err := read(i)
+		if err != nil {
+			if errors.Is(err, errObjectNotFound) {
+				return nil, false, nil
+			}
+			return nil, false, fmt.Errorf("failed to read Delta Lake commit %d: %w", i, err)
+		}
+		c, err := deltalake.ParseCommit(i, data)
+		if err != nil {
+			return nil, false, err
+		}
+		if !c.AppendOnly() {
+			return nil, false, nil
+		}
+		for _, p := range c.DataFiles() {
+			p, err := deltalake.ResolvePath(location, p)
+			if err != nil {
+				return nil, false, err
+			}
+			res = append(res, p)
+		}
+	}
+	return res, true, nil
+}
@@ -0,0 +1,73 @@
+package drivers_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/deltalake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLocalDeltaTable(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "_delta_log"), 0o755))
+	writeCommit := func(version int64, actions string) {
+		require.NoError(t, os.WriteFile(deltalake.CommitPath(dir, version), []byte(actions), 0o644))
+	}
+	incrementalRun := func(version string) *drivers.ModelExecuteOptions {
+		return &drivers.ModelExecuteOptions{
+			Incremental:      true,
+			IncrementalRun:   true,
+			IncrementalState: map[string]any{"delta_version": version},
+		}
+	}
+
+	writeCommit(0, `{"metaData":{"id":"t1"}}`+"\n"+`{"add":{"path":"a.parquet","dataChange":true}}`)
+	writeCommit(1, `{"add":{"path":"b%20c.parquet","dataChange":true}}`)
+
+	// The first run reads the whole table
+	tbl, err := drivers.ResolveLocalDeltaTable(&drivers.ModelExecuteOptions{Incremental: true}, dir)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), tbl.Version)
+	require.False(t, tbl.Incremental())
+	require.Equal(t, map[string]any{"delta_version": "1"}, tbl.IncrementalState())
+
+	// Incremental runs read the files added since the previous version
+	tbl, err = drivers.ResolveLocalDeltaTable(incrementalRun("0"), dir)
+	require.NoError(t, err)
+	require.True(t, tbl.Incremental())
+	require.False(t, tbl.FullRefresh)
+	require.Equal(t, []string{dir + "/b c.parquet"}, tbl.AddedFiles)
+
+	tbl, err = drivers.ResolveLocalDeltaTable(incrementalRun("1"), dir)
+	require.NoError(t, err)
+	require.True(t, tbl.Unchanged())
+
+	// Deleting rows falls back to a full refresh
+	writeCommit(2, `{"remove":{"path":"a.parquet","dataChange":true}}`)
+	tbl, err = drivers.ResolveLocalDeltaTable(incrementalRun("1"), dir)
+	require.NoError(t, err)
+	require.True(t, tbl.FullRefresh)
+	require.False(t, tbl.Incremental())
+	require.Equal(t, int64(2), tbl.Version)
+
+	// Partition runs can't fall back to a full refresh
+	opts := incrementalRun("1")
+	opts.PartitionRun = true
+	_, err = drivers.ResolveLocalDeltaTable(opts, dir)
+	require.ErrorContains(t, err, "run a full refresh")
+
+	// Commits removed by log cleanup fall back to a full refresh
+	writeCommit(3, `{"add":{"path":"d.parquet","dataChange":true}}`)
+	require.NoError(t, os.Remove(deltalake.CommitPath(dir, 2)))
+	tbl, err = drivers.ResolveLocalDeltaTable(incrementalRun("1"), dir)
+	require.NoError(t, err)
+	require.True(t, tbl.FullRefresh)
+
+	tbl, err = drivers.ResolveLocalDeltaTable(incrementalRun("2"), dir)
+	require.NoError(t, err)
+	require.False(t, tbl.FullRefresh)
+	require.Equal(t, []string{dir + "/d.parquet"}, tbl.AddedFiles)
+}
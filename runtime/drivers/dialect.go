@@ -20,6 +20,7 @@ const (
 	DialectNameBigQuery   = "bigquery"
 	DialectNameClickHouse = "clickhouse"
 	DialectNameDatabricks = "databricks"
+	DialectNameDoris      = "doris"
 	DialectNameDuckDB     = "duckdb"
 	DialectNameDruid      = "druid"
 	DialectNameMySQL      = "mysql"
@@ -28,6 +29,7 @@ const (
 	DialectNameRedshift   = "redshift"
 	DialectNameSnowflake  = "snowflake"
 	DialectNameStarRocks  = "starrocks"
+	DialectNameTrino      = "trino"
 )
 
 // Dialect is the SQL dialect used by an OLAP driver.
@@ -56,6 +58,10 @@ type Dialect interface {
 	AutoUnnest(expr string) string
 	MetricsViewDimensionExpression(dimension *runtimev1.MetricsViewSpec_Dimension) (string, error)
 	AnyValueExpression(expr string) string
+	// HashExpression returns an expression that replaces the value of the given expression with a hex-encoded hash of it.
+	HashExpression(expr string) (string, error)
+	// RedactExpression returns an expression that replaces all but the last keepLast characters of the value of the given expression.
+	RedactExpression(expr string, keepLast uint32) (string, error)
 	MinDimensionExpression(expr string) string
 	MaxDimensionExpression(expr string) string
 	GetTimeDimensionParameter(typeCode runtimev1.Type_Code) string
@@ -254,6 +260,28 @@ func (b *BaseDialect) AnyValueExpression(expr string) string {
 	return fmt.Sprintf("ANY_VALUE(%s)", expr)
 }
 
+func (b *BaseDialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("MD5(CAST(%s AS VARCHAR))", expr), nil
+}
+
+func (b *BaseDialect) RedactExpression(expr string, keepLast uint32) (string, error) {
+	return redactExpression(expr, fmt.Sprintf("RIGHT(CAST(%s AS VARCHAR), %d)", expr, keepLast), keepLast), nil
+}
+
+// redactExpression builds a redaction expression given an expression that extracts the last keepLast characters of expr as a string.
+// NULL values are left as NULL so they can still be distinguished from redacted values.
+func redactExpression(expr, lastExpr string, keepLast uint32) string {
+	if keepLast == 0 {
+		return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE '****' END", expr)
+	}
+	return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE CONCAT('****', %s) END", expr, lastExpr)
+}
+
+// RedactExpressionWithCast is like RedactExpression for dialects that use another string type than VARCHAR in casts.
+func (b *BaseDialect) RedactExpressionWithCast(expr string, keepLast uint32, stringType string) string {
+	return redactExpression(expr, fmt.Sprintf("RIGHT(CAST(%s AS %s), %d)", expr, stringType, keepLast), keepLast)
+}
+
 func (b *BaseDialect) MinDimensionExpression(expr string) string {
 	return fmt.Sprintf("MIN(%s)", expr)
 }
@@ -0,0 +1,213 @@
+package doris
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+)
+
+type dialect struct {
+	drivers.BaseDialect
+}
+
+var DialectDoris drivers.Dialect = func() drivers.Dialect {
+	d := &dialect{}
+	d.BaseDialect = drivers.NewBaseDialect(drivers.DialectNameDoris, EscapeIdentifier, EscapeIdentifier)
+	return d
+}()
+
+func (d *dialect) SupportsILike() bool {
+	return false
+}
+
+func (d *dialect) OrderByExpression(name string, desc bool) string {
+	res := d.EscapeIdentifier(name)
+	if desc {
+		res += " DESC"
+	}
+	res += " NULLS LAST"
+	return res
+}
+
+func (d *dialect) OrderByAliasExpression(name string, desc bool) string {
+	res := d.EscapeAlias(name)
+	if desc {
+		res += " DESC"
+	}
+	res += " NULLS LAST"
+	return res
+}
+
+func (d *dialect) JoinOnExpression(lhs, rhs string) string {
+	// Doris uses MySQL's NULL-safe equal operator.
+	return fmt.Sprintf("%s <=> %s", lhs, rhs)
+}
+
+// DateTruncExpr uses Doris' time-floor functions (e.g. DAY_FLOOR) instead of date_trunc,
+// since their origin argument supports weeks and years that start on a custom day or month.
+func (d *dialect) DateTruncExpr(dim *runtimev1.MetricsViewSpec_Dimension, grain runtimev1.TimeGrain, tz string, firstDayOfWeek, firstMonthOfYear int) (string, error) {
+	if tz == "UTC" || tz == "Etc/UTC" {
+		tz = ""
+	}
+	if tz != "" {
+		_, err := time.LoadLocation(tz)
+		if err != nil {
+			return "", fmt.Errorf("invalid time zone %q: %w", tz, err)
+		}
+	}
+
+	var expr string
+	if dim.Expression != "" {
+		expr = fmt.Sprintf("(%s)", dim.Expression)
+	} else {
+		expr = d.EscapeIdentifier(dim.Column)
+	}
+
+	if tz != "" {
+		expr = fmt.Sprintf("CONVERT_TZ(%s, 'UTC', '%s')", expr, tz)
+	}
+
+	// The default origin of the floor functions is 0001-01-01 00:00:00, which is a Monday.
+	var res string
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		res = fmt.Sprintf("SECOND_FLOOR(%s)", expr)
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		res = fmt.Sprintf("MINUTE_FLOOR(%s)", expr)
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		res = fmt.Sprintf("HOUR_FLOOR(%s)", expr)
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		res = fmt.Sprintf("DAY_FLOOR(%s)", expr)
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		if firstDayOfWeek > 1 && firstDayOfWeek <= 7 {
+			res = fmt.Sprintf("WEEK_FLOOR(%s, 1, '0001-01-%02d 00:00:00')", expr, firstDayOfWeek)
+		} else {
+			res = fmt.Sprintf("WEEK_FLOOR(%s)", expr)
+		}
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		res = fmt.Sprintf("MONTH_FLOOR(%s)", expr)
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		res = fmt.Sprintf("MONTH_FLOOR(%s, 3)", expr)
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		if firstMonthOfYear > 1 && firstMonthOfYear <= 12 {
+			res = fmt.Sprintf("MONTH_FLOOR(%s, 12, '0001-%02d-01 00:00:00')", expr, firstMonthOfYear)
+		} else {
+			res = fmt.Sprintf("YEAR_FLOOR(%s)", expr)
+		}
+	default:
+		return "", fmt.Errorf("unsupported time grain %q for Doris", grain)
+	}
+
+	if tz == "" {
+		return res, nil
+	}
+	// Truncate in the target timezone, then convert back to UTC.
+	return fmt.Sprintf("CONVERT_TZ(%s, '%s', 'UTC')", res, tz), nil
+}
+
+func (d *dialect) DateDiff(grain runtimev1.TimeGrain, t1, t2 time.Time) (string, error) {
+	start, end := datetimeLiteral(t1), datetimeLiteral(t2)
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return fmt.Sprintf("MILLISECONDS_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return fmt.Sprintf("SECONDS_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return fmt.Sprintf("MINUTES_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return fmt.Sprintf("HOURS_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return fmt.Sprintf("DAYS_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return fmt.Sprintf("WEEKS_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return fmt.Sprintf("MONTHS_DIFF(%s, %s)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		// Doris doesn't have QUARTERS_DIFF
+		return fmt.Sprintf("(MONTHS_DIFF(%s, %s) DIV 3)", end, start), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return fmt.Sprintf("YEARS_DIFF(%s, %s)", end, start), nil
+	default:
+		return "", fmt.Errorf("unsupported time grain %q for Doris", grain)
+	}
+}
+
+func (d *dialect) IntervalSubtract(tsExpr, unitExpr string, grain runtimev1.TimeGrain) (string, error) {
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return fmt.Sprintf("MILLISECONDS_SUB(%s, %s)", tsExpr, unitExpr), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return fmt.Sprintf("(%s - INTERVAL ((%s) * 3) MONTH)", tsExpr, unitExpr), nil
+	default:
+		return fmt.Sprintf("(%s - INTERVAL (%s) %s)", tsExpr, unitExpr, d.ConvertToDateTruncSpecifier(grain)), nil
+	}
+}
+
+func (d *dialect) SelectTimeRangeBins(start, end time.Time, grain runtimev1.TimeGrain, alias string, tz *time.Location, firstDay, firstMonth int) (string, []any, error) {
+	g := timeutil.TimeGrainFromAPI(grain)
+	start = timeutil.TruncateTime(start, g, tz, firstDay, firstMonth)
+	// Doris uses UNION ALL for generating time series.
+	var sb strings.Builder
+	first := true
+	for t := start; t.Before(end); t = timeutil.OffsetTime(t, g, 1, tz) {
+		if !first {
+			sb.WriteString(" UNION ALL ")
+		}
+		sb.WriteString(fmt.Sprintf("SELECT %s AS %s", datetimeLiteral(t), d.EscapeAlias(alias)))
+		first = false
+	}
+	return sb.String(), nil, nil
+}
+
+func (d *dialect) ColumnCardinality(db, dbSchema, table, column string) (string, error) {
+	return fmt.Sprintf("SELECT approx_count_distinct(%s) AS count FROM %s", d.EscapeIdentifier(column), d.EscapeTable(db, dbSchema, table)), nil
+}
+
+func (d *dialect) ColumnDescriptiveStatistics(db, dbSchema, table, column string) (string, error) {
+	return fmt.Sprintf("SELECT "+
+		"CAST(min(%[1]s) AS DOUBLE) as min, "+
+		"CAST(percentile_approx(%[1]s, 0.25) AS DOUBLE) as q25, "+
+		"CAST(percentile_approx(%[1]s, 0.5) AS DOUBLE) as q50, "+
+		"CAST(percentile_approx(%[1]s, 0.75) AS DOUBLE) as q75, "+
+		"CAST(max(%[1]s) AS DOUBLE) as max, "+
+		"CAST(avg(%[1]s) AS DOUBLE) as mean, "+
+		"CAST(stddev_samp(%[1]s) AS DOUBLE) as sd "+
+		"FROM %[2]s WHERE %[1]s IS NOT NULL",
+		d.EscapeIdentifier(column),
+		d.EscapeTable(db, dbSchema, table)), nil
+}
+
+func (d *dialect) IsNonNullFinite(floatColumn string) string {
+	sanitizedFloatColumn := d.EscapeIdentifier(floatColumn)
+	// Doris doesn't have isinf(), use range check to filter Infinity
+	return fmt.Sprintf("%s IS NOT NULL AND %s > -1e308 AND %s < 1e308", sanitizedFloatColumn, sanitizedFloatColumn, sanitizedFloatColumn)
+}
+
+func (d *dialect) ColumnNumericHistogramBucket(db, dbSchema, table, column string) (string, error) {
+	sanitizedColumnName := d.EscapeIdentifier(column)
+	return fmt.Sprintf("SELECT (percentile_approx(%s, 0.75)-percentile_approx(%s, 0.25)) AS iqr, approx_count_distinct(%s) AS count, (max(%s) - min(%s)) AS `range` FROM %s",
+		sanitizedColumnName,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		d.EscapeTable(db, dbSchema, table)), nil
+}
+
+func EscapeIdentifier(ident string) string {
+	if ident == "" {
+		return ident
+	}
+	// Doris uses backticks for quoting identifiers
+	// Replace any backticks inside the identifier with double backticks.
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(ident, "`", "``"))
+}
+
+// datetimeLiteral returns a DATETIME literal for t in UTC with millisecond precision.
+func datetimeLiteral(t time.Time) string {
+	return fmt.Sprintf("CAST('%s' AS DATETIME(3))", t.UTC().Format("2006-01-02 15:04:05.000"))
+}
@@ -0,0 +1,63 @@
+package doris
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTruncExpr(t *testing.T) {
+	dim := &runtimev1.MetricsViewSpec_Dimension{Column: "ts"}
+
+	tests := []struct {
+		grain      runtimev1.TimeGrain
+		tz         string
+		firstDay   int
+		firstMonth int
+		want       string
+	}{
+		{runtimev1.TimeGrain_TIME_GRAIN_HOUR, "", 1, 1, "HOUR_FLOOR(`ts`)"},
+		{runtimev1.TimeGrain_TIME_GRAIN_DAY, "UTC", 1, 1, "DAY_FLOOR(`ts`)"},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 1, 1, "WEEK_FLOOR(`ts`)"},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 7, 1, "WEEK_FLOOR(`ts`, 1, '0001-01-07 00:00:00')"},
+		{runtimev1.TimeGrain_TIME_GRAIN_QUARTER, "", 1, 1, "MONTH_FLOOR(`ts`, 3)"},
+		{runtimev1.TimeGrain_TIME_GRAIN_YEAR, "", 1, 1, "YEAR_FLOOR(`ts`)"},
+		{runtimev1.TimeGrain_TIME_GRAIN_YEAR, "", 1, 4, "MONTH_FLOOR(`ts`, 12, '0001-04-01 00:00:00')"},
+		{runtimev1.TimeGrain_TIME_GRAIN_DAY, "Asia/Kolkata", 1, 1, "CONVERT_TZ(DAY_FLOOR(CONVERT_TZ(`ts`, 'UTC', 'Asia/Kolkata')), 'Asia/Kolkata', 'UTC')"},
+	}
+	for _, tt := range tests {
+		got, err := DialectDoris.DateTruncExpr(dim, tt.grain, tt.tz, tt.firstDay, tt.firstMonth)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+
+	_, err := DialectDoris.DateTruncExpr(dim, runtimev1.TimeGrain_TIME_GRAIN_DAY, "Invalid/Zone", 1, 1)
+	require.Error(t, err)
+	_, err = DialectDoris.DateTruncExpr(dim, runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND, "", 1, 1)
+	require.Error(t, err)
+}
+
+func TestDateDiff(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := DialectDoris.DateDiff(runtimev1.TimeGrain_TIME_GRAIN_DAY, t1, t2)
+	require.NoError(t, err)
+	require.Equal(t, "DAYS_DIFF(CAST('2024-07-01 00:00:00.000' AS DATETIME(3)), CAST('2024-01-01 00:00:00.000' AS DATETIME(3)))", got)
+
+	got, err = DialectDoris.DateDiff(runtimev1.TimeGrain_TIME_GRAIN_QUARTER, t1, t2)
+	require.NoError(t, err)
+	require.Equal(t, "(MONTHS_DIFF(CAST('2024-07-01 00:00:00.000' AS DATETIME(3)), CAST('2024-01-01 00:00:00.000' AS DATETIME(3))) DIV 3)", got)
+}
+
+func TestIntervalSubtract(t *testing.T) {
+	got, err := DialectDoris.IntervalSubtract("`ts`", "2", runtimev1.TimeGrain_TIME_GRAIN_DAY)
+	require.NoError(t, err)
+	require.Equal(t, "(`ts` - INTERVAL (2) DAY)", got)
+
+	got, err = DialectDoris.IntervalSubtract("`ts`", "2", runtimev1.TimeGrain_TIME_GRAIN_QUARTER)
+	require.NoError(t, err)
+	require.Equal(t, "(`ts` - INTERVAL ((2) * 3) MONTH)", got)
+}
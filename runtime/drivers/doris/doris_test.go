@@ -0,0 +1,177 @@
+package doris
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigPropertiesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *ConfigProperties
+		wantErr bool
+	}{
+		{
+			name:    "empty config",
+			cfg:     &ConfigProperties{},
+			wantErr: true,
+		},
+		{
+			name: "dsn only",
+			cfg: &ConfigProperties{
+				DSN: "user:pass@tcp(host:9030)/db",
+			},
+			wantErr: false,
+		},
+		{
+			name: "host only",
+			cfg: &ConfigProperties{
+				Host: "localhost",
+			},
+			wantErr: false,
+		},
+		{
+			name: "dsn with catalog and database",
+			cfg: &ConfigProperties{
+				DSN:      "user:pass@tcp(host:9030)/",
+				Catalog:  "iceberg_catalog",
+				Database: "sales",
+			},
+			wantErr: false,
+		},
+		{
+			name: "both dsn and host",
+			cfg: &ConfigProperties{
+				DSN:  "user:pass@tcp(host:9030)/db",
+				Host: "localhost",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildDSN(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *ConfigProperties
+		contains []string
+		wantErr  bool
+	}{
+		{
+			name: "dsn",
+			cfg: &ConfigProperties{
+				DSN: "user:pass@tcp(host:9030)/db",
+			},
+			contains: []string{"user:pass@tcp(host:9030)/db", "parseTime=true", "time_zone=%27UTC%27"},
+		},
+		{
+			name: "dsn with time zone",
+			cfg: &ConfigProperties{
+				DSN: "user:pass@tcp(host:9030)/db?time_zone=%27Asia%2FTokyo%27",
+			},
+			contains: []string{"time_zone=%27Asia%2FTokyo%27"},
+		},
+		{
+			name: "build from fields",
+			cfg: &ConfigProperties{
+				Host:     "localhost",
+				Port:     9030,
+				Username: "root",
+				Password: "secret",
+			},
+			contains: []string{"root:secret@tcp(localhost:9030)", "parseTime=true"},
+		},
+		{
+			name: "build from fields with ssl",
+			cfg: &ConfigProperties{
+				Host:     "localhost",
+				Port:     9030,
+				Username: "root",
+				SSL:      true,
+			},
+			contains: []string{"tls=true"},
+		},
+		{
+			name: "invalid dsn",
+			cfg: &ConfigProperties{
+				DSN: "doris://host:9030",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &connection{configProp: tt.cfg}
+			result, err := c.buildDSN()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, s := range tt.contains {
+				require.Contains(t, result, s)
+			}
+		})
+	}
+}
+
+func TestSafeSQLName(t *testing.T) {
+	require.Equal(t, "`table_name`", safeSQLName("table_name"))
+	require.Equal(t, "`table``name`", safeSQLName("table`name"))
+	require.Equal(t, "", safeSQLName(""))
+}
+
+func TestDatabaseTypeToRuntimeType(t *testing.T) {
+	c := &connection{}
+
+	tests := []struct {
+		dbType    string
+		expected  string
+		expectErr bool
+	}{
+		{"BOOLEAN", "CODE_BOOL", false},
+		{"TINYINT", "CODE_INT8", false},
+		{"INT", "CODE_INT32", false},
+		{"BIGINT", "CODE_INT64", false},
+		{"LARGEINT", "CODE_INT128", false},
+		{"DOUBLE", "CODE_FLOAT64", false},
+		{"DECIMALV3(10,2)", "CODE_STRING", false},
+		{"VARCHAR(255)", "CODE_STRING", false},
+		{"STRING", "CODE_STRING", false},
+		{"IPV6", "CODE_STRING", false},
+		{"DATEV2", "CODE_DATE", false},
+		{"datetimev2(3)", "CODE_TIMESTAMP", false},
+		{"DATETIME", "CODE_TIMESTAMP", false},
+		{"JSON", "CODE_JSON", false},
+		{"VARIANT", "CODE_JSON", false},
+		{"ARRAY<INT>", "CODE_ARRAY", false},
+		{"HLL", "", true},
+		{"BITMAP", "", true},
+		{"QUANTILE_STATE", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dbType, func(t *testing.T) {
+			result, err := c.databaseTypeToRuntimeType(tt.dbType)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, result.Code.String(), tt.expected)
+		})
+	}
+}
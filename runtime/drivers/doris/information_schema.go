@@ -0,0 +1,310 @@
+package doris
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/mysqlolap"
+	"github.com/rilldata/rill/runtime/pkg/pagination"
+)
+
+// Doris structure: Catalog -> Database -> Table
+// We map: Database = catalog, DatabaseSchema = database
+// Queries use fully qualified names (catalog.information_schema.tables) instead of SWITCH/USE.
+
+// systemSchemas are the Doris databases that are hidden from listings.
+const systemSchemas = "'information_schema', 'mysql', '__internal_schema'"
+
+// ListDatabaseSchemas returns a list of databases in the connector's catalog.
+func (c *connection) ListDatabaseSchemas(ctx context.Context, pageSize uint32, pageToken string) ([]*drivers.DatabaseSchemaInfo, string, error) {
+	limit := pagination.ValidPageSize(pageSize, drivers.DefaultPageSize)
+	catalog := c.configProp.Catalog
+
+	q := fmt.Sprintf(`
+		SELECT schema_name
+		FROM %s.information_schema.schemata
+		WHERE schema_name NOT IN (%s)
+	`, safeSQLName(catalog), systemSchemas)
+	var args []any
+	if pageToken != "" {
+		var startAfter string
+		if err := pagination.UnmarshalPageToken(pageToken, &startAfter); err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		q += " AND schema_name > ?"
+		args = append(args, startAfter)
+	}
+	q += fmt.Sprintf(" ORDER BY schema_name LIMIT %d", limit+1)
+
+	rows, err := c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var schemas []*drivers.DatabaseSchemaInfo
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, "", err
+		}
+		schemas = append(schemas, &drivers.DatabaseSchemaInfo{
+			Database:       catalog,
+			DatabaseSchema: schemaName,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(schemas) > limit {
+		schemas = schemas[:limit]
+		next = pagination.MarshalPageToken(schemas[len(schemas)-1].DatabaseSchema)
+	}
+	return schemas, next, nil
+}
+
+// ListTables returns a list of tables in a specific database schema.
+// database parameter = catalog, databaseSchema parameter = database
+func (c *connection) ListTables(ctx context.Context, database, databaseSchema string, pageSize uint32, pageToken string) ([]*drivers.TableInfo, string, error) {
+	limit := pagination.ValidPageSize(pageSize, drivers.DefaultPageSize)
+	catalog := database
+	if catalog == "" {
+		catalog = c.configProp.Catalog
+	}
+	dbSchema := databaseSchema
+	if dbSchema == "" {
+		dbSchema = c.configProp.Database
+	}
+
+	q := fmt.Sprintf(`
+		SELECT table_name, table_type IN ('VIEW', 'MATERIALIZED VIEW') AS is_view
+		FROM %s.information_schema.tables
+		WHERE table_schema = ?
+	`, safeSQLName(catalog))
+	args := []any{dbSchema}
+	if pageToken != "" {
+		var startAfter string
+		if err := pagination.UnmarshalPageToken(pageToken, &startAfter); err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		q += " AND table_name > ?"
+		args = append(args, startAfter)
+	}
+	q += fmt.Sprintf(" ORDER BY table_name LIMIT %d", limit+1)
+
+	rows, err := c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var tables []*drivers.TableInfo
+	for rows.Next() {
+		var tableName string
+		var isView bool
+		if err := rows.Scan(&tableName, &isView); err != nil {
+			return nil, "", err
+		}
+		tables = append(tables, &drivers.TableInfo{
+			Name: tableName,
+			View: isView,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(tables) > limit {
+		tables = tables[:limit]
+		next = pagination.MarshalPageToken(tables[len(tables)-1].Name)
+	}
+	return tables, next, nil
+}
+
+// Lookup returns metadata about a specific table or view.
+// database parameter = catalog, schema parameter = database in Doris terms.
+func (c *connection) Lookup(ctx context.Context, database, databaseSchema, name string) (*drivers.OlapTable, error) {
+	catalog := database
+	if catalog == "" {
+		catalog = c.configProp.Catalog
+	}
+	dbSchema := databaseSchema
+	if dbSchema == "" {
+		dbSchema = c.configProp.Database
+	}
+
+	tableQuery := fmt.Sprintf(`
+		SELECT table_schema, table_name, table_type IN ('VIEW', 'MATERIALIZED VIEW') AS is_view
+		FROM %s.information_schema.tables
+		WHERE table_schema = ? AND LOWER(table_name) = LOWER(?)
+	`, safeSQLName(catalog))
+
+	var tableSchema, tableName string
+	var isView bool
+	err := c.db.QueryRowxContext(ctx, tableQuery, dbSchema, name).Scan(&tableSchema, &tableName, &isView)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to look up table: %w", err)
+	}
+
+	columnsQuery := fmt.Sprintf(`
+		SELECT column_name, data_type
+		FROM %s.information_schema.columns
+		WHERE table_schema = ? AND table_name = ?
+		ORDER BY ordinal_position
+	`, safeSQLName(catalog))
+
+	rows, err := c.db.QueryxContext(ctx, columnsQuery, tableSchema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+	defer rows.Close()
+
+	var fields []*runtimev1.StructType_Field
+	unsupportedCols := make(map[string]string)
+	for rows.Next() {
+		var colName, dataType string
+		if err := rows.Scan(&colName, &dataType); err != nil {
+			return nil, err
+		}
+
+		runtimeType, err := c.databaseTypeToRuntimeType(dataType)
+		if err != nil {
+			if errors.Is(err, mysqlolap.ErrUnsupportedType) {
+				unsupportedCols[colName] = dataType
+				continue // Skip unsupported types like HLL and BITMAP
+			}
+			return nil, err
+		}
+
+		fields = append(fields, &runtimev1.StructType_Field{
+			Name: colName,
+			Type: runtimeType,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Like StarRocks, always save database (catalog) and databaseSchema (database) in the metrics view
+	// because queries against external catalogs require fully qualified table names (catalog.database.table).
+	return &drivers.OlapTable{
+		Database:                catalog,
+		DatabaseSchema:          tableSchema,
+		IsDefaultDatabase:       false,
+		IsDefaultDatabaseSchema: false,
+		Name:                    tableName,
+		View:                    isView,
+		Schema:                  &runtimev1.StructType{Fields: fields},
+		UnsupportedCols:         unsupportedCols,
+	}, nil
+}
+
+// All returns metadata about all tables and views in the connector's catalog.
+func (c *connection) All(ctx context.Context, like string, pageSize uint32, pageToken string) ([]*drivers.OlapTable, string, error) {
+	limit := pagination.ValidPageSize(pageSize, drivers.DefaultPageSize)
+	catalog := c.configProp.Catalog
+
+	q := fmt.Sprintf(`
+		SELECT table_schema, table_name, table_type IN ('VIEW', 'MATERIALIZED VIEW') AS is_view
+		FROM %s.information_schema.tables
+		WHERE table_schema NOT IN (%s)
+	`, safeSQLName(catalog), systemSchemas)
+	var args []any
+	if like != "" {
+		q += " AND table_name LIKE ?"
+		args = append(args, like)
+	}
+	if pageToken != "" {
+		var startSchema, startName string
+		if err := pagination.UnmarshalPageToken(pageToken, &startSchema, &startName); err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		q += " AND (table_schema > ? OR (table_schema = ? AND table_name > ?))"
+		args = append(args, startSchema, startSchema, startName)
+	}
+	q += fmt.Sprintf(" ORDER BY table_schema, table_name LIMIT %d", limit+1)
+
+	rows, err := c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var tables []*drivers.OlapTable
+	for rows.Next() {
+		var schema, name string
+		var isView bool
+		if err := rows.Scan(&schema, &name, &isView); err != nil {
+			return nil, "", err
+		}
+		tables = append(tables, &drivers.OlapTable{
+			Database:       catalog, // Doris catalog -> Rill database
+			DatabaseSchema: schema,  // Doris database -> Rill databaseSchema
+			Name:           name,
+			View:           isView,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(tables) > limit {
+		tables = tables[:limit]
+		last := tables[len(tables)-1]
+		next = pagination.MarshalPageToken(last.DatabaseSchema, last.Name)
+	}
+	return tables, next, nil
+}
+
+// LoadPhysicalSize populates the PhysicalSizeBytes field of table metadata.
+// Doris doesn't expose the physical size consistently across catalogs, so it is left unset.
+func (c *connection) LoadPhysicalSize(ctx context.Context, tables []*drivers.OlapTable) error {
+	return nil
+}
+
+// LoadDDL implements drivers.OLAPInformationSchema.
+func (c *connection) LoadDDL(ctx context.Context, table *drivers.OlapTable) error {
+	catalog := table.Database
+	if catalog == "" {
+		catalog = c.configProp.Catalog
+	}
+	schema := table.DatabaseSchema
+	if schema == "" {
+		schema = c.configProp.Database
+	}
+
+	q := fmt.Sprintf("SHOW CREATE TABLE %s.%s.%s", safeSQLName(catalog), safeSQLName(schema), safeSQLName(table.Name))
+	rows, err := c.db.QueryxContext(ctx, q)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// For views, Doris returns additional charset and collation columns, so only the first two are read.
+	if rows.Next() {
+		row, err := rows.SliceScan()
+		if err != nil {
+			return err
+		}
+		if len(row) >= 2 {
+			switch ddl := row[1].(type) {
+			case string:
+				table.DDL = ddl
+			case []byte:
+				table.DDL = string(ddl)
+			}
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,94 @@
+package doris
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/mysqlolap"
+	"go.uber.org/zap"
+)
+
+var _ drivers.OLAPStore = (*connection)(nil)
+
+// Dialect implements drivers.OLAPStore.
+func (c *connection) Dialect() drivers.Dialect {
+	return DialectDoris
+}
+
+// MayBeScaledToZero implements drivers.OLAPStore.
+func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
+	return false
+}
+
+// WithConnection implements drivers.OLAPStore.
+// Doris is a read-only OLAP connector and does not support connection affinity operations.
+// This is only used by model executors, which are not supported for Doris.
+func (c *connection) WithConnection(ctx context.Context, priority int, fn drivers.WithConnectionFunc) error {
+	return fmt.Errorf("doris: WithConnection not supported")
+}
+
+// Exec implements drivers.OLAPStore.
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	if c.configProp.LogQueries {
+		c.logger.Info("Doris exec",
+			zap.String("query", stmt.Query),
+			zap.Any("args", stmt.Args))
+	}
+
+	return mysqlolap.Exec(ctx, c.db, stmt)
+}
+
+// Query implements drivers.OLAPStore.
+func (c *connection) Query(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	if c.configProp.LogQueries {
+		c.logger.Info("Doris query",
+			zap.String("query", stmt.Query),
+			zap.Any("args", stmt.Args))
+	}
+
+	return mysqlolap.Query(ctx, c.db, stmt)
+}
+
+func (c *connection) Head(ctx context.Context, db, schema, table string, limit int64) (*drivers.Result, error) {
+	tbl, err := c.InformationSchema().Lookup(ctx, db, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []string
+	for _, field := range tbl.Schema.Fields {
+		columns = append(columns, c.Dialect().EscapeIdentifier(field.Name))
+	}
+
+	limitClause := ""
+	if limit > 0 {
+		limitClause = fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	return c.Query(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(columns, ", "), c.Dialect().EscapeTable(tbl.Database, tbl.DatabaseSchema, tbl.Name), limitClause),
+	})
+}
+
+// QuerySchema implements drivers.OLAPStore.
+func (c *connection) QuerySchema(ctx context.Context, query string, args []any) (*runtimev1.StructType, error) {
+	return mysqlolap.QuerySchema(ctx, c.db, query, args)
+}
+
+// InformationSchema implements drivers.OLAPStore.
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return c
+}
+
+// EstimateSize implements drivers.OLAPStore.
+func (c *connection) EstimateSize(ctx context.Context) (int64, error) {
+	return -1, nil
+}
+
+// databaseTypeToRuntimeType converts a Doris type to a runtime type.
+func (c *connection) databaseTypeToRuntimeType(dbType string) (*runtimev1.Type, error) {
+	return mysqlolap.DatabaseTypeToRuntimeType(dbType)
+}
@@ -0,0 +1,16 @@
+package doris
+
+import (
+	"strings"
+)
+
+// safeSQLName escapes an identifier (catalog, database, table name) for Doris.
+// Doris uses backticks (`) to escape identifiers, similar to MySQL.
+func safeSQLName(name string) string {
+	if name == "" {
+		return name
+	}
+	// Escape backticks inside the name by doubling them
+	escaped := strings.ReplaceAll(name, "`", "``")
+	return "`" + escaped + "`"
+}
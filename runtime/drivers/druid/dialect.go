@@ -53,6 +53,10 @@ func (d *dialect) MaxDimensionExpression(expr string) string {
 	return fmt.Sprintf("LATEST(%s)", expr) // MAX on string columns is not supported in Druid
 }
 
+func (d *dialect) HashExpression(_ string) (string, error) {
+	return "", fmt.Errorf("hash masks are not supported for the druid dialect")
+}
+
 func (d *dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	return fmt.Sprintf("SAFE_DIVIDE(%s, CAST(%s AS DOUBLE))", numExpr, denExpr)
 }
@@ -427,12 +427,15 @@ func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 			return &localFileToSelfExecutor{c, f}, nil
 		}
 		switch opts.InputHandle.Driver() {
-		case "mysql", "postgres":
-			return &sqlStoreToSelfExecutor{c}, nil
+		case "mysql", "postgres", "trino":
+			return &sqlStoreToSelfExecutor{c, instanceID}, nil
 		case "https":
 			return &httpsToSelfExecutor{c}, nil
 		case "motherduck":
 			return &mdToSelfExecutor{c}, nil
+		case "sftp":
+			// DuckDB can't read from SFTP servers, so the files are downloaded using the connector instead.
+			return &objectStoreToSelfExecutorNonNative{c}, nil
 		}
 		if _, ok := opts.InputHandle.AsObjectStore(); ok {
 			return &objectStoreToSelfExecutor{c}, nil
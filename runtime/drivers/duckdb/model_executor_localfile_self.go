@@ -38,10 +38,6 @@ func (e *localFileToSelfExecutor) Concurrency(desired int) (int, bool) {
 }
 
 func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
-	if opts.IncrementalRun {
-		return nil, fmt.Errorf("duckdb: incremental models are not supported for the local_file connector")
-	}
-
 	inputProps := &inputProps{}
 	var warnings []string
 	unused, err := mapstructureutil.WeakDecodeWithWarnings(opts.InputProperties, inputProps)
@@ -58,6 +54,14 @@ func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		return nil, fmt.Errorf("invalid input properties: %w", err)
 	}
 
+	// Delta Lake tables track their own versions, so they can be read incrementally.
+	if opts.Incremental && inputProps.Format == string(drivers.FileFormatDelta) {
+		return e.executeIncrementalDelta(ctx, opts, warnings)
+	}
+	if opts.IncrementalRun {
+		return nil, fmt.Errorf("duckdb: incremental models are not supported for the local_file connector")
+	}
+
 	outputProps := &ModelOutputProperties{}
 	unused, err = mapstructureutil.WeakDecodeWithWarnings(opts.OutputProperties, outputProps)
 	if err != nil {
@@ -104,15 +108,21 @@ func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		return nil, fmt.Errorf("no files to ingest")
 	}
 
-	if inputProps.Format == "" {
-		inputProps.Format = fileutil.FullExt(localPaths[0])
+	var from string
+	if inputProps.Format == string(drivers.FileFormatDelta) {
+		// The path of a Delta Lake table is its root directory
+		from = deltaScan(localPaths[0], false)
 	} else {
-		inputProps.Format = "." + inputProps.Format
-	}
+		if inputProps.Format == "" {
+			inputProps.Format = fileutil.FullExt(localPaths[0])
+		} else {
+			inputProps.Format = "." + inputProps.Format
+		}
 
-	from, err := sourceReader(localPaths, inputProps.Format, inputProps.DuckDB)
-	if err != nil {
-		return nil, err
+		from, err = sourceReader(localPaths, inputProps.Format, inputProps.DuckDB)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// create the table
@@ -151,3 +161,42 @@ func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		Warnings:     warnings,
 	}, nil
 }
+
+// executeIncrementalDelta executes an incremental model that reads a Delta Lake table in a local directory.
+// The table's log version is tracked so that incremental runs only read the data files that were added since the previously ingested version.
+// If rows were deleted or updated, or the schema changed, the whole table is read and replaces the model's data.
+func (e *localFileToSelfExecutor) executeIncrementalDelta(ctx context.Context, opts *drivers.ModelExecuteOptions, warnings []string) (*drivers.ModelResult, error) {
+	localPaths, err := e.from.FilePaths(ctx, opts.InputProperties)
+	if err != nil {
+		return nil, err
+	}
+	if len(localPaths) == 0 {
+		return nil, fmt.Errorf("no files to ingest")
+	}
+
+	// The path of a Delta Lake table is its root directory
+	clone := *opts
+	t, err := drivers.ResolveLocalDeltaTable(&clone, localPaths[0])
+	if err != nil {
+		return nil, err
+	}
+	if t.FullRefresh {
+		clone.IncrementalRun = false
+	}
+
+	// The selfToSelfExecutor handles the incremental strategy of the model.
+	propsMap := make(map[string]any)
+	if err := mapstructure.Decode(&ModelInputProperties{SQL: deltaTableSQL(t)}, &propsMap); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	clone.InputProperties = propsMap
+
+	executor := &selfToSelfExecutor{c: e.c}
+	res, err := executor.Execute(ctx, &clone)
+	if err != nil {
+		return nil, err
+	}
+	res.Warnings = append(res.Warnings, warnings...)
+	res.IncrementalState = t.IncrementalState()
+	return res, nil
+}
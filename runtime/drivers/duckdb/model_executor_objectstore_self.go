@@ -30,7 +30,7 @@ func (e *objectStoreToSelfExecutor) Concurrency(desired int) (int, bool) {
 func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
 	// Build the model executor options with updated input properties
 	clone := *opts
-	newInputProps, warnings, err := e.modelInputProperties(ctx, opts)
+	newInputProps, incrementalState, warnings, err := e.modelInputProperties(ctx, &clone)
 	if err != nil {
 		if errors.Is(err, errGCSUsesNativeCreds) {
 			e := &objectStoreToSelfExecutorNonNative{c: e.c}
@@ -48,23 +48,50 @@ func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.M
 		return nil, err
 	}
 	res.Warnings = append(res.Warnings, warnings...)
+	res.IncrementalState = incrementalState
 	return res, nil
 }
 
-func (e *objectStoreToSelfExecutor) modelInputProperties(ctx context.Context, opts *drivers.ModelExecuteOptions) (map[string]any, []string, error) {
+// modelInputProperties builds the input properties for the selfToSelfExecutor.
+// If the input is a table format that tracks its own versions (Iceberg or Delta Lake), it also returns the incremental state for the version that is read.
+// It clears opts.IncrementalRun if the input can't be ingested incrementally and must replace the model's data.
+func (e *objectStoreToSelfExecutor) modelInputProperties(ctx context.Context, opts *drivers.ModelExecuteOptions) (map[string]any, map[string]any, []string, error) {
 	parsed := &drivers.ObjectStoreModelInputProperties{}
 	var warnings []string
 	unused, err := parsed.DecodeWithWarnings(opts.InputProperties)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse input properties: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to parse input properties: %w", err)
 	}
 	if len(unused) > 0 {
 		if opts.Env.StrictModelProps {
-			return nil, nil, fmt.Errorf("undefined fields in input properties: %q", strings.Join(unused, ", "))
+			return nil, nil, nil, fmt.Errorf("undefined fields in input properties: %q", strings.Join(unused, ", "))
 		}
 		warnings = append(warnings, fmt.Sprintf("Undefined fields %q in input properties. Will be ignored.", strings.Join(unused, ", ")))
 	}
 
+	var m *ModelInputProperties
+	var incrementalState map[string]any
+	switch parsed.Format {
+	case drivers.FileFormatIceberg:
+		m, incrementalState, err = e.icebergInputProperties(ctx, opts, parsed)
+	case drivers.FileFormatDelta:
+		m, incrementalState, err = e.deltaInputProperties(ctx, opts, parsed)
+	default:
+		m, err = e.filesInputProperties(ctx, opts, parsed)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	propsMap := make(map[string]any)
+	if err := mapstructure.Decode(m, &propsMap); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	return propsMap, incrementalState, warnings, nil
+}
+
+// filesInputProperties builds input properties that read the files matching the input path.
+func (e *objectStoreToSelfExecutor) filesInputProperties(ctx context.Context, opts *drivers.ModelExecuteOptions, parsed *drivers.ObjectStoreModelInputProperties) (*ModelInputProperties, error) {
 	m := &ModelInputProperties{}
 	var format string
 	if parsed.Format != "" {
@@ -74,23 +101,113 @@ func (e *objectStoreToSelfExecutor) modelInputProperties(ctx context.Context, op
 	}
 
 	// Generate secret SQL to access the to access object store using duckdb
+	var err error
 	m.InternalCreateSecretSQL, m.InternalDropSecretSQL, _, err = generateSecretSQL(ctx, opts, opts.InputConnector, parsed.Path, opts.InputProperties, e.c.logger)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Set SQL to read from the external source
 	from, err := sourceReader([]string{parsed.Path}, format, parsed.DuckDB)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	m.SQL = "SELECT * FROM " + from
+	return m, nil
+}
 
-	propsMap := make(map[string]any)
-	if err := mapstructure.Decode(m, &propsMap); err != nil {
-		return nil, nil, fmt.Errorf("failed to parse input properties: %w", err)
+// icebergInputProperties builds input properties that read a snapshot of an Iceberg table using DuckDB's iceberg extension.
+// On incremental runs, only rows that were appended since the previously ingested snapshot are read.
+// If rows were deleted or overwritten, or the schema changed, the whole snapshot is read and replaces the model's data.
+func (e *objectStoreToSelfExecutor) icebergInputProperties(ctx context.Context, opts *drivers.ModelExecuteOptions, parsed *drivers.ObjectStoreModelInputProperties) (*ModelInputProperties, map[string]any, error) {
+	store, _ := opts.InputHandle.AsObjectStore()
+	t, err := drivers.ResolveIcebergTable(ctx, opts, store, parsed)
+	if err != nil {
+		return nil, nil, err
 	}
-	return propsMap, warnings, nil
+
+	m := &ModelInputProperties{}
+	m.InternalCreateSecretSQL, m.InternalDropSecretSQL, _, err = generateSecretSQL(ctx, opts, opts.InputConnector, t.Location, opts.InputProperties, e.c.logger)
+	if err != nil {
+		if errors.Is(err, errGCSUsesNativeCreds) {
+			return nil, nil, fmt.Errorf("reading Iceberg tables from GCS requires HMAC keys (`key_id` and `secret`) on the connector")
+		}
+		return nil, nil, err
+	}
+
+	scan := func(snapshotID int64) string {
+		return fmt.Sprintf("iceberg_scan(%s, snapshot_from_id = %d)", safeSQLString(t.MetadataPath), snapshotID)
+	}
+	switch {
+	case t.Unchanged():
+		m.SQL = fmt.Sprintf("SELECT * FROM %s LIMIT 0", scan(t.SnapshotID))
+	case t.PreviousSnapshotID != 0:
+		// Since only rows were appended, the difference between the snapshots is the appended rows.
+		m.SQL = fmt.Sprintf("SELECT * FROM %s EXCEPT ALL SELECT * FROM %s", scan(t.SnapshotID), scan(t.PreviousSnapshotID))
+	default:
+		m.SQL = "SELECT * FROM " + scan(t.SnapshotID)
+	}
+	if t.FullRefresh {
+		opts.IncrementalRun = false
+	}
+	return m, t.IncrementalState(), nil
+}
+
+// deltaInputProperties builds input properties that read a Delta Lake table using DuckDB's delta extension.
+// For incremental models, the table's log version is tracked so that incremental runs only read the data files that were added since the previously ingested version.
+// If rows were deleted or updated, or the schema changed, the whole table is read and replaces the model's data.
+func (e *objectStoreToSelfExecutor) deltaInputProperties(ctx context.Context, opts *drivers.ModelExecuteOptions, parsed *drivers.ObjectStoreModelInputProperties) (*ModelInputProperties, map[string]any, error) {
+	m := &ModelInputProperties{}
+	var err error
+	m.InternalCreateSecretSQL, m.InternalDropSecretSQL, _, err = generateSecretSQL(ctx, opts, opts.InputConnector, parsed.Path, opts.InputProperties, e.c.logger)
+	if err != nil {
+		if errors.Is(err, errGCSUsesNativeCreds) {
+			return nil, nil, fmt.Errorf("reading Delta Lake tables from GCS requires HMAC keys (`key_id` and `secret`) on the connector")
+		}
+		return nil, nil, err
+	}
+
+	if !opts.Incremental {
+		m.SQL = "SELECT * FROM " + deltaScan(parsed.Path, false)
+		return m, nil, nil
+	}
+
+	store, _ := opts.InputHandle.AsObjectStore()
+	t, err := drivers.ResolveDeltaTable(ctx, opts, store, parsed.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.SQL = deltaTableSQL(t)
+	if t.FullRefresh {
+		opts.IncrementalRun = false
+	}
+	return m, t.IncrementalState(), nil
+}
+
+// deltaTableSQL returns a query that reads the data of a resolved Delta Lake table that must be ingested.
+// For incremental runs, it only reads the data files that were added since the previously ingested version.
+func deltaTableSQL(t *drivers.DeltaTable) string {
+	switch {
+	case t.Unchanged():
+		return fmt.Sprintf("SELECT * FROM %s LIMIT 0", deltaScan(t.Location, false))
+	case t.Incremental():
+		// Filtering on the filename column prunes the files that are scanned.
+		files := make([]string, len(t.AddedFiles))
+		for i, f := range t.AddedFiles {
+			files[i] = safeSQLString(f)
+		}
+		return fmt.Sprintf("SELECT * EXCLUDE (filename) FROM %s WHERE filename IN (%s)", deltaScan(t.Location, true), strings.Join(files, ", "))
+	default:
+		return "SELECT * FROM " + deltaScan(t.Location, false)
+	}
+}
+
+// deltaScan returns a delta_scan table function call for the Delta Lake table at the given path.
+func deltaScan(path string, filename bool) string {
+	if filename {
+		return fmt.Sprintf("delta_scan(%s, filename = true)", safeSQLString(path))
+	}
+	return fmt.Sprintf("delta_scan(%s)", safeSQLString(path))
 }
 
 // objectStoreToSelfExecutorNonNative is a non-native implementation of objectStoreToSelfExecutor.
@@ -99,6 +216,15 @@ type objectStoreToSelfExecutorNonNative struct {
 	c *connection
 }
 
+var _ drivers.ModelExecutor = &objectStoreToSelfExecutorNonNative{}
+
+func (e *objectStoreToSelfExecutorNonNative) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
 func (e *objectStoreToSelfExecutorNonNative) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
 	parsed := &drivers.ObjectStoreModelInputProperties{}
 	var warnings []string
@@ -0,0 +1,93 @@
+package duckdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	activity "github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/runtime/drivers/s3"
+)
+
+func TestIcebergInputPropertiesIncremental(t *testing.T) {
+	// The table was appended to in snapshot 2 and rows were deleted in snapshot 3.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/config":
+			_, _ = w.Write([]byte(`{"defaults": {}, "overrides": {}}`))
+		case "/v1/namespaces/db/tables/events":
+			_, _ = w.Write([]byte(`{"metadata-location": "s3://bucket/events/metadata/v3.metadata.json", "metadata": {
+	"format-version": 2,
+	"location": "s3://bucket/events",
+	"current-snapshot-id": 3,
+	"snapshots": [
+		{"snapshot-id": 1, "schema-id": 0, "summary": {"operation": "append"}},
+		{"snapshot-id": 2, "parent-snapshot-id": 1, "schema-id": 0, "summary": {"operation": "append"}},
+		{"snapshot-id": 3, "parent-snapshot-id": 2, "schema-id": 0, "summary": {"operation": "delete"}}
+	]
+}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	inputHandle, err := drivers.Open("s3", "", "default", map[string]any{
+		"aws_access_key_id":     "key",
+		"aws_secret_access_key": "secret",
+		"region":                "us-east-1",
+	}, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer inputHandle.Close()
+
+	e := &objectStoreToSelfExecutor{c: &connection{logger: zap.NewNop()}}
+	execute := func(snapshotID string, previousSnapshotID int64) (*drivers.ModelExecuteOptions, *ModelInputProperties, map[string]any) {
+		opts := &drivers.ModelExecuteOptions{
+			ModelExecutorOptions: &drivers.ModelExecutorOptions{
+				Env: &drivers.ModelEnv{
+					AcquireConnector: func(ctx context.Context, name string) (drivers.Handle, func(), error) {
+						if name == "s3" {
+							return inputHandle, func() {}, nil
+						}
+						return nil, nil, fmt.Errorf("unsupported name: %s", name)
+					},
+				},
+				InputHandle:    inputHandle,
+				InputConnector: "s3",
+			},
+			Incremental:      true,
+			IncrementalRun:   true,
+			IncrementalState: map[string]any{"snapshot_id": fmt.Sprint(previousSnapshotID)},
+		}
+		parsed := &drivers.ObjectStoreModelInputProperties{}
+		require.NoError(t, parsed.Decode(map[string]any{
+			"iceberg": map[string]any{
+				"catalog_uri": srv.URL,
+				"table":       "db.events",
+				"snapshot_id": snapshotID,
+			},
+		}))
+		m, state, err := e.icebergInputProperties(context.Background(), opts, parsed)
+		require.NoError(t, err)
+		return opts, m, state
+	}
+
+	// Only rows were appended, so only the difference between the snapshots is read.
+	opts, m, state := execute("2", 1)
+	require.True(t, opts.IncrementalRun)
+	require.Equal(t, `SELECT * FROM iceberg_scan('s3://bucket/events/metadata/v3.metadata.json', snapshot_from_id = 2) EXCEPT ALL SELECT * FROM iceberg_scan('s3://bucket/events/metadata/v3.metadata.json', snapshot_from_id = 1)`, m.SQL)
+	require.Equal(t, map[string]any{"snapshot_id": "2"}, state)
+
+	// Rows were deleted, so the whole snapshot is read and replaces the model's data.
+	opts, m, state = execute("", 1)
+	require.False(t, opts.IncrementalRun)
+	require.Equal(t, `SELECT * FROM iceberg_scan('s3://bucket/events/metadata/v3.metadata.json', snapshot_from_id = 3)`, m.SQL)
+	require.Equal(t, map[string]any{"snapshot_id": "3"}, state)
+}
@@ -168,5 +168,6 @@ func supportsExportFormat(format drivers.FileFormat, headers []string) bool {
 			return true
 		}
 	}
+	// JSONL is not exported natively because DuckDB encodes timestamps and large integers differently from the JSONL writer used for other OLAP connectors.
 	return false
 }
@@ -1,11 +1,18 @@
 package duckdb
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	rillmysql "github.com/rilldata/rill/runtime/drivers/mysql"
 	"github.com/rilldata/rill/runtime/drivers/postgres"
@@ -36,7 +43,8 @@ func (p *sqlStoreToSelfInputProps) Validate() error {
 }
 
 type sqlStoreToSelfExecutor struct {
-	c *connection
+	c          *connection
+	instanceID string
 }
 
 var _ drivers.ModelExecutor = &sqlStoreToSelfExecutor{}
@@ -67,10 +75,11 @@ func (e *sqlStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mode
 
 	// Build the model executor options with updated input properties
 	clone := *opts
-	newInputProps, err := e.modelInputProperties(opts.ModelName, opts.InputConnector, opts.InputHandle, inputProps)
+	newInputProps, cleanup, err := e.modelInputProperties(ctx, opts, inputProps)
 	if err != nil {
 		return nil, err
 	}
+	defer cleanup()
 	clone.InputProperties = newInputProps
 	newOpts := &clone
 
@@ -84,11 +93,15 @@ func (e *sqlStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mode
 	return res, nil
 }
 
-func (e *sqlStoreToSelfExecutor) modelInputProperties(modelName, inputConnector string, inputHandle drivers.Handle, inputProps *sqlStoreToSelfInputProps) (map[string]any, error) {
+// modelInputProperties builds the input properties for the selfToSelfExecutor.
+// The returned cleanup function must be called after the model has been executed.
+func (e *sqlStoreToSelfExecutor) modelInputProperties(ctx context.Context, opts *drivers.ModelExecuteOptions, inputProps *sqlStoreToSelfInputProps) (map[string]any, func(), error) {
+	inputHandle := opts.InputHandle
 	m := &ModelInputProperties{}
-	dbName := fmt.Sprintf("%s__%s", modelName, inputConnector)
+	dbName := fmt.Sprintf("%s__%s", opts.ModelName, opts.InputConnector)
 	safeDBName := safeName(dbName)
 	userQuery, _ := strings.CutSuffix(inputProps.SQL, ";") // trim trailing semi colon
+	cleanup := func() {}
 	switch inputHandle.Driver() {
 	case "mysql":
 		dsn := inputProps.resolveDSN()
@@ -96,16 +109,16 @@ func (e *sqlStoreToSelfExecutor) modelInputProperties(modelName, inputConnector
 			// may be configured via a connector
 			var config *rillmysql.ConfigProperties
 			if err := mapstructure.WeakDecode(inputHandle.Config(), &config); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			var err error
 			dsn, err = config.ResolveDSN()
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 		if dsn == "" {
-			return nil, fmt.Errorf("must set `dsn` for models that transfer data from `mysql` to `duckdb`")
+			return nil, nil, fmt.Errorf("must set `dsn` for models that transfer data from `mysql` to `duckdb`")
 		}
 		m.PreExec = fmt.Sprintf("INSTALL 'MYSQL'; LOAD 'MYSQL'; ATTACH %s AS %s (TYPE mysql, READ_ONLY)", safeSQLString(dsn), safeDBName)
 		m.SQL = fmt.Sprintf("SELECT * FROM mysql_query(%s, %s)", safeSQLString(dbName), safeSQLString(userQuery))
@@ -115,22 +128,194 @@ func (e *sqlStoreToSelfExecutor) modelInputProperties(modelName, inputConnector
 			// may be configured via a connector
 			var config *postgres.ConfigProperties
 			if err := mapstructure.WeakDecode(inputHandle.Config(), &config); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			dsn = config.ResolveDSN()
 		}
 		if dsn == "" {
-			return nil, fmt.Errorf("must set `database_url` or `dsn` for models that transfer data from `postgres` to `duckdb`")
+			return nil, nil, fmt.Errorf("must set `database_url` or `dsn` for models that transfer data from `postgres` to `duckdb`")
 		}
 		m.PreExec = fmt.Sprintf("INSTALL 'POSTGRES'; LOAD 'POSTGRES'; ATTACH %s AS %s (TYPE postgres, READ_ONLY)", safeSQLString(dsn), safeDBName)
 		m.SQL = fmt.Sprintf("SELECT * FROM postgres_query(%s, %s)", safeSQLString(dbName), safeSQLString(userQuery))
+	case "trino":
+		// DuckDB has no extension for Trino, so the query is run by the connector and the results are staged in a file.
+		if inputProps.resolveDSN() != "" {
+			return nil, nil, fmt.Errorf("`dsn` is not supported for models that transfer data from `trino` to `duckdb`, configure it on the connector instead")
+		}
+		olap, ok := inputHandle.AsOLAP(e.instanceID)
+		if !ok {
+			return nil, nil, fmt.Errorf("input handle %q is not an OLAP store", opts.InputConnector)
+		}
+		path, from, err := e.stageOLAPResults(ctx, olap, userQuery, opts.Priority)
+		if err != nil {
+			return nil, nil, err
+		}
+		cleanup = func() { _ = os.Remove(path) }
+		m.SQL = "SELECT * FROM " + from
+		propsMap := make(map[string]any)
+		if err := mapstructure.Decode(m, &propsMap); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		return propsMap, cleanup, nil
 	default:
-		return nil, fmt.Errorf("internal error: unsupported external database: %s", inputHandle.Driver())
+		return nil, nil, fmt.Errorf("internal error: unsupported external database: %s", inputHandle.Driver())
 	}
 	m.PostExec = fmt.Sprintf("DETACH %s", safeDBName)
 	propsMap := make(map[string]any)
 	if err := mapstructure.Decode(m, &propsMap); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	return propsMap, cleanup, nil
+}
+
+// stageOLAPResults runs a query on an OLAP connector that DuckDB can't attach to and writes the results to a newline-delimited JSON file.
+// It returns the path of the file and a DuckDB table function that reads it with the column types of the result.
+func (e *sqlStoreToSelfExecutor) stageOLAPResults(ctx context.Context, olap drivers.OLAPStore, query string, priority int) (string, string, error) {
+	res, err := olap.Query(ctx, &drivers.Statement{
+		Query:    query,
+		Priority: priority,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	defer res.Close()
+
+	tempDir, err := e.c.storage.TempDir()
+	if err != nil {
+		return "", "", err
+	}
+	f, err := os.CreateTemp(tempDir, "sqlstore-*.jsonl")
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	err = writeStagedRows(res, f)
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", "", err
+	}
+
+	columns := make([]string, len(res.Schema.Fields))
+	for i, field := range res.Schema.Fields {
+		columns[i] = fmt.Sprintf("%s: %s", safeSQLString(field.Name), safeSQLString(stagedColumnType(field.Type)))
+	}
+	from := fmt.Sprintf("read_json(%s, format = 'newline_delimited', columns = {%s})", safeSQLString(f.Name()), strings.Join(columns, ", "))
+	return f.Name(), from, nil
+}
+
+// writeStagedRows writes the rows of res as JSON objects that DuckDB casts to the types returned by stagedColumnType.
+func writeStagedRows(res *drivers.Result, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	row := make(map[string]any, len(res.Schema.Fields))
+	vals := make([]any, len(res.Schema.Fields))
+	ptrs := make([]any, len(res.Schema.Fields))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+
+	enc := json.NewEncoder(bw)
+	for res.Next() {
+		if err := res.Scan(ptrs...); err != nil {
+			return err
+		}
+		for i, field := range res.Schema.Fields {
+			row[field.Name] = stagedValue(vals[i], field.Type)
+		}
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// stagedValue converts a value scanned from an OLAP result to a JSON value that DuckDB can cast to the column's type without losing precision.
+func stagedValue(v any, t *runtimev1.Type) any {
+	switch v := v.(type) {
+	case float64:
+		// JSON has no representation of non-finite numbers, but DuckDB casts these strings to DOUBLE.
+		switch {
+		case math.IsNaN(v):
+			return "nan"
+		case math.IsInf(v, 1):
+			return "inf"
+		case math.IsInf(v, -1):
+			return "-inf"
+		}
+	case time.Time:
+		switch {
+		case t.Code == runtimev1.Type_CODE_DATE:
+			return v.Format(time.DateOnly)
+		case stagedColumnType(t) == "TIMESTAMP":
+			return v.Format("2006-01-02 15:04:05.999999")
+		}
+		return v.Format(time.RFC3339Nano)
+	case string:
+		// Nested values are returned as JSON text, which is written as is so DuckDB doesn't read it as a JSON string.
+		if t.Code == runtimev1.Type_CODE_JSON && json.Valid([]byte(v)) {
+			return json.RawMessage(v)
+		}
+	case []byte:
+		// DuckDB casts strings with \x escapes to BLOB.
+		var sb strings.Builder
+		for _, b := range v {
+			fmt.Fprintf(&sb, "\\x%02X", b)
+		}
+		return sb.String()
+	case []any:
+		if t.ArrayElementType != nil {
+			for i, e := range v {
+				v[i] = stagedValue(e, t.ArrayElementType)
+			}
+		}
+	}
+	return v
+}
+
+// stagedColumnType returns the DuckDB type of a staged column.
+func stagedColumnType(t *runtimev1.Type) string {
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		return "BOOLEAN"
+	case runtimev1.Type_CODE_INT8:
+		return "TINYINT"
+	case runtimev1.Type_CODE_INT16:
+		return "SMALLINT"
+	case runtimev1.Type_CODE_INT32:
+		return "INTEGER"
+	case runtimev1.Type_CODE_INT64:
+		return "BIGINT"
+	case runtimev1.Type_CODE_FLOAT32:
+		return "FLOAT"
+	case runtimev1.Type_CODE_FLOAT64:
+		return "DOUBLE"
+	case runtimev1.Type_CODE_DECIMAL:
+		// Keep the precision and scale of the source type, such as "decimal(10,2)"
+		if raw := strings.ToUpper(t.RawType); strings.HasPrefix(raw, "DECIMAL(") {
+			return raw
+		}
+		return "DOUBLE"
+	case runtimev1.Type_CODE_DATE:
+		return "DATE"
+	case runtimev1.Type_CODE_TIMESTAMP:
+		if strings.Contains(strings.ToLower(t.RawType), "with time zone") {
+			return "TIMESTAMPTZ"
+		}
+		return "TIMESTAMP"
+	case runtimev1.Type_CODE_BYTES:
+		return "BLOB"
+	case runtimev1.Type_CODE_JSON:
+		return "JSON"
+	case runtimev1.Type_CODE_ARRAY:
+		if t.ArrayElementType != nil {
+			return stagedColumnType(t.ArrayElementType) + "[]"
+		}
+		return "JSON"
+	default:
+		return "VARCHAR"
 	}
-	return propsMap, nil
 }
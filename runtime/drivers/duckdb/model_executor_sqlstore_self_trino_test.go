@@ -0,0 +1,95 @@
+package duckdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/rilldata/rill/runtime/testruntime/testmode"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	_ "github.com/rilldata/rill/runtime/drivers/trino"
+)
+
+func TestTrinoToDuckDB(t *testing.T) {
+	testmode.Expensive(t)
+
+	cfg := testruntime.AcquireConnector(t, "trino")
+	inputHandle, err := drivers.Open("trino", "trino", "default", cfg, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer inputHandle.Close()
+
+	duckDB, err := drivers.Open("duckdb", "duckdb", "default", map[string]any{"data_dir": t.TempDir()}, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer duckDB.Close()
+
+	opts := &drivers.ModelExecutorOptions{
+		InputHandle:     inputHandle,
+		InputConnector:  "trino",
+		OutputHandle:    duckDB,
+		OutputConnector: "duckdb",
+		Env: &drivers.ModelEnv{
+			AllowHostAccess: false,
+			StageChanges:    true,
+		},
+		PreliminaryInputProperties: map[string]any{
+			"sql": `SELECT * FROM (VALUES
+				(1, CAST(12.34 AS DECIMAL(10,2)), nan(), DATE '2024-01-02', TIMESTAMP '2024-01-02 03:04:05.678 UTC', ARRAY[1, 2], JSON '{"a": 1}', X'cafe'),
+				(2, NULL, NULL, NULL, NULL, NULL, NULL, NULL)
+			) AS t(id, amount, ratio, day, ts, nums, payload, raw)`,
+		},
+		PreliminaryOutputProperties: map[string]any{
+			"table": "sink",
+		},
+	}
+
+	me, err := duckDB.AsModelExecutor("default", opts)
+	require.NoError(t, err)
+
+	_, err = me.Execute(context.Background(), &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: opts,
+		InputProperties:      opts.PreliminaryInputProperties,
+		OutputProperties:     opts.PreliminaryOutputProperties,
+	})
+	require.NoError(t, err)
+
+	olap, ok := duckDB.AsOLAP("default")
+	require.True(t, ok)
+
+	res, err := olap.Query(context.Background(), &drivers.Statement{
+		Query: "SELECT CAST(amount AS VARCHAR), isnan(ratio), day, ts, nums, payload->>'a', raw FROM sink WHERE id = 1",
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	require.True(t, res.Next())
+	var amount, payload string
+	var ratioNaN bool
+	var day, ts time.Time
+	var nums []any
+	var raw []byte
+	require.NoError(t, res.Scan(&amount, &ratioNaN, &day, &ts, &nums, &payload, &raw))
+	require.Equal(t, "12.34", amount)
+	require.True(t, ratioNaN)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), day.UTC())
+	require.True(t, ts.Equal(time.Date(2024, 1, 2, 3, 4, 5, 678000000, time.UTC)))
+	require.Equal(t, []any{int32(1), int32(2)}, nums)
+	require.Equal(t, "1", payload)
+	require.Equal(t, []byte{0xca, 0xfe}, raw)
+	require.False(t, res.Next())
+	require.NoError(t, res.Err())
+
+	var count int
+	res2, err := olap.Query(context.Background(), &drivers.Statement{Query: "SELECT count(*) FROM sink"})
+	require.NoError(t, err)
+	defer res2.Close()
+	require.True(t, res2.Next())
+	require.NoError(t, res2.Scan(&count))
+	require.Equal(t, 2, count)
+}
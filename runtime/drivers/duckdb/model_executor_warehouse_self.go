@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/mitchellh/mapstructure"
@@ -46,7 +47,12 @@ func (e *warehouseToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		files = append(files, batch...)
 	}
 	if len(files) == 0 {
-		return nil, drivers.ErrNoRows
+		// Incremental runs of streaming warehouses (like Kafka) may not have any new data.
+		// In that case, we run an empty append against the existing table to preserve it and update the incremental state.
+		if !opts.IncrementalRun {
+			return nil, drivers.ErrNoRows
+		}
+		return e.executeEmptyIncrementalRun(ctx, opts)
 	}
 
 	format := fileutil.FullExt(files[0])
@@ -69,3 +75,24 @@ func (e *warehouseToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 	executor := &selfToSelfExecutor{c: e.c}
 	return executor.Execute(ctx, opts)
 }
+
+func (e *warehouseToSelfExecutor) executeEmptyIncrementalRun(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	tableName := outputProps.Table
+	if tableName == "" {
+		tableName = opts.ModelName
+	}
+
+	m := &ModelInputProperties{SQL: fmt.Sprintf("SELECT * FROM %s LIMIT 0", safeSQLName(tableName))}
+	propsMap := make(map[string]any)
+	if err := mapstructure.Decode(m, &propsMap); err != nil {
+		return nil, err
+	}
+	opts.InputProperties = propsMap
+
+	executor := &selfToSelfExecutor{c: e.c}
+	return executor.Execute(ctx, opts)
+}
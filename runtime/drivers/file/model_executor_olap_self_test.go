@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/apache/arrow-go/v18/arrow/ipc"
 	"github.com/rilldata/rill/runtime/drivers"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	"github.com/rilldata/rill/runtime/pkg/activity"
@@ -245,6 +246,73 @@ func TestExecute(t *testing.T) {
 		require.NoError(t, compareResult.Close())
 	})
 
+	t.Run("test_jsonl_export", func(t *testing.T) {
+		outPath := filepath.Join(tempDir, "out.jsonl")
+		execOpts := &drivers.ModelExecuteOptions{
+			ModelExecutorOptions: opts,
+			InputProperties: map[string]any{
+				"sql": "SELECT * FROM all_types",
+			},
+			OutputProperties: map[string]any{
+				"path":   outPath,
+				"format": "jsonl",
+			},
+		}
+
+		result, err := me.Execute(context.Background(), execOpts)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		// Read back and verify the row count and a few values
+		res, err := olap.Query(context.Background(), &drivers.Statement{
+			Query: fmt.Sprintf(`SELECT COUNT(*), COUNT(varchar_val), MAX(varchar_val) FROM read_json('%s', format='newline_delimited')`, outPath),
+		})
+		require.NoError(t, err)
+		var count, nonNull int
+		var varchar string
+		require.True(t, res.Next())
+		require.NoError(t, res.Scan(&count, &nonNull, &varchar))
+		require.Equal(t, 2, count)
+		require.Equal(t, 1, nonNull)
+		require.Equal(t, "Hello", varchar)
+		require.NoError(t, res.Close())
+	})
+
+	t.Run("test_arrow_export", func(t *testing.T) {
+		outPath := filepath.Join(tempDir, "out.arrow")
+		execOpts := &drivers.ModelExecuteOptions{
+			ModelExecutorOptions: opts,
+			InputProperties: map[string]any{
+				"sql": "SELECT * FROM all_types",
+			},
+			OutputProperties: map[string]any{
+				"path":   outPath,
+				"format": "arrow",
+			},
+		}
+
+		result, err := me.Execute(context.Background(), execOpts)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		// Read back the IPC stream and verify the schema and row count
+		f, err := os.Open(outPath)
+		require.NoError(t, err)
+		defer f.Close()
+
+		r, err := ipc.NewReader(f)
+		require.NoError(t, err)
+		defer r.Release()
+		require.Equal(t, 24, r.Schema().NumFields())
+
+		var rows int64
+		for r.Next() {
+			rows += r.RecordBatch().NumRows()
+		}
+		require.NoError(t, r.Err())
+		require.Equal(t, int64(2), rows)
+	})
+
 	t.Run("test_invalid_format", func(t *testing.T) {
 		outPath := filepath.Join(tempDir, "out.txt")
 		execOpts := &drivers.ModelExecuteOptions{
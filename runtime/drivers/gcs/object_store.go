@@ -3,6 +3,7 @@ package gcs
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"cloud.google.com/go/storage"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -114,6 +115,22 @@ func (c *Connection) DownloadFiles(ctx context.Context, path string) (drivers.Fi
 	})
 }
 
+// Upload implements drivers.ObjectStore.
+func (c *Connection) Upload(ctx context.Context, path string, r io.Reader) error {
+	url, err := c.parseBucketURL(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path %q: %w", path, err)
+	}
+
+	bucket, err := c.openBucket(ctx, url.Host)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return bucket.Upload(ctx, url.Path, r)
+}
+
 func (c *Connection) parseBucketURL(path string) (*globutil.URL, error) {
 	url, err := globutil.ParseBucketURL(path)
 	if err != nil {
@@ -0,0 +1,215 @@
+package drivers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"github.com/rilldata/rill/runtime/pkg/iceberg"
+	"github.com/rilldata/rill/runtime/pkg/pagination"
+)
+
+// icebergSnapshotStateKey is the key of the snapshot ID in the incremental state of models that read Iceberg tables.
+const icebergSnapshotStateKey = "snapshot_id"
+
+// IcebergInputProperties contain input properties for reading an Apache Iceberg table from an object store.
+// The table is either read from the table location in `path` or looked up in a REST catalog.
+type IcebergInputProperties struct {
+	// SnapshotID optionally pins the snapshot to read (time travel). If not set, the table's current snapshot is read.
+	// It is a string because snapshot IDs are 64-bit integers that can't be represented exactly as floats.
+	SnapshotID string `mapstructure:"snapshot_id"`
+	// CatalogURI is the URI of an Iceberg REST catalog.
+	CatalogURI string `mapstructure:"catalog_uri"`
+	// CatalogWarehouse is the warehouse to use in the REST catalog.
+	CatalogWarehouse string `mapstructure:"catalog_warehouse"`
+	// CatalogToken is a bearer token for authenticating with the REST catalog.
+	CatalogToken string `mapstructure:"catalog_token"`
+	// Table is the namespace-qualified name of the table in the REST catalog, such as "db.events".
+	Table string `mapstructure:"table"`
+}
+
+func (p *IcebergInputProperties) validate(hasPath bool) error {
+	if p.CatalogURI != "" {
+		if hasPath {
+			return fmt.Errorf("cannot specify both `path` and `iceberg.catalog_uri`")
+		}
+		if p.Table == "" {
+			return fmt.Errorf("missing property `iceberg.table` for the Iceberg REST catalog")
+		}
+	} else if p.Table != "" {
+		return fmt.Errorf("property `iceberg.table` requires `iceberg.catalog_uri`")
+	}
+	if p.SnapshotID != "" {
+		if _, err := strconv.ParseInt(p.SnapshotID, 10, 64); err != nil {
+			return fmt.Errorf("invalid `iceberg.snapshot_id` %q: must be an integer (quote it in YAML to avoid loss of precision)", p.SnapshotID)
+		}
+	}
+	return nil
+}
+
+// IcebergTable is a resolved snapshot of an Iceberg table.
+type IcebergTable struct {
+	// Location is the table's base location.
+	Location string
+	// MetadataPath is the path of the table's metadata file.
+	MetadataPath string
+	// SnapshotID is the ID of the snapshot to read.
+	SnapshotID int64
+	// PreviousSnapshotID is the ID of the snapshot that was read in the previous execution of an incremental model.
+	// It is zero if the model has not read the table before or if the table must be read in full (see FullRefresh).
+	PreviousSnapshotID int64
+	// FullRefresh is true if the table changed since the previous execution of an incremental model in a way that can't be ingested incrementally,
+	// such as rows being deleted or the schema changing. The table must then be read in full and replace the model's data.
+	FullRefresh bool
+}
+
+// Unchanged returns true if the snapshot is the same as the one read in the previous incremental execution.
+func (t *IcebergTable) Unchanged() bool {
+	return t.PreviousSnapshotID != 0 && t.PreviousSnapshotID == t.SnapshotID
+}
+
+// IncrementalState returns the incremental state to persist for models that have read the table.
+func (t *IcebergTable) IncrementalState() map[string]any {
+	return map[string]any{icebergSnapshotStateKey: strconv.FormatInt(t.SnapshotID, 10)}
+}
+
+// ResolveIcebergTable resolves the metadata file and snapshot of the Iceberg table configured in the input properties.
+// The store is used to read the table's metadata when it is not looked up in a REST catalog.
+// For incremental runs, it also resolves the snapshot that was read in the previous execution from the model's incremental state,
+// and whether only rows were appended since then (see IcebergTable.FullRefresh).
+func ResolveIcebergTable(ctx context.Context, opts *ModelExecuteOptions, store ObjectStore, props *ObjectStoreModelInputProperties) (*IcebergTable, error) {
+	if props.Iceberg == nil {
+		return nil, fmt.Errorf("internal error: not an Iceberg table")
+	}
+
+	var md *iceberg.Metadata
+	var metadataPath string
+	if props.Iceberg.CatalogURI != "" {
+		catalog := iceberg.NewRESTCatalog(props.Iceberg.CatalogURI, props.Iceberg.CatalogWarehouse, props.Iceberg.CatalogToken)
+		res, err := catalog.LoadTable(ctx, props.Iceberg.Table)
+		if err != nil {
+			return nil, err
+		}
+		md = res.Metadata
+		metadataPath = res.MetadataLocation
+	} else {
+		var err error
+		metadataPath, err = latestIcebergMetadataPath(ctx, store, props.Path)
+		if err != nil {
+			return nil, err
+		}
+		data, err := readObject(ctx, store, metadataPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Iceberg metadata file %q: %w", metadataPath, err)
+		}
+		md, err = iceberg.ParseMetadata(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t := &IcebergTable{
+		Location:     md.Location,
+		MetadataPath: metadataPath,
+	}
+
+	if props.Iceberg.SnapshotID != "" {
+		id, err := strconv.ParseInt(props.Iceberg.SnapshotID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Iceberg snapshot ID %q: %w", props.Iceberg.SnapshotID, err)
+		}
+		s, err := md.Snapshot(id)
+		if err != nil {
+			return nil, err
+		}
+		t.SnapshotID = s.SnapshotID
+	} else {
+		s, err := md.CurrentSnapshot()
+		if err != nil {
+			if errors.Is(err, iceberg.ErrNoSnapshots) {
+				return nil, ErrNoRows
+			}
+			return nil, err
+		}
+		t.SnapshotID = s.SnapshotID
+	}
+
+	if opts.IncrementalRun && opts.IncrementalState != nil {
+		if v, ok := opts.IncrementalState[icebergSnapshotStateKey].(string); ok && v != "" {
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Iceberg snapshot ID %q in incremental state: %w", v, err)
+			}
+			if id == t.SnapshotID || md.AppendedSince(id, t.SnapshotID) {
+				t.PreviousSnapshotID = id
+			} else if opts.PartitionRun {
+				// A full refresh would replace the data of all partitions.
+				return nil, fmt.Errorf("snapshot %d of the Iceberg table can't be ingested incrementally because rows were deleted or overwritten, the schema changed, or the previously ingested snapshot expired; run a full refresh", t.SnapshotID)
+			} else {
+				t.FullRefresh = true
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// latestIcebergMetadataPath finds the latest metadata file of the Iceberg table at the given location.
+func latestIcebergMetadataPath(ctx context.Context, store ObjectStore, location string) (string, error) {
+	if store == nil {
+		return "", fmt.Errorf("reading Iceberg tables by location requires an object store connector")
+	}
+
+	u, err := globutil.ParseBucketURL(iceberg.MetadataGlob(location))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse Iceberg table location %q: %w", location, err)
+	}
+
+	entries, err := pagination.CollectAll(ctx,
+		func(ctx context.Context, pz uint32, tk string) ([]ObjectStoreEntry, string, error) {
+			return store.ListObjectsForGlob(ctx, u.Host, u.Path, pz, tk, "", "")
+		},
+		1000)
+	if err != nil {
+		return "", fmt.Errorf("failed to list Iceberg metadata files: %w", err)
+	}
+
+	paths := make([]string, 0, len(entries))
+	for _, e := range entries {
+		paths = append(paths, (&globutil.URL{Scheme: u.Scheme, Host: u.Host, Path: e.Path}).String())
+	}
+
+	p := iceberg.LatestMetadataPath(paths)
+	if p == "" {
+		return "", fmt.Errorf("no Iceberg metadata files found at %q", location)
+	}
+	return p, nil
+}
+
+// errObjectNotFound is returned by readObject if the object doesn't exist.
+var errObjectNotFound = errors.New("object not found")
+
+// readObject downloads and reads a single object from an object store.
+func readObject(ctx context.Context, store ObjectStore, path string) ([]byte, error) {
+	iter, err := store.DownloadFiles(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	files, err := iter.Next(ctx)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errObjectNotFound
+		}
+		return nil, err
+	}
+	if len(files) != 1 {
+		return nil, fmt.Errorf("expected a single object, found %d", len(files))
+	}
+	return os.ReadFile(files[0])
+}
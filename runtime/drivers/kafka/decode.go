@@ -0,0 +1,155 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/hamba/avro/v2"
+)
+
+// decoder decodes message payloads into rows.
+// Payloads that decode to an object are expanded into columns. Other payloads are put in a "value" column.
+type decoder interface {
+	decode(payload []byte) (map[string]any, error)
+}
+
+// newDecoder returns a decoder for the given payload format.
+func newDecoder(c *configProperties, props *sourceProperties) (decoder, error) {
+	switch props.Format {
+	case "", "json":
+		return jsonDecoder{}, nil
+	case "avro":
+		if props.AvroSchema != "" {
+			schema, err := avro.Parse(props.AvroSchema)
+			if err != nil {
+				return nil, fmt.Errorf("invalid avro_schema: %w", err)
+			}
+			return &avroDecoder{schema: schema}, nil
+		}
+		if c.SchemaRegistryURL == "" {
+			return nil, errors.New(`decoding Avro messages requires either "avro_schema" on the model or "schema_registry_url" on the connector`)
+		}
+		cfg := schemaregistry.NewConfig(c.SchemaRegistryURL)
+		if c.SchemaRegistryUsername != "" || c.SchemaRegistryPassword != "" {
+			cfg.BasicAuthCredentialsSource = "USER_INFO"
+			cfg.BasicAuthUserInfo = c.SchemaRegistryUsername + ":" + c.SchemaRegistryPassword
+		}
+		client, err := schemaregistry.NewClient(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema registry client: %w", err)
+		}
+		return &avroDecoder{registry: client, schemas: make(map[int]avro.Schema)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q, must be one of 'json' or 'avro'", props.Format)
+	}
+}
+
+type jsonDecoder struct{}
+
+func (jsonDecoder) decode(payload []byte) (map[string]any, error) {
+	if len(payload) == 0 {
+		return map[string]any{}, nil
+	}
+
+	// Use json.Number to avoid losing precision on large integers.
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %w", err)
+	}
+	return asRow(v), nil
+}
+
+// avroDecoder decodes Avro payloads.
+// If a schema registry is configured, payloads are expected in the Confluent wire format (a magic byte and a 4-byte schema ID followed by the Avro data).
+// Otherwise, payloads are decoded as plain Avro data using the fixed schema.
+type avroDecoder struct {
+	schema   avro.Schema
+	registry schemaregistry.Client
+
+	mu      sync.Mutex
+	schemas map[int]avro.Schema
+}
+
+func (d *avroDecoder) decode(payload []byte) (map[string]any, error) {
+	if len(payload) == 0 {
+		return map[string]any{}, nil
+	}
+
+	schema := d.schema
+	if d.registry != nil {
+		if len(payload) < 5 || payload[0] != 0 {
+			return nil, errors.New("payload is not in the schema registry wire format")
+		}
+		id := int(binary.BigEndian.Uint32(payload[1:5]))
+		var err error
+		schema, err = d.registrySchema(id)
+		if err != nil {
+			return nil, err
+		}
+		payload = payload[5:]
+	}
+
+	var v any
+	if err := avro.Unmarshal(schema, payload, &v); err != nil {
+		return nil, fmt.Errorf("invalid Avro payload: %w", err)
+	}
+	return asRow(normalizeAvroValue(v)), nil
+}
+
+func (d *avroDecoder) registrySchema(id int) (avro.Schema, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if s, ok := d.schemas[id]; ok {
+		return s, nil
+	}
+
+	info, err := d.registry.GetBySubjectAndID("", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema %d from schema registry: %w", id, err)
+	}
+	s, err := avro.Parse(info.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema %d in schema registry: %w", id, err)
+	}
+	d.schemas[id] = s
+	return s, nil
+}
+
+// normalizeAvroValue converts decoded Avro values that don't serialize naturally to JSON.
+func normalizeAvroValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, x := range v {
+			v[k] = normalizeAvroValue(x)
+		}
+		return v
+	case []any:
+		for i, x := range v {
+			v[i] = normalizeAvroValue(x)
+		}
+		return v
+	case *big.Rat:
+		f, _ := v.Float64()
+		return f
+	case []byte:
+		return string(v)
+	default:
+		return v
+	}
+}
+
+func asRow(v any) map[string]any {
+	if m, ok := v.(map[string]any); ok {
+		return m
+	}
+	return map[string]any{"value": v}
+}
@@ -0,0 +1,276 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("kafka", driver{})
+	drivers.RegisterAsConnector("kafka", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName: "Kafka",
+	Description: "Ingest data from Apache Kafka or Kafka-compatible topics.",
+	DocsURL:     "https://docs.rilldata.com/developers/build/connectors/data-source/kafka",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "brokers",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Brokers",
+			Description: "Comma-separated list of bootstrap brokers",
+			Placeholder: "localhost:9092",
+			Required:    true,
+		},
+		{
+			Key:         "security_protocol",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Security protocol",
+			Description: "Protocol used to communicate with brokers",
+			Placeholder: "SASL_SSL",
+			Hint:        "One of PLAINTEXT, SSL, SASL_PLAINTEXT or SASL_SSL. Defaults to PLAINTEXT.",
+		},
+		{
+			Key:         "sasl_mechanism",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL mechanism",
+			Description: "SASL mechanism to use for authentication",
+			Placeholder: "PLAIN",
+			Hint:        "One of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.",
+		},
+		{
+			Key:         "sasl_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL username",
+			Description: "SASL username",
+		},
+		{
+			Key:         "sasl_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL password",
+			Description: "SASL password",
+			Secret:      true,
+		},
+		{
+			Key:         "schema_registry_url",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry URL",
+			Description: "URL of a Confluent-compatible schema registry used to decode Avro messages",
+			Placeholder: "http://localhost:8081",
+		},
+		{
+			Key:         "schema_registry_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry username",
+			Description: "Username for the schema registry",
+		},
+		{
+			Key:         "schema_registry_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry password",
+			Description: "Password for the schema registry",
+			Secret:      true,
+		},
+	},
+	ImplementsWarehouse: true,
+}
+
+type driver struct{}
+
+type configProperties struct {
+	Brokers                string `mapstructure:"brokers"`
+	SecurityProtocol       string `mapstructure:"security_protocol"`
+	SASLMechanism          string `mapstructure:"sasl_mechanism"`
+	SASLUsername           string `mapstructure:"sasl_username"`
+	SASLPassword           string `mapstructure:"sasl_password"`
+	SchemaRegistryURL      string `mapstructure:"schema_registry_url"`
+	SchemaRegistryUsername string `mapstructure:"schema_registry_username"`
+	SchemaRegistryPassword string `mapstructure:"schema_registry_password"`
+}
+
+// consumerConfig returns the librdkafka configuration for a consumer connected to the configured brokers.
+func (c *configProperties) consumerConfig() *kafka.ConfigMap {
+	cfg := &kafka.ConfigMap{
+		"bootstrap.servers": c.Brokers,
+		// Offsets are tracked in the model's incremental state, so a group ID is only needed to satisfy librdkafka.
+		"group.id":             "rill",
+		"enable.auto.commit":   false,
+		"enable.partition.eof": true,
+	}
+	if c.SecurityProtocol != "" {
+		_ = cfg.SetKey("security.protocol", c.SecurityProtocol)
+	}
+	if c.SASLMechanism != "" {
+		_ = cfg.SetKey("sasl.mechanism", c.SASLMechanism)
+	}
+	if c.SASLUsername != "" {
+		_ = cfg.SetKey("sasl.username", c.SASLUsername)
+	}
+	if c.SASLPassword != "" {
+		_ = cfg.SetKey("sasl.password", c.SASLPassword)
+	}
+	return cfg
+}
+
+func (d driver) Open(_, instanceID string, config map[string]any, st *storage.Client, ac *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, errors.New("kafka driver can't be shared")
+	}
+
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Brokers == "" {
+		return nil, errors.New("kafka: the 'brokers' property is required")
+	}
+
+	conn := &Connection{
+		config:  conf,
+		storage: st,
+		logger:  logger,
+	}
+	return conn, nil
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type Connection struct {
+	config  *configProperties
+	storage *storage.Client
+	logger  *zap.Logger
+}
+
+var _ drivers.Handle = &Connection{}
+
+// Ping implements drivers.Handle.
+func (c *Connection) Ping(ctx context.Context) error {
+	consumer, err := kafka.NewConsumer(c.config.consumerConfig())
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	timeout := 10 * time.Second
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	_, err = consumer.GetMetadata(nil, false, int(timeout.Milliseconds()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka: %w", err)
+	}
+	return nil
+}
+
+// Driver implements drivers.Handle.
+func (c *Connection) Driver() string {
+	return "kafka"
+}
+
+// Config implements drivers.Handle.
+func (c *Connection) Config() map[string]any {
+	m := make(map[string]any, 0)
+	_ = mapstructure.Decode(c.config, &m)
+	return m
+}
+
+// Close implements drivers.Handle.
+func (c *Connection) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Handle.
+func (c *Connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Handle.
+func (c *Connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Handle.
+func (c *Connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *Connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *Connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Handle.
+func (c *Connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsInformationSchema implements drivers.Handle.
+func (c *Connection) AsInformationSchema() (drivers.InformationSchema, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Handle.
+func (c *Connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Handle.
+func (c *Connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Handle.
+func (c *Connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (c *Connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+// AsModelManager implements drivers.Handle.
+func (c *Connection) AsModelManager(instanceID string) (drivers.ModelManager, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+// AsFileStore implements drivers.Handle.
+func (c *Connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (c *Connection) AsWarehouse() (drivers.Warehouse, bool) {
+	return c, true
+}
+
+// AsNotifier implements drivers.Handle.
+func (c *Connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
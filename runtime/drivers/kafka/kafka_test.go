@@ -0,0 +1,89 @@
+package kafka_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/rilldata/rill/runtime/testruntime/testmode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalIngestion(t *testing.T) {
+	testmode.Expensive(t)
+
+	cfg := testruntime.AcquireConnector(t, "kafka")
+	brokers := cfg["brokers"].(string)
+
+	produce(t, brokers, "events", 0, 100)
+
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Variables: map[string]string{"kafka_brokers": brokers},
+		Files: map[string]string{
+			"rill.yaml": ``,
+			"connectors/kafka.yaml": `
+type: connector
+driver: kafka
+brokers: "{{ .env.kafka_brokers }}"
+`,
+			"models/events.yaml": `
+type: model
+connector: kafka
+incremental: true
+topic: events
+start_offsets: '{{ if incremental }}{{ .state.offsets }}{{ end }}'
+state:
+  sql: >
+    SELECT json_group_object(_kafka_partition::VARCHAR, max_offset + 1)::VARCHAR AS offsets
+    FROM (SELECT _kafka_partition, max(_kafka_offset) AS max_offset FROM events GROUP BY 1)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, instanceID, "events", 100)
+	testruntime.RequireResolve(t, rt, instanceID, &testruntime.RequireResolveOptions{
+		Resolver:   "sql",
+		Properties: map[string]any{"sql": `SELECT min(id) AS min, max(id) AS max, count(DISTINCT _kafka_offset) AS offsets FROM events`},
+		Result:     []map[string]any{{"min": 0, "max": 99, "offsets": 100}},
+	})
+
+	// An incremental refresh without new messages should keep the existing data.
+	testruntime.RefreshAndWait(t, rt, instanceID, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "events"})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, instanceID, "events", 100)
+
+	// An incremental refresh should only ingest the new messages.
+	produce(t, brokers, "events", 100, 50)
+	testruntime.RefreshAndWait(t, rt, instanceID, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "events"})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, instanceID, "events", 150)
+	testruntime.RequireResolve(t, rt, instanceID, &testruntime.RequireResolveOptions{
+		Resolver:   "sql",
+		Properties: map[string]any{"sql": `SELECT count(DISTINCT id) AS ids FROM events`},
+		Result:     []map[string]any{{"ids": 150}},
+	})
+}
+
+func produce(t *testing.T, brokers, topic string, start, n int) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	defer p.Close()
+
+	for i := start; i < start+n; i++ {
+		v, err := json.Marshal(map[string]any{"id": i, "name": fmt.Sprintf("event_%d", i)})
+		require.NoError(t, err)
+		err = p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Key:            []byte(fmt.Sprint(i)),
+			Value:          v,
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	remaining := p.Flush(30000)
+	require.Zero(t, remaining)
+}
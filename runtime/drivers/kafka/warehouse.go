@@ -0,0 +1,354 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+var tracer = otel.Tracer("github.com/rilldata/rill/runtime/drivers/kafka")
+
+// metadataTimeout is the timeout for fetching topic metadata and watermarks from the brokers.
+const metadataTimeout = 30 * time.Second
+
+type sourceProperties struct {
+	Topic      string `mapstructure:"topic"`
+	Format     string `mapstructure:"format"`
+	AvroSchema string `mapstructure:"avro_schema"`
+	// StartOffsets maps partition IDs to the next offset to consume.
+	// It can be either a map or a JSON-encoded object, which allows it to be templated from the model's incremental state.
+	StartOffsets any    `mapstructure:"start_offsets"`
+	StartFrom    string `mapstructure:"start_from"`
+	MaxMessages  int64  `mapstructure:"max_messages"`
+	BatchSize    int    `mapstructure:"batch_size"`
+	PollTimeout  string `mapstructure:"poll_timeout"`
+
+	startOffsets map[int32]int64
+	pollTimeout  time.Duration
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Topic == "" {
+		return nil, errors.New("property 'topic' is mandatory for connector \"kafka\"")
+	}
+	switch conf.StartFrom {
+	case "":
+		conf.StartFrom = "earliest"
+	case "earliest", "latest":
+	default:
+		return nil, fmt.Errorf("invalid start_from %q, must be one of 'earliest' or 'latest'", conf.StartFrom)
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = 10000
+	}
+
+	conf.pollTimeout = 30 * time.Second
+	if conf.PollTimeout != "" {
+		conf.pollTimeout, err = time.ParseDuration(conf.PollTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid poll_timeout: %w", err)
+		}
+	}
+
+	conf.startOffsets, err = parseStartOffsets(conf.StartOffsets)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// parseStartOffsets parses the start_offsets property into a map of partition IDs to offsets.
+func parseStartOffsets(v any) (map[int32]int64, error) {
+	var raw map[string]any
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v), &raw); err != nil {
+			return nil, fmt.Errorf("invalid start_offsets: must be a JSON object of partitions to offsets: %w", err)
+		}
+	default:
+		if err := mapstructure.Decode(v, &raw); err != nil {
+			return nil, fmt.Errorf("invalid start_offsets: %w", err)
+		}
+	}
+
+	res := make(map[int32]int64, len(raw))
+	for k, v := range raw {
+		p, err := strconv.ParseInt(k, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_offsets: invalid partition %q", k)
+		}
+		var off int64
+		if err := mapstructure.WeakDecode(v, &off); err != nil {
+			return nil, fmt.Errorf("invalid start_offsets: invalid offset for partition %q: %w", k, err)
+		}
+		res[int32(p)] = off
+	}
+	return res, nil
+}
+
+// QueryAsFiles implements drivers.Warehouse.
+// It consumes the messages in the topic that were produced before the call, starting from the configured offsets.
+// The messages are written to newline-delimited JSON files with the payload's fields as columns
+// along with _kafka_partition, _kafka_offset, _kafka_timestamp and _kafka_key columns.
+func (c *Connection) QueryAsFiles(ctx context.Context, props map[string]any) (outIt drivers.FileIterator, outErr error) {
+	ctx, span := tracer.Start(ctx, "Connection.QueryAsFiles")
+	defer func() {
+		if outErr != nil {
+			span.SetStatus(codes.Error, outErr.Error())
+		}
+		span.End()
+	}()
+
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := newDecoder(c.config, srcProps)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := kafka.NewConsumer(c.config.consumerConfig())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if outErr != nil {
+			consumer.Close()
+		}
+	}()
+
+	md, err := consumer.GetMetadata(&srcProps.Topic, false, int(metadataTimeout.Milliseconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata for topic %q: %w", srcProps.Topic, err)
+	}
+	topic, ok := md.Topics[srcProps.Topic]
+	if !ok || topic.Error.Code() == kafka.ErrUnknownTopicOrPart || topic.Error.Code() == kafka.ErrUnknownTopic {
+		return nil, fmt.Errorf("topic %q not found", srcProps.Topic)
+	}
+	if topic.Error.Code() != kafka.ErrNoError {
+		return nil, fmt.Errorf("failed to get metadata for topic %q: %w", srcProps.Topic, topic.Error)
+	}
+
+	// Snapshot the high watermark of each partition so we stop at the messages that existed when the query started.
+	var assignments []kafka.TopicPartition
+	highs := make(map[int32]int64)
+	for _, p := range topic.Partitions {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		low, high, err := consumer.QueryWatermarkOffsets(srcProps.Topic, p.ID, int(metadataTimeout.Milliseconds()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offsets for partition %d: %w", p.ID, err)
+		}
+
+		start := low
+		if off, ok := srcProps.startOffsets[p.ID]; ok {
+			start = max(off, low)
+		} else if srcProps.StartFrom == "latest" {
+			start = high
+		}
+		if start >= high {
+			continue
+		}
+
+		assignments = append(assignments, kafka.TopicPartition{
+			Topic:     &srcProps.Topic,
+			Partition: p.ID,
+			Offset:    kafka.Offset(start),
+		})
+		highs[p.ID] = high
+	}
+
+	if len(assignments) > 0 {
+		err = consumer.Assign(assignments)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign partitions: %w", err)
+		}
+	}
+
+	tempDir, err := c.storage.RandomTempDir("kafka")
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileIterator{
+		consumer: consumer,
+		decoder:  dec,
+		props:    srcProps,
+		highs:    highs,
+		tempDir:  tempDir,
+		logger:   c.logger,
+	}, nil
+}
+
+type fileIterator struct {
+	consumer *kafka.Consumer
+	decoder  decoder
+	props    *sourceProperties
+	tempDir  string
+	logger   *zap.Logger
+	// highs holds the high watermark of partitions that haven't been fully consumed yet
+	highs map[int32]int64
+	// Computed while iterating
+	consumed  int64
+	keepFiles bool
+	lastFile  string
+	closed    bool
+}
+
+var _ drivers.FileIterator = &fileIterator{}
+
+// Close implements drivers.FileIterator.
+func (f *fileIterator) Close() error {
+	f.closeConsumer()
+	return os.RemoveAll(f.tempDir)
+}
+
+// Format implements drivers.FileIterator.
+func (f *fileIterator) Format() string {
+	return ""
+}
+
+// SetKeepFilesUntilClose implements drivers.FileIterator.
+func (f *fileIterator) SetKeepFilesUntilClose() {
+	f.keepFiles = true
+}
+
+// Next implements drivers.FileIterator.
+// Each call consumes up to batch_size messages and writes them to a single file.
+func (f *fileIterator) Next(ctx context.Context) ([]string, error) {
+	if !f.keepFiles && f.lastFile != "" {
+		_ = os.Remove(f.lastFile)
+		f.lastFile = ""
+	}
+
+	if f.done() {
+		f.closeConsumer()
+		return nil, io.EOF
+	}
+
+	ctx, span := tracer.Start(ctx, "fileIterator.Next")
+	defer span.End()
+
+	fw, err := os.CreateTemp(f.tempDir, "kafka*.ndjson")
+	if err != nil {
+		return nil, err
+	}
+	defer fw.Close()
+	f.lastFile = fw.Name()
+
+	w := bufio.NewWriter(fw)
+	enc := json.NewEncoder(w)
+
+	n := 0
+	lastMessage := time.Now()
+	for n < f.props.BatchSize && !f.done() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ev := f.consumer.Poll(100)
+		switch e := ev.(type) {
+		case nil:
+			if time.Since(lastMessage) > f.props.pollTimeout {
+				return nil, fmt.Errorf("timed out waiting for messages from topic %q after %s", f.props.Topic, f.props.pollTimeout)
+			}
+		case *kafka.Message:
+			lastMessage = time.Now()
+
+			p := e.TopicPartition.Partition
+			off := int64(e.TopicPartition.Offset)
+			high, ok := f.highs[p]
+			if !ok || off >= high {
+				// Ignore messages produced after the high watermark was captured.
+				continue
+			}
+			if off >= high-1 {
+				delete(f.highs, p)
+			}
+
+			row, err := f.decoder.decode(e.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode message at partition %d offset %d: %w", p, off, err)
+			}
+			row["_kafka_partition"] = p
+			row["_kafka_offset"] = off
+			row["_kafka_timestamp"] = e.Timestamp.UTC()
+			if e.Key != nil {
+				row["_kafka_key"] = string(e.Key)
+			} else {
+				row["_kafka_key"] = nil
+			}
+
+			if err := enc.Encode(row); err != nil {
+				return nil, err
+			}
+			n++
+			f.consumed++
+		case kafka.PartitionEOF:
+			lastMessage = time.Now()
+			delete(f.highs, e.Partition)
+		case kafka.Error:
+			if e.IsFatal() || e.Code() == kafka.ErrAllBrokersDown {
+				return nil, fmt.Errorf("failed to consume from topic %q: %w", f.props.Topic, e)
+			}
+			f.logger.Debug("kafka consumer error", zap.Error(e), observability.ZapCtx(ctx))
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		f.closeConsumer()
+		return nil, io.EOF
+	}
+
+	f.logger.Debug("consumed kafka messages", zap.String("topic", f.props.Topic), zap.Int("messages", n), zap.Int64("total_messages", f.consumed), observability.ZapCtx(ctx))
+	return []string{fw.Name()}, nil
+}
+
+// done returns true when all partitions have been consumed up to their high watermark or max_messages has been reached.
+func (f *fileIterator) done() bool {
+	if f.props.MaxMessages > 0 && f.consumed >= f.props.MaxMessages {
+		return true
+	}
+	return len(f.highs) == 0
+}
+
+func (f *fileIterator) closeConsumer() {
+	if f.closed {
+		return
+	}
+	f.closed = true
+	_ = f.consumer.Close()
+}
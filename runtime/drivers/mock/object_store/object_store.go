@@ -3,11 +3,14 @@ package mock
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	rillblob "github.com/rilldata/rill/runtime/pkg/blob"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
 	"github.com/rilldata/rill/runtime/storage"
 	"go.uber.org/zap"
 	"gocloud.dev/blob"
@@ -196,3 +199,13 @@ func (h *handle) ListObjectsForGlob(ctx context.Context, bucket, glob string, pa
 func (h *handle) DownloadFiles(ctx context.Context, path string) (drivers.FileIterator, error) {
 	return nil, errors.New("not implemented")
 }
+
+// Upload implements drivers.ObjectStore.
+// The bucket name in the path is ignored since all objects are served from the configured directory.
+func (h *handle) Upload(ctx context.Context, path string, r io.Reader) error {
+	url, err := globutil.ParseBucketURL(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path %q: %w", path, err)
+	}
+	return h.bucket.Upload(ctx, url.Path, r, nil)
+}
@@ -79,6 +79,9 @@ type ModelExecuteOptions struct {
 	PartitionKey string
 	// TempDir is a temporary directory for storing intermediate data.
 	TempDir string
+	// IncrementalState is the incremental state of the model from its previous execution.
+	// It is nil if the model is not incremental or does not have any incremental state yet.
+	IncrementalState map[string]any
 }
 
 // ModelEnv contains contextual info about the model's instance.
@@ -99,6 +102,9 @@ type ModelResult struct {
 	Table        string
 	ExecDuration time.Duration
 	Warnings     []string
+	// IncrementalState is an optional incremental state produced by the executor, such as the position it has read up to in the source.
+	// It is persisted as the model's incremental state if the model is incremental and does not configure a state resolver.
+	IncrementalState map[string]any
 }
 
 // IncrementalStrategy is a strategy to use for incrementally inserting data into a SQL table.
@@ -120,6 +126,12 @@ const (
 	FileFormatCSV         FileFormat = "csv"
 	FileFormatJSON        FileFormat = "json"
 	FileFormatXLSX        FileFormat = "xlsx"
+	FileFormatJSONL       FileFormat = "jsonl"
+	FileFormatArrow       FileFormat = "arrow"
+	// FileFormatIceberg is an Apache Iceberg table. It is only supported for importing data from object stores.
+	FileFormatIceberg FileFormat = "iceberg"
+	// FileFormatDelta is a Delta Lake table. It is only supported for importing data from object stores and local files.
+	FileFormatDelta FileFormat = "delta"
 )
 
 func (f FileFormat) Filename(stem string) string {
@@ -128,7 +140,7 @@ func (f FileFormat) Filename(stem string) string {
 
 func (f FileFormat) Valid() bool {
 	switch f {
-	case FileFormatParquet, FileFormatCSV, FileFormatJSON, FileFormatXLSX:
+	case FileFormatParquet, FileFormatCSV, FileFormatJSON, FileFormatXLSX, FileFormatJSONL, FileFormatArrow:
 		return true
 	}
 	return false
@@ -21,6 +21,14 @@ func (d *dialect) SupportsILike() bool {
 	return false
 }
 
+func (d *dialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("MD5(CAST(%s AS CHAR))", expr), nil
+}
+
+func (d *dialect) RedactExpression(expr string, keepLast uint32) (string, error) {
+	return d.RedactExpressionWithCast(expr, keepLast, "CHAR"), nil
+}
+
 func EscapeIdentifier(ident string) string {
 	if ident == "" {
 		return ident
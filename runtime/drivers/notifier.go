@@ -25,6 +25,9 @@ type AlertStatus struct {
 	OpenLink        string
 	EditLink        string
 	UnsubscribeLink string
+	// MutedExecutions is the number of preceding failed executions whose notifications were suppressed by a mute window or snooze.
+	// It is set on the summary sent when the mute ends.
+	MutedExecutions int
 }
 
 type ScheduledReport struct {
@@ -3,6 +3,7 @@ package drivers
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"sort"
 	"strings"
@@ -31,6 +32,9 @@ type ObjectStore interface {
 	// DownloadFiles provides an iterator for downloading and consuming files.
 	// It resolves globs similar to ListObjects.
 	DownloadFiles(ctx context.Context, path string) (FileIterator, error)
+	// Upload writes the contents of r to the object at the given path, replacing it if it already exists.
+	// The path must be a full URI including the scheme and bucket (e.g. s3://bucket/path/to/file.csv).
+	Upload(ctx context.Context, path string, r io.Reader) error
 }
 
 // ObjectStoreEntry represents a file listing in an object store.
@@ -62,6 +66,8 @@ type ObjectStoreModelInputProperties struct {
 	URI    string         `mapstructure:"uri"` // Deprecated: use `path` instead
 	Format FileFormat     `mapstructure:"format"`
 	DuckDB map[string]any `mapstructure:"duckdb"` // Deprecated: use DuckDB directly
+	// Iceberg configures reading an Apache Iceberg table. It is only used when Format is "iceberg".
+	Iceberg *IcebergInputProperties `mapstructure:"iceberg"`
 }
 
 func (p *ObjectStoreModelInputProperties) Decode(props map[string]any) error {
@@ -75,6 +81,21 @@ func (p *ObjectStoreModelInputProperties) DecodeWithWarnings(props map[string]an
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse input properties: %w", err)
 	}
+	if p.Iceberg != nil && p.Format == FileFormatUnspecified {
+		p.Format = FileFormatIceberg
+	}
+	if p.Format == FileFormatIceberg {
+		if p.Iceberg == nil {
+			p.Iceberg = &IcebergInputProperties{}
+		}
+		if err := p.Iceberg.validate(p.Path != "" || p.URI != ""); err != nil {
+			return nil, err
+		}
+		if p.Iceberg.CatalogURI != "" {
+			// The table's location is resolved from the catalog.
+			return unused, nil
+		}
+	}
 	if p.Path == "" && p.URI == "" {
 		return nil, fmt.Errorf("missing property `path`")
 	}
@@ -84,6 +105,9 @@ func (p *ObjectStoreModelInputProperties) DecodeWithWarnings(props map[string]an
 	if p.URI != "" { // Backwards compatibility
 		p.Path = p.URI
 	}
+	if p.Format == FileFormatDelta && strings.ContainsAny(p.Path, "*?[{") {
+		return nil, fmt.Errorf("the `path` of a Delta Lake table must be the table's root directory, not a glob pattern")
+	}
 	if !doublestar.ValidatePattern(p.Path) {
 		return nil, fmt.Errorf("glob pattern %q is invalid", p.Path)
 	}
@@ -31,6 +31,14 @@ func (d *dialect) UnnestSQLSuffix(_ string) string {
 	panic("Pinot auto unnests")
 }
 
+func (d *dialect) HashExpression(_ string) (string, error) {
+	return "", fmt.Errorf("hash masks are not supported for the pinot dialect")
+}
+
+func (d *dialect) RedactExpression(_ string, _ uint32) (string, error) {
+	return "", fmt.Errorf("redact masks are not supported for the pinot dialect")
+}
+
 func (d *dialect) GetTimeDimensionParameter(_ runtimev1.Type_Code) string {
 	return "CAST(? AS TIMESTAMP)"
 }
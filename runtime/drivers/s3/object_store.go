@@ -3,6 +3,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -105,6 +106,22 @@ func (c *Connection) DownloadFiles(ctx context.Context, path string) (drivers.Fi
 	})
 }
 
+// Upload implements drivers.ObjectStore.
+func (c *Connection) Upload(ctx context.Context, path string, r io.Reader) error {
+	url, err := c.parseBucketURL(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path %q: %w", path, err)
+	}
+
+	bucket, err := c.openBucket(ctx, url.Host, false)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return bucket.Upload(ctx, url.Path, r)
+}
+
 func (c *Connection) parseBucketURL(path string) (*globutil.URL, error) {
 	path = c.rewriteToS3Path(path)
 	url, err := globutil.ParseBucketURL(path)
@@ -0,0 +1,384 @@
+package sftp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+	blobdriver "gocloud.dev/blob/driver"
+	"gocloud.dev/gcerrors"
+)
+
+// errNotImplemented is returned for bucket operations that SFTP doesn't support.
+var errNotImplemented = errors.New("sftp: not implemented")
+
+// sftpBucket implements the gocloud.dev blob driver on top of an SFTP session.
+// This allows reusing the listing and download logic in runtime/pkg/blob.
+//
+// Object keys are paths relative to the root of the server's file system, e.g. the key "data/file.csv" maps to "/data/file.csv".
+// The bucket takes ownership of the client and closes it when the bucket is closed.
+type sftpBucket struct {
+	client *sftpClient
+}
+
+var _ blobdriver.Bucket = &sftpBucket{}
+
+// ErrorCode implements blobdriver.Bucket.
+func (b *sftpBucket) ErrorCode(err error) gcerrors.ErrorCode {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return gcerrors.NotFound
+	case errors.Is(err, fs.ErrPermission):
+		return gcerrors.PermissionDenied
+	case errors.Is(err, errNotImplemented):
+		return gcerrors.Unimplemented
+	default:
+		return gcerrors.Unknown
+	}
+}
+
+// As implements blobdriver.Bucket.
+func (b *sftpBucket) As(i any) bool {
+	p, ok := i.(**sftp.Client)
+	if !ok {
+		return false
+	}
+	*p = b.client.Client
+	return true
+}
+
+// ErrorAs implements blobdriver.Bucket.
+func (b *sftpBucket) ErrorAs(err error, i any) bool {
+	return errors.As(err, i)
+}
+
+// Attributes implements blobdriver.Bucket.
+func (b *sftpBucket) Attributes(ctx context.Context, key string) (*blobdriver.Attributes, error) {
+	info, err := b.client.Stat(remotePath(key))
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("sftp: %q is a directory: %w", key, fs.ErrNotExist)
+	}
+	return &blobdriver.Attributes{
+		ModTime: info.ModTime(),
+		Size:    info.Size(),
+	}, nil
+}
+
+// ListPaged implements blobdriver.Bucket.
+// Since SFTP has no native pagination, it lists all matching files and pages through them in memory.
+// Only "/" is supported as a delimiter.
+func (b *sftpBucket) ListPaged(ctx context.Context, opts *blobdriver.ListOptions) (*blobdriver.ListPage, error) {
+	if opts.Delimiter != "" && opts.Delimiter != "/" {
+		return nil, fmt.Errorf("sftp: unsupported delimiter %q: %w", opts.Delimiter, errNotImplemented)
+	}
+
+	// Supports a start offset set through BeforeList (see runtime/pkg/blob).
+	var startAfter string
+	if opts.BeforeList != nil {
+		err := opts.BeforeList(func(i any) bool {
+			p, ok := i.(**string)
+			if !ok {
+				return false
+			}
+			*p = &startAfter
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(opts.PageToken) > 0 {
+		startAfter = string(opts.PageToken)
+	}
+
+	var objs []*blobdriver.ListObject
+	var err error
+	if opts.Delimiter != "" {
+		objs, err = b.listDir(ctx, opts.Prefix)
+	} else {
+		objs, err = b.walk(ctx, opts.Prefix)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].Key < objs[j].Key })
+
+	page := &blobdriver.ListPage{}
+	for _, obj := range objs {
+		if startAfter != "" && obj.Key <= startAfter {
+			continue
+		}
+		if opts.PageSize > 0 && len(page.Objects) == opts.PageSize {
+			page.NextPageToken = []byte(page.Objects[len(page.Objects)-1].Key)
+			break
+		}
+		page.Objects = append(page.Objects, obj)
+	}
+	return page, nil
+}
+
+// listDir lists the files and directories directly under the directory that contains prefix.
+// Directories are returned with a trailing "/".
+func (b *sftpBucket) listDir(ctx context.Context, prefix string) ([]*blobdriver.ListObject, error) {
+	dir := prefix[:strings.LastIndex(prefix, "/")+1]
+	infos, err := b.client.ReadDirContext(ctx, remotePath(dir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var objs []*blobdriver.ListObject
+	for _, info := range infos {
+		key := dir + info.Name()
+		if info.IsDir() {
+			key += "/"
+		} else if !info.Mode().IsRegular() {
+			continue
+		}
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		objs = append(objs, &blobdriver.ListObject{
+			Key:     key,
+			ModTime: info.ModTime(),
+			Size:    info.Size(),
+			IsDir:   info.IsDir(),
+		})
+	}
+	return objs, nil
+}
+
+// walk recursively lists the files with keys that start with prefix.
+func (b *sftpBucket) walk(ctx context.Context, prefix string) ([]*blobdriver.ListObject, error) {
+	root := remotePath(prefix[:strings.LastIndex(prefix, "/")+1])
+
+	var objs []*blobdriver.ListObject
+	walker := b.client.Walk(root)
+	for walker.Step() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := walker.Err(); err != nil {
+			if walker.Path() == root && errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		info := walker.Stat()
+		key := strings.TrimPrefix(walker.Path(), "/")
+		if info.IsDir() {
+			// Skip directories that can't contain matching keys
+			if walker.Path() != root && !strings.HasPrefix(key+"/", prefix) && !strings.HasPrefix(prefix, key+"/") {
+				walker.SkipDir()
+			}
+			continue
+		}
+		if !info.Mode().IsRegular() || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		objs = append(objs, &blobdriver.ListObject{
+			Key:     key,
+			ModTime: info.ModTime(),
+			Size:    info.Size(),
+		})
+	}
+	return objs, nil
+}
+
+// NewRangeReader implements blobdriver.Bucket.
+func (b *sftpBucket) NewRangeReader(ctx context.Context, key string, offset, length int64, opts *blobdriver.ReaderOptions) (blobdriver.Reader, error) {
+	f, err := b.client.Open(remotePath(key))
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		f.Close()
+		return nil, fmt.Errorf("sftp: %q is a directory: %w", key, fs.ErrNotExist)
+	}
+
+	if offset > 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
+	var r io.Reader = f
+	if length >= 0 {
+		r = io.LimitReader(f, length)
+	}
+
+	return &reader{
+		r: r,
+		f: f,
+		attrs: &blobdriver.ReaderAttributes{
+			ModTime: info.ModTime(),
+			Size:    info.Size(),
+		},
+	}, nil
+}
+
+// NewTypedWriter implements blobdriver.Bucket.
+// It writes to a temporary file next to the target and renames it into place on Close, so readers never see partial files.
+func (b *sftpBucket) NewTypedWriter(ctx context.Context, key, contentType string, opts *blobdriver.WriterOptions) (blobdriver.Writer, error) {
+	p := remotePath(key)
+	if err := b.client.MkdirAll(path.Dir(p)); err != nil {
+		return nil, fmt.Errorf("sftp: failed to create directory for %q: %w", key, err)
+	}
+
+	tmp := fmt.Sprintf("%s.%d.tmp", p, time.Now().UnixNano())
+	f, err := b.client.Create(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &writer{
+		ctx:    ctx,
+		client: b.client,
+		f:      f,
+		tmp:    tmp,
+		path:   p,
+	}, nil
+}
+
+// Copy implements blobdriver.Bucket.
+func (b *sftpBucket) Copy(ctx context.Context, dstKey, srcKey string, opts *blobdriver.CopyOptions) error {
+	r, err := b.NewRangeReader(ctx, srcKey, 0, -1, &blobdriver.ReaderOptions{})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := b.NewTypedWriter(ctx, dstKey, "", &blobdriver.WriterOptions{})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return errors.Join(err, w.Close())
+}
+
+// Delete implements blobdriver.Bucket.
+func (b *sftpBucket) Delete(ctx context.Context, key string) error {
+	return b.client.Remove(remotePath(key))
+}
+
+// SignedURL implements blobdriver.Bucket.
+func (b *sftpBucket) SignedURL(ctx context.Context, key string, opts *blobdriver.SignedURLOptions) (string, error) {
+	return "", errNotImplemented
+}
+
+// Close implements blobdriver.Bucket.
+func (b *sftpBucket) Close() error {
+	return b.client.Close()
+}
+
+// reader implements blobdriver.Reader for a remote file.
+type reader struct {
+	r     io.Reader
+	f     *sftp.File
+	attrs *blobdriver.ReaderAttributes
+}
+
+// Read implements blobdriver.Reader.
+func (r *reader) Read(p []byte) (int, error) {
+	return r.r.Read(p)
+}
+
+// Close implements blobdriver.Reader.
+func (r *reader) Close() error {
+	return r.f.Close()
+}
+
+// Attributes implements blobdriver.Reader.
+func (r *reader) Attributes() *blobdriver.ReaderAttributes {
+	return r.attrs
+}
+
+// As implements blobdriver.Reader.
+func (r *reader) As(i any) bool {
+	p, ok := i.(**sftp.File)
+	if !ok {
+		return false
+	}
+	*p = r.f
+	return true
+}
+
+// writer implements blobdriver.Writer for a remote file.
+type writer struct {
+	ctx    context.Context
+	client *sftpClient
+	f      *sftp.File
+	tmp    string
+	path   string
+}
+
+// Write implements blobdriver.Writer.
+func (w *writer) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.f.Write(p)
+}
+
+// Upload implements blobdriver.Uploader.
+// It uses the SFTP client's ReadFrom, which pipelines writes for better throughput than individual Write calls.
+func (w *writer) Upload(r io.Reader) error {
+	_, err := w.f.ReadFrom(r)
+	return err
+}
+
+// Close implements blobdriver.Writer.
+// It renames the temporary file into place, or removes it if the write failed or the ctx was cancelled.
+func (w *writer) Close() error {
+	err := w.f.Close()
+	if err == nil {
+		err = w.ctx.Err()
+	}
+	if err != nil {
+		_ = w.client.Remove(w.tmp)
+		return err
+	}
+
+	// Prefer an atomic rename that replaces the existing file. Fall back to remove and rename for servers that don't support the extension.
+	if _, ok := w.client.HasExtension("posix-rename@openssh.com"); ok {
+		err = w.client.PosixRename(w.tmp, w.path)
+	} else {
+		err = w.client.Remove(w.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			_ = w.client.Remove(w.tmp)
+			return err
+		}
+		err = w.client.Rename(w.tmp, w.path)
+	}
+	if err != nil {
+		_ = w.client.Remove(w.tmp)
+		return fmt.Errorf("sftp: failed to move uploaded file into place: %w", err)
+	}
+	return nil
+}
+
+// remotePath returns the absolute path on the server for an object key.
+func remotePath(key string) string {
+	return path.Join("/", key)
+}
@@ -0,0 +1,110 @@
+package sftp
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/pkg/blob"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"gocloud.dev/blob"
+)
+
+// ListBuckets implements drivers.ObjectStore.
+// An SFTP server has a single file system, so the configured host is returned as the only bucket.
+func (c *Connection) ListBuckets(ctx context.Context, pageSize uint32, pageToken string) ([]string, string, error) {
+	return []string{c.config.Host}, "", nil
+}
+
+// ListObjects implements drivers.ObjectStore.
+func (c *Connection) ListObjects(ctx context.Context, bucket, path, delimiter string, pageSize uint32, pageToken string) ([]drivers.ObjectStoreEntry, string, error) {
+	blobBucket, err := c.openBucket(ctx, bucket)
+	if err != nil {
+		return nil, "", err
+	}
+	defer blobBucket.Close()
+	blobListfn := func(ctx context.Context, p string, d string, s uint32, t string) ([]drivers.ObjectStoreEntry, string, error) {
+		return blobBucket.ListObjects(ctx, p, d, s, t)
+	}
+	return drivers.ListObjects(ctx, nil, blobListfn, bucket, path, delimiter, pageSize, pageToken)
+}
+
+// ListObjectsForGlob implements drivers.ObjectStore.
+func (c *Connection) ListObjectsForGlob(ctx context.Context, bucket, glob string, pageSize uint32, pageToken, start, end string) ([]drivers.ObjectStoreEntry, string, error) {
+	blobBucket, err := c.openBucket(ctx, bucket)
+	if err != nil {
+		return nil, "", err
+	}
+	defer blobBucket.Close()
+
+	return blobBucket.ListObjectsForGlob(ctx, glob, pageSize, pageToken, start, end)
+}
+
+// DownloadFiles returns a file iterator over files on the SFTP server matching the path.
+// The path must be of the form sftp://<host>/<path>, where <path> is relative to the root of the server's file system.
+func (c *Connection) DownloadFiles(ctx context.Context, path string) (drivers.FileIterator, error) {
+	url, err := c.parseBucketURL(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path %q: %w", path, err)
+	}
+
+	bucket, err := c.openBucket(ctx, url.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	tempDir, err := c.storage.TempDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket.Download(ctx, &rillblob.DownloadOptions{
+		Glob:        url.Path,
+		TempDir:     tempDir,
+		CloseBucket: true,
+	})
+}
+
+// Upload implements drivers.ObjectStore.
+// Missing parent directories are created, and the file is written to a temporary path that is renamed into place once the upload completes.
+func (c *Connection) Upload(ctx context.Context, path string, r io.Reader) error {
+	url, err := c.parseBucketURL(path)
+	if err != nil {
+		return fmt.Errorf("failed to parse path %q: %w", path, err)
+	}
+
+	bucket, err := c.openBucket(ctx, url.Host)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	return bucket.Upload(ctx, url.Path, r)
+}
+
+func (c *Connection) parseBucketURL(path string) (*globutil.URL, error) {
+	url, err := globutil.ParseBucketURL(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path %q, %w", path, err)
+	}
+	if url.Scheme != "sftp" {
+		return nil, fmt.Errorf("invalid SFTP path %q: should start with sftp://", path)
+	}
+	return url, nil
+}
+
+// openBucket opens a connection to the SFTP server and wraps it as a bucket.
+// The bucket name must match the connector's host, optionally with its port.
+func (c *Connection) openBucket(ctx context.Context, bucket string) (*rillblob.Bucket, error) {
+	if bucket != c.config.Host && bucket != c.config.addr() {
+		return nil, fmt.Errorf("host %q does not match the connector's host %q", bucket, c.config.Host)
+	}
+
+	client, err := c.newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rillblob.NewBucket(blob.NewBucket(&sftpBucket{client: client}), c.logger)
+}
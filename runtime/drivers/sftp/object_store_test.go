@@ -0,0 +1,182 @@
+package sftp_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/runtime/drivers/sftp"
+)
+
+func TestObjectStore(t *testing.T) {
+	cfg := testruntime.AcquireConnector(t, "sftp")
+	delete(cfg, "private_key")
+	objectStore := openObjectStore(t, cfg)
+	bucket := cfg["host"].(string)
+
+	t.Run("testListObjectsForGlobPagination_pageSize1", func(t *testing.T) { testListObjectsForGlobPagination(t, objectStore, bucket, 1) })
+	t.Run("testListObjectsForGlobPagination_pageSize3", func(t *testing.T) { testListObjectsForGlobPagination(t, objectStore, bucket, 3) })
+	t.Run("testListDirectoriesForGlob", func(t *testing.T) { testListDirectoriesForGlob(t, objectStore, bucket) })
+	t.Run("testListObjectsDelimiter", func(t *testing.T) { testListObjectsDelimiter(t, objectStore, bucket) })
+	t.Run("testDownloadFiles", func(t *testing.T) { testDownloadFiles(t, objectStore, bucket) })
+	t.Run("testUpload", func(t *testing.T) { testUpload(t, objectStore, bucket) })
+}
+
+func TestPrivateKeyAuth(t *testing.T) {
+	cfg := testruntime.AcquireConnector(t, "sftp")
+	delete(cfg, "password")
+	conn, err := drivers.Open("sftp", "default", "default", cfg, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	require.NoError(t, conn.Ping(t.Context()))
+}
+
+func TestHostKeyMismatch(t *testing.T) {
+	cfg := testruntime.AcquireConnector(t, "sftp")
+	cfg["host_key"] = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+	conn, err := drivers.Open("sftp", "default", "default", cfg, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	err = conn.Ping(t.Context())
+	require.ErrorContains(t, err, "does not match the configured `host_key`")
+}
+
+func openObjectStore(t *testing.T, cfg map[string]any) drivers.ObjectStore {
+	conn, err := drivers.Open("sftp", "default", "default", cfg, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	objectStore, ok := conn.AsObjectStore()
+	require.True(t, ok)
+	return objectStore
+}
+
+func testListObjectsForGlobPagination(t *testing.T, objectStore drivers.ObjectStore, bucket string, pageSize uint32) {
+	ctx := t.Context()
+	path := "listing_glob_test/**/*.csv"
+
+	expected := []string{
+		"listing_glob_test/year=2024/month=11/day=30/file1.csv",
+		"listing_glob_test/year=2024/month=11/day=30/file2.csv",
+		"listing_glob_test/year=2024/month=11/day=31/file1.csv",
+
+		"listing_glob_test/year=2025/month=01/day=01/file1.csv",
+		"listing_glob_test/year=2025/month=01/day=01/file2.csv",
+		"listing_glob_test/year=2025/month=01/day=01/file3.csv",
+
+		"listing_glob_test/year=2025/month=01/day=02/file1.csv",
+		"listing_glob_test/year=2025/month=01/day=02/file2.csv",
+
+		"listing_glob_test/year=2025/month=02/day=01/file1.csv",
+
+		"listing_glob_test/year=2025/month=12/day=01/file1.csv",
+		"listing_glob_test/year=2025/month=12/day=01/file2.csv",
+
+		"listing_glob_test/year=2026/month=01/day=01/file1.csv",
+		"listing_glob_test/year=2026/month=01/day=01/hour=01/file1.csv",
+		"listing_glob_test/year=2026/month=01/day=01/hour=02/file1.csv",
+	}
+
+	var pageToken string
+	var collected []string
+	for {
+		objects, nextToken, err := objectStore.ListObjectsForGlob(ctx, bucket, path, pageSize, pageToken, "", "")
+		require.NoError(t, err)
+		if nextToken != "" {
+			require.Len(t, objects, int(pageSize))
+		}
+		for _, obj := range objects {
+			require.False(t, obj.IsDir)
+			collected = append(collected, obj.Path)
+		}
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
+	}
+
+	require.Equal(t, expected, collected)
+}
+
+func testListDirectoriesForGlob(t *testing.T, objectStore drivers.ObjectStore, bucket string) {
+	objects, nextToken, err := objectStore.ListObjectsForGlob(t.Context(), bucket, "listing_glob_test/year=*", 100, "", "", "")
+	require.NoError(t, err)
+	require.Empty(t, nextToken)
+
+	var collected []string
+	for _, obj := range objects {
+		require.True(t, obj.IsDir)
+		collected = append(collected, obj.Path)
+	}
+	require.Equal(t, []string{
+		"listing_glob_test/year=2024/",
+		"listing_glob_test/year=2025/",
+		"listing_glob_test/year=2026/",
+	}, collected)
+}
+
+func testListObjectsDelimiter(t *testing.T, objectStore drivers.ObjectStore, bucket string) {
+	objects, _, err := objectStore.ListObjects(t.Context(), bucket, "glob_test/", "/", 100, "")
+	require.NoError(t, err)
+
+	var collected []string
+	for _, obj := range objects {
+		require.True(t, obj.IsDir)
+		collected = append(collected, obj.Path)
+	}
+	require.Equal(t, []string{"glob_test/y=2010/", "glob_test/y=2023/", "glob_test/y=2024/"}, collected)
+}
+
+func testDownloadFiles(t *testing.T, objectStore drivers.ObjectStore, bucket string) {
+	ctx := t.Context()
+	iter, err := objectStore.DownloadFiles(ctx, "sftp://"+bucket+"/glob_test/y=2024/*.csv")
+	require.NoError(t, err)
+	defer iter.Close()
+
+	var names []string
+	for {
+		files, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		for _, f := range files {
+			info, err := os.Stat(f)
+			require.NoError(t, err)
+			require.NotZero(t, info.Size())
+			names = append(names, filepath.Base(f))
+		}
+	}
+	require.ElementsMatch(t, []string{"aaa.csv", "bbb.csv"}, names)
+}
+
+func testUpload(t *testing.T, objectStore drivers.ObjectStore, bucket string) {
+	ctx := t.Context()
+	path := "sftp://" + bucket + "/upload/exports/report.csv"
+
+	// Uploading twice checks that existing files are replaced.
+	require.NoError(t, objectStore.Upload(ctx, path, strings.NewReader("a,b\n1,2\n")))
+	require.NoError(t, objectStore.Upload(ctx, path, strings.NewReader("a,b\n3,4\n")))
+
+	iter, err := objectStore.DownloadFiles(ctx, path)
+	require.NoError(t, err)
+	defer iter.Close()
+	files, err := iter.Next(ctx)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.Equal(t, "a,b\n3,4\n", string(data))
+}
@@ -125,6 +125,7 @@ func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
 			DisplayName:         s.DisplayName,
 			ExecutionTimeString: s.ExecutionTime.Format(time.RFC1123),
 			FailRow:             s.FailRow,
+			MutedExecutions:     s.MutedExecutions,
 			OpenLink:            htemplate.URL(s.OpenLink),
 			EditLink:            htemplate.URL(s.EditLink),
 		})
@@ -281,6 +282,7 @@ type AlertFailData struct {
 	DisplayName         string
 	ExecutionTimeString string // Will be inferred from ExecutionTime
 	FailRow             map[string]any
+	MutedExecutions     int
 	OpenLink            htemplate.URL
 	EditLink            htemplate.URL
 }
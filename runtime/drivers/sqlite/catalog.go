@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -144,6 +145,73 @@ func (c *catalogStore) DeleteResources(ctx context.Context) error {
 	return nil
 }
 
+func (c *catalogStore) ReplaceResources(ctx context.Context, v int64, rs []drivers.Resource, partitions map[string][]drivers.ModelPartition) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var version int64
+	err = tx.QueryRowContext(ctx, "SELECT version FROM controller_version WHERE instance_id=?", c.instanceID).Scan(&version)
+	if err != nil {
+		return err
+	}
+	if version != v {
+		return drivers.ErrInconsistentControllerVersion
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM model_partitions WHERE instance_id=?", c.instanceID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM catalogv2 WHERE instance_id=?", c.instanceID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, r := range rs {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO catalogv2(instance_id, kind, name, data, created_on, updated_on) VALUES (?, ?, ?, ?, ?, ?)",
+			c.instanceID,
+			r.Kind,
+			r.Name,
+			r.Data,
+			now,
+			now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert resource %q: %w", r.Name, err)
+		}
+	}
+
+	for modelID, ps := range partitions {
+		for _, p := range ps {
+			_, err = tx.ExecContext(
+				ctx,
+				"INSERT INTO model_partitions(instance_id, model_id, key, data_json, idx, watermark, executed_on, error, elapsed_ms, skipped) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+				c.instanceID,
+				modelID,
+				p.Key,
+				p.DataJSON,
+				p.Index,
+				p.Watermark,
+				p.ExecutedOn,
+				p.Error,
+				p.Elapsed.Milliseconds(),
+				p.Skipped,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert model partition: %w", err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (c *catalogStore) FindModelPartitions(ctx context.Context, opts *drivers.FindModelPartitionsOptions) ([]drivers.ModelPartition, error) {
 	var qry strings.Builder
 	var args []any
@@ -7,6 +7,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/mysqlolap"
 )
 
 // StarRocks Uses fully qualified names (catalog.information_schema.tables) instead of SET CATALOG/USE
@@ -210,7 +211,7 @@ func (c *connection) Lookup(ctx context.Context, database, databaseSchema, name
 
 		runtimeType, err := c.databaseTypeToRuntimeType(dataType)
 		if err != nil {
-			if errors.Is(err, errUnsupportedType) {
+			if errors.Is(err, mysqlolap.ErrUnsupportedType) {
 				unsupportedCols[colName] = dataType
 				continue // Skip unsupported types
 			}
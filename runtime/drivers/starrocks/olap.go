@@ -3,18 +3,15 @@ package starrocks
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"strings"
 
-	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/mysqlolap"
 	"go.uber.org/zap"
 )
 
-var errUnsupportedType = errors.New("encountered unsupported StarRocks type")
-
 var _ drivers.OLAPStore = (*connection)(nil)
 
 // Dialect implements drivers.OLAPStore.
@@ -36,73 +33,33 @@ func (c *connection) WithConnection(ctx context.Context, priority int, fn driver
 
 // Exec implements drivers.OLAPStore.
 func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
-	db := c.db
-	var err error
-
 	if c.configProp.LogQueries {
 		c.logger.Info("StarRocks exec",
 			zap.String("query", stmt.Query),
 			zap.Any("args", stmt.Args))
 	}
 
-	// Handle DryRun: validate query without execution
-	if stmt.DryRun {
-		_, err = db.ExecContext(ctx, fmt.Sprintf("EXPLAIN %s", stmt.Query), stmt.Args...)
-		return err
-	}
-
-	_, err = db.ExecContext(ctx, stmt.Query, stmt.Args...)
-	return err
+	return mysqlolap.Exec(ctx, c.db, stmt)
 }
 
 // Query implements drivers.OLAPStore.
 func (c *connection) Query(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
-	db := c.db
-
 	if c.configProp.LogQueries {
 		c.logger.Info("StarRocks query",
 			zap.String("query", stmt.Query),
 			zap.Any("args", stmt.Args))
 	}
 
-	// Handle DryRun: validate query without execution
-	if stmt.DryRun {
-		rows, err := db.QueryxContext(ctx, fmt.Sprintf("EXPLAIN %s", stmt.Query), stmt.Args...)
-		if err != nil {
-			return nil, err
-		}
-		rows.Close()
-		// Return nil result for dry run (query is valid)
-		return nil, nil
-	}
-
-	rows, err := db.QueryxContext(ctx, stmt.Query, stmt.Args...)
-	if err != nil {
-		return nil, err
-	}
-
-	schema, err := c.rowsToSchema(rows)
-	if err != nil {
-		rows.Close()
-		return nil, err
-	}
-
-	cts, err := rows.ColumnTypes()
-	if err != nil {
-		rows.Close()
-		return nil, err
+	res, err := mysqlolap.Query(ctx, c.db, stmt)
+	if err != nil || res == nil {
+		return res, err
 	}
 
-	starrocksRows := &starrocksRows{
-		Rows:     rows,
-		scanDest: prepareScanDest(schema),
-		colTypes: cts,
+	res.Rows = &starrocksRows{
+		Rows:     res.Rows,
+		colTypes: mysqlolap.ColumnTypes(res.Rows),
 	}
-
-	return &drivers.Result{
-		Rows:   starrocksRows,
-		Schema: schema,
-	}, nil
+	return res, nil
 }
 
 func (c *connection) Head(ctx context.Context, db, schema, table string, limit int64) (*drivers.Result, error) {
@@ -128,18 +85,7 @@ func (c *connection) Head(ctx context.Context, db, schema, table string, limit i
 
 // QuerySchema implements drivers.OLAPStore.
 func (c *connection) QuerySchema(ctx context.Context, query string, args []any) (*runtimev1.StructType, error) {
-	db := c.db
-
-	// Use LIMIT 0 to get schema without data
-	schemaQuery := fmt.Sprintf("SELECT * FROM (%s) AS _schema_query LIMIT 0", query)
-
-	rows, err := db.QueryxContext(ctx, schemaQuery, args...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	return c.rowsToSchema(rows)
+	return mysqlolap.QuerySchema(ctx, c.db, query, args)
 }
 
 // InformationSchema implements drivers.OLAPStore.
@@ -152,196 +98,13 @@ func (c *connection) EstimateSize(ctx context.Context) (int64, error) {
 	return -1, nil
 }
 
-// rowsToSchema converts SQL rows to StructType schema.
-func (c *connection) rowsToSchema(rows *sqlx.Rows) (*runtimev1.StructType, error) {
-	colTypes, err := rows.ColumnTypes()
-	if err != nil {
-		return nil, err
-	}
-
-	fields := make([]*runtimev1.StructType_Field, len(colTypes))
-	for i, ct := range colTypes {
-		runtimeType, err := c.databaseTypeToRuntimeType(ct.DatabaseTypeName())
-		if err != nil {
-			if errors.Is(err, errUnsupportedType) {
-				// Skip unsupported types or handle gracefully
-				return nil, fmt.Errorf("unsupported type %q for column %q: %w", ct.DatabaseTypeName(), ct.Name(), err)
-			}
-			return nil, err
-		}
-		fields[i] = &runtimev1.StructType_Field{
-			Name: ct.Name(),
-			Type: runtimeType,
-		}
-	}
-
-	return &runtimev1.StructType{Fields: fields}, nil
-}
-
-// databaseTypeToRuntimeType converts StarRocks/MySQL types to runtime types.
-// Returns an error for unsupported types instead of falling back to string.
+// databaseTypeToRuntimeType converts a StarRocks type to a runtime type.
 func (c *connection) databaseTypeToRuntimeType(dbType string) (*runtimev1.Type, error) {
-	rawType := dbType
-	dbType = strings.ToUpper(dbType)
-
-	// Handle parameterized types
-	if idx := strings.Index(dbType, "("); idx != -1 {
-		dbType = dbType[:idx]
-	}
-
-	t := &runtimev1.Type{RawType: rawType}
-	switch dbType {
-	case "BOOLEAN", "BOOL":
-		t.Code = runtimev1.Type_CODE_BOOL
-	case "TINYINT":
-		t.Code = runtimev1.Type_CODE_INT8
-	case "SMALLINT":
-		t.Code = runtimev1.Type_CODE_INT16
-	case "INT", "INTEGER":
-		t.Code = runtimev1.Type_CODE_INT32
-	case "BIGINT":
-		t.Code = runtimev1.Type_CODE_INT64
-	case "LARGEINT":
-		t.Code = runtimev1.Type_CODE_INT128
-	case "FLOAT":
-		t.Code = runtimev1.Type_CODE_FLOAT32
-	case "DOUBLE":
-		t.Code = runtimev1.Type_CODE_FLOAT64
-	case "DECIMAL":
-		t.Code = runtimev1.Type_CODE_STRING
-	case "CHAR", "VARCHAR", "STRING", "TEXT":
-		t.Code = runtimev1.Type_CODE_STRING
-	case "DATE":
-		t.Code = runtimev1.Type_CODE_DATE
-	case "DATETIME", "TIMESTAMP":
-		t.Code = runtimev1.Type_CODE_TIMESTAMP
-	case "JSON", "JSONB":
-		t.Code = runtimev1.Type_CODE_JSON
-	case "ARRAY":
-		t.Code = runtimev1.Type_CODE_ARRAY
-	case "MAP":
-		t.Code = runtimev1.Type_CODE_MAP
-	case "STRUCT":
-		t.Code = runtimev1.Type_CODE_STRUCT
-	case "BINARY", "VARBINARY", "BLOB":
-		// Note: StarRocks doesn't have BLOB type, but MySQL driver may report VARBINARY as BLOB
-		// Use CODE_STRING like MySQL driver for consistency
-		t.Code = runtimev1.Type_CODE_STRING
-	default:
-		return nil, errUnsupportedType
-	}
-	return t, nil
+	return mysqlolap.DatabaseTypeToRuntimeType(dbType)
 }
 
-// starrocksRows wraps sqlx.Rows to provide MapScan method.
-// This is required because if the correct type is not provided to Scan
-// mysql driver just returns byte arrays.
+// starrocksRows wraps the rows of a query result with the column types reported by the MySQL driver.
 type starrocksRows struct {
-	*sqlx.Rows
-	scanDest []any
+	drivers.Rows
 	colTypes []*sql.ColumnType
 }
-
-func (r *starrocksRows) MapScan(dest map[string]any) error {
-	err := r.Rows.Scan(r.scanDest...)
-	if err != nil {
-		return err
-	}
-	for i, ct := range r.colTypes {
-		fieldName := ct.Name()
-		valPtr := r.scanDest[i]
-		// Safety guard: prepareScanDest always allocates, but check anyway
-		if valPtr == nil {
-			dest[fieldName] = nil
-			continue
-		}
-		switch valPtr := valPtr.(type) {
-		case *sql.NullBool:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.Bool
-			} else {
-				dest[fieldName] = nil
-			}
-		case *sql.NullInt16:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.Int16
-			} else {
-				dest[fieldName] = nil
-			}
-		case *sql.NullInt32:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.Int32
-			} else {
-				dest[fieldName] = nil
-			}
-		case *sql.NullInt64:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.Int64
-			} else {
-				dest[fieldName] = nil
-			}
-		case *sql.NullFloat64:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.Float64
-			} else {
-				dest[fieldName] = nil
-			}
-		case *sql.NullString:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.String
-			} else {
-				dest[fieldName] = nil
-			}
-		case *sql.NullTime:
-			if valPtr.Valid {
-				dest[fieldName] = valPtr.Time
-			} else {
-				dest[fieldName] = nil
-			}
-		default:
-			// Handle ARRAY, MAP, STRUCT, BYTES and other complex types
-			// These are scanned into *any in prepareScanDest
-			if ptr, ok := valPtr.(*any); ok {
-				dest[fieldName] = *ptr
-			} else {
-				// Fallback: store the pointer's underlying value directly
-				dest[fieldName] = valPtr
-			}
-		}
-	}
-	return nil
-}
-
-func prepareScanDest(schema *runtimev1.StructType) []any {
-	scanList := make([]any, len(schema.Fields))
-	for i, field := range schema.Fields {
-		var dest any
-		switch field.Type.Code {
-		case runtimev1.Type_CODE_BOOL:
-			dest = &sql.NullBool{}
-		case runtimev1.Type_CODE_INT8:
-			dest = &sql.NullInt16{}
-		case runtimev1.Type_CODE_INT16:
-			dest = &sql.NullInt16{}
-		case runtimev1.Type_CODE_INT32:
-			dest = &sql.NullInt32{}
-		case runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_INT128:
-			dest = &sql.NullInt64{}
-		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-			dest = &sql.NullFloat64{}
-		case runtimev1.Type_CODE_STRING:
-			dest = &sql.NullString{}
-		case runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
-			dest = &sql.NullString{}
-		case runtimev1.Type_CODE_TIMESTAMP:
-			// MySQL driver returns DATETIME as time.Time when parseTime=true in DSN
-			dest = &sql.NullTime{}
-		case runtimev1.Type_CODE_JSON:
-			dest = &sql.NullString{}
-		default:
-			dest = new(any)
-		}
-		scanList[i] = dest
-	}
-	return scanList
-}
@@ -0,0 +1,252 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"go.uber.org/zap"
+)
+
+// requestTimeout is the timeout for posting a card to a single webhook.
+const requestTimeout = 30 * time.Second
+
+type notifier struct {
+	client *http.Client
+	props  *NotifierProperties
+	logger *zap.Logger
+}
+
+type NotifierProperties struct {
+	Webhooks []string `mapstructure:"webhooks"`
+}
+
+func newNotifier(propsMap map[string]any, logger *zap.Logger) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	n := &notifier{
+		client: &http.Client{Timeout: requestTimeout},
+		props:  props,
+		logger: logger,
+	}
+	return n, nil
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	card := newCard()
+	card.addTitle(s.DisplayName)
+	card.addText(fmt.Sprintf("Your report for **%s** is ready to view.", s.ReportTime.Format(time.RFC1123)))
+	if s.Summary != "" {
+		card.addText(fmt.Sprintf("**Summary** %s", s.Summary))
+	}
+
+	card.addLink("Open in browser", s.OpenLink)
+	card.addLink(fmt.Sprintf("Download %s", s.DownloadFormat), s.DownloadLink)
+	card.addLink("Unsubscribe", s.UnsubscribeLink)
+
+	return n.send(card)
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	executionTime := s.ExecutionTime.Format(time.RFC1123)
+
+	card := newCard()
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		if s.IsRecover {
+			card.addTitle(fmt.Sprintf("Recovered: %s", s.DisplayName))
+			card.addText(fmt.Sprintf("The alert has recovered on **%s** from a previous failure.", executionTime))
+		} else {
+			card.addTitle(s.DisplayName)
+			card.addText(fmt.Sprintf("The alert has passed on **%s**.", executionTime))
+		}
+		card.addLink("Open in browser", s.OpenLink)
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		card.addTitle(s.DisplayName)
+		card.addText(fmt.Sprintf("Your alert triggered for **%s**.", executionTime))
+		if s.MutedExecutions > 0 {
+			card.addText(fmt.Sprintf("The alert kept failing during %d checks while it was muted.", s.MutedExecutions))
+		}
+		card.addText("The first row that matched your alert criteria is:")
+		card.addFacts(s.FailRow)
+		card.addLink("Open in browser", s.OpenLink)
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		card.addTitle(s.DisplayName)
+		card.addText(fmt.Sprintf("The alert failed to evaluate on **%s**. It failed with the following error message:", executionTime))
+		card.addError(s.ExecutionError)
+		// There's no useful dashboard state to open for an errored alert, so we link to the edit page.
+		card.addLink("Open in browser", s.EditLink)
+	default:
+		return fmt.Errorf("unknown assertion status: %v", s.Status)
+	}
+
+	card.addLink("Edit or unsubscribe", s.EditLink)
+
+	return n.send(card)
+}
+
+// send posts the card to each of the configured incoming webhooks.
+// A failing webhook does not prevent delivery to the others; the failures are returned together.
+func (n *notifier) send(card *adaptiveCard) error {
+	payload, err := json.Marshal(&message{
+		Type: "message",
+		Attachments: []attachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content:     card,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("teams card error: %w", err)
+	}
+
+	var errs []error
+	for _, webhook := range n.props.Webhooks {
+		err := n.post(webhook, payload)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("teams webhook error: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *notifier) post(webhook string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func EncodeProps(webhooks []string) map[string]any {
+	return map[string]any{
+		"webhooks": pbutil.ToSliceAny(webhooks),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// message is the envelope expected by Teams incoming webhooks.
+type message struct {
+	Type        string       `json:"type"`
+	Attachments []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	ContentType string        `json:"contentType"`
+	Content     *adaptiveCard `json:"content"`
+}
+
+// adaptiveCard is a minimal representation of an Adaptive Card.
+// See https://adaptivecards.io/explorer/ for the full schema.
+type adaptiveCard struct {
+	Schema  string         `json:"$schema"`
+	Type    string         `json:"type"`
+	Version string         `json:"version"`
+	Body    []cardElement  `json:"body"`
+	Actions []cardAction   `json:"actions,omitempty"`
+	MSTeams map[string]any `json:"msteams,omitempty"`
+}
+
+type cardElement struct {
+	Type   string     `json:"type"`
+	Text   string     `json:"text,omitempty"`
+	Size   string     `json:"size,omitempty"`
+	Weight string     `json:"weight,omitempty"`
+	Color  string     `json:"color,omitempty"`
+	Wrap   bool       `json:"wrap,omitempty"`
+	Facts  []cardFact `json:"facts,omitempty"`
+}
+
+type cardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type cardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func newCard() *adaptiveCard {
+	return &adaptiveCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		MSTeams: map[string]any{"width": "Full"},
+	}
+}
+
+func (c *adaptiveCard) addTitle(txt string) {
+	c.Body = append(c.Body, cardElement{Type: "TextBlock", Text: txt, Size: "Medium", Weight: "Bolder", Wrap: true})
+}
+
+func (c *adaptiveCard) addText(txt string) {
+	c.Body = append(c.Body, cardElement{Type: "TextBlock", Text: txt, Wrap: true})
+}
+
+func (c *adaptiveCard) addError(txt string) {
+	c.Body = append(c.Body, cardElement{Type: "TextBlock", Text: txt, Color: "Attention", Wrap: true})
+}
+
+// addFacts adds a fact set with the row's values sorted by key.
+func (c *adaptiveCard) addFacts(row map[string]any) {
+	if len(row) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(row))
+	for k := range row {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	facts := make([]cardFact, len(keys))
+	for i, k := range keys {
+		facts[i] = cardFact{Title: k, Value: fmt.Sprintf("%v", row[k])}
+	}
+	c.Body = append(c.Body, cardElement{Type: "FactSet", Facts: facts})
+}
+
+// addLink adds an action that opens the given URL. It is a no-op for empty URLs (e.g. when running outside of Rill Cloud).
+func (c *adaptiveCard) addLink(title, url string) {
+	if url == "" {
+		return
+	}
+	c.Actions = append(c.Actions, cardAction{Type: "Action.OpenUrl", Title: title, URL: url})
+}
@@ -0,0 +1,122 @@
+package teams
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var msg message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(EncodeProps([]string{srv.URL}), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		DisplayName:   "My Alert",
+		ExecutionTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"total": 10, "country": "Denmark"},
+		OpenLink:      "https://example.com/open",
+		EditLink:      "https://example.com/edit",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "message", msg.Type)
+	require.Len(t, msg.Attachments, 1)
+	require.Equal(t, "application/vnd.microsoft.card.adaptive", msg.Attachments[0].ContentType)
+
+	card := msg.Attachments[0].Content
+	require.Equal(t, "AdaptiveCard", card.Type)
+	require.Equal(t, "My Alert", card.Body[0].Text)
+	require.Equal(t, []cardFact{{Title: "country", Value: "Denmark"}, {Title: "total", Value: "10"}}, card.Body[3].Facts)
+	require.Equal(t, []cardAction{
+		{Type: "Action.OpenUrl", Title: "Open in browser", URL: "https://example.com/open"},
+		{Type: "Action.OpenUrl", Title: "Edit or unsubscribe", URL: "https://example.com/edit"},
+	}, card.Actions)
+}
+
+func TestSendAlertError(t *testing.T) {
+	var msg message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(EncodeProps([]string{srv.URL}), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		DisplayName:    "My Alert",
+		ExecutionTime:  time.Now(),
+		Status:         runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR,
+		ExecutionError: "table not found",
+	})
+	require.NoError(t, err)
+
+	card := msg.Attachments[0].Content
+	require.Equal(t, cardElement{Type: "TextBlock", Text: "table not found", Color: "Attention", Wrap: true}, card.Body[2])
+	require.Empty(t, card.Actions)
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	var msg message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(EncodeProps([]string{srv.URL}), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		DisplayName:     "My Report",
+		ReportTime:      time.Now(),
+		DownloadFormat:  "CSV",
+		OpenLink:        "https://example.com/open",
+		DownloadLink:    "https://example.com/download",
+		UnsubscribeLink: "https://example.com/unsubscribe",
+	})
+	require.NoError(t, err)
+
+	card := msg.Attachments[0].Content
+	require.Equal(t, "My Report", card.Body[0].Text)
+	require.Len(t, card.Actions, 3)
+	require.Equal(t, "Download CSV", card.Actions[1].Title)
+}
+
+func TestSendWebhookError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid card"))
+	}))
+	defer srv.Close()
+
+	var delivered int
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered++
+	}))
+	defer ok.Close()
+
+	// The failing webhook doesn't prevent delivery to the other webhooks
+	n, err := newNotifier(EncodeProps([]string{srv.URL, ok.URL, srv.URL}), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{DisplayName: "My Report", ReportTime: time.Now()})
+	require.ErrorContains(t, err, "unexpected status code 400: invalid card")
+	require.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+	require.Equal(t, 1, delivered)
+}
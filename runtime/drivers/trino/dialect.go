@@ -0,0 +1,207 @@
+package trino
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+)
+
+type dialect struct {
+	drivers.BaseDialect
+}
+
+var DialectTrino drivers.Dialect = func() drivers.Dialect {
+	d := &dialect{}
+	d.BaseDialect = drivers.NewBaseDialect(drivers.DialectNameTrino, drivers.DoubleQuotesEscapeIdentifier, drivers.DoubleQuotesEscapeIdentifier)
+	return d
+}()
+
+func (d *dialect) SupportsILike() bool {
+	return false
+}
+
+func (d *dialect) SupportsRegexMatch() bool {
+	return true
+}
+
+func (d *dialect) GetRegexMatchFunction() (string, error) {
+	return "regexp_like", nil
+}
+
+func (d *dialect) AnyValueExpression(expr string) string {
+	// any_value was only added in recent Trino versions, arbitrary is its older alias.
+	return fmt.Sprintf("arbitrary(%s)", expr)
+}
+
+func (d *dialect) HashExpression(expr string) (string, error) {
+	return fmt.Sprintf("lower(to_hex(md5(to_utf8(CAST(%s AS VARCHAR)))))", expr), nil
+}
+
+func (d *dialect) RedactExpression(expr string, keepLast uint32) (string, error) {
+	if keepLast == 0 {
+		return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE '****' END", expr), nil
+	}
+	// Trino doesn't have RIGHT, and substr with a negative start returns an empty string for values shorter than keepLast.
+	str := fmt.Sprintf("CAST(%s AS VARCHAR)", expr)
+	return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL WHEN length(%s) <= %d THEN concat('****', %s) ELSE concat('****', substr(%s, -%d)) END", expr, str, keepLast, str, str, keepLast), nil
+}
+
+func (d *dialect) OrderByExpression(name string, desc bool) string {
+	res := d.EscapeIdentifier(name)
+	if desc {
+		res += " DESC"
+	}
+	res += " NULLS LAST"
+	return res
+}
+
+func (d *dialect) OrderByAliasExpression(name string, desc bool) string {
+	res := d.EscapeAlias(name)
+	if desc {
+		res += " DESC"
+	}
+	res += " NULLS LAST"
+	return res
+}
+
+func (d *dialect) DimensionSelect(escapeTable string, dim *runtimev1.MetricsViewSpec_Dimension) (dimSelect, unnestClause string, err error) {
+	colName := d.EscapeIdentifier(dim.Name)
+	alias := d.EscapeAlias(dim.Name)
+	if !dim.Unnest {
+		expr, err := d.MetricsViewDimensionExpression(dim)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get dimension expression: %w", err)
+		}
+		return fmt.Sprintf(`(%s) AS %s`, expr, alias), "", nil
+	}
+
+	unnestColName := d.EscapeIdentifier(drivers.TempName(fmt.Sprintf("%s_%s_", "unnested", dim.Name)))
+	unnestTableName := drivers.TempName("tbl")
+	sel := fmt.Sprintf(`%s AS %s`, unnestColName, alias)
+	// Trino doesn't support the LATERAL keyword for UNNEST, but UNNEST in a FROM list can always reference the preceding tables.
+	if dim.Expression == "" {
+		return sel, fmt.Sprintf(`, UNNEST(%s.%s) AS %s(%s)`, escapeTable, colName, unnestTableName, unnestColName), nil
+	}
+	return sel, fmt.Sprintf(`, UNNEST(%s) AS %s(%s)`, dim.Expression, unnestTableName, unnestColName), nil
+}
+
+func (d *dialect) LateralUnnest(expr, tableAlias, colName string) (tbl string, tupleStyle, auto bool, err error) {
+	return fmt.Sprintf(`UNNEST(%s) AS %s(%s)`, expr, tableAlias, d.EscapeIdentifier(colName)), true, false, nil
+}
+
+func (d *dialect) DateTruncExpr(dim *runtimev1.MetricsViewSpec_Dimension, grain runtimev1.TimeGrain, tz string, firstDayOfWeek, firstMonthOfYear int) (string, error) {
+	if tz == "UTC" || tz == "Etc/UTC" {
+		tz = ""
+	}
+	if tz != "" {
+		_, err := time.LoadLocation(tz)
+		if err != nil {
+			return "", fmt.Errorf("invalid time zone %q: %w", tz, err)
+		}
+	}
+
+	specifier := strings.ToLower(d.ConvertToDateTruncSpecifier(grain))
+
+	var expr string
+	if dim.Expression != "" {
+		expr = fmt.Sprintf("(%s)", dim.Expression)
+	} else {
+		expr = d.EscapeIdentifier(dim.Column)
+	}
+
+	// Convert the value to a UTC timestamp with time zone so the result doesn't depend on the session time zone, which the driver doesn't set.
+	// DATE values are interpreted as midnight UTC. Other values without a time zone are interpreted in the session time zone by the cast.
+	if dim.DataType != nil && dim.DataType.Code == runtimev1.Type_CODE_DATE {
+		expr = fmt.Sprintf("with_timezone(CAST(%s AS TIMESTAMP(6)), 'UTC')", expr)
+	} else {
+		expr = fmt.Sprintf("at_timezone(CAST(%s AS TIMESTAMP(6) WITH TIME ZONE), 'UTC')", expr)
+	}
+
+	// With a timezone, truncate in local time by converting the value to the time zone.
+	inner := expr
+	if tz != "" {
+		inner = fmt.Sprintf("at_timezone(%s, '%s')", expr, tz)
+	}
+
+	var result string
+	switch {
+	case grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && firstDayOfWeek > 1:
+		offset := 8 - firstDayOfWeek
+		result = fmt.Sprintf("date_add('day', -%d, date_trunc('%s', date_add('day', %d, %s)))", offset, specifier, offset, inner)
+	case grain == runtimev1.TimeGrain_TIME_GRAIN_YEAR && firstMonthOfYear > 1:
+		offset := 13 - firstMonthOfYear
+		result = fmt.Sprintf("date_add('month', -%d, date_trunc('%s', date_add('month', %d, %s)))", offset, specifier, offset, inner)
+	default:
+		result = fmt.Sprintf("date_trunc('%s', %s)", specifier, inner)
+	}
+
+	if tz != "" {
+		result = fmt.Sprintf("at_timezone(%s, 'UTC')", result)
+	}
+	return result, nil
+}
+
+func (d *dialect) DateDiff(grain runtimev1.TimeGrain, t1, t2 time.Time) (string, error) {
+	unit := strings.ToLower(d.ConvertToDateTruncSpecifier(grain))
+	return fmt.Sprintf("date_diff('%s', %s, %s)", unit, timestampLiteral(t1), timestampLiteral(t2)), nil
+}
+
+func (d *dialect) IntervalSubtract(tsExpr, unitExpr string, grain runtimev1.TimeGrain) (string, error) {
+	return fmt.Sprintf("date_add('%s', -(%s), %s)", strings.ToLower(d.ConvertToDateTruncSpecifier(grain)), unitExpr, tsExpr), nil
+}
+
+func (d *dialect) SelectTimeRangeBins(start, end time.Time, grain runtimev1.TimeGrain, alias string, tz *time.Location, firstDay, firstMonth int) (string, []any, error) {
+	g := timeutil.TimeGrainFromAPI(grain)
+	start = timeutil.TruncateTime(start, g, tz, firstDay, firstMonth)
+	var values []string
+	for t := start; t.Before(end); t = timeutil.OffsetTime(t, g, 1, tz) {
+		values = append(values, fmt.Sprintf("(%s)", timestampLiteral(t)))
+	}
+	if len(values) == 0 {
+		return fmt.Sprintf("SELECT CAST(NULL AS TIMESTAMP(3) WITH TIME ZONE) AS %s WHERE false", d.EscapeAlias(alias)), nil, nil
+	}
+	return fmt.Sprintf("SELECT * FROM (VALUES %s) AS t(%s)", strings.Join(values, ", "), d.EscapeAlias(alias)), nil, nil
+}
+
+func (d *dialect) ColumnCardinality(db, dbSchema, table, column string) (string, error) {
+	return fmt.Sprintf("SELECT approx_distinct(%s) AS count FROM %s", d.EscapeIdentifier(column), d.EscapeTable(db, dbSchema, table)), nil
+}
+
+func (d *dialect) ColumnDescriptiveStatistics(db, dbSchema, table, column string) (string, error) {
+	return fmt.Sprintf("SELECT "+
+		"CAST(min(%[1]s) AS DOUBLE) as min, "+
+		"CAST(approx_percentile(%[1]s, 0.25) AS DOUBLE) as q25, "+
+		"CAST(approx_percentile(%[1]s, 0.5) AS DOUBLE) as q50, "+
+		"CAST(approx_percentile(%[1]s, 0.75) AS DOUBLE) as q75, "+
+		"CAST(max(%[1]s) AS DOUBLE) as max, "+
+		"CAST(avg(%[1]s) AS DOUBLE) as mean, "+
+		"CAST(stddev_samp(%[1]s) AS DOUBLE) as sd "+
+		"FROM %[2]s WHERE %[1]s IS NOT NULL",
+		d.EscapeIdentifier(column),
+		d.EscapeTable(db, dbSchema, table)), nil
+}
+
+func (d *dialect) IsNonNullFinite(floatColumn string) string {
+	sanitizedFloatColumn := d.EscapeIdentifier(floatColumn)
+	return fmt.Sprintf("%s IS NOT NULL AND is_finite(%s)", sanitizedFloatColumn, sanitizedFloatColumn)
+}
+
+func (d *dialect) ColumnNumericHistogramBucket(db, dbSchema, table, column string) (string, error) {
+	sanitizedColumnName := d.EscapeIdentifier(column)
+	return fmt.Sprintf(`SELECT (approx_percentile(%s, 0.75)-approx_percentile(%s, 0.25)) AS iqr, approx_distinct(%s) AS count, (max(%s) - min(%s)) AS "range" FROM %s`,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		sanitizedColumnName,
+		d.EscapeTable(db, dbSchema, table)), nil
+}
+
+// timestampLiteral returns a TIMESTAMP WITH TIME ZONE literal for t in UTC.
+func timestampLiteral(t time.Time) string {
+	return fmt.Sprintf("TIMESTAMP '%s UTC'", t.UTC().Format("2006-01-02 15:04:05.000"))
+}
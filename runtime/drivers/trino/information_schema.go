@@ -0,0 +1,361 @@
+package trino
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pagination"
+)
+
+// Trino structure: Catalog -> Schema -> Table
+// We map: Database = catalog, DatabaseSchema = schema
+// Only the connector's catalog is listed since listing every catalog can be slow for lake catalogs.
+
+// ListDatabaseSchemas implements drivers.InformationSchema.
+func (c *connection) ListDatabaseSchemas(ctx context.Context, pageSize uint32, pageToken string) ([]*drivers.DatabaseSchemaInfo, string, error) {
+	limit := pagination.ValidPageSize(pageSize, drivers.DefaultPageSize)
+
+	q := fmt.Sprintf("SELECT schema_name FROM %s.information_schema.schemata WHERE schema_name <> 'information_schema'", c.Dialect().EscapeIdentifier(c.catalog))
+	var args []any
+	if pageToken != "" {
+		var startAfter string
+		if err := pagination.UnmarshalPageToken(pageToken, &startAfter); err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		q += " AND schema_name > ?"
+		args = append(args, startAfter)
+	}
+	q += fmt.Sprintf(" ORDER BY schema_name LIMIT %d", limit+1)
+
+	rows, err := c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var res []*drivers.DatabaseSchemaInfo
+	for rows.Next() {
+		var schema string
+		if err := rows.Scan(&schema); err != nil {
+			return nil, "", err
+		}
+		res = append(res, &drivers.DatabaseSchemaInfo{
+			Database:       c.catalog,
+			DatabaseSchema: schema,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(res) > limit {
+		res = res[:limit]
+		next = pagination.MarshalPageToken(res[len(res)-1].DatabaseSchema)
+	}
+	return res, next, nil
+}
+
+// ListTables implements drivers.InformationSchema.
+func (c *connection) ListTables(ctx context.Context, database, databaseSchema string, pageSize uint32, pageToken string) ([]*drivers.TableInfo, string, error) {
+	limit := pagination.ValidPageSize(pageSize, drivers.DefaultPageSize)
+	if database == "" {
+		database = c.catalog
+	}
+	if databaseSchema == "" {
+		databaseSchema = c.schema
+	}
+
+	q := fmt.Sprintf("SELECT table_name, table_type = 'VIEW' FROM %s.information_schema.tables WHERE table_schema = ?", c.Dialect().EscapeIdentifier(database))
+	args := []any{databaseSchema}
+	if pageToken != "" {
+		var startAfter string
+		if err := pagination.UnmarshalPageToken(pageToken, &startAfter); err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		q += " AND table_name > ?"
+		args = append(args, startAfter)
+	}
+	q += fmt.Sprintf(" ORDER BY table_name LIMIT %d", limit+1)
+
+	rows, err := c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var res []*drivers.TableInfo
+	for rows.Next() {
+		var name string
+		var view bool
+		if err := rows.Scan(&name, &view); err != nil {
+			return nil, "", err
+		}
+		res = append(res, &drivers.TableInfo{
+			Name: name,
+			View: view,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(res) > limit {
+		res = res[:limit]
+		next = pagination.MarshalPageToken(res[len(res)-1].Name)
+	}
+	return res, next, nil
+}
+
+// Lookup implements drivers.InformationSchema.
+func (c *connection) Lookup(ctx context.Context, database, databaseSchema, name string) (*drivers.OlapTable, error) {
+	if database == "" {
+		database = c.catalog
+	}
+	if databaseSchema == "" {
+		databaseSchema = c.schema
+	}
+	if databaseSchema == "" {
+		return nil, fmt.Errorf("trino: the schema of table %q must be set since the connector has no default schema", name)
+	}
+
+	// Trino stores identifiers in lower case, so names are matched in lower case.
+	// Filtering on plain columns (instead of LOWER(table_name)) lets Trino push the filter down to the catalog.
+	escapedCatalog := c.Dialect().EscapeIdentifier(database)
+	q := fmt.Sprintf(`
+		SELECT t.table_name, t.table_type = 'VIEW', c.column_name, c.data_type
+		FROM %s.information_schema.tables t
+		JOIN %s.information_schema.columns c ON c.table_schema = t.table_schema AND c.table_name = t.table_name
+		WHERE t.table_schema = ? AND t.table_name = ? AND c.table_schema = ? AND c.table_name = ?
+		ORDER BY c.ordinal_position
+	`, escapedCatalog, escapedCatalog)
+	schema, table := strings.ToLower(databaseSchema), strings.ToLower(name)
+
+	rows, err := c.db.QueryxContext(ctx, q, schema, table, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tableName string
+	var view bool
+	var fields []*runtimev1.StructType_Field
+	unsupportedCols := make(map[string]string)
+	for rows.Next() {
+		var colName, dataType string
+		if err := rows.Scan(&tableName, &view, &colName, &dataType); err != nil {
+			return nil, err
+		}
+		t := databaseTypeToPB(dataType)
+		if t.Code == runtimev1.Type_CODE_UNSPECIFIED {
+			unsupportedCols[colName] = dataType
+			continue
+		}
+		fields = append(fields, &runtimev1.StructType_Field{
+			Name: colName,
+			Type: t,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if tableName == "" {
+		return nil, drivers.ErrNotFound
+	}
+
+	return &drivers.OlapTable{
+		Database:                database,
+		DatabaseSchema:          databaseSchema,
+		IsDefaultDatabase:       database == c.catalog,
+		IsDefaultDatabaseSchema: databaseSchema == c.schema,
+		Name:                    tableName,
+		View:                    view,
+		Schema:                  &runtimev1.StructType{Fields: fields},
+		UnsupportedCols:         unsupportedCols,
+	}, nil
+}
+
+// All implements drivers.InformationSchema.
+func (c *connection) All(ctx context.Context, like string, pageSize uint32, pageToken string) ([]*drivers.OlapTable, string, error) {
+	limit := pagination.ValidPageSize(pageSize, drivers.DefaultPageSize)
+
+	q := fmt.Sprintf("SELECT table_schema, table_name, table_type = 'VIEW' FROM %s.information_schema.tables WHERE table_schema <> 'information_schema'", c.Dialect().EscapeIdentifier(c.catalog))
+	var args []any
+	if like != "" {
+		q += " AND table_name LIKE ?"
+		args = append(args, strings.ToLower(like))
+	}
+	if pageToken != "" {
+		var startSchema, startName string
+		if err := pagination.UnmarshalPageToken(pageToken, &startSchema, &startName); err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		q += " AND (table_schema > ? OR (table_schema = ? AND table_name > ?))"
+		args = append(args, startSchema, startSchema, startName)
+	}
+	q += fmt.Sprintf(" ORDER BY table_schema, table_name LIMIT %d", limit+1)
+
+	rows, err := c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var res []*drivers.OlapTable
+	for rows.Next() {
+		var schema, name string
+		var view bool
+		if err := rows.Scan(&schema, &name, &view); err != nil {
+			return nil, "", err
+		}
+		res = append(res, &drivers.OlapTable{
+			Database:                c.catalog,
+			DatabaseSchema:          schema,
+			IsDefaultDatabase:       true,
+			IsDefaultDatabaseSchema: schema == c.schema,
+			Name:                    name,
+			View:                    view,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[len(res)-1]
+		next = pagination.MarshalPageToken(last.DatabaseSchema, last.Name)
+	}
+	return res, next, nil
+}
+
+// LoadPhysicalSize implements drivers.InformationSchema.
+// Trino doesn't expose the physical size of tables in a connector-independent way, so it is left unset.
+func (c *connection) LoadPhysicalSize(ctx context.Context, tables []*drivers.OlapTable) error {
+	return nil
+}
+
+// LoadDDL implements drivers.InformationSchema.
+func (c *connection) LoadDDL(ctx context.Context, table *drivers.OlapTable) error {
+	database := table.Database
+	if database == "" {
+		database = c.catalog
+	}
+	schema := table.DatabaseSchema
+	if schema == "" {
+		schema = c.schema
+	}
+
+	typ := "TABLE"
+	if table.View {
+		typ = "VIEW"
+	}
+	q := fmt.Sprintf("SHOW CREATE %s %s", typ, c.Dialect().EscapeTable(database, schema, table.Name))
+
+	var ddl string
+	err := c.db.QueryRowxContext(ctx, q).Scan(&ddl)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	table.DDL = ddl
+	return nil
+}
+
+// databaseTypeToPB maps a Trino type to a runtime type.
+// Types that are not supported are returned with CODE_UNSPECIFIED.
+func databaseTypeToPB(dbt string) *runtimev1.Type {
+	t := &runtimev1.Type{Nullable: true, RawType: dbt}
+	switch baseType(dbt) {
+	case "boolean":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "tinyint":
+		t.Code = runtimev1.Type_CODE_INT8
+	case "smallint":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "integer":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "bigint":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "real":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "double":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "decimal":
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case "varchar", "char", "uuid", "ipaddress", "time", "time with time zone", "interval year to month", "interval day to second":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "varbinary":
+		t.Code = runtimev1.Type_CODE_BYTES
+	case "date":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "timestamp", "timestamp with time zone":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "json", "map", "row":
+		// The driver returns maps and rows as decoded JSON values.
+		t.Code = runtimev1.Type_CODE_JSON
+	case "array":
+		elem := databaseTypeToPB(elementType(dbt))
+		if elem.Code == runtimev1.Type_CODE_UNSPECIFIED {
+			return &runtimev1.Type{Code: runtimev1.Type_CODE_UNSPECIFIED, Nullable: true, RawType: dbt}
+		}
+		t.Code = runtimev1.Type_CODE_ARRAY
+		t.ArrayElementType = elem
+	default:
+		t.Code = runtimev1.Type_CODE_UNSPECIFIED
+	}
+	return t
+}
+
+// baseType returns the lower-cased type name without parameters.
+// For example, "decimal(10,2)" becomes "decimal" and "timestamp(3) with time zone" becomes "timestamp with time zone".
+func baseType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+	start := strings.IndexByte(t, '(')
+	if start < 0 {
+		return t
+	}
+	end := matchingParen(t, start)
+	if end < 0 {
+		return t[:start]
+	}
+	return strings.TrimSpace(t[:start] + t[end+1:])
+}
+
+// elementType returns the first parameter of a parameterized type. For example, "array(varchar)" becomes "varchar".
+func elementType(t string) string {
+	start := strings.IndexByte(t, '(')
+	if start < 0 {
+		return ""
+	}
+	end := matchingParen(t, start)
+	if end < 0 {
+		return ""
+	}
+	return strings.TrimSpace(t[start+1 : end])
+}
+
+func matchingParen(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,188 @@
+package trino
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var tracer = otel.Tracer("github.com/rilldata/rill/runtime/drivers/trino")
+
+var _ drivers.OLAPStore = &connection{}
+
+// Dialect implements drivers.OLAPStore.
+func (c *connection) Dialect() drivers.Dialect {
+	return DialectTrino
+}
+
+// MayBeScaledToZero implements drivers.OLAPStore.
+func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
+	return false
+}
+
+// WithConnection implements drivers.OLAPStore.
+func (c *connection) WithConnection(ctx context.Context, priority int, fn drivers.WithConnectionFunc) error {
+	return fmt.Errorf("trino: WithConnection not supported")
+}
+
+// Exec implements drivers.OLAPStore.
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	res, err := c.Query(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if stmt.DryRun {
+		return nil
+	}
+	return res.Close()
+}
+
+// Query implements drivers.OLAPStore.
+func (c *connection) Query(ctx context.Context, stmt *drivers.Statement) (res *drivers.Result, outErr error) {
+	if c.configProp.LogQueries {
+		fields := []zap.Field{
+			zap.String("sql", stmt.Query),
+			zap.Any("args", stmt.Args),
+			observability.ZapCtx(ctx),
+		}
+		if len(stmt.QueryAttributes) > 0 {
+			fields = append(fields, zap.Any("query_attributes", stmt.QueryAttributes))
+		}
+		c.logger.Info("trino query", fields...)
+	}
+	if stmt.DryRun {
+		// EXPLAIN (TYPE VALIDATE) analyzes the query without planning or running it.
+		rows, err := c.db.QueryxContext(ctx, "EXPLAIN (TYPE VALIDATE) "+stmt.Query, stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+		return nil, rows.Close()
+	}
+
+	// Start a span covering query execution
+	ctx, span := tracer.Start(ctx, "olap.query", oteltrace.WithAttributes(attribute.String("driver", "trino"), attribute.String("connector", c.connectorName)))
+
+	start := time.Now()
+	defer func() {
+		totalLatency := time.Since(start).Milliseconds()
+		cancelled := errors.Is(outErr, context.Canceled)
+		spanAttrs := []attribute.KeyValue{
+			attribute.Int64("total_latency_ms", totalLatency),
+			attribute.Bool("cancelled", cancelled),
+		}
+		if outErr != nil {
+			spanAttrs = append(spanAttrs, attribute.String("error", outErr.Error()))
+		}
+		span.SetAttributes(spanAttrs...)
+		span.End()
+	}()
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	rows, err := c.db.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		rows.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	res = &drivers.Result{Rows: rows, Schema: schema}
+	res.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil
+	})
+
+	return res, nil
+}
+
+// Head implements drivers.OLAPStore.
+func (c *connection) Head(ctx context.Context, db, schema, table string, limit int64) (*drivers.Result, error) {
+	tbl, err := c.Lookup(ctx, db, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []string
+	for _, field := range tbl.Schema.Fields {
+		columns = append(columns, c.Dialect().EscapeIdentifier(field.Name))
+	}
+
+	limitClause := ""
+	if limit > 0 {
+		limitClause = fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	return c.Query(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(columns, ", "), c.Dialect().EscapeTable(tbl.Database, tbl.DatabaseSchema, tbl.Name), limitClause),
+	})
+}
+
+// QuerySchema implements drivers.OLAPStore.
+func (c *connection) QuerySchema(ctx context.Context, query string, args []any) (*runtimev1.StructType, error) {
+	query = fmt.Sprintf("SELECT * FROM (%s) LIMIT 0", query)
+
+	res, err := c.Query(ctx, &drivers.Statement{
+		Query:            query,
+		Args:             args,
+		ExecutionTimeout: drivers.DefaultQuerySchemaTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	return res.Schema, nil
+}
+
+// InformationSchema implements drivers.OLAPStore.
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return c
+}
+
+// EstimateSize implements drivers.OLAPStore.
+func (c *connection) EstimateSize(ctx context.Context) (int64, error) {
+	return -1, nil
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: databaseTypeToPB(ct.DatabaseTypeName()),
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
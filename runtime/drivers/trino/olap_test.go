@@ -0,0 +1,119 @@
+package trino_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/rilldata/rill/runtime/testruntime/testmode"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOLAP(t *testing.T) {
+	testmode.Expensive(t)
+
+	cfg := testruntime.AcquireConnector(t, "trino")
+	conn, err := drivers.Open("trino", "trino", "default", cfg, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	olap, ok := conn.AsOLAP("default")
+	require.True(t, ok)
+
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: `
+		CREATE TABLE events AS SELECT * FROM (VALUES
+			(1, 'US', 1.5, TIMESTAMP '2024-01-01 10:00:00.000 UTC', ARRAY['a', 'b']),
+			(2, 'DK', 2.5, TIMESTAMP '2024-01-02 23:30:00.000 UTC', ARRAY['b']),
+			(3, NULL, NULL, NULL, NULL)
+		) AS t(id, country, amount, event_time, tags)
+	`})
+	require.NoError(t, err)
+
+	t.Run("Query", func(t *testing.T) { testQuery(t, olap) })
+	t.Run("DryRun", func(t *testing.T) { testDryRun(t, olap) })
+	t.Run("DateTrunc", func(t *testing.T) { testDateTrunc(t, olap) })
+	t.Run("InformationSchema", func(t *testing.T) { testInformationSchema(t, olap) })
+}
+
+func testQuery(t *testing.T, olap drivers.OLAPStore) {
+	res, err := olap.Query(context.Background(), &drivers.Statement{
+		Query: "SELECT id, country, amount, event_time, tags FROM events WHERE id < ? ORDER BY id",
+		Args:  []any{3},
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	require.Len(t, res.Schema.Fields, 5)
+	require.Equal(t, runtimev1.Type_CODE_INT32, res.Schema.Fields[0].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, res.Schema.Fields[1].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_DECIMAL, res.Schema.Fields[2].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, res.Schema.Fields[3].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_ARRAY, res.Schema.Fields[4].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, res.Schema.Fields[4].Type.ArrayElementType.Code)
+
+	var ids []int64
+	var times []time.Time
+	for res.Next() {
+		row := make(map[string]any)
+		require.NoError(t, res.MapScan(row))
+		ids = append(ids, row["id"].(int64))
+		times = append(times, row["event_time"].(time.Time))
+	}
+	require.NoError(t, res.Err())
+	require.Equal(t, []int64{1, 2}, ids)
+	require.True(t, times[0].Equal(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)))
+}
+
+func testDryRun(t *testing.T, olap drivers.OLAPStore) {
+	err := olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT id FROM events", DryRun: true})
+	require.NoError(t, err)
+
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT nonexistent FROM events", DryRun: true})
+	require.Error(t, err)
+}
+
+func testDateTrunc(t *testing.T, olap drivers.OLAPStore) {
+	dim := &runtimev1.MetricsViewSpec_Dimension{Column: "event_time"}
+	expr, err := olap.Dialect().DateTruncExpr(dim, runtimev1.TimeGrain_TIME_GRAIN_DAY, "America/New_York", 1, 1)
+	require.NoError(t, err)
+
+	res, err := olap.Query(context.Background(), &drivers.Statement{
+		Query: "SELECT " + expr + " FROM events WHERE id = 2",
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	require.True(t, res.Next())
+	var ts time.Time
+	require.NoError(t, res.Scan(&ts))
+	// 2024-01-02 23:30 UTC is 2024-01-02 18:30 in New York, which truncates to 2024-01-02 00:00 New York time.
+	require.True(t, ts.Equal(time.Date(2024, 1, 2, 5, 0, 0, 0, time.UTC)), "got %v", ts)
+	require.NoError(t, res.Err())
+}
+
+func testInformationSchema(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+
+	tables, _, err := olap.InformationSchema().All(ctx, "%vent%", 0, "")
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	require.Equal(t, "memory", tables[0].Database)
+	require.Equal(t, "default", tables[0].DatabaseSchema)
+	require.Equal(t, "events", tables[0].Name)
+
+	tbl, err := olap.InformationSchema().Lookup(ctx, "", "", "events")
+	require.NoError(t, err)
+	require.True(t, tbl.IsDefaultDatabase)
+	require.True(t, tbl.IsDefaultDatabaseSchema)
+	require.Len(t, tbl.Schema.Fields, 5)
+	require.Equal(t, "id", tbl.Schema.Fields[0].Name)
+
+	_, err = olap.InformationSchema().Lookup(ctx, "", "", "nonexistent")
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+}
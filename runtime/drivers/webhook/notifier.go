@@ -0,0 +1,303 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/eapache/go-resiliency/retrier"
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader contains the hex encoded HMAC-SHA256 of "<timestamp>.<body>", prefixed with "sha256=".
+	SignatureHeader = "X-Rill-Signature"
+	// TimestampHeader contains the Unix time (in seconds) at which the payload was signed.
+	TimestampHeader = "X-Rill-Timestamp"
+)
+
+// Retry settings for delivering a payload to a single URL.
+// They are variables so tests can shorten the backoff.
+var (
+	retryCount = 3
+	retryWait  = time.Second
+)
+
+// requestTimeout is the timeout for a single webhook request (excluding retries).
+const requestTimeout = 30 * time.Second
+
+// maxErrorBodyBytes caps how much of an error response body is included in error messages.
+const maxErrorBodyBytes = 512
+
+//go:embed templates/webhook/*
+var templatesFS embed.FS
+
+type notifier struct {
+	client    *http.Client
+	secret    string
+	props     *NotifierProperties
+	templates *template.Template
+	logger    *zap.Logger
+}
+
+// NotifierProperties are the properties of a webhook notifier in an AlertSpec or ReportSpec.
+type NotifierProperties struct {
+	// URLs to POST the payload to.
+	URLs []string `mapstructure:"urls"`
+	// Template optionally overrides the default JSON payload.
+	// It is a Go text/template that is executed with AlertStatusData or ReportStatusData and must render valid JSON.
+	Template string `mapstructure:"template"`
+}
+
+func newNotifier(secret string, propsMap map[string]any, logger *zap.Logger) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl := template.New("").Funcs(templateFuncs)
+	tpl = template.Must(tpl.ParseFS(templatesFS, "templates/webhook/*.json"))
+	if props.Template != "" {
+		_, err := tpl.New("custom").Parse(props.Template)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook template: %w", err)
+		}
+	}
+
+	n := &notifier{
+		client:    &http.Client{Timeout: requestTimeout},
+		secret:    secret,
+		props:     props,
+		templates: tpl,
+		logger:    logger,
+	}
+	return n, nil
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	d := &ReportStatusData{
+		Type:            "scheduled_report",
+		DisplayName:     s.DisplayName,
+		ReportTime:      s.ReportTime.UTC().Format(time.RFC3339),
+		DownloadFormat:  s.DownloadFormat,
+		OpenLink:        s.OpenLink,
+		DownloadLink:    s.DownloadLink,
+		UnsubscribeLink: s.UnsubscribeLink,
+		Summary:         s.Summary,
+	}
+
+	body, err := n.render("scheduled_report.json", d)
+	if err != nil {
+		return err
+	}
+	return n.post(body)
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	d := &AlertStatusData{
+		Type:            "alert_status",
+		DisplayName:     s.DisplayName,
+		ExecutionTime:   s.ExecutionTime.UTC().Format(time.RFC3339),
+		IsRecover:       s.IsRecover,
+		MutedExecutions: s.MutedExecutions,
+		OpenLink:        s.OpenLink,
+		EditLink:        s.EditLink,
+	}
+
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		d.Status = "pass"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		d.Status = "fail"
+		d.FailRow = s.FailRow
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		d.Status = "error"
+		d.ErrorMessage = s.ExecutionError
+	default:
+		return fmt.Errorf("unknown assertion status: %v", s.Status)
+	}
+
+	body, err := n.render("alert_status.json", d)
+	if err != nil {
+		return err
+	}
+	return n.post(body)
+}
+
+// render executes the named default template (or the custom template if configured) and validates that the output is JSON.
+func (n *notifier) render(name string, data any) ([]byte, error) {
+	t := n.templates.Lookup("custom")
+	if t == nil {
+		t = n.templates.Lookup(name)
+	}
+
+	buf := new(bytes.Buffer)
+	err := t.Execute(buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("webhook template error: %w", err)
+	}
+
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("webhook template error: rendered payload is not valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// post sends the payload to all configured URLs, retrying each with exponential backoff.
+// A failing URL does not prevent delivery to the others; the failures are returned together.
+func (n *notifier) post(body []byte) error {
+	var errs []error
+	for _, u := range n.props.URLs {
+		re := retrier.New(retrier.ExponentialBackoff(retryCount, retryWait), retryErrClassifier{})
+		err := re.Run(func() error {
+			return n.postOnce(u, body)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("webhook error: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *notifier) postOnce(u string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "rill-webhook")
+
+	if n.secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, ts)
+		req.Header.Set(SignatureHeader, "sha256="+Sign(n.secret, ts, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		n.logger.Debug("webhook request failed", zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	return &statusError{code: resp.StatusCode, body: string(msg)}
+}
+
+// Sign computes the hex encoded HMAC-SHA256 signature of a payload.
+// The signed message is "<timestamp>.<body>", which lets receivers reject replayed payloads.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func EncodeProps(urls []string, tpl string) map[string]any {
+	res := map[string]any{
+		"urls": pbutil.ToSliceAny(urls),
+	}
+	if tpl != "" {
+		res["template"] = tpl
+	}
+	return res
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// statusError is returned when a webhook responds with a non-2xx status code.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	if e.body == "" {
+		return fmt.Sprintf("unexpected status code %d", e.code)
+	}
+	return fmt.Sprintf("unexpected status code %d: %s", e.code, e.body)
+}
+
+// retryErrClassifier retries network errors, 429s and 5xx responses. Other errors are not retried.
+type retryErrClassifier struct{}
+
+func (retryErrClassifier) Classify(err error) retrier.Action {
+	if err == nil {
+		return retrier.Succeed
+	}
+
+	var se *statusError
+	if errors.As(err, &se) {
+		if se.code == http.StatusTooManyRequests || se.code >= 500 {
+			return retrier.Retry
+		}
+		return retrier.Fail
+	}
+
+	return retrier.Retry
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+}
+
+type ReportStatusData struct {
+	Type            string
+	DisplayName     string
+	ReportTime      string // RFC3339 formatted
+	DownloadFormat  string
+	OpenLink        string
+	DownloadLink    string
+	UnsubscribeLink string
+	Summary         string
+}
+
+type AlertStatusData struct {
+	Type            string
+	DisplayName     string
+	ExecutionTime   string // RFC3339 formatted
+	Status          string // One of "pass", "fail" or "error"
+	IsRecover       bool
+	MutedExecutions int
+	FailRow         map[string]any
+	ErrorMessage    string
+	OpenLink        string
+	EditLink        string
+}
@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	shortRetryWait(t)
+
+	var calls atomic.Int32
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first request to exercise retries
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "sha256="+Sign("s3cret", r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+		require.NoError(t, json.Unmarshal(body, &payload))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("s3cret", EncodeProps([]string{srv.URL}, ""), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		DisplayName:   "My Alert",
+		ExecutionTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"country": "Denmark", "total": 10},
+		OpenLink:      "https://example.com/open",
+		EditLink:      "https://example.com/edit",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, "alert_status", payload["type"])
+	require.Equal(t, "My Alert", payload["display_name"])
+	require.Equal(t, "2024-01-01T00:00:00Z", payload["execution_time"])
+	require.Equal(t, "fail", payload["status"])
+	require.Equal(t, map[string]any{"country": "Denmark", "total": float64(10)}, payload["fail_row"])
+	require.Equal(t, "https://example.com/open", payload["open_link"])
+}
+
+func TestSendScheduledReportCustomTemplate(t *testing.T) {
+	shortRetryWait(t)
+
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.Header.Get(SignatureHeader))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer srv.Close()
+
+	tpl := `{"summary": {{ json (printf "%s is ready" .DisplayName) }}, "link": {{ json .OpenLink }}}`
+	n, err := newNotifier("", EncodeProps([]string{srv.URL}, tpl), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		DisplayName: "My Report",
+		ReportTime:  time.Now(),
+		OpenLink:    "https://example.com/open",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"summary": "My Report is ready", "link": "https://example.com/open"}, payload)
+}
+
+func TestSendNoRetryOnClientError(t *testing.T) {
+	shortRetryWait(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("", EncodeProps([]string{srv.URL}, ""), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{DisplayName: "My Report", ReportTime: time.Now()})
+	require.ErrorContains(t, err, "unexpected status code 400")
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestSendContinuesAfterFailedURL(t *testing.T) {
+	shortRetryWait(t)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer failing.Close()
+
+	var calls atomic.Int32
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer ok.Close()
+
+	n, err := newNotifier("", EncodeProps([]string{failing.URL, ok.URL, failing.URL}, ""), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{DisplayName: "My Report", ReportTime: time.Now()})
+	require.ErrorContains(t, err, "unexpected status code 400")
+	require.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestInvalidTemplateOutput(t *testing.T) {
+	n, err := newNotifier("", EncodeProps([]string{"http://localhost"}, `not json {{ .DisplayName }}`), zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{DisplayName: "My Report", ReportTime: time.Now()})
+	require.ErrorContains(t, err, "not valid JSON")
+}
+
+// shortRetryWait speeds up retries for the duration of a test.
+func shortRetryWait(t *testing.T) {
+	prev := retryWait
+	retryWait = time.Millisecond
+	t.Cleanup(func() { retryWait = prev })
+}
@@ -0,0 +1,238 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+)
+
+// ColumnLineage builds the column-level lineage graph of the models, metrics views and explores in an instance.
+// Model SQL is analyzed with the DuckDB parser, so precise lineage is only available for models that run on DuckDB.
+// For other models, and for SQL that could not be analyzed, all columns of the model are assumed to depend on all columns of its upstream models.
+func (r *Runtime) ColumnLineage(ctx context.Context, instanceID string) ([]*runtimev1.ColumnLineageEdge, error) {
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(ctx, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	var edges []*runtimev1.ColumnLineageEdge
+	for _, res := range rs {
+		switch res.Meta.Name.Kind {
+		case ResourceKindModel:
+			edges = append(edges, r.modelColumnLineage(ctx, instanceID, res)...)
+		case ResourceKindMetricsView:
+			edges = append(edges, metricsViewColumnLineage(res)...)
+		case ResourceKindExplore:
+			edges = append(edges, exploreColumnLineage(res)...)
+		}
+	}
+	return edges, nil
+}
+
+// ColumnLineageDownstream filters a lineage graph to the edges that are transitively downstream of the given column.
+func ColumnLineageDownstream(edges []*runtimev1.ColumnLineageEdge, name *runtimev1.ResourceName, column string) []*runtimev1.ColumnLineageEdge {
+	type node struct {
+		kind, name, column string
+	}
+	newNode := func(n *runtimev1.ResourceName, column string) node {
+		return node{kind: n.Kind, name: strings.ToLower(n.Name), column: strings.ToLower(column)}
+	}
+
+	var res []*runtimev1.ColumnLineageEdge
+	seenEdges := make(map[*runtimev1.ColumnLineageEdge]bool)
+	seen := map[node]bool{newNode(name, column): true}
+	queue := []node{newNode(name, column)}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		for _, e := range edges {
+			if e.FromResource.Kind != n.kind || strings.ToLower(e.FromResource.Name) != n.name {
+				continue
+			}
+
+			// Star edges pass all columns through under the same name.
+			next := newNode(e.ToResource, e.ToColumn)
+			if e.FromColumn == "*" {
+				if e.ToColumn == "*" {
+					next.column = n.column
+				}
+			} else if !strings.EqualFold(e.FromColumn, n.column) {
+				continue
+			}
+
+			if !seenEdges[e] {
+				seenEdges[e] = true
+				res = append(res, e)
+			}
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return res
+}
+
+// modelColumnLineage returns the lineage edges from a model's upstream models and sources to the model's columns.
+func (r *Runtime) modelColumnLineage(ctx context.Context, instanceID string, res *runtimev1.Resource) []*runtimev1.ColumnLineageEdge {
+	var upstream []*runtimev1.ResourceName
+	for _, ref := range res.Meta.Refs {
+		if ref.Kind == ResourceKindModel || ref.Kind == ResourceKindSource {
+			upstream = append(upstream, ref)
+		}
+	}
+	if len(upstream) == 0 {
+		return nil
+	}
+
+	var lineage []*duckdbsql.ColumnLineage
+	spec := res.GetModel().Spec
+	sql, _ := spec.InputProperties.AsMap()["sql"].(string)
+	if sql != "" {
+		cfg, err := r.ConnectorConfig(ctx, instanceID, spec.InputConnector)
+		if err == nil && cfg.Driver == "duckdb" {
+			ast, err := duckdbsql.Parse(sql)
+			if err == nil {
+				lineage = ast.ExtractColumnLineage()
+			}
+		}
+	}
+
+	// Fall back to assuming all upstream columns may flow into all of the model's columns.
+	if lineage == nil {
+		edges := make([]*runtimev1.ColumnLineageEdge, len(upstream))
+		for i, ref := range upstream {
+			edges[i] = newColumnLineageEdge(ref, "*", res.Meta.Name, "*")
+		}
+		return edges
+	}
+
+	var edges []*runtimev1.ColumnLineageEdge
+	for _, l := range lineage {
+		if l.Name == "" {
+			continue
+		}
+		for _, src := range l.Sources {
+			for _, ref := range upstream {
+				// Sources without a table could come from any upstream resource.
+				if src.Table != "" && !strings.EqualFold(src.Table, ref.Name) {
+					continue
+				}
+				edges = append(edges, newColumnLineageEdge(ref, src.Column, res.Meta.Name, l.Name))
+			}
+		}
+	}
+	return edges
+}
+
+// metricsViewColumnLineage returns the lineage edges from a metrics view's underlying model to its dimensions and measures.
+// For derived metrics views, the edges are from the parent metrics view's dimensions and measures.
+func metricsViewColumnLineage(res *runtimev1.Resource) []*runtimev1.ColumnLineageEdge {
+	mv := res.GetMetricsView()
+	spec := mv.State.GetValidSpec()
+	if spec == nil {
+		spec = mv.Spec
+	}
+	self := res.Meta.Name
+
+	var edges []*runtimev1.ColumnLineageEdge
+	if spec.Parent != "" {
+		parent := &runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: spec.Parent}
+		for _, d := range spec.Dimensions {
+			edges = append(edges, newColumnLineageEdge(parent, d.Name, self, d.Name))
+		}
+		for _, m := range spec.Measures {
+			edges = append(edges, newColumnLineageEdge(parent, m.Name, self, m.Name))
+		}
+		return edges
+	}
+
+	var upstream *runtimev1.ResourceName
+	for _, ref := range res.Meta.Refs {
+		if ref.Kind != ResourceKindModel && ref.Kind != ResourceKindSource {
+			continue
+		}
+		if strings.EqualFold(ref.Name, spec.Model) || strings.EqualFold(ref.Name, spec.Table) {
+			upstream = ref
+			break
+		}
+	}
+
+	dims := make(map[string]bool, len(spec.Dimensions))
+	for _, d := range spec.Dimensions {
+		dims[strings.ToLower(d.Name)] = true
+		if upstream == nil {
+			continue
+		}
+		var cols []string
+		if d.Column != "" {
+			cols = []string{d.Column}
+		} else if d.Expression != "" {
+			cols, _ = duckdbsql.ExtractExpressionColumns(d.Expression)
+		}
+		for _, col := range cols {
+			edges = append(edges, newColumnLineageEdge(upstream, col, self, d.Name))
+		}
+	}
+	if upstream != nil && spec.TimeDimension != "" && !dims[strings.ToLower(spec.TimeDimension)] {
+		edges = append(edges, newColumnLineageEdge(upstream, spec.TimeDimension, self, spec.TimeDimension))
+	}
+
+	measures := make(map[string]bool, len(spec.Measures))
+	for _, m := range spec.Measures {
+		measures[strings.ToLower(m.Name)] = true
+	}
+	for _, m := range spec.Measures {
+		// Derived measures reference other measures, which are matched by name.
+		for _, ref := range m.ReferencedMeasures {
+			edges = append(edges, newColumnLineageEdge(self, ref, self, m.Name))
+		}
+		cols, _ := duckdbsql.ExtractExpressionColumns(m.Expression)
+		for _, col := range cols {
+			if measures[strings.ToLower(col)] {
+				continue
+			}
+			if upstream != nil {
+				edges = append(edges, newColumnLineageEdge(upstream, col, self, m.Name))
+			}
+		}
+	}
+
+	return edges
+}
+
+// exploreColumnLineage returns the lineage edges from a metrics view to the dimensions and measures shown in an explore.
+func exploreColumnLineage(res *runtimev1.Resource) []*runtimev1.ColumnLineageEdge {
+	e := res.GetExplore()
+	spec := e.State.GetValidSpec()
+	if spec == nil {
+		spec = e.Spec
+	}
+	mv := &runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: spec.MetricsView}
+
+	edges := make([]*runtimev1.ColumnLineageEdge, 0, len(spec.Dimensions)+len(spec.Measures))
+	for _, d := range spec.Dimensions {
+		edges = append(edges, newColumnLineageEdge(mv, d, res.Meta.Name, d))
+	}
+	for _, m := range spec.Measures {
+		edges = append(edges, newColumnLineageEdge(mv, m, res.Meta.Name, m))
+	}
+	return edges
+}
+
+func newColumnLineageEdge(from *runtimev1.ResourceName, fromColumn string, to *runtimev1.ResourceName, toColumn string) *runtimev1.ColumnLineageEdge {
+	return &runtimev1.ColumnLineageEdge{
+		FromResource: from,
+		FromColumn:   fromColumn,
+		ToResource:   to,
+		ToColumn:     toColumn,
+	}
+}
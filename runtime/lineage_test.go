@@ -0,0 +1,89 @@
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnLineage(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/orders.sql": `
+SELECT range AS id, range % 10 AS customer_id, range * 1.5 AS price, 'US' AS country FROM range(100)
+`,
+		"/models/customers.sql": `
+SELECT range AS id, 'name_' || range AS name FROM range(10)
+`,
+		"/models/enriched.sql": `
+SELECT o.id, o.price, c.name AS customer_name FROM orders o JOIN customers c ON o.customer_id = c.id
+`,
+		"/metrics/mv.yaml": `
+version: 1
+type: metrics_view
+model: enriched
+dimensions:
+- name: customer
+  expression: upper(customer_name)
+measures:
+- name: revenue
+  expression: sum(price)
+- name: orders
+  expression: count(distinct id)
+- name: revenue_per_order
+  expression: revenue / orders
+  type: derived
+  requires: [revenue, orders]
+`,
+		"/explores/ex.yaml": `
+type: explore
+metrics_view: mv
+dimensions: '*'
+measures: [revenue]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 6, 0, 0)
+
+	edges, err := rt.ColumnLineage(context.Background(), id)
+	require.NoError(t, err)
+
+	names := make([]string, len(edges))
+	for i, e := range edges {
+		names[i] = edgeString(e)
+	}
+	require.Contains(t, names, "orders.price -> enriched.price")
+	require.Contains(t, names, "customers.name -> enriched.customer_name")
+	require.Contains(t, names, "enriched.customer_name -> mv.customer")
+	require.Contains(t, names, "enriched.price -> mv.revenue")
+	require.Contains(t, names, "mv.revenue -> mv.revenue_per_order")
+	require.Contains(t, names, "mv.revenue -> ex.revenue")
+	require.NotContains(t, names, "mv.orders -> ex.orders")
+
+	// Dropping orders.price breaks the revenue measures and the explore showing revenue
+	orders := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "orders"}
+	downstream := runtime.ColumnLineageDownstream(edges, orders, "price")
+	names = make([]string, len(downstream))
+	for i, e := range downstream {
+		names[i] = edgeString(e)
+	}
+	require.ElementsMatch(t, []string{
+		"orders.price -> enriched.price",
+		"enriched.price -> mv.revenue",
+		"mv.revenue -> mv.revenue_per_order",
+		"mv.revenue -> ex.revenue",
+	}, names)
+
+	// Dropping customers.id doesn't affect any output column
+	customers := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "customers"}
+	require.Empty(t, runtime.ColumnLineageDownstream(edges, customers, "id"))
+}
+
+func edgeString(e *runtimev1.ColumnLineageEdge) string {
+	return fmt.Sprintf("%s.%s -> %s.%s", e.FromResource.Name, e.FromColumn, e.ToResource.Name, e.ToColumn)
+}
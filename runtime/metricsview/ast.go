@@ -18,6 +18,7 @@ var ErrForbidden = errors.New("action not allowed")
 // The interface is currently a subset of the *runtime.ResolvedSecurity concrete type.
 type MetricsViewSecurity interface {
 	CanAccessField(field string) bool
+	FieldMask(field string) *runtimev1.SecurityRuleFieldMask
 	RowFilter() string
 	QueryFilter() *runtimev1.Expression
 }
@@ -288,11 +289,38 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec MetricsViewSecurity, qry *Query,
 			}
 		}
 
+		// Apply the security policy's field mask, if any. Computed time dimensions are masked like the dimension they are derived from.
+		// Since lookups filter against the unmasked lookup keys, they are skipped for masked dimensions.
+		maskName := dim.Name
+		if qd.Compute != nil && qd.Compute.TimeFloor != nil {
+			maskName = qd.Compute.TimeFloor.Dimension
+		}
+		var masked bool
+		f.Expr, masked, err = ast.maskDimension(maskName, f.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if masked {
+			f.LookupMeta = nil
+			cf.LookupMeta = nil
+			cf.Expr, _, err = ast.maskDimension(maskName, cf.Expr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		ast.dimFields = append(ast.dimFields, f)
 		ast.comparisonDimFields = append(ast.comparisonDimFields, cf)
 	}
 
 	if qry.Rows {
+		// Underlying rows would expose the unmasked values of masked dimensions
+		for _, dim := range mv.Dimensions {
+			if sec.FieldMask(dim.Name) != nil {
+				return nil, fmt.Errorf("%w: cannot query underlying rows of a metrics view with masked dimension %q", ErrForbidden, dim.Name)
+			}
+		}
+
 		// when Rows is set we want underlying rows from the model, adding only * as the dim field, query validation is done earlier which disallows any dimensions
 		ast.dimFields = append(ast.dimFields, FieldNode{
 			Name: "*",
@@ -564,10 +592,10 @@ func (a *AST) ResolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSp
 			return nil, err
 		}
 
-		// StarRocks returns DECIMAL for division, which gets mapped to string.
+		// StarRocks, Doris and Trino return DECIMAL for division, which gets mapped to string.
 		// Cast to DOUBLE for consistent numeric handling across all dialects.
 		expr := fmt.Sprintf("%s/%#f", a.Dialect.EscapeAlias(m.Name), *qm.Compute.PercentOfTotal.Total)
-		if a.Dialect.String() == drivers.DialectNameStarRocks {
+		if a.Dialect.String() == drivers.DialectNameStarRocks || a.Dialect.String() == drivers.DialectNameDoris || a.Dialect.String() == drivers.DialectNameTrino {
 			expr = fmt.Sprintf("CAST(%s AS DOUBLE)", expr)
 		}
 
@@ -591,6 +619,11 @@ func (a *AST) ResolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSp
 			return nil, fmt.Errorf("`uri` not set for the dimension %v", qm.Compute.URI.Dimension)
 		}
 
+		// The URI is usually derived from the dimension's unmasked values
+		if a.Security.FieldMask(dim.Name) != nil {
+			return nil, fmt.Errorf("%w: cannot compute the URI of masked dimension %q", ErrForbidden, dim.Name)
+		}
+
 		return &runtimev1.MetricsViewSpec_Measure{
 			Name:        qm.Name,
 			Expression:  a.Dialect.AnyValueExpression(uri),
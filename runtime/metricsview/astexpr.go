@@ -739,6 +739,18 @@ func (b *sqlExprBuilder) sqlForName(name string) (expr string, unnest bool, look
 			return "", false, nil, fmt.Errorf("invalid dimension reference %q: %w", name, err)
 		}
 
+		// Expressions that are exposed by the security policy must see the masked values of masked dimensions.
+		if b.visible && b.ast.Security.FieldMask(dim.Name) != nil {
+			if dim.Unnest {
+				return "", false, nil, fmt.Errorf("cannot filter on masked unnest dimension %q unless it is included in the query", name)
+			}
+			ex, _, err = b.ast.maskDimension(dim.Name, ex)
+			if err != nil {
+				return "", false, nil, err
+			}
+			return ex, false, nil, nil
+		}
+
 		var lm *lookupMeta
 		if dim.LookupTable != "" {
 			var keyExpr string
@@ -804,6 +816,10 @@ func (s skipMetricsViewSecurity) CanAccessField(field string) bool {
 	return true
 }
 
+func (s skipMetricsViewSecurity) FieldMask(field string) *runtimev1.SecurityRuleFieldMask {
+	return nil
+}
+
 func (s skipMetricsViewSecurity) RowFilter() string {
 	return ""
 }
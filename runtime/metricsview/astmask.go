@@ -0,0 +1,42 @@
+package metricsview
+
+import (
+	"fmt"
+	"strconv"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// MaskDimensionExpression wraps a dimension expression so that it returns the masked values of the dimension.
+// It is used to apply the field masks of a security policy.
+func MaskDimensionExpression(dialect drivers.Dialect, expr string, mask *runtimev1.SecurityRuleFieldMask) (string, error) {
+	switch mask.Type {
+	case runtimev1.FieldMaskType_FIELD_MASK_TYPE_HASH:
+		return dialect.HashExpression(expr)
+	case runtimev1.FieldMaskType_FIELD_MASK_TYPE_REDACT:
+		return dialect.RedactExpression(expr, mask.KeepLast)
+	case runtimev1.FieldMaskType_FIELD_MASK_TYPE_BUCKET:
+		if mask.BucketSize <= 0 {
+			return "", fmt.Errorf("bucket mask must have a positive bucket size")
+		}
+		size := strconv.FormatFloat(mask.BucketSize, 'f', -1, 64)
+		return fmt.Sprintf("FLOOR((%s) / %s) * %s", expr, size, size), nil
+	default:
+		return "", fmt.Errorf("unsupported field mask type %q", mask.Type.String())
+	}
+}
+
+// maskDimension applies the security policy's field mask for the named dimension to the expression, if any.
+// It returns true if the dimension is masked.
+func (a *AST) maskDimension(name, expr string) (string, bool, error) {
+	mask := a.Security.FieldMask(name)
+	if mask == nil {
+		return expr, false, nil
+	}
+	res, err := MaskDimensionExpression(a.Dialect, expr, mask)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to mask dimension %q: %w", name, err)
+	}
+	return res, true, nil
+}
@@ -0,0 +1,114 @@
+package metricsview
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+// maskedSecurity is a MetricsViewSecurity that allows access to all fields and masks some of them.
+type maskedSecurity struct {
+	skipMetricsViewSecurity
+	masks map[string]*runtimev1.SecurityRuleFieldMask
+}
+
+func (s maskedSecurity) FieldMask(field string) *runtimev1.SecurityRuleFieldMask {
+	return s.masks[field]
+}
+
+func TestMaskDimensionExpression(t *testing.T) {
+	d := drivers.NewBaseDialect(drivers.DialectNameDuckDB, drivers.DoubleQuotesEscapeIdentifier, drivers.DoubleQuotesEscapeIdentifier)
+	dialect := &d
+
+	expr, err := MaskDimensionExpression(dialect, `"email"`, &runtimev1.SecurityRuleFieldMask{Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_HASH})
+	require.NoError(t, err)
+	require.Equal(t, `MD5(CAST("email" AS VARCHAR))`, expr)
+
+	expr, err = MaskDimensionExpression(dialect, `"phone"`, &runtimev1.SecurityRuleFieldMask{Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_REDACT, KeepLast: 4})
+	require.NoError(t, err)
+	require.Equal(t, `CASE WHEN "phone" IS NULL THEN NULL ELSE CONCAT('****', RIGHT(CAST("phone" AS VARCHAR), 4)) END`, expr)
+
+	expr, err = MaskDimensionExpression(dialect, `"phone"`, &runtimev1.SecurityRuleFieldMask{Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_REDACT})
+	require.NoError(t, err)
+	require.Equal(t, `CASE WHEN "phone" IS NULL THEN NULL ELSE '****' END`, expr)
+
+	expr, err = MaskDimensionExpression(dialect, `"age"`, &runtimev1.SecurityRuleFieldMask{Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_BUCKET, BucketSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, `FLOOR(("age") / 10) * 10`, expr)
+
+	_, err = MaskDimensionExpression(dialect, `"age"`, &runtimev1.SecurityRuleFieldMask{Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_BUCKET})
+	require.Error(t, err)
+}
+
+func TestASTMaskedDimensions(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "test_table",
+		Dimensions: []*runtimev1.MetricsViewSpec_Dimension{
+			{Name: "customer_id", Column: "customer_id", Uri: "concat('https://example.com/customers/', customer_id)"},
+			{Name: "country", Column: "country"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_Measure{
+			{Name: "count", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "customers", Expression: "count(distinct customer_id)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+		},
+	}
+	sec := maskedSecurity{masks: map[string]*runtimev1.SecurityRuleFieldMask{
+		"customer_id": {Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_HASH},
+	}}
+	d := drivers.NewBaseDialect(drivers.DialectNameDuckDB, drivers.DoubleQuotesEscapeIdentifier, drivers.DoubleQuotesEscapeIdentifier)
+	dialect := &d
+
+	// Selecting and filtering on a masked dimension uses the masked values
+	ast, err := NewAST(mv, sec, &Query{
+		Dimensions: []Dimension{{Name: "customer_id"}},
+		Measures:   []Measure{{Name: "count"}},
+		Where: &Expression{Condition: &Condition{
+			Operator:    OperatorEq,
+			Expressions: []*Expression{{Name: "customer_id"}, {Value: "abc"}},
+		}},
+	}, dialect)
+	require.NoError(t, err)
+	require.Equal(t, `MD5(CAST("customer_id" AS VARCHAR))`, ast.Root.DimFields[0].Expr)
+	sql, _, err := ast.SQL()
+	require.NoError(t, err)
+	require.Contains(t, sql, `(MD5(CAST("customer_id" AS VARCHAR))) = ?`)
+	require.NotContains(t, sql, `("customer_id") = ?`)
+
+	// Filtering on a masked dimension that is not in the query also uses the masked values
+	ast, err = NewAST(mv, sec, &Query{
+		Dimensions: []Dimension{{Name: "country"}},
+		Measures:   []Measure{{Name: "count"}},
+		Where: &Expression{Condition: &Condition{
+			Operator:    OperatorEq,
+			Expressions: []*Expression{{Name: "customer_id"}, {Value: "abc"}},
+		}},
+	}, dialect)
+	require.NoError(t, err)
+	sql, _, err = ast.SQL()
+	require.NoError(t, err)
+	require.Contains(t, sql, `(MD5(CAST("customer_id" AS VARCHAR))) = ?`)
+
+	// Underlying rows are not allowed
+	_, err = NewAST(mv, sec, &Query{Rows: true}, dialect)
+	require.ErrorIs(t, err, ErrForbidden)
+
+	// The URI of a masked dimension is not allowed
+	_, err = NewAST(mv, sec, &Query{
+		Dimensions: []Dimension{{Name: "customer_id"}},
+		Measures:   []Measure{{Name: "customer_uri", Compute: &MeasureCompute{URI: &MeasureComputeURI{Dimension: "customer_id"}}}},
+	}, dialect)
+	require.ErrorIs(t, err, ErrForbidden)
+
+	// Measures are computed from the unmasked values
+	ast, err = NewAST(mv, sec, &Query{
+		Dimensions: []Dimension{{Name: "country"}},
+		Measures:   []Measure{{Name: "customers"}},
+	}, dialect)
+	require.NoError(t, err)
+	sql, _, err = ast.SQL()
+	require.NoError(t, err)
+	require.Contains(t, sql, `count(distinct customer_id)`)
+	require.NotContains(t, sql, `MD5`)
+}
@@ -135,6 +135,12 @@ func (e *Executor) Summary(ctx context.Context) (*SummaryResult, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to get expression for dimension %s: %w", dim.Name, err)
 		}
+		if mask := e.security.FieldMask(dim.Name); mask != nil {
+			expr, err = metricsview.MaskDimensionExpression(e.olap.Dialect(), expr, mask)
+			if err != nil {
+				return nil, fmt.Errorf("failed to mask dimension %s: %w", dim.Name, err)
+			}
+		}
 
 		// Add select clauses for min, max, has_nulls, and sample value
 		dialect := e.olap.Dialect()
@@ -78,10 +78,10 @@ func (e *Executor) ValidateAndNormalizeMetricsView(ctx context.Context) (*Valida
 		return nil, fmt.Errorf("failed to look up table %q: %w", mv.Table, err)
 	}
 
-	// Populate empty database/databaseSchema from table metadata for StarRocks only.
-	// StarRocks requires fully qualified table names (catalog.database.table),
+	// Populate empty database/databaseSchema from table metadata for StarRocks and Doris only.
+	// They require fully qualified table names (catalog.database.table),
 	// even when the metrics view YAML doesn't explicitly specify them (e.g., when using models).
-	if e.olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if e.olap.Dialect().String() == drivers.DialectNameStarRocks || e.olap.Dialect().String() == drivers.DialectNameDoris {
 		if mv.Database == "" && t.Database != "" {
 			mv.Database = t.Database
 		}
@@ -130,6 +130,32 @@ func (e *Executor) ValidateAndNormalizeMetricsView(ctx context.Context) (*Valida
 		}
 	}
 
+	// Check security policy masks apply to categorical dimensions and are supported by the OLAP engine
+	dims := make(map[string]*runtimev1.MetricsViewSpec_Dimension, len(mv.Dimensions))
+	for _, d := range mv.Dimensions {
+		dims[strings.ToLower(d.Name)] = d
+	}
+	for _, rule := range mv.SecurityRules {
+		fm := rule.GetFieldMask()
+		if fm == nil {
+			continue
+		}
+		if _, err := metricsview.MaskDimensionExpression(e.olap.Dialect(), "1", fm); err != nil {
+			res.OtherErrs = append(res.OtherErrs, fmt.Errorf("invalid mask in 'security': %w", err))
+			continue
+		}
+		for _, f := range fm.Fields {
+			d, ok := dims[strings.ToLower(f)]
+			if !ok {
+				res.OtherErrs = append(res.OtherErrs, fmt.Errorf("field %q masked in 'security' is not a dimension", f))
+				continue
+			}
+			if d.Type == runtimev1.MetricsViewSpec_DIMENSION_TYPE_TIME || strings.EqualFold(d.Name, mv.TimeDimension) {
+				res.OtherErrs = append(res.OtherErrs, fmt.Errorf("time dimension %q cannot be masked in 'security'", f))
+			}
+		}
+	}
+
 	// ClickHouse specifically does not support using a column name as a dimension or measure name if the dimension or measure has an expression.
 	// This is due to ClickHouse's aggressive substitution of aliases: https://github.com/ClickHouse/ClickHouse/issues/9715.
 	if e.olap.Dialect().String() == drivers.DialectNameClickHouse {
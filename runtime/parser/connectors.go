@@ -170,6 +170,11 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 			}
 		}
 
+		// Teams and webhook notifiers can always be used anonymously (they post to URLs configured on the notifier)
+		if n.Connector == "teams" || n.Connector == "webhook" {
+			anonAccess = true
+		}
+
 		a.trackConnector(n.Connector, r, anonAccess)
 	}
 }
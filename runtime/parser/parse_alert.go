@@ -10,9 +10,13 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/robfig/cron/v3"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AlertYAML is the raw structure of an Alert resource defined in YAML (does not include common fields)
@@ -58,8 +62,22 @@ type AlertYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams"`
+		Webhook struct {
+			URLs     []string `yaml:"urls"`
+			Template string   `yaml:"template"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
+	Mute        []struct {
+		Cron     string `yaml:"cron"`
+		TimeZone string `yaml:"time_zone"`
+		Duration string `yaml:"duration"`
+	} `yaml:"mute"`
+	SnoozeUntil string   `yaml:"snooze_until"`
+	GroupBy     []string `yaml:"group_by"`
 	// Backwards compatibility
 	Email struct {
 		Recipients    []string `yaml:"recipients"`
@@ -269,6 +287,60 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
+	// Parse mute windows
+	var muteWindows []*runtimev1.AlertMuteWindow
+	for i, m := range tmp.Mute {
+		if m.Cron == "" {
+			return fmt.Errorf(`missing "cron" for "mute" entry %d`, i)
+		}
+		_, err := cron.ParseStandard(m.Cron)
+		if err != nil {
+			return fmt.Errorf(`invalid cron schedule for "mute" entry %d: %w`, i, err)
+		}
+		if m.TimeZone != "" {
+			_, err := time.LoadLocation(m.TimeZone)
+			if err != nil {
+				return fmt.Errorf(`invalid time zone for "mute" entry %d: %w`, i, err)
+			}
+		}
+		if m.Duration == "" {
+			return fmt.Errorf(`missing "duration" for "mute" entry %d`, i)
+		}
+		d, err := parseDuration(m.Duration)
+		if err != nil {
+			return fmt.Errorf(`invalid duration for "mute" entry %d: %w`, i, err)
+		}
+		if d < time.Second {
+			return fmt.Errorf(`"mute" entry %d must have a duration of at least one second`, i)
+		}
+		muteWindows = append(muteWindows, &runtimev1.AlertMuteWindow{
+			Cron:            m.Cron,
+			TimeZone:        m.TimeZone,
+			DurationSeconds: uint32(d.Seconds()),
+		})
+	}
+
+	// Parse snooze
+	var snoozeUntil time.Time
+	if tmp.SnoozeUntil != "" {
+		snoozeUntil, err = time.Parse(time.RFC3339, tmp.SnoozeUntil)
+		if err != nil {
+			return fmt.Errorf(`invalid value %q for property "snooze_until": must be an RFC3339 timestamp`, tmp.SnoozeUntil)
+		}
+	}
+
+	// Validate group_by
+	seenGroupBy := make(map[string]bool, len(tmp.GroupBy))
+	for _, f := range tmp.GroupBy {
+		if f == "" {
+			return errors.New(`"group_by" must not contain empty field names`)
+		}
+		if seenGroupBy[f] {
+			return fmt.Errorf(`duplicate field %q in "group_by"`, f)
+		}
+		seenGroupBy[f] = true
+	}
+
 	// Track alert
 	r, err := p.insertResource(ResourceKindAlert, node.Name, node.Paths, node.Tags, node.Refs...)
 	if err != nil {
@@ -377,9 +449,36 @@ func (p *Parser) parseAlert(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Teams settings
+		if len(tmp.Notify.Teams.Webhooks) > 0 {
+			props, err := structpb.NewStruct(teams.EncodeProps(tmp.Notify.Teams.Webhooks))
+			if err != nil {
+				return err
+			}
+			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "teams",
+				Properties: props,
+			})
+		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Template))
+			if err != nil {
+				return err
+			}
+			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
 	}
 
 	r.AlertSpec.Annotations = tmp.Annotations
+	r.AlertSpec.MuteWindows = muteWindows
+	if !snoozeUntil.IsZero() {
+		r.AlertSpec.SnoozeUntil = timestamppb.New(snoozeUntil)
+	}
+	r.AlertSpec.GroupBy = tmp.GroupBy
 
 	return nil
 }
@@ -3,9 +3,12 @@ package parser
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 	"unicode"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/pkg/openapiutil"
 )
 
@@ -15,6 +18,83 @@ type APIYAML struct {
 	OpenAPI            *OpenAPIYAML        `yaml:"openapi"`
 	Security           *SecurityPolicyYAML `yaml:"security"`
 	SkipNestedSecurity bool                `yaml:"skip_nested_security"`
+	RateLimit          *RateLimitYAML      `yaml:"rate_limit"`
+	Pagination         *PaginationYAML     `yaml:"pagination"`
+}
+
+// PaginationYAML is the raw structure of an API's pagination config.
+type PaginationYAML struct {
+	SortKeys    []string `yaml:"sort_keys"`
+	PageSize    uint32   `yaml:"page_size"`
+	MaxPageSize uint32   `yaml:"max_page_size"`
+}
+
+// Proto converts the pagination config to its proto representation.
+// The page size defaults to 100 and the max page size defaults to the larger of the page size and 10000.
+func (y *PaginationYAML) Proto() (*runtimev1.APIPagination, error) {
+	if y == nil {
+		return nil, nil
+	}
+	if len(y.SortKeys) == 0 {
+		return nil, fmt.Errorf("must specify at least one column in 'sort_keys'")
+	}
+	for _, k := range y.SortKeys {
+		if k == "" {
+			return nil, fmt.Errorf("'sort_keys' cannot contain empty column names")
+		}
+	}
+	pageSize := y.PageSize
+	if pageSize == 0 {
+		pageSize = 100
+	}
+	maxPageSize := y.MaxPageSize
+	if maxPageSize == 0 {
+		maxPageSize = max(pageSize, 10000)
+	}
+	if pageSize > maxPageSize {
+		return nil, fmt.Errorf("'page_size' cannot be larger than 'max_page_size'")
+	}
+	return &runtimev1.APIPagination{
+		SortKeys:    y.SortKeys,
+		PageSize:    pageSize,
+		MaxPageSize: maxPageSize,
+	}, nil
+}
+
+// RateLimitYAML is the raw structure of an API's rate limit.
+type RateLimitYAML struct {
+	Requests     uint32 `yaml:"requests"`
+	Period       string `yaml:"period"`
+	KeyAttribute string `yaml:"key_attribute"`
+}
+
+// Proto converts the rate limit to its proto representation. The period defaults to one minute.
+func (y *RateLimitYAML) Proto() (*runtimev1.APIRateLimit, error) {
+	if y == nil {
+		return nil, nil
+	}
+	if y.Requests == 0 {
+		return nil, fmt.Errorf("'requests' must be greater than zero")
+	}
+	period := time.Minute
+	if y.Period != "" {
+		var err error
+		period, err = time.ParseDuration(y.Period)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'period': %w", err)
+		}
+	}
+	if period < time.Second || period%time.Second != 0 {
+		return nil, fmt.Errorf("'period' must be a whole number of seconds")
+	}
+	if period.Seconds() > math.MaxUint32 {
+		return nil, fmt.Errorf("'period' is too long")
+	}
+	return &runtimev1.APIRateLimit{
+		Requests:      y.Requests,
+		PeriodSeconds: uint32(period.Seconds()),
+		KeyAttribute:  y.KeyAttribute,
+	}, nil
 }
 
 type OpenAPIYAML struct {
@@ -108,6 +188,19 @@ func (p *Parser) parseAPI(node *Node) error {
 		}
 	}
 
+	rateLimit, err := tmp.RateLimit.Proto()
+	if err != nil {
+		return fmt.Errorf("invalid 'rate_limit': %w", err)
+	}
+
+	pagination, err := tmp.Pagination.Proto()
+	if err != nil {
+		return fmt.Errorf("invalid 'pagination': %w", err)
+	}
+	if pagination != nil && resolver != "sql" && resolver != "metrics_sql" {
+		return fmt.Errorf("'pagination' is only supported for APIs that use 'sql' or 'metrics_sql'")
+	}
+
 	r, err := p.insertResource(ResourceKindAPI, node.Name, node.Paths, node.Tags, node.Refs...)
 	if err != nil {
 		return err
@@ -123,6 +216,8 @@ func (p *Parser) parseAPI(node *Node) error {
 	r.APISpec.OpenapiDefsPrefix = openapiDefsPrefix
 	r.APISpec.SecurityRules = securityRules
 	r.APISpec.SkipNestedSecurity = tmp.SkipNestedSecurity
+	r.APISpec.RateLimit = rateLimit
+	r.APISpec.Pagination = pagination
 
 	return nil
 }
@@ -46,6 +46,31 @@ metrics_sql: select * from m1
 skip_nested_security: true
 security:
   access: '{{ .user.admin }}'
+`,
+		// api a6 with a rate limit
+		`apis/a6.yaml`: `
+type: api
+sql: select * from m1
+rate_limit:
+  requests: 100
+  period: 1h
+  key_attribute: email
+`,
+		// api a7 with an invalid rate limit
+		`apis/a7.yaml`: `
+type: api
+sql: select * from m1
+rate_limit:
+  requests: 100
+  period: 1500ms
+`,
+		// api a8 with pagination
+		`apis/a8.yaml`: `
+type: api
+metrics_sql: select * from m1
+pagination:
+  sort_keys: [id]
+  page_size: 50
 `,
 	})
 
@@ -120,10 +145,42 @@ security:
 				SkipNestedSecurity: true,
 			},
 		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a6"},
+			Paths: []string{"/apis/a6.yaml"},
+			APISpec: &runtimev1.APISpec{
+				Resolver:           "sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"connector": "duckdb", "sql": "select * from m1"})),
+				RateLimit: &runtimev1.APIRateLimit{
+					Requests:      100,
+					PeriodSeconds: 3600,
+					KeyAttribute:  "email",
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a8"},
+			Paths: []string{"/apis/a8.yaml"},
+			APISpec: &runtimev1.APISpec{
+				Resolver:           "metrics_sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "select * from m1"})),
+				Pagination: &runtimev1.APIPagination{
+					SortKeys:    []string{"id"},
+					PageSize:    50,
+					MaxPageSize: 10000,
+				},
+			},
+		},
+	}
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  "'period' must be a whole number of seconds",
+			FilePath: "/apis/a7.yaml",
+		},
 	}
 	p, err := Parse(ctx, repo, "", "", "duckdb", true)
 	require.NoError(t, err)
-	requireResourcesAndErrors(t, p, resources, nil)
+	requireResourcesAndErrors(t, p, resources, errors)
 }
 
 func TestAPIWithOpenAPI(t *testing.T) {
@@ -44,6 +44,10 @@ type ModelYAML struct {
 		Assert   string `yaml:"assert"`
 		DataYAML `yaml:",inline"`
 	} `yaml:"tests"`
+	Freshness *struct {
+		MaxStaleness string `yaml:"max_staleness" mapstructure:"max_staleness"`
+		Watermark    string `yaml:"watermark"`
+	} `yaml:"freshness"`
 	Materialize     *bool `yaml:"materialize"`
 	DefinedAsSource bool  `yaml:"defined_as_source"`
 }
@@ -224,6 +228,17 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		node.Refs = append(node.Refs, refs...)
 	}
 
+	// Parse the freshness SLA
+	var freshness *runtimev1.ModelFreshness
+	if tmp.Freshness != nil {
+		var refs []ResourceName
+		freshness, refs, err = p.parseModelFreshness(node.Paths, tmp.Freshness.MaxStaleness, tmp.Freshness.Watermark, outputConnector, node.Name)
+		if err != nil {
+			return fmt.Errorf(`failed to parse "freshness": %w`, err)
+		}
+		node.Refs = append(node.Refs, refs...)
+	}
+
 	var retryDelay *uint32
 	if tmp.Retry.Delay != nil {
 		duration, err := time.ParseDuration(*tmp.Retry.Delay)
@@ -279,9 +294,43 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 
 	r.ModelSpec.Tests = modelTests
 
+	r.ModelSpec.Freshness = freshness
+
 	return nil
 }
 
+// parseModelFreshness parses a model's freshness SLA.
+// The optional watermark is a SQL expression that is evaluated against the model's output to determine when its data was last updated.
+func (p *Parser) parseModelFreshness(paths []string, maxStaleness, watermark, connector, modelName string) (*runtimev1.ModelFreshness, []ResourceName, error) {
+	if maxStaleness == "" {
+		return nil, nil, errors.New(`must specify "max_staleness"`)
+	}
+	d, err := parseDuration(maxStaleness)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`invalid "max_staleness": %w`, err)
+	}
+	if d < time.Second {
+		return nil, nil, errors.New(`"max_staleness" must be at least one second`)
+	}
+
+	res := &runtimev1.ModelFreshness{
+		MaxStalenessSeconds: uint32(d.Seconds()),
+	}
+	if watermark == "" {
+		return res, nil, nil
+	}
+
+	// The model is referenced with a "ref" so the SQL resolver escapes its name.
+	data := &DataYAML{SQL: fmt.Sprintf("SELECT %s AS watermark FROM {{ ref %q }}", watermark, modelName)}
+	resolver, props, refs, err := p.parseDataYAML(paths, data, connector)
+	if err != nil {
+		return nil, nil, err
+	}
+	res.WatermarkResolver = resolver
+	res.WatermarkResolverProperties = props
+	return res, refs, nil
+}
+
 // parseModelTests parses the model tests from the YAML file
 func (p *Parser) parseModelTest(paths []string, name string, data *DataYAML, connector, modelName, assert string) (*runtimev1.ModelTest, []ResourceName, error) {
 	// Validate required name field
@@ -15,6 +15,7 @@ type DataYAML struct {
 	SQL            string         `yaml:"sql"`
 	MetricsSQL     string         `yaml:"metrics_sql"`
 	Metrics        map[string]any `yaml:"metrics"`
+	Anomaly        map[string]any `yaml:"anomaly"` // Metrics anomaly resolver properties
 	API            string         `yaml:"api"`
 	Args           map[string]any `yaml:"args"`
 	Glob           yaml.Node      `yaml:"glob"` // Path (string) or properties (map[string]any)
@@ -74,6 +75,21 @@ func (p *Parser) parseDataYAML(paths []string, raw *DataYAML, contextualConnecto
 		refs = append(refs, ResourceName{Kind: ResourceKindMetricsView, Name: mvName})
 	}
 
+	// Handle metrics anomaly resolver
+	if len(raw.Anomaly) > 0 {
+		count++
+		resolver = "metrics_anomaly"
+		resolverProps = raw.Anomaly
+		mvName, ok := raw.Anomaly["metrics_view"].(string)
+		if !ok {
+			return "", nil, nil, fmt.Errorf("anomaly resolver requires a metrics_view to be specified")
+		}
+		if _, ok := raw.Anomaly["measure"].(string); !ok {
+			return "", nil, nil, fmt.Errorf("anomaly resolver requires a measure to be specified")
+		}
+		refs = append(refs, ResourceName{Kind: ResourceKindMetricsView, Name: mvName})
+	}
+
 	// Handle API resolver
 	if raw.API != "" {
 		count++
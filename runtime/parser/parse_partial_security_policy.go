@@ -18,6 +18,13 @@ type SecurityPolicyYAML struct {
 		Condition string    `yaml:"if"`
 		Names     yaml.Node // []string or "*" (will be parsed with parseNamesYAML)
 	}
+	Mask []*struct {
+		Condition  string    `yaml:"if"`
+		Names      yaml.Node // []string (will be parsed with parseNamesYAML)
+		Type       string    `yaml:"type"`
+		KeepLast   uint32    `yaml:"keep_last"`
+		BucketSize float64   `yaml:"bucket_size"`
+	}
 	Rules []*SecurityRuleYAML `yaml:"rules"`
 }
 
@@ -156,6 +163,41 @@ func (p *SecurityPolicyYAML) Proto() ([]*runtimev1.SecurityRule, error) {
 		})
 	}
 
+	for _, m := range p.Mask {
+		if m == nil {
+			continue
+		}
+
+		tmp, err := ResolveTemplate(m.Condition, validationTemplateData, false)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'if' condition templating is not valid: %w`, err)
+		}
+		_, err = EvaluateBoolExpression(tmp)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'if' condition expression error: %w`, err)
+		}
+
+		names, all, err := parseNamesYAML(m.Names)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'mask' names: %w`, err)
+		}
+		if all || len(names) == 0 {
+			return nil, fmt.Errorf(`invalid 'security': 'mask' must have a valid 'names' list`)
+		}
+
+		fm, err := parseFieldMask(names, m.Type, m.KeepLast, m.BucketSize)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'mask': %w`, err)
+		}
+		fm.ConditionExpression = m.Condition
+
+		rules = append(rules, &runtimev1.SecurityRule{
+			Rule: &runtimev1.SecurityRule_FieldMask{
+				FieldMask: fm,
+			},
+		})
+	}
+
 	for _, r := range p.Rules {
 		if r == nil {
 			continue
@@ -178,6 +220,10 @@ type SecurityRuleYAML struct {
 	Names  []string
 	All    bool
 	SQL    string
+	// Mask, KeepLast and BucketSize configure rules of type "field_mask".
+	Mask       string
+	KeepLast   uint32  `yaml:"keep_last"`
+	BucketSize float64 `yaml:"bucket_size"`
 }
 
 func (r *SecurityRuleYAML) Proto() (*runtimev1.SecurityRule, error) {
@@ -256,7 +302,55 @@ func (r *SecurityRuleYAML) Proto() (*runtimev1.SecurityRule, error) {
 				},
 			},
 		}, nil
+	case "field_mask":
+		if allow != nil {
+			return nil, fmt.Errorf("invalid security rule of type %q: cannot specify an action", r.Type)
+		}
+		if r.All || len(r.Names) == 0 {
+			return nil, fmt.Errorf(`invalid security rule of type %q: must have a valid 'names' list`, r.Type)
+		}
+		fm, err := parseFieldMask(r.Names, r.Mask, r.KeepLast, r.BucketSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid security rule of type %q: %w", r.Type, err)
+		}
+		fm.ConditionExpression = condition
+		return &runtimev1.SecurityRule{
+			Rule: &runtimev1.SecurityRule_FieldMask{
+				FieldMask: fm,
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid security rule type %q", r.Type)
 	}
 }
+
+// parseFieldMask parses the properties of a field mask.
+func parseFieldMask(names []string, typ string, keepLast uint32, bucketSize float64) (*runtimev1.SecurityRuleFieldMask, error) {
+	fm := &runtimev1.SecurityRuleFieldMask{
+		Fields: names,
+	}
+	switch typ {
+	case "hash":
+		fm.Type = runtimev1.FieldMaskType_FIELD_MASK_TYPE_HASH
+	case "redact":
+		fm.Type = runtimev1.FieldMaskType_FIELD_MASK_TYPE_REDACT
+		fm.KeepLast = keepLast
+	case "bucket":
+		if bucketSize <= 0 {
+			return nil, fmt.Errorf("mask of type %q must have a positive 'bucket_size'", typ)
+		}
+		fm.Type = runtimev1.FieldMaskType_FIELD_MASK_TYPE_BUCKET
+		fm.BucketSize = bucketSize
+	case "":
+		return nil, fmt.Errorf("must specify a mask type (one of 'hash', 'redact' or 'bucket')")
+	default:
+		return nil, fmt.Errorf("invalid mask type %q (must be one of 'hash', 'redact' or 'bucket')", typ)
+	}
+	if typ != "redact" && keepLast != 0 {
+		return nil, fmt.Errorf("'keep_last' can only be set for masks of type 'redact'")
+	}
+	if typ != "bucket" && bucketSize != 0 {
+		return nil, fmt.Errorf("'bucket_size' can only be set for masks of type 'bucket'")
+	}
+	return fm, nil
+}
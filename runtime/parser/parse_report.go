@@ -10,6 +10,8 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -53,6 +55,13 @@ type ReportYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams"`
+		Webhook struct {
+			URLs     []string `yaml:"urls"`
+			Template string   `yaml:"template"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
@@ -179,6 +188,23 @@ func (p *Parser) parseReport(node *Node) error {
 		return fmt.Errorf(`missing required property "data" or "query.name"`)
 	}
 
+	// Parse export output
+	var outputProps *structpb.Struct
+	if tmp.Export.OutputConnector != "" {
+		if exportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_PDF {
+			return fmt.Errorf(`property "export.output_connector" is not supported for export format "pdf"`)
+		}
+		if path, ok := tmp.Export.OutputProperties["path"].(string); !ok || path == "" {
+			return fmt.Errorf(`missing required property "export.output_properties.path"`)
+		}
+		outputProps, err = structpb.NewStruct(tmp.Export.OutputProperties)
+		if err != nil {
+			return fmt.Errorf(`invalid property "export.output_properties": %w`, err)
+		}
+	} else if len(tmp.Export.OutputProperties) > 0 {
+		return fmt.Errorf(`property "export.output_properties" requires "export.output_connector"`)
+	}
+
 	if len(tmp.Email.Recipients) > 0 && len(tmp.Notify.Email.Recipients) > 0 {
 		return errors.New(`cannot set both "email.recipients" and "notify.email.recipients"`)
 	}
@@ -196,7 +222,7 @@ func (p *Parser) parseReport(node *Node) error {
 		}
 	} else {
 		if len(tmp.Notify.Email.Recipients) == 0 && len(tmp.Notify.Slack.Channels) == 0 &&
-			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 {
+			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 && tmp.Export.OutputConnector == "" {
 			return fmt.Errorf(`missing notification recipients`)
 		}
 		for _, email := range tmp.Notify.Email.Recipients {
@@ -240,6 +266,8 @@ func (p *Parser) parseReport(node *Node) error {
 	r.ReportSpec.ExportLimit = uint64(tmp.Export.Limit)
 	r.ReportSpec.ExportFormat = exportFormat
 	r.ReportSpec.ExportIncludeHeader = tmp.Export.IncludeHeader
+	r.ReportSpec.ExportOutputConnector = tmp.Export.OutputConnector
+	r.ReportSpec.ExportOutputProperties = outputProps
 
 	if isLegacySyntax {
 		// Backwards compatibility
@@ -281,6 +309,28 @@ func (p *Parser) parseReport(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Teams settings
+		if len(tmp.Notify.Teams.Webhooks) > 0 {
+			props, err := structpb.NewStruct(teams.EncodeProps(tmp.Notify.Teams.Webhooks))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "teams",
+				Properties: props,
+			})
+		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Template))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
 	}
 
 	r.ReportSpec.Annotations = tmp.Annotations
@@ -300,6 +350,10 @@ func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
 		return runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET, nil
 	case "pdf":
 		return runtimev1.ExportFormat_EXPORT_FORMAT_PDF, nil
+	case "jsonl", "ndjson":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_JSONL, nil
+	case "arrow":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_ARROW, nil
 	default:
 		if val, ok := runtimev1.ExportFormat_value[s]; ok {
 			return runtimev1.ExportFormat(val), nil
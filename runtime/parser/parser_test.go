@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 )
@@ -1351,6 +1353,100 @@ security:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewSecurityMask(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`metrics/d1.yaml`: `
+version: 1
+type: metrics_view
+table: t1
+dimensions:
+  - name: a
+    column: a
+  - name: c
+    column: c
+measures:
+  - name: b
+    expression: count(*)
+security:
+  access: true
+  mask:
+    - if: "'{{ .user.domain }}' != 'example.com'"
+      names: [a]
+      type: hash
+  rules:
+    - type: field_mask
+      names: [c]
+      mask: bucket
+      bucket_size: 100
+`,
+		`metrics/d2.yaml`: `
+version: 1
+type: metrics_view
+table: t1
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+security:
+  access: true
+  mask:
+    - names: [a]
+      type: hash
+      keep_last: 4
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/metrics/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector:   "duckdb",
+				Table:       "t1",
+				DisplayName: "D1",
+				Dimensions: []*runtimev1.MetricsViewSpec_Dimension{
+					{Name: "a", DisplayName: "A", Column: "a"},
+					{Name: "c", DisplayName: "C", Column: "c"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_Measure{
+					{Name: "b", DisplayName: "B", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				},
+				SecurityRules: []*runtimev1.SecurityRule{
+					{Rule: &runtimev1.SecurityRule_Access{Access: &runtimev1.SecurityRuleAccess{
+						ConditionExpression: "true",
+						Allow:               true,
+					}}},
+					{Rule: &runtimev1.SecurityRule_FieldMask{FieldMask: &runtimev1.SecurityRuleFieldMask{
+						ConditionExpression: "'{{ .user.domain }}' != 'example.com'",
+						Fields:              []string{"a"},
+						Type:                runtimev1.FieldMaskType_FIELD_MASK_TYPE_HASH,
+					}}},
+					{Rule: &runtimev1.SecurityRule_FieldMask{FieldMask: &runtimev1.SecurityRuleFieldMask{
+						Fields:     []string{"c"},
+						Type:       runtimev1.FieldMaskType_FIELD_MASK_TYPE_BUCKET,
+						BucketSize: 100,
+					}}},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  "'keep_last' can only be set for masks of type 'redact'",
+			FilePath: "/metrics/d2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", true)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestReport(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1419,6 +1515,12 @@ notify:
       - reports
     users:
       - user_2@example.com
+  teams:
+    webhooks:
+      - https://example.webhook.office.com/hook
+  webhook:
+    urls:
+      - https://example.com/hook
 
 annotations:
   foo: bar
@@ -1493,6 +1595,8 @@ annotations:
 				Notifiers: []*runtimev1.Notifier{
 					{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"user_1@example.com"}}))},
 					{Connector: "slack", Properties: must(structpb.NewStruct(map[string]any{"users": []any{"user_2@example.com"}, "channels": []any{"reports"}, "webhooks": []any{}}))},
+					{Connector: "teams", Properties: must(structpb.NewStruct(map[string]any{"webhooks": []any{"https://example.webhook.office.com/hook"}}))},
+					{Connector: "webhook", Properties: must(structpb.NewStruct(map[string]any{"urls": []any{"https://example.com/hook"}}))},
 				},
 				Annotations:          map[string]string{"foo": "bar"},
 				WatermarkInherit:     true,
@@ -1584,6 +1688,72 @@ notify:
 	})
 }
 
+func TestReportExportOutput(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// Reports that write to an object store don't need notification recipients
+		`reports/r1.yaml`: `
+type: report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+export:
+  format: parquet
+  output_connector: s3
+  output_properties:
+    path: 's3://bucket/finance/{{ date "2006-01-02" .report_time }}.parquet'
+`,
+		`reports/r2.yaml`: `
+type: report
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+  output_connector: s3
+notify:
+  email:
+    recipients:
+      - user_1@example.com
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r1"},
+			Paths: []string{"/reports/r1.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				DisplayName:     "R1",
+				RefreshSchedule: &runtimev1.Schedule{Cron: "0 * * * *"},
+				Resolver:        "legacy_metrics",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{
+					"query_name":      "MetricsViewToplist",
+					"query_args_json": "{\"metrics_view\":\"mv1\"}",
+				})),
+				ExportFormat:          runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET,
+				ExportOutputConnector: "s3",
+				ExportOutputProperties: must(structpb.NewStruct(map[string]any{
+					"path": `s3://bucket/finance/{{ date "2006-01-02" .report_time }}.parquet`,
+				})),
+			},
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", true)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, []*runtimev1.ParseError{
+		{
+			Message:  `missing required property "export.output_properties.path"`,
+			FilePath: "/reports/r2.yaml",
+		},
+	})
+}
+
 func TestAlert(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1674,6 +1844,167 @@ annotations:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAlertMute(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+data:
+  metrics_sql: SELECT * FROM mv1
+mute:
+  - cron: '0 2 * * *'
+    duration: 4h
+    time_zone: America/Los_Angeles
+snooze_until: 2024-01-02T00:00:00Z
+`,
+		`alerts/a2.yaml`: `
+type: alert
+data:
+  metrics_sql: SELECT * FROM mv1
+mute:
+  - cron: '0 2 * * *'
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			AlertSpec: &runtimev1.AlertSpec{
+				DisplayName:        "A1",
+				RefreshSchedule:    &runtimev1.Schedule{RefUpdate: true},
+				Resolver:           "metrics_sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT * FROM mv1"})),
+				NotifyOnFail:       true,
+				MuteWindows: []*runtimev1.AlertMuteWindow{
+					{Cron: "0 2 * * *", TimeZone: "America/Los_Angeles", DurationSeconds: 4 * 60 * 60},
+				},
+				SnoozeUntil: timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `missing "duration" for "mute" entry 0`,
+			FilePath: "/alerts/a2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", true)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestAlertGroupBy(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+data:
+  metrics_sql: SELECT country, revenue FROM mv1 WHERE revenue < 100
+group_by:
+  - country
+`,
+		`alerts/a2.yaml`: `
+type: alert
+data:
+  metrics_sql: SELECT country, revenue FROM mv1 WHERE revenue < 100
+group_by:
+  - country
+  - country
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			AlertSpec: &runtimev1.AlertSpec{
+				DisplayName:        "A1",
+				RefreshSchedule:    &runtimev1.Schedule{RefUpdate: true},
+				Resolver:           "metrics_sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT country, revenue FROM mv1 WHERE revenue < 100"})),
+				NotifyOnFail:       true,
+				GroupBy:            []string{"country"},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `duplicate field "country" in "group_by"`,
+			FilePath: "/alerts/a2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", true)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestAlertAnomaly(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+data:
+  anomaly:
+    metrics_view: mv1
+    measure: revenue
+    time_grain: day
+    periods: 8
+    threshold: 2.5
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`alerts/a2.yaml`: `
+type: alert
+data:
+  anomaly:
+    metrics_view: mv1
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				DisplayName:     "A1",
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				Resolver:        "metrics_anomaly",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{
+					"metrics_view": "mv1",
+					"measure":      "revenue",
+					"time_grain":   "day",
+					"periods":      8,
+					"threshold":    2.5,
+				})),
+				NotifyOnFail: true,
+				Notifiers:    []*runtimev1.Notifier{{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"benjamin@example.com"}}))}},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  "anomaly resolver requires a measure to be specified",
+			FilePath: "/alerts/a2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", true)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestMetricsViewAvoidSelfCyclicRef(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -2857,6 +3188,77 @@ tests:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestModelFreshness(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`models/m1.yaml`: `
+type: model
+sql: SELECT now() AS updated_at
+freshness:
+  max_staleness: 6h
+`,
+		`models/m2.yaml`: `
+type: model
+sql: SELECT now() AS updated_at
+freshness:
+  max_staleness: 1h
+  watermark: MAX(updated_at)
+`,
+		`models/m3.yaml`: `
+type: model
+sql: SELECT now() AS updated_at
+freshness:
+  watermark: MAX(updated_at)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "duckdb",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT now() AS updated_at"})),
+				OutputConnector: "duckdb",
+				ChangeMode:      runtimev1.ModelChangeMode_MODEL_CHANGE_MODE_RESET,
+				Freshness: &runtimev1.ModelFreshness{
+					MaxStalenessSeconds: 6 * 60 * 60,
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+			Paths: []string{"/models/m2.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "duckdb",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT now() AS updated_at"})),
+				OutputConnector: "duckdb",
+				ChangeMode:      runtimev1.ModelChangeMode_MODEL_CHANGE_MODE_RESET,
+				Freshness: &runtimev1.ModelFreshness{
+					MaxStalenessSeconds: 60 * 60,
+					WatermarkResolver:   "sql",
+					WatermarkResolverProperties: must(structpb.NewStruct(map[string]any{
+						"connector": "duckdb",
+						"sql":       "SELECT MAX(updated_at) AS watermark FROM {{ ref \"m2\" }}",
+					})),
+				},
+			},
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", true)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, []*runtimev1.ParseError{
+		{
+			Message:  `must specify "max_staleness"`,
+			FilePath: "/models/m3.yaml",
+		},
+	})
+}
+
 func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resource, wantErrors []*runtimev1.ParseError) {
 	// Check errors
 	// NOTE: Assumes there's at most one parse error per file path
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"mime"
+	"path"
 	"strings"
 
 	"cloud.google.com/go/storage"
@@ -44,6 +47,17 @@ func (b *Bucket) Underlying() *blob.Bucket {
 	return b.bucket
 }
 
+// Upload writes the contents of r to the object with the given key, replacing it if it already exists.
+// The key should be a path *without* scheme or bucket name.
+// The content type is inferred from the key's file extension.
+func (b *Bucket) Upload(ctx context.Context, key string, r io.Reader) error {
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return b.bucket.Upload(ctx, key, r, &blob.WriterOptions{ContentType: contentType})
+}
+
 // ListObjectsForGlob lists objects in the bucket that match the given glob pattern.
 // The glob pattern should be a valid path *without* scheme or bucket name.
 // E.g. to list gs://my-bucket/path/to/files/*, the glob pattern should be "path/to/files/*".
@@ -119,6 +133,12 @@ func (b *Bucket) ListObjectsForGlob(ctx context.Context, glob string, pageSize u
 						azOpts.StartFrom = &driverStartAfter
 					}
 				}
+
+				// Handle drivers that take a plain start offset (e.g. SFTP)
+				var startAfter *string
+				if as(&startAfter) {
+					*startAfter = driverStartAfter
+				}
 				return nil
 			},
 		})
@@ -0,0 +1,155 @@
+// Package deltalake implements reading the transaction log of Delta Lake tables.
+// It is used to resolve the version of a table and the data files added between versions before the table's data is read by an OLAP engine.
+// See https://github.com/delta-io/delta/blob/master/PROTOCOL.md for details about the log format.
+package deltalake
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// logDir is the name of the directory containing a table's transaction log.
+const logDir = "_delta_log"
+
+// Commit contains the actions of a single commit file in the transaction log.
+// It only contains the fields that are needed for incremental reads.
+type Commit struct {
+	Version int64
+	// Added contains the data files that were added in the commit.
+	Added []AddFile
+	// Removed contains the data files that were removed in the commit.
+	Removed []RemoveFile
+	// MetadataChanged is true if the commit changed the table's metadata, such as its schema or partitioning.
+	MetadataChanged bool
+}
+
+// AddFile is an "add" action in a commit.
+type AddFile struct {
+	// Path is the path of the data file. It is a URL-encoded path relative to the table location or an absolute URI.
+	Path            string             `json:"path"`
+	PartitionValues map[string]*string `json:"partitionValues"`
+	Size            int64              `json:"size"`
+	// DataChange is false for files that were rewritten without changing the table's data, such as during compaction.
+	DataChange bool `json:"dataChange"`
+}
+
+// RemoveFile is a "remove" action in a commit.
+type RemoveFile struct {
+	Path       string `json:"path"`
+	DataChange bool   `json:"dataChange"`
+}
+
+// ParseCommit parses the contents of a commit file. Each line in a commit file contains a single action.
+func ParseCommit(version int64, data []byte) (*Commit, error) {
+	c := &Commit{Version: version}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for s.Scan() {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var action struct {
+			Add      *AddFile        `json:"add"`
+			Remove   *RemoveFile     `json:"remove"`
+			MetaData json.RawMessage `json:"metaData"`
+		}
+		if err := json.Unmarshal(line, &action); err != nil {
+			return nil, fmt.Errorf("deltalake: invalid action in commit %d: %w", version, err)
+		}
+		if action.Add != nil {
+			c.Added = append(c.Added, *action.Add)
+		}
+		if action.Remove != nil {
+			c.Removed = append(c.Removed, *action.Remove)
+		}
+		if action.MetaData != nil {
+			c.MetadataChanged = true
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("deltalake: failed to read commit %d: %w", version, err)
+	}
+	return c, nil
+}
+
+// AppendOnly returns true if the commit only appended data to the table.
+// Files that were added or removed without changing the table's data, such as during compaction, are ignored.
+func (c *Commit) AppendOnly() bool {
+	if c.MetadataChanged {
+		return false
+	}
+	for _, r := range c.Removed {
+		if r.DataChange {
+			return false
+		}
+	}
+	return true
+}
+
+// DataFiles returns the paths of the files that added data to the table in the commit.
+func (c *Commit) DataFiles() []string {
+	var res []string
+	for _, a := range c.Added {
+		if a.DataChange {
+			res = append(res, a.Path)
+		}
+	}
+	return res
+}
+
+// LogGlob returns a glob that matches the commit files of the table at the given location.
+func LogGlob(location string) string {
+	return strings.TrimSuffix(location, "/") + "/" + logDir + "/*.json"
+}
+
+// CommitPath returns the path of the commit file for the given version of the table at the given location.
+func CommitPath(location string, version int64) string {
+	return fmt.Sprintf("%s/%s/%020d.json", strings.TrimSuffix(location, "/"), logDir, version)
+}
+
+// CommitVersion extracts the version from the path of a commit file.
+// Commit files are named after their version zero-padded to 20 digits, such as "00000000000000000010.json".
+func CommitVersion(p string) (int64, bool) {
+	name, ok := strings.CutSuffix(path.Base(p), ".json")
+	if !ok || len(name) != 20 {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(name, 10, 64)
+	if err != nil || v < 0 {
+		return 0, false
+	}
+	return v, true
+}
+
+// LatestVersion returns the latest version of a table given the paths of its commit files.
+// Paths that are not commit files are ignored. It returns false if no commit files are found.
+func LatestVersion(paths []string) (int64, bool) {
+	latest := int64(-1)
+	for _, p := range paths {
+		v, ok := CommitVersion(p)
+		if ok && v > latest {
+			latest = v
+		}
+	}
+	return latest, latest >= 0
+}
+
+// ResolvePath resolves the path of a data file in an add or remove action against the table location.
+// Relative paths are URL-encoded in the log, so they are decoded before being joined with the location.
+func ResolvePath(location, p string) (string, error) {
+	if strings.Contains(p, "://") {
+		return p, nil
+	}
+	decoded, err := url.PathUnescape(p)
+	if err != nil {
+		return "", fmt.Errorf("deltalake: invalid data file path %q: %w", p, err)
+	}
+	return strings.TrimSuffix(location, "/") + "/" + decoded, nil
+}
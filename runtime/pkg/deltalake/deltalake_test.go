@@ -0,0 +1,67 @@
+package deltalake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommit(t *testing.T) {
+	c, err := ParseCommit(3, []byte(`{"commitInfo":{"timestamp":1700000000000,"operation":"WRITE"}}
+{"add":{"path":"country=US/part-00000-1a2b.snappy.parquet","partitionValues":{"country":"US"},"size":1024,"modificationTime":1700000000000,"dataChange":true}}
+{"add":{"path":"country=DK/part-00001-3c4d.snappy.parquet","partitionValues":{"country":"DK"},"size":2048,"modificationTime":1700000000000,"dataChange":true}}
+`))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), c.Version)
+	require.True(t, c.AppendOnly())
+	require.Equal(t, []string{"country=US/part-00000-1a2b.snappy.parquet", "country=DK/part-00001-3c4d.snappy.parquet"}, c.DataFiles())
+
+	// Compaction rewrites files without changing data
+	c, err = ParseCommit(4, []byte(`{"remove":{"path":"country=US/part-00000-1a2b.snappy.parquet","dataChange":false}}
+{"add":{"path":"country=US/part-00002-5e6f.snappy.parquet","partitionValues":{"country":"US"},"size":1024,"dataChange":false}}
+`))
+	require.NoError(t, err)
+	require.True(t, c.AppendOnly())
+	require.Empty(t, c.DataFiles())
+
+	// Deletes are not append-only
+	c, err = ParseCommit(5, []byte(`{"remove":{"path":"country=DK/part-00001-3c4d.snappy.parquet","dataChange":true}}`))
+	require.NoError(t, err)
+	require.False(t, c.AppendOnly())
+
+	// Schema changes are not append-only
+	c, err = ParseCommit(6, []byte(`{"metaData":{"id":"5b9a","format":{"provider":"parquet"},"schemaString":"{}","partitionColumns":[]}}`))
+	require.NoError(t, err)
+	require.False(t, c.AppendOnly())
+
+	_, err = ParseCommit(7, []byte(`{"add":`))
+	require.Error(t, err)
+}
+
+func TestLatestVersion(t *testing.T) {
+	v, ok := LatestVersion([]string{
+		"s3://b/t/_delta_log/00000000000000000000.json",
+		"s3://b/t/_delta_log/00000000000000000010.json",
+		"s3://b/t/_delta_log/00000000000000000009.json",
+		"s3://b/t/_delta_log/00000000000000000010.checkpoint.parquet",
+		"s3://b/t/_delta_log/_last_checkpoint",
+	})
+	require.True(t, ok)
+	require.Equal(t, int64(10), v)
+
+	_, ok = LatestVersion([]string{"s3://b/t/part-00000.parquet"})
+	require.False(t, ok)
+
+	require.Equal(t, "s3://b/t/_delta_log/00000000000000000012.json", CommitPath("s3://b/t/", 12))
+	require.Equal(t, "s3://b/t/_delta_log/*.json", LogGlob("s3://b/t"))
+}
+
+func TestResolvePath(t *testing.T) {
+	p, err := ResolvePath("s3://b/t", "event%20date=2024-01-01/part-00000.parquet")
+	require.NoError(t, err)
+	require.Equal(t, "s3://b/t/event date=2024-01-01/part-00000.parquet", p)
+
+	p, err = ResolvePath("s3://b/t", "s3://other/part-00000.parquet")
+	require.NoError(t, err)
+	require.Equal(t, "s3://other/part-00000.parquet", p)
+}
@@ -1,6 +1,7 @@
 package driverutil
 
 import (
+	"bufio"
 	"encoding/csv"
 	"encoding/json"
 	"errors"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/parquet/pqarrow"
 	"github.com/c2h5oh/datasize"
@@ -21,6 +23,9 @@ import (
 
 const maxParquetRowGroupSize = 512 * int64(datasize.MB)
 
+// arrowBatchSize is the number of rows buffered in memory before they are flushed as a record batch.
+const arrowBatchSize = 1000
+
 func ResultToFile(res *drivers.Result, fw io.Writer, format drivers.FileFormat, headers []string) error {
 	switch format {
 	case drivers.FileFormatParquet:
@@ -31,6 +36,10 @@ func ResultToFile(res *drivers.Result, fw io.Writer, format drivers.FileFormat,
 		return errors.New("json file output not currently supported")
 	case drivers.FileFormatXLSX:
 		return writeXLSX(res, fw, headers)
+	case drivers.FileFormatJSONL:
+		return writeJSONL(res, fw)
+	case drivers.FileFormatArrow:
+		return writeArrow(res, fw)
 	default:
 		return fmt.Errorf("unsupported output format %q", format)
 	}
@@ -217,36 +226,128 @@ func writeXLSX(res *drivers.Result, fw io.Writer, headers []string) error {
 	return nil
 }
 
-func writeParquet(res *drivers.Result, fw io.Writer) error {
-	fields := make([]arrow.Field, 0, len(res.Schema.Fields))
-	for _, f := range res.Schema.Fields {
-		arrowField := arrow.Field{}
-		arrowField.Name = f.Name
-		switch f.Type.Code {
-		case runtimev1.Type_CODE_BOOL:
-			arrowField.Type = arrow.FixedWidthTypes.Boolean
-		case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
-			arrowField.Type = arrow.PrimitiveTypes.Int64
-		case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
-			arrowField.Type = arrow.PrimitiveTypes.Uint64
-		case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_DECIMAL:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
-			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
-		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_INTERVAL, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
-			arrowField.Type = arrow.BinaryTypes.String
-		case runtimev1.Type_CODE_BYTES:
-			arrowField.Type = arrow.BinaryTypes.Binary
+// writeJSONL writes the result as newline-delimited JSON with one object per row.
+// Keys are written in the order of the result's schema. Rows are streamed, so memory usage is independent of the result size.
+func writeJSONL(res *drivers.Result, fw io.Writer) error {
+	w := bufio.NewWriter(fw)
+
+	// Pre-encode the keys since they are the same for every row
+	keys := make([][]byte, len(res.Schema.Fields))
+	for i, f := range res.Schema.Fields {
+		k, err := json.Marshal(f.Name)
+		if err != nil {
+			return err
+		}
+		keys[i] = k
+	}
+
+	vals := make([]any, len(res.Schema.Fields))
+	for i := range vals {
+		vals[i] = new(any)
+	}
+
+	for res.Next() {
+		err := res.Scan(vals...)
+		if err != nil {
+			return err
+		}
+
+		_ = w.WriteByte('{')
+		for i, v := range vals {
+			v := *(v.(*any))
+			val, err := jsonval.ToValue(v, res.Schema.Fields[i].Type)
+			if err != nil {
+				return fmt.Errorf("failed to convert to JSON value: %w", err)
+			}
+
+			enc, err := json.Marshal(val)
+			if err != nil {
+				return fmt.Errorf("failed to marshal JSON value: %w", err)
+			}
+
+			if i > 0 {
+				_ = w.WriteByte(',')
+			}
+			_, _ = w.Write(keys[i])
+			_ = w.WriteByte(':')
+			_, _ = w.Write(enc)
+		}
+		_ = w.WriteByte('}')
+		err = w.WriteByte('\n')
+		if err != nil {
+			return err
 		}
-		fields = append(fields, arrowField)
 	}
-	schema := arrow.NewSchema(fields, nil)
+	if res.Err() != nil {
+		return res.Err()
+	}
+
+	return w.Flush()
+}
+
+// writeArrow writes the result as an Arrow IPC stream.
+// Rows are written in record batches, so memory usage is bounded by the batch size.
+func writeArrow(res *drivers.Result, fw io.Writer) error {
+	schema := arrowSchema(res, true)
+	mem := memory.DefaultAllocator
+	recordBuilder := array.NewRecordBuilder(mem, schema)
+	defer recordBuilder.Release()
+
+	w := ipc.NewWriter(fw, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+
+	vals := make([]any, len(res.Schema.Fields))
+	for i := range vals {
+		vals[i] = new(any)
+	}
+
+	writeBatch := func() error {
+		rec := recordBuilder.NewRecordBatch()
+		defer rec.Release()
+		return w.Write(rec)
+	}
+
+	var rows int64
+	for res.Next() {
+		err := res.Scan(vals...)
+		if err != nil {
+			_ = w.Close()
+			return err
+		}
+
+		for i, v := range vals {
+			err := appendArrowValue(recordBuilder.Field(i), res.Schema.Fields[i].Type, *(v.(*any)), true)
+			if err != nil {
+				_ = w.Close()
+				return err
+			}
+		}
+
+		rows++
+		if rows == arrowBatchSize {
+			if err := writeBatch(); err != nil {
+				_ = w.Close()
+				return err
+			}
+			rows = 0
+		}
+	}
+	if res.Err() != nil {
+		_ = w.Close()
+		return res.Err()
+	}
+	if rows > 0 {
+		if err := writeBatch(); err != nil {
+			_ = w.Close()
+			return err
+		}
+	}
+
+	// Close writes the end-of-stream marker. It also writes the schema if no batches were written.
+	return w.Close()
+}
+
+func writeParquet(res *drivers.Result, fw io.Writer) error {
+	schema := arrowSchema(res, false)
 	mem := memory.DefaultAllocator
 	recordBuilder := array.NewRecordBuilder(mem, schema)
 	defer recordBuilder.Release()
@@ -269,58 +370,13 @@ func writeParquet(res *drivers.Result, fw io.Writer) error {
 		}
 
 		for i, v := range vals {
-			t := res.Schema.Fields[i].Type
-			v := *(v.(*any))
-			v, err := jsonval.ToValue(v, res.Schema.Fields[i].Type)
+			err := appendArrowValue(recordBuilder.Field(i), res.Schema.Fields[i].Type, *(v.(*any)), false)
 			if err != nil {
-				return fmt.Errorf("failed to convert to JSON value: %w", err)
-			}
-
-			switch t.Code {
-			case runtimev1.Type_CODE_BOOL:
-				v, _ := v.(bool)
-				recordBuilder.Field(i).(*array.BooleanBuilder).Append(v)
-			case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
-				v, _ := v.(int64)
-				recordBuilder.Field(i).(*array.Int64Builder).Append(v)
-			case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
-				v, _ := v.(uint64)
-				recordBuilder.Field(i).(*array.Uint64Builder).Append(v)
-			case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_DECIMAL:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
-				v, _ := v.(time.Time)
-				tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
-				if err != nil {
-					return err
-				}
-				recordBuilder.Field(i).(*array.TimestampBuilder).Append(tmp)
-			case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE:
-				v, _ := v.(string)
-				recordBuilder.Field(i).(*array.StringBuilder).Append(v)
-			case runtimev1.Type_CODE_INTERVAL, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
-				res, err := json.Marshal(v)
-				if err != nil {
-					return fmt.Errorf("failed to convert to JSON value: %w", err)
-				}
-				recordBuilder.Field(i).(*array.StringBuilder).Append(jsonval.TrimQuotes(string(res)))
-			case runtimev1.Type_CODE_BYTES:
-				v, _ := v.([]byte)
-				recordBuilder.Field(i).(*array.BinaryBuilder).Append(v)
+				return err
 			}
 		}
 		rows++
-		if rows == 1000 {
+		if rows == arrowBatchSize {
 			rec := recordBuilder.NewRecordBatch()
 			if err := parquetwriter.WriteBuffered(rec); err != nil {
 				rec.Release()
@@ -346,3 +402,95 @@ func writeParquet(res *drivers.Result, fw io.Writer) error {
 	rec.Release()
 	return err
 }
+
+// arrowSchema maps the result's schema to an Arrow schema.
+// If nullable is false, NULL values are written as the zero value of the column's type.
+func arrowSchema(res *drivers.Result, nullable bool) *arrow.Schema {
+	fields := make([]arrow.Field, 0, len(res.Schema.Fields))
+	for _, f := range res.Schema.Fields {
+		arrowField := arrow.Field{}
+		arrowField.Name = f.Name
+		arrowField.Nullable = nullable
+		switch f.Type.Code {
+		case runtimev1.Type_CODE_BOOL:
+			arrowField.Type = arrow.FixedWidthTypes.Boolean
+		case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
+			arrowField.Type = arrow.PrimitiveTypes.Int64
+		case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
+			arrowField.Type = arrow.PrimitiveTypes.Uint64
+		case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_DECIMAL:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
+			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
+		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_INTERVAL, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
+			arrowField.Type = arrow.BinaryTypes.String
+		case runtimev1.Type_CODE_BYTES:
+			arrowField.Type = arrow.BinaryTypes.Binary
+		}
+		fields = append(fields, arrowField)
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// appendArrowValue appends a value scanned from a drivers.Result to a builder created for the schema returned by arrowSchema.
+func appendArrowValue(b array.Builder, t *runtimev1.Type, v any, nullable bool) error {
+	v, err := jsonval.ToValue(v, t)
+	if err != nil {
+		return fmt.Errorf("failed to convert to JSON value: %w", err)
+	}
+
+	if nullable && v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		v, _ := v.(bool)
+		b.(*array.BooleanBuilder).Append(v)
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
+		v, _ := v.(int64)
+		b.(*array.Int64Builder).Append(v)
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
+		v, _ := v.(float64)
+		b.(*array.Float64Builder).Append(v)
+	case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
+		v, _ := v.(uint64)
+		b.(*array.Uint64Builder).Append(v)
+	case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
+		v, _ := v.(float64)
+		b.(*array.Float64Builder).Append(v)
+	case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+		v, _ := v.(float64)
+		b.(*array.Float64Builder).Append(v)
+	case runtimev1.Type_CODE_DECIMAL:
+		v, _ := v.(float64)
+		b.(*array.Float64Builder).Append(v)
+	case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
+		v, _ := v.(time.Time)
+		tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
+		if err != nil {
+			return err
+		}
+		b.(*array.TimestampBuilder).Append(tmp)
+	case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE:
+		v, _ := v.(string)
+		b.(*array.StringBuilder).Append(v)
+	case runtimev1.Type_CODE_INTERVAL, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
+		res, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to convert to JSON value: %w", err)
+		}
+		b.(*array.StringBuilder).Append(jsonval.TrimQuotes(string(res)))
+	case runtimev1.Type_CODE_BYTES:
+		v, _ := v.([]byte)
+		b.(*array.BinaryBuilder).Append(v)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package driverutil
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultToFileJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	err := ResultToFile(testResult(), &buf, drivers.FileFormatJSONL, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"a","count":1,"ts":"2024-01-01T00:00:00Z"}
+{"name":null,"count":2,"ts":null}
+`, buf.String())
+}
+
+func TestResultToFileArrow(t *testing.T) {
+	var buf bytes.Buffer
+	err := ResultToFile(testResult(), &buf, drivers.FileFormatArrow, nil)
+	require.NoError(t, err)
+
+	r, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer r.Release()
+
+	require.Equal(t, []string{"name", "count", "ts"}, []string{r.Schema().Field(0).Name, r.Schema().Field(1).Name, r.Schema().Field(2).Name})
+	require.Equal(t, arrow.PrimitiveTypes.Int64, r.Schema().Field(1).Type)
+
+	require.True(t, r.Next())
+	rec := r.RecordBatch()
+	require.Equal(t, int64(2), rec.NumRows())
+	require.Equal(t, "a", rec.Column(0).(*array.String).Value(0))
+	require.True(t, rec.Column(0).IsNull(1))
+	require.Equal(t, []int64{1, 2}, rec.Column(1).(*array.Int64).Int64Values())
+	require.True(t, rec.Column(2).IsNull(1))
+	require.False(t, r.Next())
+	require.NoError(t, r.Err())
+}
+
+func testResult() *drivers.Result {
+	return &drivers.Result{
+		Rows: &sliceRows{rows: [][]any{
+			{"a", int32(1), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{nil, int32(2), nil},
+		}},
+		Schema: &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+			{Name: "name", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+			{Name: "count", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT32}},
+			{Name: "ts", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+		}},
+	}
+}
+
+// sliceRows implements drivers.Rows for a static set of rows.
+type sliceRows struct {
+	rows [][]any
+	idx  int
+}
+
+func (r *sliceRows) Next() bool {
+	if r.idx >= len(r.rows) {
+		return false
+	}
+	r.idx++
+	return true
+}
+
+func (r *sliceRows) Err() error {
+	return nil
+}
+
+func (r *sliceRows) Close() error {
+	return nil
+}
+
+func (r *sliceRows) Scan(dest ...any) error {
+	row := r.rows[r.idx-1]
+	if len(dest) != len(row) {
+		return errors.New("unexpected number of scan destinations")
+	}
+	for i, v := range row {
+		*(dest[i].(*any)) = v
+	}
+	return nil
+}
+
+func (r *sliceRows) MapScan(dest map[string]any) error {
+	return errors.New("not implemented")
+}
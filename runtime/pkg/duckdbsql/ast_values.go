@@ -38,6 +38,7 @@ const (
 	astKeySubQuery         string = "subquery"
 	astKetRelationName     string = "relation_name"
 	astKeySchema           string = "schema"
+	astKeySchemaName       string = "schema_name"
 	astKeyIsNull           string = "is_null"
 	astKeyTypeInfo         string = "type_info"
 	astKeyScale            string = "scale"
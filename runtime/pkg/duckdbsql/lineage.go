@@ -0,0 +1,303 @@
+package duckdbsql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ColumnLineage describes the input columns that an output column of a SELECT statement is derived from.
+type ColumnLineage struct {
+	// Name of the output column. It is "*" for star expressions that could not be expanded, and empty for unaliased expressions.
+	Name string
+	// Sources are the input columns referenced by the output column's expression.
+	Sources []*ColumnSource
+}
+
+// ColumnSource is an input column of a SELECT statement.
+type ColumnSource struct {
+	// Table is the base table the column belongs to. It is empty if it could not be determined.
+	Table string
+	// Column is the name of the column in the base table. It is "*" for star expressions.
+	Column string
+}
+
+// ExtractColumnLineage traces the output columns of the first statement in a DuckDB SQL query back to the base table columns they are derived from.
+// Column references are resolved through CTEs, subqueries, joins and set operations.
+// The result is a conservative approximation: when a reference can't be resolved unambiguously, all candidate tables are returned.
+func (a *AST) ExtractColumnLineage() []*ColumnLineage {
+	statements := toNodeArray(a.ast, astKeyStatements)
+	if len(statements) == 0 {
+		return nil
+	}
+	return lineageOfQuery(toNode(statements[0], astKeyNode), nil)
+}
+
+// ExtractExpressionColumns returns the names of the columns referenced in a DuckDB SQL expression.
+// It is useful for finding the columns used by metrics view dimension and measure expressions.
+func ExtractExpressionColumns(expr string) ([]string, error) {
+	ast, err := Parse(fmt.Sprintf("SELECT %s FROM tbl", expr))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	seen := map[string]bool{}
+	for _, l := range ast.ExtractColumnLineage() {
+		for _, src := range l.Sources {
+			if src.Column == "*" || seen[src.Column] {
+				continue
+			}
+			seen[src.Column] = true
+			res = append(res, src.Column)
+		}
+	}
+	return res, nil
+}
+
+// lineageRelation is a relation in the FROM clause of a SELECT statement.
+// Exactly one of table or columns is set for resolvable relations. Both are empty for relations that can't be traced (e.g. table functions).
+type lineageRelation struct {
+	alias   string
+	table   string
+	columns []*ColumnLineage
+}
+
+func lineageOfQuery(node astNode, ctes map[string][]*ColumnLineage) []*ColumnLineage {
+	switch toString(node, astKeyType) {
+	case "CTE_NODE":
+		ctes = copyCTEs(ctes)
+		if query := toNode(toNode(node, astKeyQuery), astKeyNode); query != nil {
+			ctes[strings.ToLower(toString(node, astKeyCTEName))] = lineageOfQuery(query, ctes)
+		}
+		return lineageOfQuery(toNode(node, astKeyChild), ctes)
+
+	case "SELECT_NODE":
+		ctes = lineageOfCTEMap(toNode(node, astKeyCTE), ctes)
+		rels := lineageRelations(toNode(node, astKeyFromTable), ctes)
+
+		var res []*ColumnLineage
+		for _, col := range toNodeArray(node, astKeySelectColumnList) {
+			if toString(col, astKeyType) == "STAR" {
+				res = append(res, lineageOfStar(col, rels)...)
+				continue
+			}
+
+			name := toString(col, astKeyAlias)
+			if name == "" && toString(col, astKeyType) == "COLUMN_REF" {
+				if names := toTypedArray[string](col, astKeyColumnNames); len(names) > 0 {
+					name = names[len(names)-1]
+				}
+			}
+
+			var sources []*ColumnSource
+			walkColumnRefs(col, func(names []string) {
+				sources = append(sources, resolveColumnRef(rels, names)...)
+			})
+
+			res = append(res, &ColumnLineage{
+				Name:    name,
+				Sources: dedupeColumnSources(sources),
+			})
+		}
+		return res
+
+	case "SET_OPERATION_NODE":
+		ctes = lineageOfCTEMap(toNode(node, astKeyCTE), ctes)
+		left := lineageOfQuery(toNode(node, astKeyLeft), ctes)
+		right := lineageOfQuery(toNode(node, astKeyRight), ctes)
+
+		// The output columns are named by the left side and matched by position.
+		res := make([]*ColumnLineage, len(left))
+		for i, l := range left {
+			sources := append([]*ColumnSource{}, l.Sources...)
+			if i < len(right) {
+				sources = append(sources, right[i].Sources...)
+			}
+			res[i] = &ColumnLineage{
+				Name:    l.Name,
+				Sources: dedupeColumnSources(sources),
+			}
+		}
+		return res
+	}
+
+	return nil
+}
+
+func lineageOfCTEMap(node astNode, ctes map[string][]*ColumnLineage) map[string][]*ColumnLineage {
+	entries := toNodeArray(node, astKeyMap)
+	if len(entries) == 0 {
+		return ctes
+	}
+
+	ctes = copyCTEs(ctes)
+	for _, entry := range entries {
+		query := toNode(toNode(toNode(entry, astKeyValue), astKeyQuery), astKeyNode)
+		ctes[strings.ToLower(toString(entry, astKeyKey))] = lineageOfQuery(query, ctes)
+	}
+	return ctes
+}
+
+func lineageRelations(node astNode, ctes map[string][]*ColumnLineage) []*lineageRelation {
+	switch toString(node, astKeyType) {
+	case "JOIN":
+		left := lineageRelations(toNode(node, astKeyLeft), ctes)
+		right := lineageRelations(toNode(node, astKeyRight), ctes)
+		return append(left, right...)
+
+	case "BASE_TABLE":
+		name := toString(node, astKeyTableName)
+		alias := toString(node, astKeyAlias)
+		if alias == "" {
+			alias = name
+		}
+		if cols, ok := ctes[strings.ToLower(name)]; ok && toString(node, astKeySchemaName) == "" {
+			return []*lineageRelation{{alias: alias, columns: cols}}
+		}
+		return []*lineageRelation{{alias: alias, table: name}}
+
+	case "SUBQUERY":
+		cols := lineageOfQuery(toNode(toNode(node, astKeySubQuery), astKeyNode), ctes)
+		return []*lineageRelation{{alias: toString(node, astKeyAlias), columns: cols}}
+
+	case "TABLE_FUNCTION":
+		return []*lineageRelation{{alias: toString(node, astKeyAlias)}}
+
+	case "PIVOT":
+		return lineageRelations(toNode(node, astKeySource), ctes)
+	}
+
+	return nil
+}
+
+func lineageOfStar(node astNode, rels []*lineageRelation) []*ColumnLineage {
+	relation := toString(node, astKetRelationName)
+
+	var res []*ColumnLineage
+	for _, rel := range rels {
+		if relation != "" && !strings.EqualFold(rel.alias, relation) {
+			continue
+		}
+		if rel.table != "" {
+			res = append(res, &ColumnLineage{
+				Name:    "*",
+				Sources: []*ColumnSource{{Table: rel.table, Column: "*"}},
+			})
+			continue
+		}
+		res = append(res, rel.columns...)
+	}
+	return res
+}
+
+// resolveColumnRef resolves the column_names of a COLUMN_REF node to the base table columns it may refer to.
+func resolveColumnRef(rels []*lineageRelation, names []string) []*ColumnSource {
+	if len(names) == 0 {
+		return nil
+	}
+
+	// A qualified reference to a known relation.
+	if len(names) >= 2 {
+		relation, column := names[len(names)-2], names[len(names)-1]
+		for _, rel := range rels {
+			if strings.EqualFold(rel.alias, relation) {
+				return resolveRelationColumn(rel, column)
+			}
+		}
+	}
+
+	// Otherwise, the first name is a column (any remaining names are struct field accesses).
+	column := names[0]
+
+	// Prefer relations that explicitly expose the column.
+	var res []*ColumnSource
+	for _, rel := range rels {
+		for _, c := range rel.columns {
+			if strings.EqualFold(c.Name, column) {
+				res = append(res, c.Sources...)
+			}
+		}
+	}
+	if len(res) > 0 {
+		return res
+	}
+
+	for _, rel := range rels {
+		res = append(res, resolveRelationColumn(rel, column)...)
+	}
+	if len(res) == 0 {
+		// No FROM clause or only untraceable relations.
+		res = append(res, &ColumnSource{Column: column})
+	}
+	return res
+}
+
+func resolveRelationColumn(rel *lineageRelation, column string) []*ColumnSource {
+	if rel.table != "" {
+		return []*ColumnSource{{Table: rel.table, Column: column}}
+	}
+
+	var res []*ColumnSource
+	for _, c := range rel.columns {
+		if strings.EqualFold(c.Name, column) {
+			return c.Sources
+		}
+		if c.Name == "*" {
+			for _, src := range c.Sources {
+				res = append(res, &ColumnSource{Table: src.Table, Column: column})
+			}
+		}
+	}
+	return res
+}
+
+// walkColumnRefs calls fn with the column_names of every COLUMN_REF node in an expression.
+// It does not descend into subquery expressions, which have their own scope.
+func walkColumnRefs(node any, fn func(names []string)) {
+	switch n := node.(type) {
+	case map[string]any:
+		switch toString(n, astKeyType) {
+		case "COLUMN_REF":
+			fn(toTypedArray[string](n, astKeyColumnNames))
+			return
+		case "SUBQUERY":
+			return
+		}
+		// Visit keys in a stable order so the output is deterministic.
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			walkColumnRefs(n[k], fn)
+		}
+	case []any:
+		for _, v := range n {
+			walkColumnRefs(v, fn)
+		}
+	}
+}
+
+func dedupeColumnSources(sources []*ColumnSource) []*ColumnSource {
+	res := make([]*ColumnSource, 0, len(sources))
+	seen := map[ColumnSource]bool{}
+	for _, src := range sources {
+		key := ColumnSource{Table: strings.ToLower(src.Table), Column: strings.ToLower(src.Column)}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		res = append(res, src)
+	}
+	return res
+}
+
+func copyCTEs(ctes map[string][]*ColumnLineage) map[string][]*ColumnLineage {
+	res := make(map[string][]*ColumnLineage, len(ctes)+1)
+	for k, v := range ctes {
+		res[k] = v
+	}
+	return res
+}
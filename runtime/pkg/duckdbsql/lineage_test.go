@@ -0,0 +1,76 @@
+package duckdbsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAST_ExtractColumnLineage(t *testing.T) {
+	tests := []struct {
+		title   string
+		sql     string
+		lineage []*ColumnLineage
+	}{
+		{
+			"simple",
+			`select id, upper(name) as name, price * qty as total, 1 as one from orders`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "orders", Column: "id"}}},
+				{Name: "name", Sources: []*ColumnSource{{Table: "orders", Column: "name"}}},
+				{Name: "total", Sources: []*ColumnSource{{Table: "orders", Column: "price"}, {Table: "orders", Column: "qty"}}},
+				{Name: "one", Sources: []*ColumnSource{}},
+			},
+		},
+		{
+			"star",
+			`select *, o.id as order_id from orders o`,
+			[]*ColumnLineage{
+				{Name: "*", Sources: []*ColumnSource{{Table: "orders", Column: "*"}}},
+				{Name: "order_id", Sources: []*ColumnSource{{Table: "orders", Column: "id"}}},
+			},
+		},
+		{
+			"join",
+			`select o.id, c.name, country from orders o join customers c on o.customer_id = c.id`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "orders", Column: "id"}}},
+				{Name: "name", Sources: []*ColumnSource{{Table: "customers", Column: "name"}}},
+				{Name: "country", Sources: []*ColumnSource{{Table: "orders", Column: "country"}, {Table: "customers", Column: "country"}}},
+			},
+		},
+		{
+			"cte and subquery",
+			`
+with c as (select id, name as customer_name from customers)
+select s.total, c.customer_name from (select customer_id, sum(price) as total from orders group by 1) s join c on s.customer_id = c.id
+`,
+			[]*ColumnLineage{
+				{Name: "total", Sources: []*ColumnSource{{Table: "orders", Column: "price"}}},
+				{Name: "customer_name", Sources: []*ColumnSource{{Table: "customers", Column: "name"}}},
+			},
+		},
+		{
+			"union",
+			`select id, a as val from t1 union all select id, b from t2`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "t1", Column: "id"}, {Table: "t2", Column: "id"}}},
+				{Name: "val", Sources: []*ColumnSource{{Table: "t1", Column: "a"}, {Table: "t2", Column: "b"}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			ast, err := Parse(tt.sql)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.lineage, ast.ExtractColumnLineage())
+		})
+	}
+}
+
+func TestExtractExpressionColumns(t *testing.T) {
+	cols, err := ExtractExpressionColumns("SUM(price * qty) FILTER (WHERE country = 'US') / COUNT(DISTINCT id)")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"price", "qty", "country", "id"}, cols)
+}
@@ -102,6 +102,7 @@ func (c *Client) SendAlertStatus(opts *drivers.AlertStatus) error {
 			DisplayName:         opts.DisplayName,
 			ExecutionTimeString: opts.ExecutionTime.Format(time.RFC1123),
 			FailRow:             opts.FailRow,
+			MutedExecutions:     opts.MutedExecutions,
 			OpenLink:            template.URL(opts.OpenLink),
 			EditLink:            template.URL(opts.EditLink),
 			UnsubscribeLink:     template.URL(opts.UnsubscribeLink),
@@ -125,6 +126,7 @@ type alertFailData struct {
 	DisplayName         string
 	ExecutionTimeString string // Will be inferred from ExecutionTime
 	FailRow             map[string]any
+	MutedExecutions     int
 	OpenLink            template.URL
 	EditLink            template.URL
 	UnsubscribeLink     template.URL
@@ -766,6 +768,78 @@ Hope to see you back soon,
 	})
 }
 
+type BudgetThresholdReached struct {
+	ToEmail    string
+	ToName     string
+	OrgName    string
+	BillingURL string
+	// Metric is the usage metric the budget applies to. It is empty for spend budgets.
+	Metric    string
+	Threshold int
+	Current   float64
+	Amount    float64
+}
+
+func (c *Client) SendBudgetThresholdReached(opts *BudgetThresholdReached) error {
+	return c.SendCallToAction(&CallToAction{
+		ToEmail: opts.ToEmail,
+		ToName:  opts.ToName,
+		Subject: fmt.Sprintf("%s has reached %d%% of its monthly budget", opts.OrgName, opts.Threshold),
+		PreButton: template.HTML(fmt.Sprintf(`
+Hi there,
+<br /><br />
+<b>%s</b> has reached %d%% of its monthly %s budget, with %s used of %s so far this month.
+`, opts.OrgName, opts.Threshold, budgetKind(opts.Metric), formatBudgetValue(opts.Metric, opts.Current), formatBudgetValue(opts.Metric, opts.Amount))),
+		ButtonText: "View Billing",
+		ButtonLink: opts.BillingURL,
+		ShowFooter: true,
+	})
+}
+
+type BudgetCapReached struct {
+	ToEmail    string
+	ToName     string
+	OrgName    string
+	BillingURL string
+	// Metric is the usage metric the budget applies to. It is empty for spend budgets.
+	Metric string
+	Amount float64
+}
+
+func (c *Client) SendBudgetCapReached(opts *BudgetCapReached) error {
+	return c.SendCallToAction(&CallToAction{
+		ToEmail: opts.ToEmail,
+		ToName:  opts.ToName,
+		Subject: fmt.Sprintf("%s has exceeded its monthly budget", opts.OrgName),
+		PreButton: template.HTML(fmt.Sprintf(`
+Hi there,
+<br /><br />
+<b>%s</b> has exceeded its monthly %s budget of %s. Since the budget has a hard cap, its non-production deployments have been <a href="https://docs.rilldata.com/developers/other/FAQ#what-is-project-hibernation" style="color:#4736F5">hibernated</a>. Production deployments are not affected.
+<br /><br />
+Nothing has been deleted. To bring the deployments back online, raise or remove the budget and redeploy them, or wait for the budget to reset at the start of next month.
+`, opts.OrgName, budgetKind(opts.Metric), formatBudgetValue(opts.Metric, opts.Amount))),
+		ButtonText: "View Billing",
+		ButtonLink: opts.BillingURL,
+		ShowFooter: true,
+	})
+}
+
+// budgetKind describes a budget in emails, e.g. "spend" or "query usage".
+func budgetKind(metric string) string {
+	if metric == "" {
+		return "spend"
+	}
+	return fmt.Sprintf("%s usage", metric)
+}
+
+// formatBudgetValue formats a budget amount or usage for display in emails.
+func formatBudgetValue(metric string, v float64) string {
+	if metric == "" {
+		return fmt.Sprintf("$%.2f", v)
+	}
+	return fmt.Sprintf("%.0f", v)
+}
+
 type PlanUpdate struct {
 	ToEmail  string
 	ToName   string
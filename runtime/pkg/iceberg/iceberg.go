@@ -0,0 +1,164 @@
+// Package iceberg implements reading Apache Iceberg table metadata.
+// It is used to resolve the metadata file and snapshot of a table before the table's data is read by an OLAP engine.
+// See https://iceberg.apache.org/spec/#table-metadata for details about the metadata format.
+package iceberg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Metadata is the parsed contents of an Iceberg table metadata file.
+// It only contains the fields that are needed for resolving snapshots.
+type Metadata struct {
+	FormatVersion     int        `json:"format-version"`
+	TableUUID         string     `json:"table-uuid"`
+	Location          string     `json:"location"`
+	CurrentSnapshotID *int64     `json:"current-snapshot-id"`
+	Snapshots         []Snapshot `json:"snapshots"`
+}
+
+// Snapshot is a snapshot of an Iceberg table.
+type Snapshot struct {
+	SnapshotID       int64             `json:"snapshot-id"`
+	ParentSnapshotID *int64            `json:"parent-snapshot-id"`
+	SequenceNumber   int64             `json:"sequence-number"`
+	TimestampMS      int64             `json:"timestamp-ms"`
+	ManifestList     string            `json:"manifest-list"`
+	SchemaID         *int              `json:"schema-id"`
+	Summary          map[string]string `json:"summary"`
+}
+
+// Snapshot operations, see https://iceberg.apache.org/spec/#snapshots.
+const (
+	OperationAppend    = "append"
+	OperationReplace   = "replace"
+	OperationOverwrite = "overwrite"
+	OperationDelete    = "delete"
+)
+
+// Operation returns the operation that produced the snapshot. It returns an empty string if the writer did not record it.
+func (s *Snapshot) Operation() string {
+	return s.Summary["operation"]
+}
+
+// ErrNoSnapshots is returned when a table does not have any snapshots, which means it has never been written to.
+var ErrNoSnapshots = errors.New("iceberg: table does not have any snapshots")
+
+// ParseMetadata parses the contents of a table metadata file.
+func ParseMetadata(data []byte) (*Metadata, error) {
+	m := &Metadata{}
+	err := json.Unmarshal(data, m)
+	if err != nil {
+		return nil, fmt.Errorf("iceberg: invalid table metadata: %w", err)
+	}
+	if m.FormatVersion == 0 || m.Location == "" {
+		return nil, errors.New("iceberg: invalid table metadata: missing format-version or location")
+	}
+	// Some writers use -1 to indicate that the table has no current snapshot.
+	if m.CurrentSnapshotID != nil && *m.CurrentSnapshotID < 0 {
+		m.CurrentSnapshotID = nil
+	}
+	return m, nil
+}
+
+// CurrentSnapshot returns the table's current snapshot.
+// It returns ErrNoSnapshots if the table has no current snapshot.
+func (m *Metadata) CurrentSnapshot() (*Snapshot, error) {
+	if m.CurrentSnapshotID == nil {
+		return nil, ErrNoSnapshots
+	}
+	return m.Snapshot(*m.CurrentSnapshotID)
+}
+
+// Snapshot returns the snapshot with the given ID.
+// Snapshots are removed from the metadata when they expire, so old snapshot IDs may not be found.
+func (m *Metadata) Snapshot(id int64) (*Snapshot, error) {
+	for i := range m.Snapshots {
+		if m.Snapshots[i].SnapshotID == id {
+			return &m.Snapshots[i], nil
+		}
+	}
+	return nil, fmt.Errorf("iceberg: snapshot %d not found (it may have expired)", id)
+}
+
+// AppendedSince returns true if the rows of the snapshot with the given ID are the rows of its ancestor snapshot fromID plus rows that were appended after it.
+// It returns false if fromID is not an ancestor (or has expired), if a snapshot in between deleted or overwrote rows, or if the table's schema changed.
+// Snapshots that only rewrote data files (the "replace" operation, e.g. compactions) don't change the table's rows.
+func (m *Metadata) AppendedSince(fromID, id int64) bool {
+	from, err := m.Snapshot(fromID)
+	if err != nil {
+		return false
+	}
+	s, err := m.Snapshot(id)
+	if err != nil {
+		return false
+	}
+	if from.SchemaID != nil && s.SchemaID != nil && *from.SchemaID != *s.SchemaID {
+		return false
+	}
+
+	// Walk the ancestors of the snapshot until reaching fromID. The number of steps is bounded to guard against cycles in corrupt metadata.
+	for i := 0; i < len(m.Snapshots); i++ {
+		if s.SnapshotID == fromID {
+			return true
+		}
+		switch s.Operation() {
+		case OperationAppend, OperationReplace:
+		default:
+			return false
+		}
+		if s.ParentSnapshotID == nil {
+			return false
+		}
+		s, err = m.Snapshot(*s.ParentSnapshotID)
+		if err != nil {
+			return false
+		}
+	}
+	return false
+}
+
+// LatestMetadataPath returns the path of the latest metadata file in a list of paths.
+// Metadata files are named either "v<version>.metadata.json" or "<version>-<uuid>.metadata.json" depending on the writer.
+// Paths that are not uncompressed metadata files are ignored. It returns an empty string if no metadata files are found.
+func LatestMetadataPath(paths []string) string {
+	var latest string
+	latestVersion := -1
+	for _, p := range paths {
+		v, ok := metadataVersion(path.Base(p))
+		if ok && v > latestVersion {
+			latest = p
+			latestVersion = v
+		}
+	}
+	return latest
+}
+
+// metadataFileRegexp matches the names of uncompressed metadata files, capturing their version.
+// Compressed metadata files (such as "<version>-<uuid>.gz.metadata.json") are not matched since they can't be parsed.
+var metadataFileRegexp = regexp.MustCompile(`^(?:v(\d+)|(\d+)-[0-9a-fA-F-]+)\.metadata\.json$`)
+
+// metadataVersion extracts the version from the name of a metadata file.
+func metadataVersion(name string) (int, bool) {
+	m := metadataFileRegexp.FindStringSubmatch(name)
+	if m == nil {
+		return 0, false
+	}
+	v, err := strconv.Atoi(m[1] + m[2])
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// MetadataGlob returns a glob that matches the metadata files of the table at the given location.
+// It also matches other files, such as compressed metadata files, which LatestMetadataPath ignores.
+func MetadataGlob(location string) string {
+	return strings.TrimSuffix(location, "/") + "/metadata/*.metadata.json"
+}
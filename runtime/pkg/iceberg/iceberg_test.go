@@ -0,0 +1,128 @@
+package iceberg
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMetadata = `{
+	"format-version": 2,
+	"table-uuid": "9c12d441-03fe-4693-9a96-a0705ddf69c1",
+	"location": "s3://bucket/warehouse/db/events",
+	"current-snapshot-id": 3055729675574597004,
+	"snapshots": [
+		{"snapshot-id": 3051729675574597004, "sequence-number": 1, "timestamp-ms": 1515100955770, "manifest-list": "s3://bucket/warehouse/db/events/metadata/snap-1.avro"},
+		{"snapshot-id": 3055729675574597004, "parent-snapshot-id": 3051729675574597004, "sequence-number": 2, "timestamp-ms": 1555100955770, "manifest-list": "s3://bucket/warehouse/db/events/metadata/snap-2.avro"}
+	]
+}`
+
+func TestParseMetadata(t *testing.T) {
+	md, err := ParseMetadata([]byte(testMetadata))
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/warehouse/db/events", md.Location)
+
+	s, err := md.CurrentSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, int64(3055729675574597004), s.SnapshotID)
+	require.Equal(t, int64(3051729675574597004), *s.ParentSnapshotID)
+
+	_, err = md.Snapshot(1)
+	require.Error(t, err)
+
+	md, err = ParseMetadata([]byte(`{"format-version": 2, "location": "s3://bucket/t", "current-snapshot-id": -1}`))
+	require.NoError(t, err)
+	_, err = md.CurrentSnapshot()
+	require.ErrorIs(t, err, ErrNoSnapshots)
+
+	_, err = ParseMetadata([]byte(`{"foo": "bar"}`))
+	require.Error(t, err)
+}
+
+func TestLatestMetadataPath(t *testing.T) {
+	require.Equal(t, "s3://b/t/metadata/v10.metadata.json", LatestMetadataPath([]string{
+		"s3://b/t/metadata/v1.metadata.json",
+		"s3://b/t/metadata/v10.metadata.json",
+		"s3://b/t/metadata/v9.metadata.json",
+		"s3://b/t/metadata/version-hint.text",
+	}))
+	require.Equal(t, "s3://b/t/metadata/00001-5d6e.metadata.json", LatestMetadataPath([]string{
+		"s3://b/t/metadata/00000-1a2b.metadata.json",
+		"s3://b/t/metadata/00002-7c1e.gz.metadata.json",
+		"s3://b/t/metadata/00001-5d6e.metadata.json",
+		"s3://b/t/metadata/00003.metadata.json",
+		"s3://b/t/metadata/v4-copy.metadata.json",
+	}))
+	require.Equal(t, "", LatestMetadataPath([]string{"s3://b/t/data/00000.parquet"}))
+}
+
+func TestRESTCatalog(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		switch r.URL.EscapedPath() {
+		case "/v1/config":
+			require.Equal(t, "lake", r.URL.Query().Get("warehouse"))
+			_, _ = w.Write([]byte(`{"defaults": {}, "overrides": {"prefix": "lake"}}`))
+		case "/v1/lake/namespaces/analytics%1Fprod/tables/events":
+			_, _ = w.Write([]byte(`{"metadata-location": "s3://bucket/warehouse/db/events/metadata/00002-7c1e.metadata.json", "metadata": ` + testMetadata + `}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"message": "Table does not exist", "type": "NoSuchTableException", "code": 404}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewRESTCatalog(srv.URL, "lake", "secret")
+
+	res, err := c.LoadTable(context.Background(), "analytics.prod.events")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/warehouse/db/events/metadata/00002-7c1e.metadata.json", res.MetadataLocation)
+	require.Equal(t, int64(3055729675574597004), *res.Metadata.CurrentSnapshotID)
+
+	_, err = c.LoadTable(context.Background(), "analytics.missing")
+	require.ErrorContains(t, err, "Table does not exist")
+
+	_, err = c.LoadTable(context.Background(), "events")
+	require.Error(t, err)
+}
+
+func TestAppendedSince(t *testing.T) {
+	md, err := ParseMetadata([]byte(`{
+	"format-version": 2,
+	"location": "s3://bucket/warehouse/db/events",
+	"current-snapshot-id": 5,
+	"snapshots": [
+		{"snapshot-id": 1, "schema-id": 0, "summary": {"operation": "append"}},
+		{"snapshot-id": 2, "parent-snapshot-id": 1, "schema-id": 0, "summary": {"operation": "append"}},
+		{"snapshot-id": 3, "parent-snapshot-id": 2, "schema-id": 0, "summary": {"operation": "replace"}},
+		{"snapshot-id": 4, "parent-snapshot-id": 3, "schema-id": 0, "summary": {"operation": "delete"}},
+		{"snapshot-id": 5, "parent-snapshot-id": 4, "schema-id": 0, "summary": {"operation": "append"}},
+		{"snapshot-id": 6, "parent-snapshot-id": 3, "schema-id": 1, "summary": {"operation": "append"}},
+		{"snapshot-id": 7, "parent-snapshot-id": 8, "schema-id": 0, "summary": {"operation": "append"}}
+	]
+}`))
+	require.NoError(t, err)
+
+	// Only appends and compactions
+	require.True(t, md.AppendedSince(1, 2))
+	require.True(t, md.AppendedSince(1, 3))
+	require.True(t, md.AppendedSince(4, 5))
+
+	// Rows were deleted in between
+	require.False(t, md.AppendedSince(1, 4))
+	require.False(t, md.AppendedSince(3, 5))
+
+	// The schema changed
+	require.False(t, md.AppendedSince(3, 6))
+
+	// Not an ancestor
+	require.False(t, md.AppendedSince(2, 1))
+	require.False(t, md.AppendedSince(5, 6))
+
+	// Expired snapshots
+	require.False(t, md.AppendedSince(8, 7))
+	require.False(t, md.AppendedSince(1, 7))
+}
@@ -0,0 +1,140 @@
+package iceberg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RESTCatalog is a minimal client for the Iceberg REST catalog API.
+// See https://github.com/apache/iceberg/blob/main/open-api/rest-catalog-open-api.yaml for the API specification.
+type RESTCatalog struct {
+	uri       string
+	warehouse string
+	token     string
+	client    *http.Client
+}
+
+// NewRESTCatalog creates a new REST catalog client.
+// The warehouse and token are optional.
+func NewRESTCatalog(uri, warehouse, token string) *RESTCatalog {
+	return &RESTCatalog{
+		uri:       strings.TrimSuffix(uri, "/"),
+		warehouse: warehouse,
+		token:     token,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// LoadTableResult is the result of loading a table from a REST catalog.
+type LoadTableResult struct {
+	MetadataLocation string
+	Metadata         *Metadata
+}
+
+// LoadTable loads a table's metadata from the catalog.
+// The table must be qualified with its namespace, for example "db.events". Nested namespaces are separated by dots.
+func (c *RESTCatalog) LoadTable(ctx context.Context, table string) (*LoadTableResult, error) {
+	i := strings.LastIndexByte(table, '.')
+	if i <= 0 || i == len(table)-1 {
+		return nil, fmt.Errorf("iceberg: table %q must be qualified with a namespace", table)
+	}
+	namespace := strings.ReplaceAll(table[:i], ".", "\x1f")
+	name := table[i+1:]
+
+	prefix, err := c.prefix(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := "/v1/"
+	if prefix != "" {
+		p += url.PathEscape(prefix) + "/"
+	}
+	p += fmt.Sprintf("namespaces/%s/tables/%s", url.PathEscape(namespace), url.PathEscape(name))
+
+	var res struct {
+		MetadataLocation string          `json:"metadata-location"`
+		Metadata         json.RawMessage `json:"metadata"`
+	}
+	err = c.get(ctx, p, nil, &res)
+	if err != nil {
+		return nil, fmt.Errorf("iceberg: failed to load table %q: %w", table, err)
+	}
+
+	md, err := ParseMetadata(res.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadTableResult{
+		MetadataLocation: res.MetadataLocation,
+		Metadata:         md,
+	}, nil
+}
+
+// prefix returns the path prefix the catalog uses for the configured warehouse.
+func (c *RESTCatalog) prefix(ctx context.Context) (string, error) {
+	var query url.Values
+	if c.warehouse != "" {
+		query = url.Values{"warehouse": []string{c.warehouse}}
+	}
+
+	var res struct {
+		Defaults  map[string]string `json:"defaults"`
+		Overrides map[string]string `json:"overrides"`
+	}
+	err := c.get(ctx, "/v1/config", query, &res)
+	if err != nil {
+		return "", fmt.Errorf("iceberg: failed to get catalog config: %w", err)
+	}
+
+	if p, ok := res.Overrides["prefix"]; ok {
+		return p, nil
+	}
+	return res.Defaults["prefix"], nil
+}
+
+func (c *RESTCatalog) get(ctx context.Context, path string, query url.Values, dst any) error {
+	u := c.uri + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// The catalog returns errors as {"error": {"message": "...", "type": "...", "code": 404}}
+		var e struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		if json.Unmarshal(body, &e) == nil && e.Error.Message != "" {
+			return fmt.Errorf("%s: %s", resp.Status, e.Error.Message)
+		}
+		return errors.New(resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
@@ -0,0 +1,282 @@
+// Package mysqlolap implements the query execution that is shared by OLAP drivers for databases that speak the MySQL protocol, such as StarRocks and Doris.
+package mysqlolap
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// ErrUnsupportedType is returned by DatabaseTypeToRuntimeType for types that can't be represented as a runtime type,
+// such as the aggregate-only HLL and BITMAP types.
+var ErrUnsupportedType = errors.New("encountered unsupported type")
+
+// Exec executes a statement. Dry runs are validated with EXPLAIN.
+func Exec(ctx context.Context, db *sqlx.DB, stmt *drivers.Statement) error {
+	if stmt.DryRun {
+		_, err := db.ExecContext(ctx, fmt.Sprintf("EXPLAIN %s", stmt.Query), stmt.Args...)
+		return err
+	}
+
+	_, err := db.ExecContext(ctx, stmt.Query, stmt.Args...)
+	return err
+}
+
+// Query executes a query and returns its result. Dry runs are validated with EXPLAIN and return a nil result.
+func Query(ctx context.Context, db *sqlx.DB, stmt *drivers.Statement) (*drivers.Result, error) {
+	if stmt.DryRun {
+		rows, err := db.QueryxContext(ctx, fmt.Sprintf("EXPLAIN %s", stmt.Query), stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+		rows.Close()
+		return nil, nil
+	}
+
+	rows, err := db.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := RowsToSchema(rows)
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	return &drivers.Result{
+		Rows: &mysqlRows{
+			Rows:     rows,
+			scanDest: prepareScanDest(schema),
+			colTypes: cts,
+		},
+		Schema: schema,
+	}, nil
+}
+
+// QuerySchema returns the schema of a query's result without executing it in full.
+func QuerySchema(ctx context.Context, db *sqlx.DB, query string, args []any) (*runtimev1.StructType, error) {
+	// Use LIMIT 0 to get schema without data
+	schemaQuery := fmt.Sprintf("SELECT * FROM (%s) AS _schema_query LIMIT 0", query)
+
+	rows, err := db.QueryxContext(ctx, schemaQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return RowsToSchema(rows)
+}
+
+// RowsToSchema converts the column types of SQL rows to a StructType schema.
+func RowsToSchema(rows *sqlx.Rows) (*runtimev1.StructType, error) {
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(colTypes))
+	for i, ct := range colTypes {
+		runtimeType, err := DatabaseTypeToRuntimeType(ct.DatabaseTypeName())
+		if err != nil {
+			if errors.Is(err, ErrUnsupportedType) {
+				return nil, fmt.Errorf("unsupported type %q for column %q: %w", ct.DatabaseTypeName(), ct.Name(), err)
+			}
+			return nil, err
+		}
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: runtimeType,
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// DatabaseTypeToRuntimeType converts a MySQL, StarRocks or Doris type to a runtime type.
+// Returns ErrUnsupportedType for unsupported types instead of falling back to string.
+func DatabaseTypeToRuntimeType(dbType string) (*runtimev1.Type, error) {
+	rawType := dbType
+	dbType = strings.ToUpper(dbType)
+
+	// Handle parameterized types, e.g. DECIMAL(10,2) and ARRAY<INT>
+	if idx := strings.IndexAny(dbType, "(<"); idx != -1 {
+		dbType = dbType[:idx]
+	}
+
+	t := &runtimev1.Type{RawType: rawType}
+	switch dbType {
+	case "BOOLEAN", "BOOL":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "TINYINT":
+		t.Code = runtimev1.Type_CODE_INT8
+	case "SMALLINT":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "INT", "INTEGER":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "BIGINT":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "LARGEINT":
+		t.Code = runtimev1.Type_CODE_INT128
+	case "FLOAT":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "DOUBLE":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "DECIMAL", "DECIMALV2", "DECIMALV3":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "CHAR", "VARCHAR", "STRING", "TEXT", "IPV4", "IPV6":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "DATE", "DATEV2":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "DATETIME", "DATETIMEV2", "TIMESTAMP":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "JSON", "JSONB", "VARIANT":
+		t.Code = runtimev1.Type_CODE_JSON
+	case "ARRAY":
+		t.Code = runtimev1.Type_CODE_ARRAY
+	case "MAP":
+		t.Code = runtimev1.Type_CODE_MAP
+	case "STRUCT":
+		t.Code = runtimev1.Type_CODE_STRUCT
+	case "BINARY", "VARBINARY", "BLOB":
+		// Note: StarRocks and Doris don't have a BLOB type, but the MySQL driver may report VARBINARY as BLOB
+		// Use CODE_STRING like MySQL driver for consistency
+		t.Code = runtimev1.Type_CODE_STRING
+	default:
+		return nil, ErrUnsupportedType
+	}
+	return t, nil
+}
+
+// mysqlRows wraps sqlx.Rows to provide MapScan method.
+// This is required because if the correct type is not provided to Scan
+// mysql driver just returns byte arrays.
+type mysqlRows struct {
+	*sqlx.Rows
+	scanDest []any
+	colTypes []*sql.ColumnType
+}
+
+// ColumnTypes returns the column types reported by the MySQL driver for rows returned by Query.
+func ColumnTypes(rows drivers.Rows) []*sql.ColumnType {
+	if r, ok := rows.(*mysqlRows); ok {
+		return r.colTypes
+	}
+	return nil
+}
+
+func (r *mysqlRows) MapScan(dest map[string]any) error {
+	err := r.Rows.Scan(r.scanDest...)
+	if err != nil {
+		return err
+	}
+	for i, ct := range r.colTypes {
+		fieldName := ct.Name()
+		valPtr := r.scanDest[i]
+		// Safety guard: prepareScanDest always allocates, but check anyway
+		if valPtr == nil {
+			dest[fieldName] = nil
+			continue
+		}
+		switch valPtr := valPtr.(type) {
+		case *sql.NullBool:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.Bool
+			} else {
+				dest[fieldName] = nil
+			}
+		case *sql.NullInt16:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.Int16
+			} else {
+				dest[fieldName] = nil
+			}
+		case *sql.NullInt32:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.Int32
+			} else {
+				dest[fieldName] = nil
+			}
+		case *sql.NullInt64:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.Int64
+			} else {
+				dest[fieldName] = nil
+			}
+		case *sql.NullFloat64:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.Float64
+			} else {
+				dest[fieldName] = nil
+			}
+		case *sql.NullString:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.String
+			} else {
+				dest[fieldName] = nil
+			}
+		case *sql.NullTime:
+			if valPtr.Valid {
+				dest[fieldName] = valPtr.Time
+			} else {
+				dest[fieldName] = nil
+			}
+		default:
+			// Handle ARRAY, MAP, STRUCT, BYTES and other complex types
+			// These are scanned into *any in prepareScanDest
+			if ptr, ok := valPtr.(*any); ok {
+				dest[fieldName] = *ptr
+			} else {
+				// Fallback: store the pointer's underlying value directly
+				dest[fieldName] = valPtr
+			}
+		}
+	}
+	return nil
+}
+
+func prepareScanDest(schema *runtimev1.StructType) []any {
+	scanList := make([]any, len(schema.Fields))
+	for i, field := range schema.Fields {
+		var dest any
+		switch field.Type.Code {
+		case runtimev1.Type_CODE_BOOL:
+			dest = &sql.NullBool{}
+		case runtimev1.Type_CODE_INT8:
+			dest = &sql.NullInt16{}
+		case runtimev1.Type_CODE_INT16:
+			dest = &sql.NullInt16{}
+		case runtimev1.Type_CODE_INT32:
+			dest = &sql.NullInt32{}
+		case runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_INT128:
+			dest = &sql.NullInt64{}
+		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+			dest = &sql.NullFloat64{}
+		case runtimev1.Type_CODE_STRING:
+			dest = &sql.NullString{}
+		case runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
+			dest = &sql.NullString{}
+		case runtimev1.Type_CODE_TIMESTAMP:
+			// MySQL driver returns DATETIME as time.Time when parseTime=true in DSN
+			dest = &sql.NullTime{}
+		case runtimev1.Type_CODE_JSON:
+			dest = &sql.NullString{}
+		default:
+			dest = new(any)
+		}
+		scanList[i] = dest
+	}
+	return scanList
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis_rate/v10"
 	"github.com/redis/go-redis/v9"
@@ -57,7 +58,10 @@ func (l *Redis) Limit(ctx context.Context, limitKey string, limit redis_rate.Lim
 	}
 
 	if rateResult.Allowed == 0 {
-		return NewQuotaExceededError(fmt.Sprintf("Rate limit exceeded. Try again in %v seconds", rateResult.RetryAfter))
+		return QuotaExceededError{
+			message:    fmt.Sprintf("Rate limit exceeded. Try again in %v seconds", rateResult.RetryAfter),
+			retryAfter: rateResult.RetryAfter,
+		}
 	}
 
 	return nil
@@ -93,15 +97,21 @@ var (
 )
 
 type QuotaExceededError struct {
-	message string
+	message    string
+	retryAfter time.Duration
 }
 
 func (e QuotaExceededError) Error() string {
 	return e.message
 }
 
+// RetryAfter returns how long the caller should wait before retrying. It is zero if unknown.
+func (e QuotaExceededError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
 func NewQuotaExceededError(message string) QuotaExceededError {
-	return QuotaExceededError{message}
+	return QuotaExceededError{message: message}
 }
 
 func AuthLimitKey(methodName, authID string) string {
@@ -34,9 +34,13 @@ func TestRequestRateLimiter_Limit(t *testing.T) {
 			t.Errorf("Unexpected error: %v", err)
 		}
 		err = limiter.Limit(ctx, "testKey", redis_rate.PerMinute(1))
-		if !errors.As(err, &QuotaExceededError{}) {
+		var qerr QuotaExceededError
+		if !errors.As(err, &qerr) {
 			t.Errorf("QuotaExceededError expected: %v", err)
 		}
+		if qerr.RetryAfter() <= 0 {
+			t.Errorf("Positive RetryAfter expected: %v", qerr.RetryAfter())
+		}
 	})
 }
 
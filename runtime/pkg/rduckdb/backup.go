@@ -0,0 +1,300 @@
+package rduckdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+	"golang.org/x/sync/errgroup"
+)
+
+// ErrBackupNotFound is returned from Restore when the source bucket does not contain a backup.
+var ErrBackupNotFound = errors.New("rduckdb: backup not found")
+
+// backupManifestKey is the key of the manifest written to the root of a backup.
+// It is written after all table files have been copied, so its presence indicates that the backup is complete.
+const backupManifestKey = "backup.json"
+
+// BackupInfo describes the contents of a backup.
+type BackupInfo struct {
+	// Tables are the names of the tables and views in the backup.
+	Tables []string `json:"tables"`
+	// SizeBytes is the total size of the table files in the backup.
+	SizeBytes int64 `json:"size_bytes"`
+	// CreatedOn is the time the backup was completed.
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// Backup copies the current version of every table in remote to dst.
+// The remote must be a bucket used as DBOptions.Remote by a DB.
+// Since table versions are immutable once pushed to the remote, it is safe to take a backup while the DB is in use.
+// The backup is consistent for each table, but not across tables that are written while the backup is in progress.
+func Backup(ctx context.Context, remote, dst *blob.Bucket) (*BackupInfo, error) {
+	names, err := listRemoteTables(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &BackupInfo{}
+	for _, name := range names {
+		var meta *tableMeta
+		var size int64
+		for attempt := 1; ; attempt++ {
+			meta, size, err = backupTable(ctx, remote, dst, name)
+			if err == nil {
+				break
+			}
+			// If the table was written while it was being copied, the old version is deleted from the remote.
+			// In that case, we retry with the new version.
+			if gcerrors.Code(err) != gcerrors.NotFound || attempt >= 3 {
+				return nil, fmt.Errorf("failed to backup table %q: %w", name, err)
+			}
+			err = deletePrefix(ctx, dst, name+"/")
+			if err != nil {
+				return nil, err
+			}
+		}
+		if meta == nil {
+			// The table was dropped after it was listed
+			continue
+		}
+		info.Tables = append(info.Tables, name)
+		info.SizeBytes += size
+	}
+	info.CreatedOn = time.Now()
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(ctx, func() error {
+		return dst.WriteAll(ctx, backupManifestKey, data, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// Restore replaces the tables in remote with the tables in a backup created by Backup.
+// Tables in remote that are not in the backup are deleted.
+// Tables are replaced one at a time, so if Restore fails midway, remote contains a mix of restored and current tables.
+// Calling Restore again completes the restore, since tables that were already restored are skipped.
+// It must not be called while a DB is writing to remote. A DB that was opened before the restore must be re-opened to observe the restored tables.
+func Restore(ctx context.Context, src, remote *blob.Bucket) (*BackupInfo, error) {
+	data, err := src.ReadAll(ctx, backupManifestKey)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, ErrBackupNotFound
+		}
+		return nil, err
+	}
+	info := &BackupInfo{}
+	err = json.Unmarshal(data, info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backup manifest: %w", err)
+	}
+
+	restored := make(map[string]bool, len(info.Tables))
+	for _, name := range info.Tables {
+		meta, err := readRemoteTableMeta(ctx, src, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backup of table %q: %w", name, err)
+		}
+
+		current, err := readRemoteTableMeta(ctx, remote, name)
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return nil, err
+		}
+
+		if meta.Type == "TABLE" && (current == nil || current.Version != meta.Version) {
+			_, err = copyPrefix(ctx, src, remote, path.Join(name, meta.Version)+"/")
+			if err != nil {
+				return nil, fmt.Errorf("failed to restore table %q: %w", name, err)
+			}
+		}
+
+		err = writeRemoteTableMeta(ctx, remote, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		if current != nil && current.Version != meta.Version {
+			err = deletePrefix(ctx, remote, path.Join(name, current.Version)+"/")
+			if err != nil {
+				return nil, err
+			}
+		}
+		restored[name] = true
+	}
+
+	// Delete tables that were created after the backup was taken
+	names, err := listRemoteTables(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if restored[name] {
+			continue
+		}
+		err = retry(ctx, func() error { return remote.Delete(ctx, path.Join(name, "meta.json")) })
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return nil, err
+		}
+		err = deletePrefix(ctx, remote, name+"/")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// backupTable copies the current version of a table to dst.
+// It returns a nil meta if the table does not exist.
+func backupTable(ctx context.Context, remote, dst *blob.Bucket, name string) (*tableMeta, int64, error) {
+	meta, err := readRemoteTableMeta(ctx, remote, name)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+
+	var size int64
+	if meta.Type == "TABLE" {
+		size, err = copyPrefix(ctx, remote, dst, path.Join(name, meta.Version)+"/")
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	err = writeRemoteTableMeta(ctx, dst, meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	return meta, size, nil
+}
+
+// listRemoteTables returns the sorted names of the table directories in a bucket.
+func listRemoteTables(ctx context.Context, bkt *blob.Bucket) ([]string, error) {
+	var names []string
+	iter := bkt.List(&blob.ListOptions{Delimiter: "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if !obj.IsDir {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(obj.Key, "/"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func readRemoteTableMeta(ctx context.Context, bkt *blob.Bucket, name string) (*tableMeta, error) {
+	var data []byte
+	err := retry(ctx, func() error {
+		var err error
+		data, err = bkt.ReadAll(ctx, path.Join(name, "meta.json"))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	meta := &tableMeta{}
+	err = json.Unmarshal(data, meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal table metadata: %w", err)
+	}
+	return meta, nil
+}
+
+func writeRemoteTableMeta(ctx context.Context, bkt *blob.Bucket, meta *tableMeta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal table metadata: %w", err)
+	}
+	return retry(ctx, func() error {
+		return bkt.WriteAll(ctx, path.Join(meta.Name, "meta.json"), data, nil)
+	})
+}
+
+// copyPrefix copies all objects with the given prefix from src to dst. It returns the total size of the copied objects.
+func copyPrefix(ctx context.Context, src, dst *blob.Bucket, prefix string) (int64, error) {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(8)
+
+	var size atomic.Int64
+	iter := src.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(gctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			_ = g.Wait()
+			return 0, err
+		}
+		if obj.IsDir {
+			continue
+		}
+		g.Go(func() error {
+			return retry(gctx, func() error {
+				rdr, err := src.NewReader(gctx, obj.Key, nil)
+				if err != nil {
+					return err
+				}
+				defer rdr.Close()
+
+				err = dst.Upload(gctx, obj.Key, rdr, &blob.WriterOptions{
+					ContentType: "application/octet-stream",
+				})
+				if err != nil {
+					return err
+				}
+				size.Add(obj.Size)
+				return nil
+			})
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return 0, err
+	}
+	return size.Load(), nil
+}
+
+// deletePrefix deletes all objects with the given prefix from the bucket.
+func deletePrefix(ctx context.Context, bkt *blob.Bucket, prefix string) error {
+	iter := bkt.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		err = retry(ctx, func() error { return bkt.Delete(ctx, obj.Key) })
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package rduckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob/fileblob"
+)
+
+func TestBackupRestore(t *testing.T) {
+	db, localDir, remoteDir := prepareDB(t)
+	ctx := context.Background()
+
+	// create a table and a view
+	_, err := db.CreateTableAsSelect(ctx, "test", "SELECT 1 AS id, 'India' AS country", &CreateTableOptions{})
+	require.NoError(t, err)
+	_, err = db.CreateTableAsSelect(ctx, "test_view", "SELECT * FROM test", &CreateTableOptions{View: true})
+	require.NoError(t, err)
+
+	// take a backup
+	remote, err := fileblob.OpenBucket(remoteDir, nil)
+	require.NoError(t, err)
+	backup, err := fileblob.OpenBucket(t.TempDir(), nil)
+	require.NoError(t, err)
+	info, err := Backup(ctx, remote, backup)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test", "test_view"}, info.Tables)
+	require.Greater(t, info.SizeBytes, int64(0))
+
+	// overwrite the table and create a new table
+	_, err = db.CreateTableAsSelect(ctx, "test", "SELECT 2 AS id, 'USA' AS country", &CreateTableOptions{})
+	require.NoError(t, err)
+	_, err = db.CreateTableAsSelect(ctx, "test2", "SELECT 3 AS id, 'UK' AS country", &CreateTableOptions{})
+	require.NoError(t, err)
+	verifyTable(t, db, "SELECT id, country FROM test_view", []testData{{ID: 2, Country: "USA"}})
+
+	// restore the backup and re-open the db
+	require.NoError(t, db.Close())
+	_, err = Restore(ctx, backup, remote)
+	require.NoError(t, err)
+	db, err = NewDB(ctx, &DBOptions{
+		LocalPath:      localDir,
+		Remote:         remote,
+		MemoryLimitGB:  2,
+		CPU:            1,
+		ReadWriteRatio: 0.5,
+		DBInitQueries:  []string{"SET autoinstall_known_extensions=true", "SET autoload_known_extensions=true"},
+		Logger:         zap.NewNop(),
+	})
+	require.NoError(t, err)
+
+	verifyTable(t, db, "SELECT id, country FROM test", []testData{{ID: 1, Country: "India"}})
+	verifyTable(t, db, "SELECT id, country FROM test_view", []testData{{ID: 1, Country: "India"}})
+	require.ErrorContains(t, db.DropTable(ctx, "test2"), "not found")
+	require.NoError(t, db.Close())
+
+	// restoring from an empty bucket fails
+	_, err = Restore(ctx, remote, remote)
+	require.ErrorIs(t, err, ErrBackupNotFound)
+}
@@ -0,0 +1,470 @@
+package runtime
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/parser"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ProjectPlan describes the changes that reconciling a project's files would make to the catalog.
+type ProjectPlan struct {
+	Changes     []*runtimev1.ResourceChange
+	ParseErrors []*runtimev1.ParseError
+}
+
+// PlanProject parses a project and diffs the resulting resources against the catalog without applying any changes.
+// If files is not empty, it is parsed instead of the instance's repo.
+// The plan mirrors the decisions made by the project parser and model reconcilers, but it does not consider changes to variables or to the data of local files.
+func (r *Runtime) PlanProject(ctx context.Context, instanceID string, files map[string]string) (*ProjectPlan, error) {
+	inst, err := r.Instance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	instCfg, err := inst.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	var repo drivers.RepoStore
+	if len(files) > 0 {
+		repo = newPlanRepo(files)
+	} else {
+		var release func()
+		repo, release, err = r.Repo(ctx, instanceID)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	// NOTE: Passing inst.OLAPConnector for consistency with the project parser reconciler.
+	p, err := parser.Parse(ctx, repo, instanceID, inst.Environment, inst.OLAPConnector, instCfg.StrictResolverProps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	plan := &ProjectPlan{ParseErrors: p.Errors}
+	if p.RillYAML == nil || (instCfg.ParserSkipUpdatesIfParseErrors && p.HasParseErrors()) {
+		// The project parser would not apply any changes
+		return plan, nil
+	}
+
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := ctrl.List(ctx, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	planner := &projectPlanner{
+		parser:    p,
+		existing:  make(map[parser.ResourceName]*runtimev1.Resource),
+		changes:   make(map[parser.ResourceName]*runtimev1.ResourceChange),
+		execSpec:  make(map[parser.ResourceName]bool),
+		executed:  make(map[parser.ResourceName]bool),
+		visited:   make(map[parser.ResourceName]bool),
+		skipLocal: len(files) > 0,
+	}
+	for _, res := range rs {
+		if equalResourceName(res.Meta.Owner, GlobalProjectParserName) {
+			planner.existing[ResourceNameToParser(res.Meta.Name).Normalized()] = res
+		}
+	}
+	plan.Changes = planner.plan()
+
+	return plan, nil
+}
+
+// projectPlanner computes the changes for a ProjectPlan.
+type projectPlanner struct {
+	parser   *parser.Parser
+	existing map[parser.ResourceName]*runtimev1.Resource
+	// changes tracks the planned change for each resource (nil if it is unchanged).
+	changes map[parser.ResourceName]*runtimev1.ResourceChange
+	// execSpec tracks models whose execution spec changes (which includes the execution specs of the models they reference).
+	execSpec map[parser.ResourceName]bool
+	// executed tracks models that will be executed.
+	executed map[parser.ResourceName]bool
+	visited  map[parser.ResourceName]bool
+	// skipLocal is true if the planned files don't include data files, so local file hashes can't be compared.
+	skipLocal bool
+	result    []*runtimev1.ResourceChange
+}
+
+func (p *projectPlanner) plan() []*runtimev1.ResourceChange {
+	// Match existing resources to their parser definitions, detecting renames for unmatched ones.
+	// This mirrors the project parser reconciler's handling of renames.
+	renamedFrom := make(map[parser.ResourceName]*runtimev1.Resource)
+	var deleted []*runtimev1.Resource
+	for n, res := range p.existing {
+		if _, ok := p.parser.Resources[n]; !ok {
+			deleted = append(deleted, res)
+		}
+	}
+	sort.Slice(deleted, func(i, j int) bool { return deleted[i].Meta.Name.Name < deleted[j].Meta.Name.Name })
+
+	defs := make([]*parser.Resource, 0, len(p.parser.Resources))
+	for _, def := range p.parser.Resources {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].Name.Kind != defs[j].Name.Kind {
+			return defs[i].Name.Kind < defs[j].Name.Kind
+		}
+		return defs[i].Name.Name < defs[j].Name.Name
+	})
+
+	for _, def := range defs {
+		if _, ok := p.existing[def.Name.Normalized()]; ok {
+			continue
+		}
+		for i, res := range deleted {
+			if res != nil && p.canRename(def, res) {
+				renamedFrom[def.Name.Normalized()] = res
+				deleted[i] = nil
+				break
+			}
+		}
+	}
+
+	// Visit the definitions in DAG order
+	for _, def := range defs {
+		p.visit(def, renamedFrom)
+	}
+
+	// Deletions come last
+	for _, res := range deleted {
+		if res == nil {
+			continue
+		}
+		p.result = append(p.result, &runtimev1.ResourceChange{
+			Name:      res.Meta.Name,
+			Action:    runtimev1.ResourceChange_ACTION_DELETE,
+			FilePaths: res.Meta.FilePaths,
+		})
+	}
+
+	return p.result
+}
+
+// visit computes the change for a resource after visiting the resources it references.
+func (p *projectPlanner) visit(def *parser.Resource, renamedFrom map[parser.ResourceName]*runtimev1.Resource) {
+	n := def.Name.Normalized()
+	if p.visited[n] {
+		return
+	}
+	p.visited[n] = true
+
+	// Visit refs first and check if any of them will change or execute.
+	var refsChanged, refsExecSpec, refsExecuted bool
+	for _, ref := range def.Refs {
+		refN := ref.Normalized()
+		if refDef, ok := p.parser.Resources[refN]; ok {
+			p.visit(refDef, renamedFrom)
+		} else if _, ok := p.existing[refN]; ok {
+			// The ref is being deleted
+			refsChanged = true
+		}
+		refsChanged = refsChanged || p.changes[refN] != nil
+		refsExecSpec = refsExecSpec || p.execSpec[refN]
+		refsExecuted = refsExecuted || p.executed[refN]
+	}
+
+	change := &runtimev1.ResourceChange{
+		Name:      ResourceNameFromParser(def.Name),
+		FilePaths: def.Paths,
+	}
+
+	existing := p.existing[n]
+	if existing == nil {
+		existing = renamedFrom[n]
+	}
+
+	if existing == nil {
+		change.Action = runtimev1.ResourceChange_ACTION_CREATE
+	} else {
+		renamed := existing.Meta.Name.Name != def.Name.Name
+		if renamed {
+			change.RenamedFrom = existing.Meta.Name
+		}
+		refs := resourceNamesFromParser(def.Refs)
+		if p.specChanged(def, existing) || !equalResourceNames(existing.Meta.Refs, refs) || !slices.Equal(existing.Meta.Tags, def.Tags) {
+			change.Action = runtimev1.ResourceChange_ACTION_UPDATE
+		} else if renamed {
+			change.Action = runtimev1.ResourceChange_ACTION_RENAME
+		} else if refsChanged && def.ModelSpec == nil {
+			// Models are handled below since they only re-execute if their refresh conditions are met.
+			change.Action = runtimev1.ResourceChange_ACTION_REEXECUTE
+		}
+	}
+
+	if def.ModelSpec != nil {
+		// The execution spec hash of a model includes the names and spec hashes of its refs.
+		execSpec := refsExecSpec || existing == nil || !equalModelExecutionSpec(def.ModelSpec, existing.GetModel().Spec, p.skipLocal)
+		if !execSpec && existing != nil && !equalResourceNames(existing.Meta.Refs, resourceNamesFromParser(def.Refs)) {
+			execSpec = true
+		}
+		p.execSpec[n] = execSpec
+
+		change.ModelRefresh = modelRefresh(def.ModelSpec, existing == nil, execSpec, refsExecuted)
+		p.executed[n] = change.ModelRefresh == runtimev1.ResourceChange_MODEL_REFRESH_FULL || change.ModelRefresh == runtimev1.ResourceChange_MODEL_REFRESH_INCREMENTAL
+
+		if change.Action == runtimev1.ResourceChange_ACTION_UNSPECIFIED && p.executed[n] {
+			change.Action = runtimev1.ResourceChange_ACTION_REEXECUTE
+		}
+	} else if change.Action == runtimev1.ResourceChange_ACTION_UNSPECIFIED && refsExecuted {
+		change.Action = runtimev1.ResourceChange_ACTION_REEXECUTE
+	}
+
+	if change.Action == runtimev1.ResourceChange_ACTION_UNSPECIFIED {
+		return
+	}
+	p.changes[n] = change
+	p.result = append(p.result, change)
+}
+
+// canRename returns true if the project parser would rename the existing resource to match the definition.
+func (p *projectPlanner) canRename(def *parser.Resource, existing *runtimev1.Resource) bool {
+	if existing.Meta.Name.Kind != ResourceKindFromParser(def.Name.Kind) {
+		return false
+	}
+	if !equalResourceNames(existing.Meta.Refs, resourceNamesFromParser(def.Refs)) {
+		return false
+	}
+	switch def.Name.Kind {
+	case parser.ResourceKindSource, parser.ResourceKindModel, parser.ResourceKindMetricsView, parser.ResourceKindMigration:
+		return !p.specChanged(def, existing)
+	default:
+		return false
+	}
+}
+
+// specChanged returns true if the definition's spec differs from the existing resource's spec.
+// Ephemeral trigger fields are ignored.
+func (p *projectPlanner) specChanged(def *parser.Resource, existing *runtimev1.Resource) bool {
+	switch def.Name.Kind {
+	case parser.ResourceKindSource:
+		return !proto.Equal(def.SourceSpec, existing.GetSource().GetSpec())
+	case parser.ResourceKindModel:
+		a := proto.Clone(def.ModelSpec).(*runtimev1.ModelSpec)
+		b := existing.GetModel().GetSpec()
+		if b == nil {
+			return true
+		}
+		b = proto.Clone(b).(*runtimev1.ModelSpec)
+		a.Trigger, a.TriggerFull, a.TriggerPartitions = false, false, false
+		b.Trigger, b.TriggerFull, b.TriggerPartitions = false, false, false
+		if p.skipLocal {
+			copyLocalFilesHash(a, b)
+		}
+		return !proto.Equal(a, b)
+	case parser.ResourceKindMetricsView:
+		return !proto.Equal(def.MetricsViewSpec, existing.GetMetricsView().GetSpec())
+	case parser.ResourceKindExplore:
+		return !proto.Equal(def.ExploreSpec, existing.GetExplore().GetSpec())
+	case parser.ResourceKindMigration:
+		return !proto.Equal(def.MigrationSpec, existing.GetMigration().GetSpec())
+	case parser.ResourceKindReport:
+		a := proto.Clone(def.ReportSpec).(*runtimev1.ReportSpec)
+		b := existing.GetReport().GetSpec()
+		if b == nil {
+			return true
+		}
+		a.Trigger = b.Trigger
+		return !proto.Equal(a, b)
+	case parser.ResourceKindAlert:
+		a := proto.Clone(def.AlertSpec).(*runtimev1.AlertSpec)
+		b := existing.GetAlert().GetSpec()
+		if b == nil {
+			return true
+		}
+		a.Trigger = b.Trigger
+		return !proto.Equal(a, b)
+	case parser.ResourceKindTheme:
+		return !proto.Equal(def.ThemeSpec, existing.GetTheme().GetSpec())
+	case parser.ResourceKindComponent:
+		return !proto.Equal(def.ComponentSpec, existing.GetComponent().GetSpec())
+	case parser.ResourceKindCanvas:
+		return !proto.Equal(def.CanvasSpec, existing.GetCanvas().GetSpec())
+	case parser.ResourceKindAPI:
+		return !proto.Equal(def.APISpec, existing.GetApi().GetSpec())
+	case parser.ResourceKindConnector:
+		return !proto.Equal(def.ConnectorSpec, existing.GetConnector().GetSpec())
+	default:
+		return true
+	}
+}
+
+// modelRefresh determines how a model will be refreshed based on its change mode.
+// It mirrors the model reconciler's trigger resolution.
+func modelRefresh(spec *runtimev1.ModelSpec, isNew, execSpecChanged, refsExecuted bool) runtimev1.ResourceChange_ModelRefresh {
+	scheduled := refsExecuted && spec.RefreshSchedule != nil && spec.RefreshSchedule.RefUpdate
+	normal := runtimev1.ResourceChange_MODEL_REFRESH_FULL
+	if spec.Incremental {
+		normal = runtimev1.ResourceChange_MODEL_REFRESH_INCREMENTAL
+	}
+
+	switch spec.ChangeMode {
+	case runtimev1.ModelChangeMode_MODEL_CHANGE_MODE_MANUAL:
+		if execSpecChanged {
+			return runtimev1.ResourceChange_MODEL_REFRESH_MANUAL
+		}
+	case runtimev1.ModelChangeMode_MODEL_CHANGE_MODE_PATCH:
+		// Spec changes are applied without a refresh, but new models need a manual trigger.
+		if isNew {
+			return runtimev1.ResourceChange_MODEL_REFRESH_MANUAL
+		}
+	default:
+		if execSpecChanged {
+			return runtimev1.ResourceChange_MODEL_REFRESH_FULL
+		}
+	}
+
+	if scheduled {
+		return normal
+	}
+	return runtimev1.ResourceChange_MODEL_REFRESH_UNSPECIFIED
+}
+
+// equalModelExecutionSpec compares the model spec fields that are included in the model reconciler's execution spec hash.
+func equalModelExecutionSpec(a, b *runtimev1.ModelSpec, skipLocal bool) bool {
+	if b == nil {
+		return false
+	}
+	if skipLocal {
+		a = proto.Clone(a).(*runtimev1.ModelSpec)
+		copyLocalFilesHash(a, b)
+	}
+	return a.TimeoutSeconds == b.TimeoutSeconds &&
+		a.Incremental == b.Incremental &&
+		a.IncrementalStateResolver == b.IncrementalStateResolver &&
+		proto.Equal(a.IncrementalStateResolverProperties, b.IncrementalStateResolverProperties) &&
+		a.PartitionsResolver == b.PartitionsResolver &&
+		proto.Equal(a.PartitionsResolverProperties, b.PartitionsResolverProperties) &&
+		a.PartitionsWatermarkField == b.PartitionsWatermarkField &&
+		a.InputConnector == b.InputConnector &&
+		proto.Equal(a.InputProperties, b.InputProperties) &&
+		a.OutputConnector == b.OutputConnector &&
+		proto.Equal(a.OutputProperties, b.OutputProperties)
+}
+
+// copyLocalFilesHash copies the hash of local data files from one model spec to another.
+// It is used when planning files that don't include data files.
+func copyLocalFilesHash(to, from *runtimev1.ModelSpec) {
+	if to.InputProperties == nil || from.InputProperties == nil {
+		return
+	}
+	if _, ok := to.InputProperties.Fields["local_files_hash"]; !ok {
+		return
+	}
+	if v, ok := from.InputProperties.Fields["local_files_hash"]; ok {
+		to.InputProperties = proto.Clone(to.InputProperties).(*structpb.Struct)
+		to.InputProperties.Fields["local_files_hash"] = v
+	}
+}
+
+func resourceNamesFromParser(ns []parser.ResourceName) []*runtimev1.ResourceName {
+	res := make([]*runtimev1.ResourceName, 0, len(ns))
+	for _, n := range ns {
+		res = append(res, ResourceNameFromParser(n))
+	}
+	return res
+}
+
+func equalResourceName(a, b *runtimev1.ResourceName) bool {
+	return a != nil && b != nil && a.Kind == b.Kind && strings.EqualFold(a.Name, b.Name)
+}
+
+func equalResourceNames(a, b []*runtimev1.ResourceName) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if !equalResourceName(v, b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// planRepo is an in-memory drivers.RepoStore for planning files that are not in the instance's repo.
+// It only implements the read operations used by the parser. Calling any other method will panic.
+type planRepo struct {
+	drivers.RepoStore
+	files   map[string]string
+	created time.Time
+}
+
+func newPlanRepo(files map[string]string) *planRepo {
+	normalized := make(map[string]string, len(files))
+	for p, data := range files {
+		normalized[path.Join("/", p)] = data
+	}
+	return &planRepo{files: normalized, created: time.Now()}
+}
+
+func (r *planRepo) Root(ctx context.Context) (string, error) {
+	return "", fmt.Errorf("planned files are not stored on disk")
+}
+
+func (r *planRepo) ListGlob(ctx context.Context, glob string, skipDirs bool) ([]drivers.DirEntry, error) {
+	glob = strings.TrimPrefix(path.Clean(path.Join("/", glob)), "/")
+	var entries []drivers.DirEntry
+	for p := range r.files {
+		ok, err := doublestar.Match(glob, strings.TrimPrefix(p, "/"))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entries = append(entries, drivers.DirEntry{Path: p})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+func (r *planRepo) Get(ctx context.Context, filePath string) (string, error) {
+	data, ok := r.files[path.Join("/", filePath)]
+	if !ok {
+		return "", &fs.PathError{Op: "open", Path: filePath, Err: fs.ErrNotExist}
+	}
+	return data, nil
+}
+
+func (r *planRepo) Hash(ctx context.Context, paths []string) (string, error) {
+	hasher := md5.New()
+	for _, p := range paths {
+		data, ok := r.files[path.Join("/", p)]
+		if !ok {
+			continue
+		}
+		_, err := hasher.Write([]byte(data))
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func (r *planRepo) Stat(ctx context.Context, filePath string) (*drivers.FileInfo, error) {
+	if _, ok := r.files[path.Join("/", filePath)]; !ok {
+		return nil, &fs.PathError{Op: "stat", Path: filePath, Err: fs.ErrNotExist}
+	}
+	return &drivers.FileInfo{LastUpdated: r.created}, nil
+}
@@ -0,0 +1,80 @@
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanProject(t *testing.T) {
+	files := map[string]string{
+		"rill.yaml":        "",
+		"/models/foo.sql":  `SELECT range AS id FROM range(10)`,
+		"/models/bar.sql":  `SELECT * FROM foo`,
+		"/models/baz.sql":  `SELECT 1 AS x`,
+		"/models/qux.yaml": "type: model\nsql: SELECT 2 AS y\nincremental: true\nrefresh:\n  ref_update: true\n",
+		"/metrics/mv.yaml": `
+version: 1
+type: metrics_view
+model: bar
+dimensions:
+- column: id
+measures:
+- expression: count(*)
+`,
+	}
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{Files: files})
+	testruntime.RequireReconcileState(t, rt, id, 6, 0, 0)
+
+	// Planning the unchanged files yields no changes
+	plan, err := rt.PlanProject(context.Background(), id, files)
+	require.NoError(t, err)
+	require.Empty(t, plan.Changes)
+	require.Empty(t, plan.ParseErrors)
+
+	// Change foo, delete baz, rename qux and add a new model
+	files["/models/foo.sql"] = `SELECT range AS id FROM range(20)`
+	delete(files, "/models/baz.sql")
+	files["/models/qux2.yaml"] = files["/models/qux.yaml"]
+	delete(files, "/models/qux.yaml")
+	files["/models/new.sql"] = `SELECT 3 AS z`
+
+	plan, err = rt.PlanProject(context.Background(), id, files)
+	require.NoError(t, err)
+
+	changes := make(map[string]*runtimev1.ResourceChange)
+	for _, c := range plan.Changes {
+		changes[c.Name.Name] = c
+	}
+	require.Len(t, changes, 6)
+
+	require.Equal(t, runtimev1.ResourceChange_ACTION_UPDATE, changes["foo"].Action)
+	require.Equal(t, runtimev1.ResourceChange_MODEL_REFRESH_FULL, changes["foo"].ModelRefresh)
+
+	// bar's execution spec includes foo's spec, so it is fully refreshed
+	require.Equal(t, runtimev1.ResourceChange_ACTION_REEXECUTE, changes["bar"].Action)
+	require.Equal(t, runtimev1.ResourceChange_MODEL_REFRESH_FULL, changes["bar"].ModelRefresh)
+
+	require.Equal(t, runtimev1.ResourceChange_ACTION_REEXECUTE, changes["mv"].Action)
+
+	require.Equal(t, runtimev1.ResourceChange_ACTION_DELETE, changes["baz"].Action)
+
+	require.Equal(t, runtimev1.ResourceChange_ACTION_RENAME, changes["qux2"].Action)
+	require.Equal(t, "qux", changes["qux2"].RenamedFrom.Name)
+	require.Equal(t, runtimev1.ResourceChange_MODEL_REFRESH_UNSPECIFIED, changes["qux2"].ModelRefresh)
+
+	require.Equal(t, runtimev1.ResourceChange_ACTION_CREATE, changes["new"].Action)
+	require.Equal(t, runtimev1.ResourceChange_MODEL_REFRESH_FULL, changes["new"].ModelRefresh)
+	require.Equal(t, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "new"}, changes["new"].Name)
+
+	// Parse errors are reported
+	files["/models/broken.yaml"] = "type: model\nsql: [\n"
+	plan, err = rt.PlanProject(context.Background(), id, files)
+	require.NoError(t, err)
+	require.Len(t, plan.ParseErrors, 1)
+	require.Equal(t, "/models/broken.yaml", plan.ParseErrors[0].FilePath)
+}
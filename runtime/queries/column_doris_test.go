@@ -0,0 +1,57 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers/doris"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimeseriesDorisSQL(t *testing.T) {
+	q := &ColumnTimeseries{
+		TableName:           "events",
+		TimestampColumnName: "ts",
+		Measures: []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure{
+			{Expression: "sum(clicks)", SqlName: "clicks"},
+		},
+		FirstDayOfWeek:   1,
+		FirstMonthOfYear: 1,
+	}
+	timeRange := &runtimev1.TimeSeriesTimeRange{
+		Start:    timestamppb.New(time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)),
+		End:      timestamppb.New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+		Interval: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+	}
+
+	sql, args, err := timeSeriesDorisSQL(timeRange, q, "_ts_", "UTC", doris.DialectDoris)
+	require.NoError(t, err)
+	require.Empty(t, args)
+	require.Contains(t, sql, "SELECT CAST('2024-01-01 00:00:00.000' AS DATETIME(3)) AS `_ts_` UNION ALL SELECT CAST('2024-01-02 00:00:00.000' AS DATETIME(3)) AS `_ts_`")
+	require.NotContains(t, sql, "2024-01-03")
+	require.Contains(t, sql, "SELECT DAY_FLOOR(`ts`) AS _ts_, sum(clicks) as `clicks`")
+	require.Contains(t, sql, "FROM `events`")
+	require.Contains(t, sql, "COALESCE(`clicks`, 0) as `clicks`")
+
+	// The time zone is applied to the buckets of the source data
+	sql, _, err = timeSeriesDorisSQL(timeRange, q, "_ts_", "Asia/Kolkata", doris.DialectDoris)
+	require.NoError(t, err)
+	require.Contains(t, sql, "CONVERT_TZ(DAY_FLOOR(CONVERT_TZ(`ts`, 'UTC', 'Asia/Kolkata')), 'Asia/Kolkata', 'UTC') AS _ts_")
+
+	// An empty time range has no buckets
+	timeRange.End = timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	sql, _, err = timeSeriesDorisSQL(timeRange, q, "_ts_", "UTC", doris.DialectDoris)
+	require.NoError(t, err)
+	require.Empty(t, sql)
+
+	_, _, err = timeSeriesDorisSQL(timeRange, q, "_ts_", "Invalid/Zone", doris.DialectDoris)
+	require.Error(t, err)
+}
+
+func TestRangeNumbersDoris(t *testing.T) {
+	require.True(t, isMySQLDialect(doris.DialectDoris))
+	require.Equal(t, `numbers("number" = "%[4]v")`, rangeNumbers(doris.DialectDoris, "%[4]v"))
+	require.Equal(t, "number", rangeNumbersCol(doris.DialectDoris))
+}
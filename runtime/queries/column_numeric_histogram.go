@@ -130,7 +130,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 	}
 	defer release()
 
-	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks {
+	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks && olap.Dialect().String() != drivers.DialectNameDoris {
 		return fmt.Errorf("not available for dialect %q", olap.Dialect())
 	}
 
@@ -157,9 +157,9 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 		return nil
 	}
 
-	// StarRocks uses CAST() function instead of ::TYPE syntax
+	// StarRocks and Doris use the CAST() function instead of ::TYPE syntax
 	var selectColumn string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		sanitizedColumnName = olap.Dialect().EscapeIdentifier(q.ColumnName)
 		selectColumn = fmt.Sprintf("CAST(%s AS DOUBLE)", sanitizedColumnName)
 	} else {
@@ -167,10 +167,10 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 	}
 
 	// For bucket column casting - generate_series returns BIGINT, cast to DOUBLE for calculations
-	// StarRocks: CAST(column AS DOUBLE)
+	// StarRocks/Doris: CAST(column AS DOUBLE)
 	// DuckDB/ClickHouse: column::DOUBLE
 	var bucketColumn string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		bucketColumn = fmt.Sprintf("CAST(%s AS DOUBLE)", rangeNumbersCol(olap.Dialect()))
 	} else {
 		bucketColumn = rangeNumbersCol(olap.Dialect()) + "::DOUBLE"
@@ -192,7 +192,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
               `+bucketColumn+` as bucket,
               (bucket) * (%[7]v) / %[4]v + (%[5]v) as low,
               (bucket + 1) * (%[7]v) / %[4]v + (%[5]v) as high
-            FROM `+rangeNumbers(olap.Dialect())+`(0, %[4]v`+rangeNumbersEnd(olap.Dialect())+`
+            FROM `+rangeNumbers(olap.Dialect(), "%[4]v")+`
           ),
           -- bin the values
           binned_data AS (
@@ -273,7 +273,7 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 	}
 	defer release()
 
-	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks {
+	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks && olap.Dialect().String() != drivers.DialectNameDoris {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -303,9 +303,9 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 
 	sanitizedColumnName := olap.Dialect().EscapeIdentifier(q.ColumnName)
 
-	// StarRocks uses implicit type conversion instead of ::TYPE syntax
+	// StarRocks and Doris use implicit type conversion instead of ::TYPE syntax
 	var castDouble, castFloat string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		sanitizedColumnName = olap.Dialect().EscapeIdentifier(q.ColumnName)
 		castDouble = ""
 		castFloat = ""
@@ -340,7 +340,7 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 				(bucket * %[7]f`+castFloat+` + %[5]f) as low,
 				(bucket * %[7]f`+castFloat+` + %[7]f`+castFloat+` / 2 + %[5]f) as midpoint,
 				((bucket + 1) * %[7]f`+castFloat+` + %[5]f) as high
-			FROM `+rangeNumbers(olap.Dialect())+`(0, %[4]d`+rangeNumbersEnd(olap.Dialect())+`
+			FROM `+rangeNumbers(olap.Dialect(), "%[4]d")+`
 		),
 		-- bin the values
 		binned_data AS (
@@ -421,9 +421,9 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 func getMinMaxRange(ctx context.Context, olap drivers.OLAPStore, columnName, database, databaseSchema, tableName string, priority int) (*float64, *float64, *float64, error) {
 	sanitizedColumnName := olap.Dialect().EscapeIdentifier(columnName)
 
-	// StarRocks uses CAST() instead of ::TYPE syntax
+	// StarRocks and Doris use CAST() instead of ::TYPE syntax
 	var selectColumn string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		selectColumn = fmt.Sprintf("CAST(%s AS DOUBLE)", sanitizedColumnName)
 	} else {
 		selectColumn = fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
@@ -60,7 +60,7 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 	defer release()
 
-	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks {
+	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks && olap.Dialect().String() != drivers.DialectNameDoris {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -80,9 +80,9 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	sanitizedColumnName := olap.Dialect().EscapeIdentifier(q.ColumnName)
 	outlierPseudoBucketCount := 500
 
-	// StarRocks uses CAST() function instead of ::TYPE syntax
+	// StarRocks and Doris use the CAST() function instead of ::TYPE syntax
 	var selectColumn string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		selectColumn = fmt.Sprintf("CAST(%s AS DOUBLE)", sanitizedColumnName)
 	} else {
 		selectColumn = fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
@@ -90,7 +90,7 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 
 	// For bucket column casting
 	var castFloat string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		castFloat = ""
 	} else {
 		castFloat = "::FLOAT"
@@ -99,9 +99,9 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	// StarRocks: "values" is a reserved keyword, use alias
 	valuesAlias := "vals"
 
-	// StarRocks doesn't support referencing SELECT column aliases in WHERE clause
+	// StarRocks and Doris don't support referencing SELECT column aliases in WHERE clause
 	var whereClause string
-	if olap.Dialect().String() == drivers.DialectNameStarRocks {
+	if isMySQLDialect(olap.Dialect()) {
 		whereClause = "WHERE count>0"
 	} else {
 		whereClause = "WHERE present=true"
@@ -121,7 +121,7 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 		`+rangeNumbersCol(olap.Dialect())+castFloat+` as bucket, -- range
 		  (bucket) * (%[7]v) / %[4]v + (%[5]v) AS low, -- bucket * (max-min) / bucketCount + min
 		  (bucket + 1) * (%[7]v) / %[4]v + (%[5]v) AS high -- (bucket+1) * (max-min) / bucketCount + min
-		FROM `+rangeNumbers(olap.Dialect())+`(0, %[4]v`+rangeNumbersEnd(olap.Dialect())+` -- range(0,bucketCount)
+		FROM `+rangeNumbers(olap.Dialect(), "%[4]v")+` -- range(0,bucketCount)
 	),
 	-- bin the values
 	binned_data AS (
@@ -207,21 +207,26 @@ func (q *ColumnRugHistogram) Export(ctx context.Context, rt *runtime.Runtime, in
 	return ErrExportNotSupported
 }
 
-func rangeNumbers(dialect drivers.Dialect) string {
+// rangeNumbers returns a table expression with the numbers from 0 (inclusive) to end (exclusive), like range(0, end) in DuckDB.
+// The numbers are in the column returned by rangeNumbersCol.
+func rangeNumbers(dialect drivers.Dialect, end string) string {
 	switch dialect.String() {
 	case drivers.DialectNameClickHouse:
-		return "numbers"
+		return fmt.Sprintf("numbers(0, %s)", end)
 	case drivers.DialectNameStarRocks:
-		// StarRocks uses generate_series for number sequences
-		return "TABLE(generate_series"
+		// StarRocks uses generate_series for number sequences, which has an inclusive end
+		return fmt.Sprintf("TABLE(generate_series(0, %s-1))", end)
+	case drivers.DialectNameDoris:
+		// Doris uses the numbers table function, which generates the numbers from 0 to number-1
+		return fmt.Sprintf(`numbers("number" = "%s")`, end)
 	default:
-		return "range"
+		return fmt.Sprintf("range(0, %s)", end)
 	}
 }
 
 func rangeNumbersCol(dialect drivers.Dialect) string {
 	switch dialect.String() {
-	case drivers.DialectNameClickHouse:
+	case drivers.DialectNameClickHouse, drivers.DialectNameDoris:
 		return "number"
 	case drivers.DialectNameStarRocks:
 		// generate_series returns a column named 'generate_series'
@@ -231,15 +236,8 @@ func rangeNumbersCol(dialect drivers.Dialect) string {
 	}
 }
 
-// rangeNumbersEnd returns the closing syntax for range/numbers/generate_series
-// For StarRocks generate_series, end is inclusive so we need to subtract 1 to match DuckDB range behavior
-func rangeNumbersEnd(dialect drivers.Dialect) string {
-	switch dialect.String() {
-	case drivers.DialectNameStarRocks:
-		// generate_series(start, end) has inclusive end, so subtract 1 to match range(start, end) exclusive behavior
-		// Then close both generate_series() and TABLE()
-		return "-1))"
-	default:
-		return ")"
-	}
+// isMySQLDialect returns true for the OLAP dialects that are based on MySQL (StarRocks and Doris).
+// They don't support the ::TYPE cast syntax and referencing SELECT column aliases in the WHERE clause.
+func isMySQLDialect(dialect drivers.Dialect) bool {
+	return dialect.String() == drivers.DialectNameStarRocks || dialect.String() == drivers.DialectNameDoris
 }
@@ -162,7 +162,7 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
 			useSample,
 		)
-	case drivers.DialectNameStarRocks:
+	case drivers.DialectNameStarRocks, drivers.DialectNameDoris:
 		if sampleSize <= cq.Result {
 			useSample = fmt.Sprintf("ORDER BY rand() LIMIT %d", sampleSize)
 		}
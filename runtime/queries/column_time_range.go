@@ -65,9 +65,9 @@ func (q *ColumnTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 
 	// TODO: Try and merge this with metrics_time_range. Both use same queries but metrics_time_range uses a specific timestamp column from metrics_view
 	switch olap.Dialect().String() {
-	case drivers.DialectNameDuckDB, drivers.DialectNameClickHouse, drivers.DialectNameSnowflake, drivers.DialectNameBigQuery, drivers.DialectNameDatabricks:
+	case drivers.DialectNameDuckDB, drivers.DialectNameClickHouse, drivers.DialectNameSnowflake, drivers.DialectNameBigQuery, drivers.DialectNameDatabricks, drivers.DialectNameTrino:
 		return q.resolveGeneric(ctx, olap, priority)
-	case drivers.DialectNameStarRocks:
+	case drivers.DialectNameStarRocks, drivers.DialectNameDoris:
 		return q.resolveStarRocks(ctx, olap, priority)
 	case drivers.DialectNameDruid:
 		return q.resolveDruid(ctx, olap, priority)
@@ -93,7 +93,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	}
 	defer release()
 
-	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks {
+	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks && olap.Dialect().String() != drivers.DialectNameDoris {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -116,6 +116,9 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	if olap.Dialect().String() == drivers.DialectNameStarRocks {
 		return q.resolveStarRocks(ctx, olap, timeRange, priority)
 	}
+	if olap.Dialect().String() == drivers.DialectNameDoris {
+		return q.resolveDoris(ctx, olap, timeRange, timezone, priority)
+	}
 
 	return olap.WithConnection(ctx, priority, func(ctx context.Context, ensuredCtx context.Context) error {
 		tsAlias := tempName("_ts_")
@@ -813,13 +816,95 @@ func (q *ColumnTimeseries) resolveStarRocks(ctx context.Context, olap drivers.OL
 		LEFT OUTER JOIN series ON template.` + tsAlias + ` = series.` + tsAlias + `
 		ORDER BY template.` + tsAlias
 
+	return q.resolveTemplateQuery(ctx, olap, querySQL, nil, tsAlias, priority)
+}
+
+// resolveDoris handles Doris-specific time series resolution.
+// Like for StarRocks, it uses a CTE-based query instead of temporary tables.
+// Since Doris doesn't support generate_series, the time buckets are generated by the dialect's SelectTimeRangeBins.
+func (q *ColumnTimeseries) resolveDoris(ctx context.Context, olap drivers.OLAPStore, timeRange *runtimev1.TimeSeriesTimeRange, timezone string, priority int) error {
+	tsAlias := "_ts_"
+
+	if q.FirstDayOfWeek > 7 || q.FirstDayOfWeek <= 0 {
+		q.FirstDayOfWeek = 1
+	}
+
+	if q.FirstMonthOfYear > 12 || q.FirstMonthOfYear <= 0 {
+		q.FirstMonthOfYear = 1
+	}
+
+	querySQL, args, err := timeSeriesDorisSQL(timeRange, q, tsAlias, timezone, olap.Dialect())
+	if err != nil {
+		return err
+	}
+	if querySQL == "" {
+		q.Result = &ColumnTimeseriesResult{}
+		return nil
+	}
+
+	return q.resolveTemplateQuery(ctx, olap, querySQL, args, tsAlias, priority)
+}
+
+// timeSeriesDorisSQL returns the time series query for Doris. It returns an empty query if the time range has no buckets.
+func timeSeriesDorisSQL(timeRange *runtimev1.TimeSeriesTimeRange, q *ColumnTimeseries, tsAlias, timezone string, dialect drivers.Dialect) (string, []any, error) {
+	tz, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", nil, err
+	}
+
+	measures := normaliseMeasures(q.Measures, q.Pixels != 0)
+
+	templateSQL, args, err := dialect.SelectTimeRangeBins(timeRange.Start.AsTime(), timeRange.End.AsTime(), timeRange.Interval, tsAlias, tz, int(q.FirstDayOfWeek), int(q.FirstMonthOfYear))
+	if err != nil {
+		return "", nil, err
+	}
+	if templateSQL == "" {
+		return "", nil, nil
+	}
+
+	colSQL, err := dialect.DateTruncExpr(&runtimev1.MetricsViewSpec_Dimension{Column: q.TimestampColumnName}, timeRange.Interval, timezone, int(q.FirstDayOfWeek), int(q.FirstMonthOfYear))
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Build COALESCE statements for measures
+	var coalesceStatements string
+	for i, measure := range measures {
+		safeMeasureName := dialect.EscapeIdentifier(measure.SqlName)
+		coalesceStatements += `COALESCE(` + safeMeasureName + `, 0) as ` + safeMeasureName
+		if i < len(measures)-1 {
+			coalesceStatements += ", "
+		}
+	}
+
+	querySQL := `
+		WITH template AS (
+			` + templateSQL + `
+		),
+		series AS (
+			SELECT ` + colSQL + ` AS ` + tsAlias + `, ` + getExpressionColumnsFromMeasures(dialect, measures) + `
+			FROM ` + dialect.EscapeTable(q.Database, q.DatabaseSchema, q.TableName) + `
+			GROUP BY ` + tsAlias + `
+		)
+		SELECT template.` + tsAlias + `, ` + coalesceStatements + `
+		FROM template
+		LEFT OUTER JOIN series ON template.` + tsAlias + ` = series.` + tsAlias + `
+		ORDER BY template.` + tsAlias
+
+	return querySQL, args, nil
+}
+
+// resolveTemplateQuery runs a CTE-based time series query that returns the time bucket in the tsAlias column followed by the measures, and sets q.Result.
+// It is used for dialects that don't support the temporary tables needed for spark values.
+func (q *ColumnTimeseries) resolveTemplateQuery(ctx context.Context, olap drivers.OLAPStore, querySQL string, args []any, tsAlias string, priority int) error {
 	rows, err := olap.Query(ctx, &drivers.Statement{
 		Query:            querySQL,
+		Args:             args,
 		Priority:         priority,
 		ExecutionTimeout: defaultExecutionTimeout,
 	})
 	if err != nil {
-		return fmt.Errorf("starrocks timeseries query: %w", err)
+		return fmt.Errorf("%s timeseries query: %w", olap.Dialect(), err)
 	}
 	defer rows.Close()
 
@@ -872,7 +957,7 @@ func (q *ColumnTimeseries) resolveStarRocks(ctx context.Context, olap drivers.OL
 
 	meta := structTypeToMetricsViewColumn(rows.Schema)
 
-	// Note: Spark values not supported for StarRocks (requires temp tables for M4 algorithm)
+	// Note: Spark values are not supported (requires temp tables for M4 algorithm)
 	q.Result = &ColumnTimeseriesResult{
 		Meta:    meta,
 		Results: data,
@@ -62,7 +62,7 @@ func (q *ColumnTopK) Resolve(ctx context.Context, rt *runtime.Runtime, instanceI
 	// Build column name based on dialect
 	var columnName string
 	switch olap.Dialect().String() {
-	case drivers.DialectNameDuckDB, drivers.DialectNameClickHouse, drivers.DialectNameStarRocks:
+	case drivers.DialectNameDuckDB, drivers.DialectNameClickHouse, drivers.DialectNameStarRocks, drivers.DialectNameDoris, drivers.DialectNameTrino:
 		columnName = olap.Dialect().EscapeIdentifier(q.ColumnName)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -1,8 +1,10 @@
 package queries
 
 import (
+	"bufio"
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/apache/arrow-go/v18/parquet/pqarrow"
 	"github.com/google/uuid"
@@ -308,6 +311,83 @@ func WriteXLSX(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, wri
 }
 
 func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := structsToArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	parquetwriter, err := pqarrow.NewFileWriter(rec.Schema(), ioWriter, nil, pqarrow.ArrowWriterProperties{})
+	if err != nil {
+		return err
+	}
+
+	defer parquetwriter.Close()
+
+	return parquetwriter.Write(rec)
+}
+
+// WriteArrow writes the data as an Arrow IPC stream.
+func WriteArrow(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := structsToArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	w := ipc.NewWriter(ioWriter, ipc.WithSchema(rec.Schema()))
+	if err := w.Write(rec); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// WriteJSONL writes the data as newline-delimited JSON with one object per row.
+// Keys are written in the order of meta.
+func WriteJSONL(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
+	w := bufio.NewWriter(writer)
+
+	keys := make([][]byte, len(meta))
+	for i, field := range meta {
+		k, err := json.Marshal(field.Name)
+		if err != nil {
+			return err
+		}
+		keys[i] = k
+	}
+
+	for _, structs := range data {
+		_ = w.WriteByte('{')
+		for i, field := range meta {
+			var val any
+			if pbvalue := structs.Fields[field.Name]; pbvalue != nil {
+				val = pbvalue.AsInterface()
+			}
+
+			enc, err := json.Marshal(val)
+			if err != nil {
+				return err
+			}
+
+			if i > 0 {
+				_ = w.WriteByte(',')
+			}
+			_, _ = w.Write(keys[i])
+			_ = w.WriteByte(':')
+			_, _ = w.Write(enc)
+		}
+		_ = w.WriteByte('}')
+		if err := w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// structsToArrowRecord converts the data to a single Arrow record batch. The caller must release the returned record.
+func structsToArrowRecord(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct) (arrow.RecordBatch, error) {
 	fields := make([]arrow.Field, 0, len(meta))
 	for _, f := range meta {
 		arrowField := arrow.Field{}
@@ -348,7 +428,7 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 			arrowField.Type = arrow.BinaryTypes.Binary
 		default:
 			// A nil arrow.DataType panics in array.NewRecordBuilder below, so reject unmapped type codes explicitly.
-			return fmt.Errorf("parquet export: unsupported type %q for column %q", f.Type, f.Name)
+			return nil, fmt.Errorf("export: unsupported type %q for column %q", f.Type, f.Name)
 		}
 		fields = append(fields, arrowField)
 	}
@@ -391,14 +471,14 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
 				tmp, err := arrow.TimestampFromString(v.GetStringValue(), arrow.Microsecond)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.TimestampBuilder).Append(tmp)
 			case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_STRUCT:
 				bts, err := protojson.Marshal(v)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.StringBuilder).Append(string(bts))
@@ -415,16 +495,7 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 		}
 	}
 
-	parquetwriter, err := pqarrow.NewFileWriter(schema, ioWriter, nil, pqarrow.ArrowWriterProperties{})
-	if err != nil {
-		return err
-	}
-
-	defer parquetwriter.Close()
-
-	rec := recordBuilder.NewRecordBatch()
-	err = parquetwriter.Write(rec)
-	return err
+	return recordBuilder.NewRecordBatch(), nil
 }
 
 func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, exportFormat runtimev1.ExportFormat) error {
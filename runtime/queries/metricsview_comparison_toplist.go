@@ -214,6 +214,10 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 		format = drivers.FileFormatXLSX
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		format = drivers.FileFormatParquet
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		format = drivers.FileFormatJSONL
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		format = drivers.FileFormatArrow
 	default:
 		return fmt.Errorf("unsupported format: %s", opts.Format.String())
 	}
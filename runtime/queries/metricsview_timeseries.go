@@ -195,6 +195,10 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		return WriteXLSX(meta, tmp, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, tmp, w)
 	}
 
 	return nil
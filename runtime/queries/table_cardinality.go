@@ -55,7 +55,7 @@ func (q *TableCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	}
 	defer release()
 
-	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks {
+	if olap.Dialect().String() != drivers.DialectNameDuckDB && olap.Dialect().String() != drivers.DialectNameClickHouse && olap.Dialect().String() != drivers.DialectNameStarRocks && olap.Dialect().String() != drivers.DialectNameDoris && olap.Dialect().String() != drivers.DialectNameTrino {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
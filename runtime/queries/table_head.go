@@ -103,7 +103,7 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 				return err
 			}
 		}
-	case drivers.DialectNameDruid, drivers.DialectNameClickHouse, drivers.DialectNameStarRocks, drivers.DialectNameSnowflake, drivers.DialectNameBigQuery:
+	case drivers.DialectNameDruid, drivers.DialectNameClickHouse, drivers.DialectNameStarRocks, drivers.DialectNameDoris, drivers.DialectNameSnowflake, drivers.DialectNameBigQuery, drivers.DialectNameTrino:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts); err != nil {
 			return err
 		}
@@ -138,6 +138,10 @@ func (q *TableHead) generalExport(ctx context.Context, rt *runtime.Runtime, inst
 		return WriteXLSX(meta, q.Result, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, q.Result, w)
 	}
 
 	return nil
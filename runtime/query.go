@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -18,12 +19,14 @@ import (
 )
 
 var (
-	meter                        = otel.Meter("github.com/rilldata/rill/runtime")
-	queryCacheHitsCounter        = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
-	queryCacheMissesCounter      = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
-	queryCacheItemCountGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
-	queryCacheSizeBytesGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
-	queryCacheEntrySizeHistogram = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
+	meter                          = otel.Meter("github.com/rilldata/rill/runtime")
+	queryCacheHitsCounter          = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
+	queryCacheMissesCounter        = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
+	queryCacheEvictionsCounter     = observability.Must(meter.Int64ObservableCounter("query_cache.evictions"))
+	queryCacheInvalidationsCounter = observability.Must(meter.Int64Counter("query_cache.invalidations"))
+	queryCacheItemCountGauge       = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
+	queryCacheSizeBytesGauge       = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
+	queryCacheEntrySizeHistogram   = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
 )
 
 type QueryResult struct {
@@ -98,6 +101,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 		// Using StateUpdatedOn instead of StateVersion because the state version is reset when the resource is deleted and recreated.
 		key := fmt.Sprintf("%s:%s:%d:%d", res.Meta.Name.Kind, res.Meta.Name.Name, res.Meta.StateUpdatedOn.Seconds, res.Meta.StateUpdatedOn.Nanos/int32(time.Millisecond))
 		if mv := res.GetMetricsView(); mv != nil {
+			key = fmt.Sprintf("%s:%d", key, r.queryCache.generation(instanceID, res.Meta.Name.Name))
 			cacheKey, ok, err := r.metricsViewCacheKey(ctx, instanceID, res.Meta.Name.Name, priority)
 			if err != nil {
 				return err
@@ -120,6 +124,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	depKey := strings.Join(depKeys, ";")
 	key := queryCacheKey{
 		instanceID:    instanceID,
+		generation:    r.queryCache.generation(instanceID, ""),
 		queryKey:      qk,
 		dependencyKey: depKey,
 	}.String()
@@ -182,20 +187,41 @@ func (r *Runtime) metricsViewCacheKey(ctx context.Context, instanceID, name stri
 	return cacheKey, true, nil
 }
 
+// InvalidateQueryCache invalidates cached query and resolver results for a metrics view.
+// If metricsView is empty, all cached results for the instance are invalidated.
+// It is intended for forcing fresh results after the underlying data has changed outside of Rill's control (e.g. a manual backfill).
+func (r *Runtime) InvalidateQueryCache(ctx context.Context, instanceID, metricsView string) {
+	r.queryCache.invalidate(instanceID, metricsView)
+
+	attrs := []attribute.KeyValue{attribute.String("instance_id", instanceID)}
+	if metricsView != "" {
+		attrs = append(attrs, attribute.String("metrics_view", metricsView))
+	}
+	queryCacheInvalidationsCounter.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
 type queryCacheKey struct {
 	instanceID    string
+	generation    int64
 	queryKey      string
 	dependencyKey string
 }
 
 func (k queryCacheKey) String() string {
-	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
+	return fmt.Sprintf("inst:%s gen:%d deps:%s qry:%s", k.instanceID, k.generation, k.dependencyKey, k.queryKey)
 }
 
 type queryCache struct {
 	cache        *ristretto.Cache
 	singleflight *singleflight.Group[string, any]
 	metrics      metric.Registration
+
+	// generations tracks invalidations of cached results.
+	// Ristretto doesn't support enumerating keys, so instead of deleting entries, we include the current generation
+	// of the instance and its metrics views in cache keys. Invalidated entries then become unreachable and are eventually evicted.
+	// It is keyed by instance ID for instance-wide invalidations and by "<instance ID>/<metrics view>" for metrics view invalidations.
+	generations   map[string]int64
+	generationsMu sync.Mutex
 }
 
 func newQueryCache(sizeInBytes int64) *queryCache {
@@ -218,16 +244,39 @@ func newQueryCache(sizeInBytes int64) *queryCache {
 	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
 		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
 		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
+		observer.ObserveInt64(queryCacheEvictionsCounter, int64(cache.Metrics.KeysEvicted()))
 		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
 		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
 		return nil
-	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
+	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheEvictionsCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
 
 	return &queryCache{
 		cache:        cache,
 		singleflight: &singleflight.Group[string, any]{},
 		metrics:      metrics,
+		generations:  make(map[string]int64),
+	}
+}
+
+// generation returns the current cache generation for an instance (if metricsView is empty) or for a metrics view in the instance.
+func (c *queryCache) generation(instanceID, metricsView string) int64 {
+	c.generationsMu.Lock()
+	defer c.generationsMu.Unlock()
+	return c.generations[generationKey(instanceID, metricsView)]
+}
+
+// invalidate bumps the cache generation for an instance (if metricsView is empty) or for a metrics view in the instance.
+func (c *queryCache) invalidate(instanceID, metricsView string) {
+	c.generationsMu.Lock()
+	defer c.generationsMu.Unlock()
+	c.generations[generationKey(instanceID, metricsView)]++
+}
+
+func generationKey(instanceID, metricsView string) string {
+	if metricsView == "" {
+		return instanceID
 	}
+	return instanceID + "/" + metricsView
 }
 
 func (c *queryCache) close() error {
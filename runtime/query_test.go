@@ -0,0 +1,146 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCacheInvalidate(t *testing.T) {
+	rt, id := newQueryCacheInstance(t)
+	ctx := t.Context()
+
+	// Each resolve of the query returns a new value, so only cache hits return the same value twice
+	var resolves int
+	query := func() int {
+		q := &counterQuery{
+			deps:     []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: "mv"}},
+			resolves: &resolves,
+		}
+		require.NoError(t, rt.Query(ctx, id, q, 1))
+		return q.Result
+	}
+	cached := requireCached(t, query)
+
+	// Invalidating another metrics view doesn't affect the cached result
+	rt.InvalidateQueryCache(ctx, id, "other")
+	require.Equal(t, cached, query())
+
+	// Invalidating the metrics view returns fresh data
+	rt.InvalidateQueryCache(ctx, id, "mv")
+	require.Greater(t, query(), cached)
+	cached = requireCached(t, query)
+
+	// Invalidating the instance returns fresh data
+	rt.InvalidateQueryCache(ctx, id, "")
+	require.Greater(t, query(), cached)
+}
+
+func TestResolveCacheInvalidate(t *testing.T) {
+	rt, id := newQueryCacheInstance(t)
+	ctx := t.Context()
+
+	// The SQL returns a new value on every execution, so only cache hits return the same value twice
+	resolve := func() string {
+		res, _, err := rt.Resolve(ctx, &runtime.ResolveOptions{
+			InstanceID:         id,
+			Resolver:           "sql",
+			ResolverProperties: map[string]any{"sql": "SELECT uuid()::VARCHAR AS id FROM foo LIMIT 1"},
+			Claims:             &runtime.SecurityClaims{SkipChecks: true},
+		})
+		require.NoError(t, err)
+		defer res.Close()
+		row, err := res.Next()
+		require.NoError(t, err)
+		return row["id"].(string)
+	}
+	cached := requireCached(t, resolve)
+
+	// Invalidating the instance returns fresh data
+	rt.InvalidateQueryCache(ctx, id, "")
+	require.NotEqual(t, cached, resolve())
+}
+
+// requireCached calls fn until it returns the same value twice in a row, which means the result has been cached.
+// It is needed because the query cache stores new entries asynchronously.
+func requireCached[T comparable](t *testing.T, fn func() T) T {
+	for i := 0; i < 100; i++ {
+		res := fn()
+		if fn() == res {
+			return res
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.FailNow(t, "result was not cached")
+	panic("unreachable")
+}
+
+func newQueryCacheInstance(t *testing.T) (*runtime.Runtime, string) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"models/foo.sql": `SELECT range AS id FROM range(3)`,
+			"metrics/mv.yaml": `
+version: 1
+type: metrics_view
+model: foo
+dimensions:
+- column: id
+measures:
+- name: count
+  expression: count(*)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	return rt, id
+}
+
+// counterQuery is a runtime.Query that counts the number of times it has been resolved.
+type counterQuery struct {
+	deps     []*runtimev1.ResourceName
+	resolves *int
+	Result   int
+}
+
+var _ runtime.Query = &counterQuery{}
+
+func (q *counterQuery) Key() string {
+	return "counter"
+}
+
+func (q *counterQuery) Deps() []*runtimev1.ResourceName {
+	return q.deps
+}
+
+func (q *counterQuery) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: 8,
+	}
+}
+
+func (q *counterQuery) UnmarshalResult(v any) error {
+	res, ok := v.(int)
+	if !ok {
+		return errors.New("counterQuery: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *counterQuery) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	*q.resolves++
+	q.Result = *q.resolves
+	return nil
+}
+
+func (q *counterQuery) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return errors.New("not implemented")
+}
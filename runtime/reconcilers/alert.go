@@ -5,10 +5,12 @@ import (
 	"crypto/md5"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -20,6 +22,7 @@ import (
 	"github.com/rilldata/rill/runtime/queries"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -29,10 +32,16 @@ const alertExecutionHistoryLimit = 25
 
 const alertDefaultIntervalsLimit = 25
 
+// alertGroupsLimit is the maximum number of failing groups tracked per execution for alerts with group_by.
+const alertGroupsLimit = 100
+
 const alertQueryPriority = 1
 
 const alertCheckDefaultTimeout = 5 * time.Minute
 
+// alertNow returns the current time used for scheduling and muting alerts. It is overridden in tests.
+var alertNow = time.Now
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindAlert, newAlertReconciler)
 }
@@ -145,7 +154,7 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	adhocTrigger := a.Spec.Trigger
 	specHashTrigger := a.State.SpecHash != specHash
 	refsTrigger := a.State.RefsHash != refsHash && a.Spec.RefreshSchedule != nil && a.Spec.RefreshSchedule.RefUpdate
-	scheduleTrigger := a.State.NextRunOn != nil && !a.State.NextRunOn.AsTime().After(time.Now())
+	scheduleTrigger := a.State.NextRunOn != nil && !a.State.NextRunOn.AsTime().After(alertNow())
 	trigger := adhocTrigger || specHashTrigger || refsTrigger || scheduleTrigger
 
 	// If not triggering now, update NextRunOn and retrigger when it falls due
@@ -168,6 +177,7 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		a.State.CurrentExecution = nil
 		a.State.ExecutionHistory = nil
 		a.State.ExecutionCount = 0
+		a.State.Groups = nil
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -180,7 +190,7 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	if scheduleTrigger && !adhocTrigger && !specHashTrigger && !refsTrigger {
 		triggerTime = a.State.NextRunOn.AsTime()
 	} else {
-		triggerTime = time.Now()
+		triggerTime = alertNow()
 	}
 
 	// Run alert queries and send notifications
@@ -421,6 +431,13 @@ func (r *AlertReconciler) executionSpecHash(spec *runtimev1.AlertSpec, refs []*r
 		return "", err
 	}
 
+	for _, f := range spec.GroupBy {
+		_, err = hash.Write([]byte(f))
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -465,7 +482,7 @@ func (r *AlertReconciler) refsStateHash(ctx context.Context, refs []*runtimev1.R
 // updateNextRunOn evaluates the alert's schedule relative to the current time, and updates the NextRunOn state accordingly.
 // If the schedule is nil, it will set NextRunOn to nil.
 func (r *AlertReconciler) updateNextRunOn(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert) error {
-	next, err := nextRefreshTime(time.Now(), a.Spec.RefreshSchedule)
+	next, err := nextRefreshTime(alertNow(), a.Spec.RefreshSchedule)
 	if err != nil {
 		return err
 	}
@@ -475,6 +492,14 @@ func (r *AlertReconciler) updateNextRunOn(ctx context.Context, self *runtimev1.R
 		curr = a.State.NextRunOn.AsTime()
 	}
 
+	// If the latest execution was muted, retrigger when the mute ends so a summary can be sent promptly.
+	if len(a.State.ExecutionHistory) > 0 && a.State.ExecutionHistory[0].MutedUntil != nil {
+		mutedUntil := a.State.ExecutionHistory[0].MutedUntil.AsTime()
+		if mutedUntil.After(alertNow()) && (next.IsZero() || mutedUntil.Before(next)) {
+			next = mutedUntil
+		}
+	}
+
 	if next.Equal(curr) {
 		return nil
 	}
@@ -620,12 +645,17 @@ func (r *AlertReconciler) executeAllWrapped(ctx context.Context, self *runtimev1
 	ts, err := calculateAlertExecutionTimes(a, watermark, previousWatermark)
 	if err != nil {
 		skipErr := &skipError{}
-		if errors.As(err, skipErr) {
+		if !errors.As(err, skipErr) {
+			r.C.Logger.Error("Internal: failed to calculate execution times", zap.String("name", self.Meta.Name.Name), zap.Error(err), observability.ZapCtx(ctx))
+			return err
+		}
+		if !alertMuteEnded(a) {
 			r.C.Logger.Info("Skipped alert check", zap.String("name", self.Meta.Name.Name), zap.String("reason", skipErr.reason), zap.Time("current_watermark", watermark), zap.Time("previous_watermark", previousWatermark), zap.String("interval", a.Spec.IntervalsIsoDuration), observability.ZapCtx(ctx))
 			return nil
 		}
-		r.C.Logger.Error("Internal: failed to calculate execution times", zap.String("name", self.Meta.Name.Name), zap.Error(err), observability.ZapCtx(ctx))
-		return err
+		// The latest execution was muted and the mute has ended.
+		// We check the previous watermark again so a summary of the muted executions can be sent even though the watermark hasn't advanced.
+		ts = []time.Time{previousWatermark}
 	}
 	if len(ts) == 0 {
 		// This should never happen
@@ -706,6 +736,13 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 	// Tag as alert execution so the metrics queries it runs are attributed to the "alert" source for billing.
 	ctx = runtime.WithRequestSource(ctx, runtime.RequestSourceAlert)
 
+	// For alerts with group_by, we need the failing row of every group.
+	// We fetch one row more than the limit to detect if it was exceeded.
+	limit := 1
+	if len(a.Spec.GroupBy) > 0 {
+		limit = alertGroupsLimit + 1
+	}
+
 	res, info, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
 		InstanceID:         r.C.InstanceID,
 		Resolver:           a.Spec.Resolver,
@@ -714,7 +751,7 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 			"priority":       alertQueryPriority,
 			"execution_time": executionTime,
 			"format":         true,
-			"limit":          1,
+			"limit":          limit,
 		},
 		Claims: &runtime.SecurityClaims{UserAttributes: queryForAttrs},
 	})
@@ -744,11 +781,42 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert fail row to proto: %w", err)
 	}
-	return &runtimev1.AssertionResult{
+	result := &runtimev1.AssertionResult{
 		Status:   runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
 		FailRow:  failRow,
 		Warnings: warnings,
-	}, nil
+	}
+	if len(a.Spec.GroupBy) == 0 {
+		return result, nil
+	}
+
+	// For alerts with group_by, also return the fail row of each group
+	for {
+		for _, f := range a.Spec.GroupBy {
+			if _, ok := failRow.Fields[f]; !ok {
+				return nil, fmt.Errorf("group_by field %q not found in alert result", f)
+			}
+		}
+		if len(result.FailRows) == alertGroupsLimit {
+			result.FailRowsTruncated = true
+			result.Warnings = append(result.Warnings, fmt.Sprintf("alert returned more than %d groups, only the first %d are tracked", alertGroupsLimit, alertGroupsLimit))
+			break
+		}
+		result.FailRows = append(result.FailRows, failRow)
+
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to get row from alert resolver: %w", err)
+		}
+		failRow, err = structpb.NewStruct(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert fail row to proto: %w", err)
+		}
+	}
+	return result, nil
 }
 
 // popCurrentExecution moves the current execution into the execution history and sends notifications if the execution matched the notification criteria.
@@ -760,6 +828,15 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 
 	current := a.State.CurrentExecution
 
+	// Check if notifications are muted
+	mutedUntil, muted, err := alertMutedUntil(a.Spec, alertNow())
+	if err != nil {
+		return err
+	}
+	if muted {
+		current.MutedUntil = timestamppb.New(mutedUntil)
+	}
+
 	// td represents the amount of time since we last sent a notification for the current status AND where all intervening executions have returned the same status.
 	var td *time.Duration
 	var lastNotifyTime time.Time
@@ -819,6 +896,25 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 	}
 
+	// Suppress notifications while muted.
+	// Once the mute has ended, send a single summary if the alert is still failing.
+	var mutedExecutions int
+	if muted {
+		notify = false
+	} else if current.Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL {
+		for _, prev := range a.State.ExecutionHistory {
+			if prev.MutedUntil == nil {
+				break
+			}
+			if prev.Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL {
+				mutedExecutions++
+			}
+		}
+		if mutedExecutions > 0 {
+			notify = true
+		}
+	}
+
 	// Get execution time
 	var executionTime time.Time
 	if current.ExecutionTime != nil {
@@ -855,10 +951,11 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 			}
 
 			msg = &drivers.AlertStatus{
-				DisplayName:   a.Spec.DisplayName,
-				ExecutionTime: executionTime,
-				Status:        current.Result.Status,
-				FailRow:       current.Result.FailRow.AsMap(),
+				DisplayName:     a.Spec.DisplayName,
+				ExecutionTime:   executionTime,
+				Status:          current.Result.Status,
+				FailRow:         current.Result.FailRow.AsMap(),
+				MutedExecutions: mutedExecutions,
 			}
 		case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
 			if !a.Spec.NotifyOnError {
@@ -876,93 +973,27 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 	}
 
-	// Send a notification (if applicable)
+	// For alerts with group_by, notifications are sent per group instead.
+	// Errors are handled at the alert level since they don't relate to a specific group.
+	var msgs []*drivers.AlertStatus
+	if len(a.Spec.GroupBy) > 0 && current.Result.Status != runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR {
+		msgs, err = alertGroupStatuses(a, current, executionTime, muted)
+		if err != nil {
+			return err
+		}
+	} else if msg != nil {
+		msgs = append(msgs, msg)
+	}
+
+	// Send notifications (if applicable)
 	var notificationErr error
 	var sentNotifications bool
-	if msg != nil {
-		for _, notifier := range a.Spec.Notifiers {
-			switch notifier.Connector {
-			// TODO: transform email client to notifier
-			case "email":
-				recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-				for _, recipient := range recipients {
-					msg.ToEmail = recipient
-
-					// Set recipient-specific URLs if available from admin metadata
-					if adminMeta != nil && adminMeta.RecipientURLs != nil {
-						if recipientURLs, ok := adminMeta.RecipientURLs[recipient]; ok {
-							// Use recipient-specific URLs (with magic token)
-							openLink, err := addExecutionTime(recipientURLs.OpenURL, executionTime)
-							if err != nil {
-								return fmt.Errorf("failed to build recipient open url: %w", err)
-							}
-							msg.OpenLink = openLink
-							msg.EditLink = recipientURLs.EditURL
-							msg.UnsubscribeLink = recipientURLs.UnsubscribeURL
-						} else {
-							// Note: adminMeta may not always be available (if outside of cloud) or no links sent for this recipient. In those cases, we leave the links blank (no clickthrough available).
-							msg.OpenLink = ""
-							msg.EditLink = ""
-							msg.UnsubscribeLink = ""
-						}
-					}
-
-					err := r.C.Runtime.Email.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
-						break
-					}
-				}
-			default:
-				err := func() (outErr error) {
-					conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-					if err != nil {
-						return err
-					}
-					defer release()
-					n, err := conn.AsNotifier(notifier.Properties.AsMap())
-					if err != nil {
-						return err
-					}
-					// Note: adminMeta may not always be available (if outside of cloud). In that case, we leave the links blank (no clickthrough available).
-					msg.OpenLink = ""
-					msg.EditLink = ""
-					msg.UnsubscribeLink = ""
-					if adminMeta != nil && adminMeta.RecipientURLs != nil {
-						urls, ok := adminMeta.RecipientURLs[""]
-						if !ok {
-							return fmt.Errorf("failed to get recipient URLs for anon user")
-						}
-						openLink, err := addExecutionTime(urls.OpenURL, executionTime)
-						if err != nil {
-							return fmt.Errorf("failed to build recipient open url: %w", err)
-						}
-						msg.OpenLink = openLink
-						msg.EditLink = urls.EditURL
-					}
-					start := time.Now()
-					defer func() {
-						totalLatency := time.Since(start).Milliseconds()
-
-						if r.C.Activity != nil {
-							r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-								attribute.Bool("failed", outErr != nil),
-								attribute.String("connector", notifier.Connector),
-								attribute.String("notification_type", "alert_status"),
-							)
-						}
-					}()
-					err = n.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
-					}
-					return nil
-				}()
-				if err != nil {
-					return err
-				}
-			}
+	for _, msg := range msgs {
+		nerr, err := r.sendAlertStatus(ctx, a, adminMeta, msg, executionTime)
+		if err != nil {
+			return err
 		}
+		notificationErr = errors.Join(notificationErr, nerr)
 		sentNotifications = true
 	}
 
@@ -987,6 +1018,95 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
 
+// sendAlertStatus sends the alert status message to all of the alert's notifiers.
+// Failures to deliver a notification are returned as notificationErr, while other failures are returned as err.
+func (r *AlertReconciler) sendAlertStatus(ctx context.Context, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata, msg *drivers.AlertStatus, executionTime time.Time) (notificationErr, err error) {
+	for _, notifier := range a.Spec.Notifiers {
+		switch notifier.Connector {
+		// TODO: transform email client to notifier
+		case "email":
+			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
+			for _, recipient := range recipients {
+				msg.ToEmail = recipient
+
+				// Set recipient-specific URLs if available from admin metadata
+				if adminMeta != nil && adminMeta.RecipientURLs != nil {
+					if recipientURLs, ok := adminMeta.RecipientURLs[recipient]; ok {
+						// Use recipient-specific URLs (with magic token)
+						openLink, err := addExecutionTime(recipientURLs.OpenURL, executionTime)
+						if err != nil {
+							return nil, fmt.Errorf("failed to build recipient open url: %w", err)
+						}
+						msg.OpenLink = openLink
+						msg.EditLink = recipientURLs.EditURL
+						msg.UnsubscribeLink = recipientURLs.UnsubscribeURL
+					} else {
+						// Note: adminMeta may not always be available (if outside of cloud) or no links sent for this recipient. In those cases, we leave the links blank (no clickthrough available).
+						msg.OpenLink = ""
+						msg.EditLink = ""
+						msg.UnsubscribeLink = ""
+					}
+				}
+
+				err := r.C.Runtime.Email.SendAlertStatus(msg)
+				if err != nil {
+					notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
+					break
+				}
+			}
+		default:
+			err := func() (outErr error) {
+				conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
+				if err != nil {
+					return err
+				}
+				defer release()
+				n, err := conn.AsNotifier(notifier.Properties.AsMap())
+				if err != nil {
+					return err
+				}
+				// Note: adminMeta may not always be available (if outside of cloud). In that case, we leave the links blank (no clickthrough available).
+				msg.OpenLink = ""
+				msg.EditLink = ""
+				msg.UnsubscribeLink = ""
+				if adminMeta != nil && adminMeta.RecipientURLs != nil {
+					urls, ok := adminMeta.RecipientURLs[""]
+					if !ok {
+						return fmt.Errorf("failed to get recipient URLs for anon user")
+					}
+					openLink, err := addExecutionTime(urls.OpenURL, executionTime)
+					if err != nil {
+						return fmt.Errorf("failed to build recipient open url: %w", err)
+					}
+					msg.OpenLink = openLink
+					msg.EditLink = urls.EditURL
+				}
+				start := time.Now()
+				defer func() {
+					totalLatency := time.Since(start).Milliseconds()
+
+					if r.C.Activity != nil {
+						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+							attribute.Bool("failed", outErr != nil),
+							attribute.String("connector", notifier.Connector),
+							attribute.String("notification_type", "alert_status"),
+						)
+					}
+				}()
+				err = n.SendAlertStatus(msg)
+				if err != nil {
+					notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
+				}
+				return nil
+			}()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return notificationErr, nil
+}
+
 // computeInheritedWatermark computes the inherited watermark for the alert.
 // It returns false if the watermark could not be computed.
 func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []*runtimev1.ResourceName) (time.Time, bool, error) {
@@ -1009,6 +1129,193 @@ func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []
 	return t, !t.IsZero(), nil
 }
 
+// alertMuteEnded returns true if the alert's latest execution was muted and the mute has since ended.
+func alertMuteEnded(a *runtimev1.Alert) bool {
+	if len(a.State.ExecutionHistory) == 0 || a.State.ExecutionHistory[0].MutedUntil == nil {
+		return false
+	}
+	return !a.State.ExecutionHistory[0].MutedUntil.AsTime().After(alertNow())
+}
+
+// alertMutedUntil returns the time until which the alert's notifications are muted by its snooze or mute windows.
+// It returns false if notifications are not muted at time t.
+func alertMutedUntil(spec *runtimev1.AlertSpec, t time.Time) (time.Time, bool, error) {
+	var until time.Time
+	if spec.SnoozeUntil != nil && spec.SnoozeUntil.AsTime().After(t) {
+		until = spec.SnoozeUntil.AsTime()
+	}
+
+	for _, w := range spec.MuteWindows {
+		// Find the windows that started within the window duration before t.
+		d := time.Duration(w.DurationSeconds) * time.Second
+		schedule := &runtimev1.Schedule{Cron: w.Cron, TimeZone: w.TimeZone}
+		start, err := nextRefreshTime(t.Add(-d), schedule)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid mute window: %w", err)
+		}
+		for !start.IsZero() && !start.After(t) {
+			if end := start.Add(d); end.After(until) {
+				until = end
+			}
+			start, err = nextRefreshTime(start, schedule)
+			if err != nil {
+				return time.Time{}, false, fmt.Errorf("invalid mute window: %w", err)
+			}
+		}
+	}
+
+	return until, !until.IsZero(), nil
+}
+
+// alertGroupStatuses updates the state of each group of an alert with group_by based on the current execution's result.
+// It returns the notification messages to send for groups that started failing, should be renotified, or recovered.
+// While muted, the group states are updated but no messages are returned; the changes and the groups that kept failing are notified once the mute ends.
+func alertGroupStatuses(a *runtimev1.Alert, current *runtimev1.AlertExecution, executionTime time.Time, muted bool) ([]*drivers.AlertStatus, error) {
+	if a.State.Groups == nil {
+		a.State.Groups = make(map[string]*runtimev1.AlertGroupState)
+	}
+
+	var msgs []*drivers.AlertStatus
+	failing := make(map[string]bool, len(current.Result.FailRows))
+	for _, row := range current.Result.FailRows {
+		key, keyStruct, err := alertGroupKey(a.Spec.GroupBy, row)
+		if err != nil {
+			return nil, err
+		}
+		if failing[key] {
+			// Only the first row of each group is considered
+			continue
+		}
+		failing[key] = true
+
+		g, ok := a.State.Groups[key]
+		if !ok {
+			g = &runtimev1.AlertGroupState{Key: keyStruct}
+			a.State.Groups[key] = g
+		}
+
+		// Determine if we should notify/renotify for the group
+		changed := g.Status != runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL || g.LastNotifiedOn == nil
+		if g.Status != runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL {
+			g.FailingSince = timestamppb.New(executionTime)
+		}
+		g.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL
+		g.FailRow = row
+
+		if muted {
+			continue
+		}
+
+		// Once a mute has ended, send a summary if the group kept failing while muted
+		mutedExecutions := alertGroupMutedExecutions(a, key)
+
+		var notify bool
+		if changed || mutedExecutions > 0 {
+			notify = true
+		} else if a.Spec.Renotify {
+			td := executionTime.Sub(g.LastNotifiedOn.AsTime())
+			notify = a.Spec.RenotifyAfterSeconds == 0 || int(td.Seconds()) >= int(a.Spec.RenotifyAfterSeconds)
+		}
+		if !notify || !a.Spec.NotifyOnFail {
+			continue
+		}
+		g.LastNotifiedOn = timestamppb.New(executionTime)
+
+		msgs = append(msgs, &drivers.AlertStatus{
+			DisplayName:     alertGroupDisplayName(a.Spec, g.Key),
+			ExecutionTime:   executionTime,
+			Status:          runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+			FailRow:         row.AsMap(),
+			MutedExecutions: mutedExecutions,
+		})
+	}
+
+	// If the result was truncated, groups that are not in it may still be failing, so we can't tell whether they recovered.
+	if current.Result.FailRowsTruncated {
+		return msgs, nil
+	}
+
+	// Groups that are no longer failing have recovered
+	keys := maps.Keys(a.State.Groups)
+	slices.Sort(keys)
+	for _, key := range keys {
+		if failing[key] {
+			continue
+		}
+		g := a.State.Groups[key]
+		g.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_PASS
+		g.FailRow = nil
+
+		// Keep the group around while muted so the recovery can be notified once the mute ends
+		if muted {
+			continue
+		}
+		delete(a.State.Groups, key)
+
+		// Only notify about recoveries of groups that we notified had failed
+		if a.Spec.NotifyOnRecover && g.LastNotifiedOn != nil {
+			msgs = append(msgs, &drivers.AlertStatus{
+				DisplayName:   alertGroupDisplayName(a.Spec, g.Key),
+				ExecutionTime: executionTime,
+				Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_PASS,
+				IsRecover:     true,
+			})
+		}
+	}
+
+	return msgs, nil
+}
+
+// alertGroupMutedExecutions returns the number of preceding muted executions in which the group with the given key was failing.
+// Like for alerts without group_by, only the executions since the last unmuted execution are counted.
+// Rows that don't match the current group_by (e.g. because it was changed) are ignored.
+func alertGroupMutedExecutions(a *runtimev1.Alert, key string) int {
+	var n int
+	for _, prev := range a.State.ExecutionHistory {
+		if prev.MutedUntil == nil {
+			break
+		}
+		for _, row := range prev.Result.FailRows {
+			k, _, err := alertGroupKey(a.Spec.GroupBy, row)
+			if err == nil && k == key {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
+
+// alertGroupKey returns the key that identifies the group of a fail row for an alert with group_by.
+// It returns the key both as a string suitable for map lookups and as a struct of the group_by values.
+func alertGroupKey(groupBy []string, row *structpb.Struct) (string, *structpb.Struct, error) {
+	vals := make([]any, len(groupBy))
+	keyStruct := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(groupBy))}
+	for i, f := range groupBy {
+		v, ok := row.Fields[f]
+		if !ok {
+			return "", nil, fmt.Errorf("group_by field %q not found in alert result", f)
+		}
+		vals[i] = v.AsInterface()
+		keyStruct.Fields[f] = v
+	}
+	key, err := json.Marshal(vals)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to compute group key: %w", err)
+	}
+	return string(key), keyStruct, nil
+}
+
+// alertGroupDisplayName returns the display name to use in notifications for a group of an alert with group_by.
+// For example: "Revenue drop (country=Denmark)".
+func alertGroupDisplayName(spec *runtimev1.AlertSpec, key *structpb.Struct) string {
+	parts := make([]string, len(spec.GroupBy))
+	for i, f := range spec.GroupBy {
+		parts[i] = fmt.Sprintf("%s=%v", f, key.Fields[f].AsInterface())
+	}
+	return fmt.Sprintf("%s (%s)", spec.DisplayName, strings.Join(parts, ", "))
+}
+
 // calculateAlertExecutionTimes calculates the execution times for an alert, taking into consideration the alert's intervals configuration and previous executions.
 // If the alert is not configured to run on intervals, it will return a slice containing only the current watermark.
 // If the alert should not be executed, it returns a skipError explaining why.
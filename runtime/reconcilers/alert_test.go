@@ -3,6 +3,7 @@ package reconcilers_test
 import (
 	"fmt"
 	"slices"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/parser"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/reconcilers"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -573,6 +575,290 @@ SELECT '2024-01-02T00:00:00Z'::TIMESTAMP as __time, 'Sweden' as country
 	require.Contains(t, emails[0].Body, "measure_0")
 }
 
+func TestAlertGroupsTruncated(t *testing.T) {
+	// 150 groups fail, which is more than the 100 groups tracked per execution.
+	// The "rank" column controls which groups are returned first.
+	model := func(desc, fail bool) string {
+		rank, where := "i", ""
+		if desc {
+			rank = "-i"
+		}
+		if !fail {
+			where = "WHERE false"
+		}
+		return fmt.Sprintf(`
+-- @materialize: true
+SELECT 'c' || lpad(i::VARCHAR, 3, '0') AS country, %s AS rank FROM range(150) t(i) %s
+`, rank, where)
+	}
+
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": model(false, true),
+		"/alerts/a1.yaml": `
+type: alert
+refs:
+- type: Model
+  name: bar
+data:
+  sql: SELECT country FROM bar ORDER BY rank
+group_by:
+- country
+on_recover: true
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	sender := rt.Email.Sender.(*email.TestSender)
+
+	// The first 100 groups are tracked and notified
+	a1 := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.True(t, a1.State.ExecutionHistory[0].Result.FailRowsTruncated)
+	require.Len(t, a1.State.Groups, 100)
+	require.Len(t, sender.Emails, 100)
+
+	// Return the groups in the opposite order. The previously tracked groups that are not returned are still failing, so they must not recover.
+	testruntime.PutFiles(t, rt, id, map[string]string{"/models/bar.sql": model(true, true)})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.True(t, a1.State.ExecutionHistory[0].Result.FailRowsTruncated)
+	require.Len(t, a1.State.Groups, 150)
+	for _, g := range a1.State.Groups {
+		require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, g.Status)
+	}
+	require.Len(t, sender.Emails, 150)
+
+	// When no groups fail, all the tracked groups recover
+	testruntime.PutFiles(t, rt, id, map[string]string{"/models/bar.sql": model(true, false)})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.False(t, a1.State.ExecutionHistory[0].Result.FailRowsTruncated)
+	require.Empty(t, a1.State.Groups)
+	require.Len(t, sender.Emails, 300)
+}
+
+func TestAlertMutedUntil(t *testing.T) {
+	at := func(s string) time.Time {
+		return must(time.Parse(time.RFC3339, s))
+	}
+
+	spec := &runtimev1.AlertSpec{
+		MuteWindows: []*runtimev1.AlertMuteWindow{
+			// Every day from 02:00 to 04:00 UTC
+			{Cron: "0 2 * * *", DurationSeconds: 2 * 60 * 60},
+			// Every day from 01:00 to 02:00 in New York
+			{Cron: "0 1 * * *", TimeZone: "America/New_York", DurationSeconds: 60 * 60},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		snoozeUntil time.Time
+		t           time.Time
+		want        time.Time
+	}{
+		{
+			name: "before window",
+			t:    at("2024-01-01T01:59:00Z"),
+		},
+		{
+			name: "at window start",
+			t:    at("2024-01-01T02:00:00Z"),
+			want: at("2024-01-01T04:00:00Z"),
+		},
+		{
+			name: "inside window",
+			t:    at("2024-01-01T03:30:00Z"),
+			want: at("2024-01-01T04:00:00Z"),
+		},
+		{
+			name: "at window end",
+			t:    at("2024-01-01T04:00:00Z"),
+		},
+		{
+			name: "inside window with time zone",
+			t:    at("2024-01-01T06:30:00Z"),
+			want: at("2024-01-01T07:00:00Z"),
+		},
+		{
+			name:        "snoozed",
+			snoozeUntil: at("2024-01-01T12:00:00Z"),
+			t:           at("2024-01-01T10:00:00Z"),
+			want:        at("2024-01-01T12:00:00Z"),
+		},
+		{
+			name:        "snooze ends before window",
+			snoozeUntil: at("2024-01-01T03:00:00Z"),
+			t:           at("2024-01-01T02:30:00Z"),
+			want:        at("2024-01-01T04:00:00Z"),
+		},
+		{
+			name:        "snooze expired",
+			snoozeUntil: at("2024-01-01T09:00:00Z"),
+			t:           at("2024-01-01T10:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec.SnoozeUntil = nil
+			if !tt.snoozeUntil.IsZero() {
+				spec.SnoozeUntil = timestamppb.New(tt.snoozeUntil)
+			}
+			got, ok, err := reconcilers.AlertMutedUntil(spec, tt.t)
+			require.NoError(t, err)
+			require.Equal(t, !tt.want.IsZero(), ok)
+			if ok {
+				require.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAlertMuted(t *testing.T) {
+	// Use a fixed clock that the test advances past the snooze.
+	// It starts at the current time so the controller's retrigger for the end of the snooze doesn't fire during the test.
+	t0 := time.Now().Truncate(time.Second)
+	var clock atomic.Int64
+	clock.Store(t0.UnixNano())
+	reconcilers.SetAlertNow(t, func() time.Time { return time.Unix(0, clock.Load()) })
+	snoozeUntil := t0.Add(time.Hour)
+
+	t.Run("trigger time watermark", func(t *testing.T) {
+		clock.Store(t0.UnixNano())
+		rt, id := testruntime.NewInstance(t)
+		testruntime.PutFiles(t, rt, id, map[string]string{
+			"/models/bar.sql": `SELECT 'Denmark' AS country`,
+			"/alerts/a1.yaml": fmt.Sprintf(`
+type: alert
+refs:
+- type: Model
+  name: bar
+data:
+  sql: SELECT country FROM bar
+snooze_until: %s
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`, snoozeUntil.UTC().Format(time.RFC3339)),
+		})
+		testruntime.ReconcileParserAndWait(t, rt, id)
+		testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+		sender := rt.Email.Sender.(*email.TestSender)
+
+		// The alert fails while snoozed, so no notification is sent
+		a1 := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 1)
+		require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, a1.State.ExecutionHistory[0].Result.Status)
+		require.True(t, snoozeUntil.Equal(a1.State.ExecutionHistory[0].MutedUntil.AsTime()))
+		require.False(t, a1.State.ExecutionHistory[0].SentNotifications)
+		require.True(t, snoozeUntil.Equal(a1.State.NextRunOn.AsTime()))
+		require.Empty(t, sender.Emails)
+
+		// The alert fails again while snoozed
+		clock.Store(t0.Add(time.Minute).UnixNano())
+		testruntime.PutFiles(t, rt, id, map[string]string{"/models/bar.sql": `SELECT 'Norway' AS country`})
+		testruntime.ReconcileParserAndWait(t, rt, id)
+		a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 2)
+		require.NotNil(t, a1.State.ExecutionHistory[0].MutedUntil)
+		require.Empty(t, sender.Emails)
+
+		// When the snooze has ended, the alert runs and sends a summary of the muted executions
+		clock.Store(snoozeUntil.Add(time.Second).UnixNano())
+		reconcileAlert(t, rt, id, "a1")
+		require.Len(t, sender.Emails, 1)
+		require.Contains(t, sender.Emails[0].Body, "The alert kept failing during 2 checks while it was muted.")
+
+		a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 3)
+		require.Nil(t, a1.State.ExecutionHistory[0].MutedUntil)
+		require.True(t, a1.State.ExecutionHistory[0].SentNotifications)
+	})
+
+	t.Run("inherited watermark", func(t *testing.T) {
+		clock.Store(t0.UnixNano())
+		rt, id := testruntime.NewInstance(t)
+		testruntime.PutFiles(t, rt, id, map[string]string{
+			"/models/bar.sql": `SELECT '2024-01-01T00:00:00Z'::TIMESTAMP AS __time, 'Sweden' AS country`,
+			"/metrics/mv1.yaml": `
+version: 1
+type: metrics_view
+model: bar
+timeseries: __time
+dimensions:
+- column: country
+measures:
+- expression: count(*)
+`,
+			"/alerts/a1.yaml": fmt.Sprintf(`
+type: alert
+refs:
+- type: MetricsView
+  name: mv1
+watermark: inherit
+data:
+  metrics_sql: select country, measure_0 from mv1 where country <> 'Denmark'
+snooze_until: %s
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`, snoozeUntil.UTC().Format(time.RFC3339)),
+		})
+		testruntime.ReconcileParserAndWait(t, rt, id)
+		testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+		sender := rt.Email.Sender.(*email.TestSender)
+
+		// The alert fails for two watermarks while snoozed
+		a1 := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 1)
+		require.NotNil(t, a1.State.ExecutionHistory[0].MutedUntil)
+		testruntime.PutFiles(t, rt, id, map[string]string{
+			"/models/bar.sql": `
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP AS __time, 'Sweden' AS country
+UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP AS __time, 'Sweden' AS country
+`,
+		})
+		testruntime.ReconcileParserAndWait(t, rt, id)
+		a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 2)
+		require.NotNil(t, a1.State.ExecutionHistory[0].MutedUntil)
+		require.Empty(t, sender.Emails)
+
+		// When the snooze has ended, the alert runs for the unchanged watermark and sends a summary of the muted executions
+		clock.Store(snoozeUntil.Add(time.Second).UnixNano())
+		reconcileAlert(t, rt, id, "a1")
+		require.Len(t, sender.Emails, 1)
+		require.Contains(t, sender.Emails[0].Body, "The alert kept failing during 2 checks while it was muted.")
+
+		a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 3)
+		require.Nil(t, a1.State.ExecutionHistory[0].MutedUntil)
+		require.True(t, a1.State.ExecutionHistory[0].SentNotifications)
+		require.True(t, a1.State.ExecutionHistory[0].ExecutionTime.AsTime().Equal(a1.State.ExecutionHistory[1].ExecutionTime.AsTime()))
+
+		// Later checks for the unchanged watermark are skipped again
+		reconcileAlert(t, rt, id, "a1")
+		a1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+		require.Len(t, a1.State.ExecutionHistory, 3)
+		require.Len(t, sender.Emails, 1)
+	})
+}
+
+// reconcileAlert reconciles the alert and waits for it to finish.
+func reconcileAlert(t *testing.T, rt *runtime.Runtime, id, name string) {
+	ctrl, err := rt.Controller(t.Context(), id)
+	require.NoError(t, err)
+	require.NoError(t, ctrl.Reconcile(t.Context(), &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: name}))
+	require.NoError(t, ctrl.WaitUntilIdle(t.Context(), false))
+}
+
 func newMetricsView(name, model, timeDim string, measures, dimensions []any) (*runtimev1.MetricsView, *runtimev1.Resource) {
 	metrics := &runtimev1.MetricsView{
 		Spec: &runtimev1.MetricsViewSpec{
@@ -0,0 +1,17 @@
+package reconcilers
+
+import (
+	"testing"
+	"time"
+)
+
+// AlertMutedUntil exports alertMutedUntil for tests in the reconcilers_test package.
+var AlertMutedUntil = alertMutedUntil
+
+// SetAlertNow overrides the clock used for scheduling and muting alerts until the test completes.
+// It must be called before the test creates any instances.
+func SetAlertNow(t testing.TB, now func() time.Time) {
+	prev := alertNow
+	alertNow = now
+	t.Cleanup(func() { alertNow = prev })
+}
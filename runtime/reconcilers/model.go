@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -36,10 +37,17 @@ const (
 
 	_modelSyncPartitionsBatchSize    = 1000
 	_modelPendingPartitionsBatchSize = 1000
+
+	// _modelFreshnessRecheckInterval is the max interval at which a stale model's freshness watermark is re-evaluated.
+	// It only applies to models with a watermark resolver since the data may be updated outside of Rill.
+	_modelFreshnessRecheckInterval = 15 * time.Minute
 )
 
 var errPartitionsHaveErrors = errors.New("some partitions have errors")
 
+// errModelStale is returned when a model's data is older than the max staleness allowed by its freshness SLA.
+var errModelStale = errors.New("model is stale")
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindModel, newModelReconciler)
 }
@@ -342,6 +350,12 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 				}
 			}
 		}
+		// Check the freshness SLA (if any). It may be due even if the model isn't.
+		freshnessCheckOn, err := r.checkFreshness(ctx, self)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err, Retrigger: refreshOn}
+		}
+		refreshOn = earliestTime(refreshOn, freshnessCheckOn)
 		// Show if any partitions errored
 		if model.State.PartitionsHaveErrors && !cfg.ModelPartitionsWarnOnFailure {
 			return runtime.ReconcileResult{Err: errPartitionsHaveErrors, Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
@@ -350,6 +364,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		if len(model.State.TestErrors) > 0 && !cfg.ModelTestsWarnOnFailure {
 			return runtime.ReconcileResult{Err: errors.New(newTestsErrorMsg(model.State.TestErrors)), Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
 		}
+		// Show if the model is stale
+		if model.State.Stale {
+			return runtime.ReconcileResult{Err: newStaleError(model), Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
+		}
 		return runtime.ReconcileResult{Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
 	}
 	// Acquire the execution semaphore for the remainder of the function.
@@ -387,6 +405,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	if execErr == nil {
 		newIncrementalState, newIncrementalStateSchema, incrementalStateWarnings, execErr = r.resolveIncrementalState(ctx, model)
 	}
+	if execErr == nil && model.Spec.Incremental && model.Spec.IncrementalStateResolver == "" && execRes.IncrementalState != nil {
+		// Fall back to the incremental state produced by the executor (e.g. the last snapshot read from an Iceberg table)
+		newIncrementalState, execErr = structpb.NewStruct(execRes.IncrementalState)
+		if execErr != nil {
+			execErr = fmt.Errorf("executor produced invalid incremental state: %w", execErr)
+		}
+	}
 
 	// If the model is partitioned, track if any of the partitions have errors
 	var partitionsHaveErrors bool
@@ -502,6 +527,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: errors.Join(err, execErr)}
 	}
 
+	// Check the freshness SLA (if any). We also do this if the build failed since the previous data may now be stale.
+	freshnessCheckOn, err := r.checkFreshness(ctx, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: errors.Join(err, execErr), Retrigger: refreshOn}
+	}
+	refreshOn = earliestTime(refreshOn, freshnessCheckOn)
+
 	// Note: If the build failed, this is where we return the error.
 	if execErr != nil {
 		return runtime.ReconcileResult{Err: execErr, Retrigger: refreshOn}
@@ -517,6 +549,11 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: errors.New(newTestsErrorMsg(model.State.TestErrors)), Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
 	}
 
+	// Show if the model is stale
+	if model.State.Stale {
+		return runtime.ReconcileResult{Err: newStaleError(model), Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
+	}
+
 	return runtime.ReconcileResult{Warnings: reconcileWarnings(model, &cfg), Retrigger: refreshOn}
 }
 
@@ -1471,6 +1508,12 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 		return nil, err
 	}
 
+	// Pass the persisted incremental state to the executor (without the "incremental" flag that is only added for templating)
+	var prevIncrementalState map[string]any
+	if mdl.State.IncrementalState != nil {
+		prevIncrementalState = mdl.State.IncrementalState.AsMap()
+	}
+
 	// Execute the stage step if configured
 	return r.executeWithRetry(ctx, self, mdl, func(ctx context.Context) (*drivers.ModelResult, error) {
 		var stageDuration time.Duration
@@ -1493,6 +1536,7 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 				PartitionRun:         partitionKey != "",
 				PartitionKey:         partitionKey,
 				TempDir:              tempDir,
+				IncrementalState:     prevIncrementalState,
 			})
 			if err != nil {
 				return nil, err
@@ -1525,6 +1569,7 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 			PartitionRun:         partitionKey != "",
 			PartitionKey:         partitionKey,
 			TempDir:              tempDir,
+			IncrementalState:     prevIncrementalState,
 		})
 		if err != nil {
 			return nil, err
@@ -2074,6 +2119,130 @@ func (r *ModelReconciler) execModelTest(ctx context.Context, test *runtimev1.Mod
 	return fmt.Sprintf("%s: test did not pass", test.Name), warnings, nil
 }
 
+// checkFreshness evaluates the model's freshness SLA (if any) and updates the model's freshness watermark and stale flag.
+// The state is only persisted when it changes, which lets resources that reference the model (e.g. alerts with ref_update) react to it becoming stale.
+// It returns the next time the freshness should be checked, or the zero time if no check is needed.
+func (r *ModelReconciler) checkFreshness(ctx context.Context, self *runtimev1.Resource) (time.Time, error) {
+	mdl := self.GetModel()
+	freshness := mdl.Spec.Freshness
+
+	// Clear the freshness state if the SLA has been removed
+	if freshness == nil {
+		if mdl.State.FreshnessWatermark == nil && !mdl.State.Stale {
+			return time.Time{}, nil
+		}
+		mdl.State.FreshnessWatermark = nil
+		mdl.State.Stale = false
+		return time.Time{}, r.C.UpdateState(ctx, self.Meta.Name, self)
+	}
+
+	// Get the watermark. If there's no watermark resolver, we use the time of the last successful refresh.
+	var watermark time.Time
+	if freshness.WatermarkResolver != "" {
+		t, err := r.resolveFreshnessWatermark(ctx, freshness)
+		if err != nil {
+			if errors.Is(err, ctx.Err()) {
+				return time.Time{}, err
+			}
+			return time.Time{}, fmt.Errorf("failed to resolve freshness watermark: %w", err)
+		}
+		watermark = t
+	} else if mdl.State.RefreshedOn != nil {
+		watermark = mdl.State.RefreshedOn.AsTime()
+	}
+
+	// The model is stale if the watermark is older than the max staleness (or if there is no watermark at all).
+	maxStaleness := time.Duration(freshness.MaxStalenessSeconds) * time.Second
+	var stale bool
+	var checkOn time.Time
+	if watermark.IsZero() {
+		stale = true
+	} else {
+		deadline := watermark.Add(maxStaleness)
+		stale = !time.Now().Before(deadline)
+		if !stale {
+			checkOn = deadline
+		}
+	}
+	if stale && freshness.WatermarkResolver != "" {
+		checkOn = time.Now().Add(min(maxStaleness, _modelFreshnessRecheckInterval))
+	}
+
+	var watermarkPB *timestamppb.Timestamp
+	if !watermark.IsZero() {
+		watermarkPB = timestamppb.New(watermark)
+	}
+	if mdl.State.Stale == stale && proto.Equal(mdl.State.FreshnessWatermark, watermarkPB) {
+		return checkOn, nil
+	}
+
+	mdl.State.FreshnessWatermark = watermarkPB
+	mdl.State.Stale = stale
+	err := r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return checkOn, nil
+}
+
+// resolveFreshnessWatermark resolves the model's freshness watermark using its watermark resolver.
+// It returns the zero time if the resolver doesn't return a value (e.g. if the model is empty).
+// It bypasses the query cache since the data may be updated externally without any change to the model's state.
+func (r *ModelReconciler) resolveFreshnessWatermark(ctx context.Context, freshness *runtimev1.ModelFreshness) (time.Time, error) {
+	result, _, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.C.InstanceID,
+		Resolver:           freshness.WatermarkResolver,
+		ResolverProperties: freshness.WatermarkResolverProperties.AsMap(),
+		Claims:             &runtime.SecurityClaims{SkipChecks: true},
+		NoCache:            true,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer result.Close()
+
+	row, err := result.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	switch v := row["watermark"].(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("watermark is a non-time formatted string: %w", err)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("expected a timestamp for the watermark, got type %T", v)
+	}
+}
+
+// newStaleError creates an error describing why a model is stale.
+func newStaleError(model *runtimev1.Model) error {
+	maxStaleness := time.Duration(model.Spec.Freshness.MaxStalenessSeconds) * time.Second
+	if model.State.FreshnessWatermark == nil {
+		return fmt.Errorf("%w: no data has been loaded within the max staleness of %s", errModelStale, maxStaleness)
+	}
+	watermark := model.State.FreshnessWatermark.AsTime()
+	return fmt.Errorf("%w: data was last updated at %s, which exceeds the max staleness of %s", errModelStale, watermark.Format(time.RFC3339), maxStaleness)
+}
+
+// earliestTime returns the earliest of the two times, ignoring zero times.
+func earliestTime(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
 // reconcileWarnings combines execution and test warnings for a ReconcileResult.
 func reconcileWarnings(model *runtimev1.Model, cfg *drivers.InstanceConfig) []string {
 	warnings := make([]string, 0, len(model.State.Warnings)+len(model.State.TestWarnings))
@@ -2,6 +2,7 @@ package reconcilers_test
 
 import (
 	"testing"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -394,6 +395,42 @@ tests:
 	}
 }
 
+func TestModelFreshness(t *testing.T) {
+	rt, instanceID := testruntime.NewInstance(t)
+
+	testruntime.PutFiles(t, rt, instanceID, map[string]string{
+		"rill.yaml": ``,
+		"models/fresh_model.yaml": `
+type: model
+sql: SELECT now() AS updated_at
+freshness:
+  max_staleness: 1h
+`,
+		"models/stale_model.yaml": `
+type: model
+sql: SELECT TIMESTAMP '2020-01-01 00:00:00' AS updated_at
+freshness:
+  max_staleness: 1h
+  watermark: MAX(updated_at)
+`,
+	})
+
+	testruntime.ReconcileParserAndWait(t, rt, instanceID)
+
+	// The model without a watermark uses its refresh time, so it should be fresh
+	res := testruntime.GetResource(t, rt, instanceID, runtime.ResourceKindModel, "fresh_model")
+	require.Empty(t, res.Meta.ReconcileError)
+	require.False(t, res.GetModel().State.Stale)
+	require.NotNil(t, res.GetModel().State.FreshnessWatermark)
+
+	// The model with an old watermark should be marked stale, but still have a result
+	res = testruntime.GetResource(t, rt, instanceID, runtime.ResourceKindModel, "stale_model")
+	require.Contains(t, res.Meta.ReconcileError, "model is stale")
+	require.True(t, res.GetModel().State.Stale)
+	require.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), res.GetModel().State.FreshnessWatermark.AsTime())
+	require.NotEmpty(t, res.GetModel().State.ResultTable)
+}
+
 func TestModelPartitionsSkippedClearsErrorState(t *testing.T) {
 	rt, instanceID := testruntime.NewInstance(t)
 	ctx := t.Context()
@@ -6,14 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/ai"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/parser"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/queries"
@@ -525,6 +528,10 @@ func (r *ReportReconciler) executeSingle(ctx context.Context, self *runtimev1.Re
 func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, t time.Time) (bool, []string, error) {
 	r.C.Logger.Info("Sending report", zap.String("report", self.Meta.Name.Name), zap.Time("report_time", t), observability.ZapCtx(ctx))
 
+	if len(rep.Spec.Notifiers) == 0 && rep.Spec.ExportOutputConnector == "" {
+		return false, nil, nil
+	}
+
 	admin, release, err := r.C.Runtime.Admin(ctx, r.C.InstanceID)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to get admin client: %w", err)
@@ -553,14 +560,34 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	}
 
 	meta, err := admin.GetReportMetadata(ctx, self.Meta.Name.Name, ownerID, webOpenMode, emailRecipients, anonRecipients, t)
-	if err != nil {
-		if errors.Is(err, drivers.ErrNotImplemented) {
-			r.C.Logger.Info("Skipped sending report: admin service does not support reports", zap.String("report", self.Meta.Name.Name), observability.ZapCtx(ctx))
-			return false, nil, nil
-		}
+	if err != nil && !errors.Is(err, drivers.ErrNotImplemented) {
 		return false, nil, fmt.Errorf("failed to get report metadata: %w", err)
 	}
 
+	// Write the exported file to the output connector (if any).
+	// It's exported with the owner's access, same as the export links in the report's notifications.
+	// Reports without an owner (e.g. reports defined in the project's files) are exported without user attributes, like their export links.
+	if rep.Spec.ExportOutputConnector != "" {
+		claims := &runtime.SecurityClaims{}
+		if meta != nil && ownerID != "" {
+			claims.UserID = ownerID
+			claims.UserAttributes = meta.OwnerAttrs
+		}
+		uri, err := r.writeReportOutput(ctx, self, rep, t, claims)
+		if err != nil {
+			return false, nil, fmt.Errorf("failed to write report output: %w", err)
+		}
+		rep.State.CurrentExecution.OutputUri = uri
+	}
+
+	if len(rep.Spec.Notifiers) == 0 {
+		return false, nil, nil
+	}
+	if meta == nil {
+		r.C.Logger.Info("Skipped sending report: admin service does not support reports", zap.String("report", self.Meta.Name.Name), observability.ZapCtx(ctx))
+		return false, nil, nil
+	}
+
 	// recipient -> notification data
 	notificationsContent := make(map[string]*notificationData)
 
@@ -637,6 +664,100 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	return false, allWarnings, nil
 }
 
+// reportOutputProperties are the properties for writing a report's exported file to an object store.
+type reportOutputProperties struct {
+	// Path is a template for the URI to write the file to. It can reference the report time as {{ .report_time }}.
+	Path string `mapstructure:"path"`
+}
+
+// writeReportOutput exports the report's data and uploads it to the report's output connector.
+// It exports the data with the given claims and returns the URI of the written object.
+func (r *ReportReconciler) writeReportOutput(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, t time.Time, claims *runtime.SecurityClaims) (string, error) {
+	props := &reportOutputProperties{}
+	err := mapstructureutil.WeakDecode(rep.Spec.ExportOutputProperties.AsMap(), props)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if props.Path == "" {
+		return "", errors.New(`missing output property "path"`)
+	}
+
+	// Resolve the path template
+	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+	if err != nil {
+		return "", err
+	}
+	path, err := parser.ResolveTemplate(props.Path, parser.TemplateData{
+		Environment: inst.Environment,
+		User:        map[string]any{},
+		Variables:   inst.ResolveVariables(false),
+		ExtraProps: map[string]any{
+			"report_time": t,
+		},
+		Self: parser.TemplateResource{
+			Meta:  self.Meta,
+			Spec:  rep.Spec,
+			State: rep.State,
+		},
+		Resolve: func(ref parser.ResourceName) (string, error) {
+			return ref.Name, nil
+		},
+	}, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve output path: %w", err)
+	}
+
+	// Get the object store
+	conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, rep.Spec.ExportOutputConnector)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	store, ok := conn.AsObjectStore()
+	if !ok {
+		return "", fmt.Errorf("connector %q is not an object store", rep.Spec.ExportOutputConnector)
+	}
+
+	// Export to a temporary file
+	f, err := os.CreateTemp("", "report-export-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = r.C.Runtime.ResolveExport(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.C.InstanceID,
+		Resolver:           rep.Spec.Resolver,
+		ResolverProperties: rep.Spec.ResolverProperties.AsMap(),
+		Args: map[string]any{
+			"priority":       reportQueryPriority,
+			"execution_time": t,
+			"limit":          int(rep.Spec.ExportLimit),
+		},
+		Claims: claims,
+	}, f, &runtime.ResolverExportOptions{
+		Format:        rep.Spec.ExportFormat,
+		IncludeHeader: rep.Spec.ExportIncludeHeader,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to export report data: %w", err)
+	}
+
+	// Upload the file
+	_, err = f.Seek(0, 0)
+	if err != nil {
+		return "", err
+	}
+	err = store.Upload(ctx, path, f)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload to %q: %w", path, err)
+	}
+
+	r.C.Logger.Info("Wrote report output", zap.String("report", self.Meta.Name.Name), zap.String("uri", path), observability.ZapCtx(ctx))
+	return path, nil
+}
+
 func (r *ReportReconciler) sendEmailNotification(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, t time.Time, recipients []string, notificationsContent map[string]*notificationData) (bool, error) {
 	sent := false
 	for _, recipient := range recipients {
@@ -877,6 +998,10 @@ func formatExportFormat(f runtimev1.ExportFormat) string {
 		return "Parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PDF:
 		return "PDF"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return "JSON Lines"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return "Arrow"
 	default:
 		return f.String()
 	}
@@ -1,6 +1,9 @@
 package reconcilers_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -133,3 +136,64 @@ annotations:
 	require.NoError(t, err)
 	require.False(t, sec.CanAccess())
 }
+
+func TestReportExportOutput(t *testing.T) {
+	// Serve the output connector from a temporary directory
+	tempDir := t.TempDir()
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": ``,
+			"out.yaml": fmt.Sprintf(`
+type: connector
+driver: mock_object_store
+path: %s
+`, tempDir),
+		},
+	})
+
+	// The metrics view's row filter should apply to the report's output since it's written with the owner's access.
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"m1.sql": `SELECT 'a' AS foo, 1 AS x UNION ALL SELECT 'b' AS foo, 2 AS x UNION ALL SELECT 'c' AS foo, 3 AS x`,
+		"mv1.yaml": `
+version: 1
+type: metrics_view
+model: m1
+dimensions:
+- column: foo
+measures:
+- name: total
+  expression: sum(x)
+security:
+  access: true
+  row_filter: x > 1
+`,
+		"r1.yaml": `
+type: report
+refresh:
+  cron: 0 0 1 1 *
+data:
+  metrics_sql: SELECT foo, total FROM mv1 ORDER BY foo
+export:
+  format: csv
+  include_header: true
+  output_connector: out
+  output_properties:
+    path: s3://bucket/reports/r1.csv
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 5, 0, 0)
+
+	n := &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r1"}
+	testruntime.RefreshAndWait(t, rt, id, n)
+
+	r1 := testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r1")
+	history := r1.GetReport().State.ExecutionHistory
+	require.Len(t, history, 1)
+	require.Empty(t, history[0].ErrorMessage)
+	require.Equal(t, "s3://bucket/reports/r1.csv", history[0].OutputUri)
+
+	data, err := os.ReadFile(filepath.Join(tempDir, "reports", "r1.csv"))
+	require.NoError(t, err)
+	require.Equal(t, "foo,total\nb,2\nc,3\n", string(data))
+}
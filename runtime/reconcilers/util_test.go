@@ -1,9 +1,14 @@
 package reconcilers
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestExploreNameFromAnnotations(t *testing.T) {
@@ -75,3 +80,94 @@ func TestExploreNameFromAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestAlertGroupStatuses(t *testing.T) {
+	a := &runtimev1.Alert{
+		Spec: &runtimev1.AlertSpec{
+			DisplayName:     "Low revenue",
+			GroupBy:         []string{"country"},
+			NotifyOnFail:    true,
+			NotifyOnRecover: true,
+		},
+		State: &runtimev1.AlertState{},
+	}
+	execute := func(executionTime time.Time, muted bool, countries ...string) []string {
+		res := &runtimev1.AssertionResult{Status: runtimev1.AssertionStatus_ASSERTION_STATUS_PASS}
+		for _, c := range countries {
+			res.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL
+			res.FailRows = append(res.FailRows, must(structpb.NewStruct(map[string]any{"country": c, "revenue": 10})))
+		}
+		current := &runtimev1.AlertExecution{Result: res}
+		if muted {
+			current.MutedUntil = timestamppb.New(executionTime.Add(time.Hour))
+		}
+		msgs, err := alertGroupStatuses(a, current, executionTime, muted)
+		require.NoError(t, err)
+		a.State.ExecutionHistory = append([]*runtimev1.AlertExecution{current}, a.State.ExecutionHistory...)
+		var names []string
+		for _, msg := range msgs {
+			name := fmt.Sprintf("%s: %s", msg.DisplayName, msg.Status)
+			if msg.MutedExecutions > 0 {
+				name += fmt.Sprintf(" (muted %d)", msg.MutedExecutions)
+			}
+			names = append(names, name)
+		}
+		return names
+	}
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// New failing groups are notified
+	require.Equal(t, []string{
+		"Low revenue (country=Denmark): ASSERTION_STATUS_FAIL",
+		"Low revenue (country=Norway): ASSERTION_STATUS_FAIL",
+	}, execute(t0, false, "Denmark", "Norway"))
+	require.Len(t, a.State.Groups, 2)
+
+	// Groups that are still failing are not renotified, but new ones are
+	require.Equal(t, []string{
+		"Low revenue (country=Sweden): ASSERTION_STATUS_FAIL",
+		"Low revenue (country=Norway): ASSERTION_STATUS_PASS",
+	}, execute(t0.Add(time.Hour), false, "Denmark", "Sweden"))
+	require.Len(t, a.State.Groups, 2)
+	require.Equal(t, t0, a.State.Groups[`["Denmark"]`].FailingSince.AsTime())
+
+	// Renotify after the suppression period
+	a.Spec.Renotify = true
+	a.Spec.RenotifyAfterSeconds = 2 * 60 * 60
+	require.Empty(t, execute(t0.Add(90*time.Minute), false, "Denmark", "Sweden"))
+	require.Equal(t, []string{
+		"Low revenue (country=Denmark): ASSERTION_STATUS_FAIL",
+	}, execute(t0.Add(2*time.Hour), false, "Denmark", "Sweden"))
+
+	// Changes while muted are notified after the mute ends, along with a summary of the groups that kept failing
+	a.Spec.Renotify = false
+	require.Empty(t, execute(t0.Add(3*time.Hour), true, "Denmark", "Finland"))
+	require.Empty(t, execute(t0.Add(4*time.Hour), true, "Denmark", "Finland"))
+	require.Len(t, a.State.Groups, 3)
+	require.Equal(t, []string{
+		"Low revenue (country=Denmark): ASSERTION_STATUS_FAIL (muted 2)",
+		"Low revenue (country=Finland): ASSERTION_STATUS_FAIL (muted 2)",
+		"Low revenue (country=Sweden): ASSERTION_STATUS_PASS",
+	}, execute(t0.Add(5*time.Hour), false, "Denmark", "Finland"))
+	require.Empty(t, execute(t0.Add(5*time.Hour+30*time.Minute), false, "Denmark", "Finland"))
+
+	// All groups recover
+	require.Equal(t, []string{
+		"Low revenue (country=Denmark): ASSERTION_STATUS_PASS",
+		"Low revenue (country=Finland): ASSERTION_STATUS_PASS",
+	}, execute(t0.Add(6*time.Hour), false))
+	require.Empty(t, a.State.Groups)
+
+	// Groups are not marked as notified when failures are not notified
+	a.Spec.NotifyOnFail = false
+	require.Empty(t, execute(t0.Add(7*time.Hour), false, "Denmark"))
+	require.Nil(t, a.State.Groups[`["Denmark"]`].LastNotifiedOn)
+	require.Empty(t, execute(t0.Add(8*time.Hour), false))
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
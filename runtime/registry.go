@@ -99,7 +99,9 @@ func (r *Runtime) EditInstance(ctx context.Context, inst *drivers.Instance, rest
 }
 
 // DeleteInstance deletes an instance and stops its controller.
-func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string) error {
+// If keepStorage is true, the instance's data in remote storage is kept and only its local data is removed.
+// This is used when moving an instance to another runtime that shares the same remote storage.
+func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, keepStorage bool) error {
 	inst, err := r.registryCache.get(instanceID)
 	if err != nil {
 		if errors.Is(err, drivers.ErrNotFound) {
@@ -119,7 +121,12 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string) error {
 	// Wait for the controller to stop and the connection cache to be evicted
 	<-completed
 
-	if err := r.storage.RemovePrefix(ctx, inst.ID); err != nil {
+	if keepStorage {
+		err = r.storage.RemoveLocalPrefix(inst.ID)
+	} else {
+		err = r.storage.RemovePrefix(ctx, inst.ID)
+	}
+	if err != nil {
 		r.Logger.Error("could not drop instance data directory", zap.Error(err), zap.String("instance_id", instanceID), observability.ZapCtx(ctx))
 	}
 
@@ -179,6 +186,15 @@ type instanceWithController struct {
 	ready     bool
 	readyCh   chan struct{}
 	reopen    bool
+
+	// stoppedFns are called after the controller has stopped and before it is restarted. See runWithControllerStopped.
+	stoppedFns []*stoppedFn
+}
+
+// stoppedFn is a function scheduled to run while an instance's controller is stopped.
+type stoppedFn struct {
+	fn   func(ctx context.Context) error
+	done chan error
 }
 
 func newRegistryCache(rt *Runtime, registry drivers.RegistryStore, logger *zap.Logger, ac *activity.Client) *registryCache {
@@ -436,6 +452,20 @@ func (r *registryCache) restartController(iwc *instanceWithController) {
 	go func() {
 		// Loop in case reopen gets set
 		for {
+			// Call functions scheduled with runWithControllerStopped.
+			// We evict connections before and after, so they run without open handles and changes are picked up by the next handles.
+			r.mu.Lock()
+			fns := iwc.stoppedFns
+			iwc.stoppedFns = nil
+			r.mu.Unlock()
+			if len(fns) > 0 {
+				r.rt.evictInstanceConnections(iwc.instanceID)
+				for _, f := range fns {
+					f.done <- f.fn(r.baseCtx)
+				}
+				r.rt.evictInstanceConnections(iwc.instanceID)
+			}
+
 			// Before starting the controller, pull the repo.
 			// Even though the project parser will also do this, it needs to happen before the controller starts.
 			// This is necessary for models that reference files in the repo, since they may be triggered before the project parser is triggered.
@@ -501,6 +531,10 @@ func (r *registryCache) restartController(iwc *instanceWithController) {
 
 			// If not reopening, exit
 			if !iwc.reopen {
+				for _, f := range iwc.stoppedFns {
+					f.done <- fmt.Errorf("controller for instance %q was stopped", iwc.instanceID)
+				}
+				iwc.stoppedFns = nil
 				iwc.controller = nil
 				iwc.controllerErr = err
 				iwc.running = false
@@ -524,6 +558,29 @@ func (r *registryCache) restartController(iwc *instanceWithController) {
 	}()
 }
 
+// runWithControllerStopped stops the instance's controller, evicts its connections, calls fn, and then restarts the controller.
+// It is useful for operations that must not run concurrently with reconciles, like replacing the instance's data.
+// It blocks until fn returns or ctx is cancelled. If ctx is cancelled, fn still runs to completion in the background.
+func (r *registryCache) runWithControllerStopped(ctx context.Context, instanceID string, fn func(ctx context.Context) error) error {
+	r.mu.Lock()
+	iwc, ok := r.instances[instanceID]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("instance %q not found", instanceID)
+	}
+	f := &stoppedFn{fn: fn, done: make(chan error, 1)}
+	iwc.stoppedFns = append(iwc.stoppedFns, f)
+	r.restartController(iwc)
+	r.mu.Unlock()
+
+	select {
+	case err := <-f.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (r *registryCache) ensureRepoReady(ctx context.Context, instanceID string) error {
 	repo, release, err := r.rt.Repo(ctx, instanceID)
 	if err != nil {
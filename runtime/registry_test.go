@@ -410,7 +410,7 @@ func TestRuntime_DeleteInstance(t *testing.T) {
 			require.NoError(t, olap.Exec(ctx, &drivers.Statement{Query: "INSERT INTO data VALUES (1, 'Mark'), (2, 'Hannes')"}))
 
 			// delete instance
-			err = rt.DeleteInstance(ctx, inst.ID)
+			err = rt.DeleteInstance(ctx, inst.ID, false)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Runtime.DeleteInstance() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -487,7 +487,7 @@ func TestRuntime_DeleteInstance_DropCorrupted(t *testing.T) {
 	require.Nil(t, conn)
 
 	// Delete instance and check it still drops the .db file for DuckDB
-	err = rt.DeleteInstance(ctx, inst.ID)
+	err = rt.DeleteInstance(ctx, inst.ID, false)
 	require.NoError(t, err)
 	require.NoFileExists(t, dbpath)
 }
@@ -80,6 +80,9 @@ type ResolverExportOptions struct {
 	Format runtimev1.ExportFormat
 	// PreWriteHook is a function that is called after the export has been prepared, but before the first bytes are output to the io.Writer.
 	PreWriteHook func(filename string) error
+	// IncludeHeader indicates whether to include a header with metadata in the export.
+	// Not supported for all resolvers and file formats.
+	IncludeHeader bool
 }
 
 // ResolverOptions are the options passed to a resolver initializer.
@@ -113,6 +116,8 @@ type ResolveOptions struct {
 	ResolverProperties map[string]any
 	Args               map[string]any
 	Claims             *SecurityClaims
+	// NoCache bypasses the query cache. Use it for results that may change without a change to the resolver's refs.
+	NoCache bool
 }
 
 type ResolveInfo struct {
@@ -189,7 +194,7 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (res Resolv
 	if err != nil {
 		return nil, nil, err
 	}
-	if !ok {
+	if !ok || opts.NoCache {
 		cacheHit = false
 		// If not cacheable, just resolve and return
 		res, err := resolver.ResolveInteractive(ctx)
@@ -219,6 +224,10 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (res Resolv
 	if _, err := hash.Write(claimsJSON); err != nil {
 		return nil, nil, err
 	}
+	// Include the cache generations so explicit invalidations (see InvalidateQueryCache) produce new keys.
+	if err := binary.Write(hash, binary.BigEndian, r.queryCache.generation(opts.InstanceID, "")); err != nil {
+		return nil, nil, err
+	}
 	for _, ref := range resolver.Refs() {
 		res, err := ctrl.Get(ctx, ref, false)
 		if err != nil {
@@ -238,6 +247,11 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (res Resolv
 		if err := binary.Write(hash, binary.BigEndian, res.Meta.StateUpdatedOn.Nanos); err != nil {
 			return nil, nil, err
 		}
+		if res.Meta.Name.Kind == ResourceKindMetricsView {
+			if err := binary.Write(hash, binary.BigEndian, r.queryCache.generation(opts.InstanceID, res.Meta.Name.Name)); err != nil {
+				return nil, nil, err
+			}
+		}
 	}
 	sum := hex.EncodeToString(hash.Sum(nil))
 	key := fmt.Sprintf("inst:%s:resolver:%s:hash:%s", opts.InstanceID, opts.Resolver, sum)
@@ -280,6 +294,61 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (res Resolv
 	return val.(*cachedResolverResult).copy(), info, nil
 }
 
+// ResolveExport resolves data for export using the given options and writes it to w.
+// Unlike Resolve, the result is never cached.
+func (r *Runtime) ResolveExport(ctx context.Context, opts *ResolveOptions, w io.Writer, exportOpts *ResolverExportOptions) error {
+	// Since claims don't really make sense for some resolver use cases, it's easy to forget to set them.
+	// Adding an early panic to catch this.
+	if opts.Claims == nil {
+		panic("received nil claims")
+	}
+
+	ctx, span := tracer.Start(ctx, "runtime.ResolveExport", trace.WithAttributes(attribute.String("resolver", opts.Resolver)))
+	defer span.End()
+
+	queryAttrs := append(r.GetInstanceAttributes(ctx, opts.InstanceID), attribute.String("source", string(RequestSourceFromContext(ctx))), attribute.String("resolver", opts.Resolver))
+	r.activity.RecordMetric(ctx, "query", 1, queryAttrs...)
+
+	initializer, ok := ResolverInitializers[opts.Resolver]
+	if !ok {
+		return fmt.Errorf("no resolver found for name %q", opts.Resolver)
+	}
+	resolver, err := initializer(ctx, &ResolverOptions{
+		Runtime:    r,
+		InstanceID: opts.InstanceID,
+		Properties: opts.ResolverProperties,
+		Args:       opts.Args,
+		Claims:     opts.Claims,
+		ForExport:  true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resolver.Close()
+
+	err = resolver.Validate(ctx)
+	if err != nil {
+		var invalidErr *ResolverUnusedFieldsError
+		if !errors.As(err, &invalidErr) {
+			return err
+		}
+		cfg, cfgErr := r.InstanceConfig(ctx, opts.InstanceID)
+		if cfgErr != nil {
+			return fmt.Errorf("failed to get instance config: %w", cfgErr)
+		}
+		if cfg.StrictResolverProps {
+			return err
+		}
+	}
+
+	err = resolver.ResolveExport(ctx, w, exportOpts)
+	if err != nil {
+		span.SetAttributes(attribute.String("err", err.Error()))
+		return err
+	}
+	return nil
+}
+
 // NewDriverResolverResult creates a ResolverResult from a drivers.Result.
 func NewDriverResolverResult(result *drivers.Result, meta map[string]any) ResolverResult {
 	return &driverResolverResult{
@@ -3,7 +3,6 @@ package resolvers
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -184,7 +183,27 @@ func (r *legacyMetricsResolver) ResolveInteractive(ctx context.Context) (runtime
 }
 
 func (r *legacyMetricsResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
-	return errors.New("not implemented")
+	// Apply the limit to the query
+	if r.args.Limit > 0 {
+		limit := int64(r.args.Limit)
+		switch q := r.query.(type) {
+		case *queries.MetricsViewAggregation:
+			if q.Limit == nil || *q.Limit > limit {
+				q.Limit = &limit
+			}
+		case *queries.MetricsViewComparison:
+			if q.Limit == 0 || q.Limit > limit {
+				q.Limit = limit
+			}
+		}
+	}
+
+	return r.query.Export(ctx, r.runtime, r.instanceID, w, &runtime.ExportOptions{
+		Format:        opts.Format,
+		Priority:      r.args.Priority,
+		PreWriteHook:  opts.PreWriteHook,
+		IncludeHeader: opts.IncludeHeader,
+	})
 }
 
 func (r *legacyMetricsResolver) InferRequiredSecurityRules() ([]*runtimev1.SecurityRule, error) {
@@ -0,0 +1,464 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	defaultAnomalyPeriods   = 4
+	maxAnomalyPeriods       = 52
+	defaultAnomalyThreshold = 3.0
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("metrics_anomaly", newMetricsAnomaly)
+}
+
+// metricsAnomalyResolver detects anomalies in a metrics view measure.
+// It compares the measure's value in the latest complete time grain against a seasonal baseline made up of the same interval in previous seasons (e.g. the same day in the last four weeks).
+// The baseline values are computed using metrics view queries with a comparison time range.
+//
+// It returns a single row if the latest value deviates from the baseline mean by more than the threshold number of standard deviations (a z-score),
+// and no rows otherwise. This makes it suitable for use in alerts, which fail when their resolver returns rows.
+// Two cases are reported even though they don't have a z-score:
+//   - missing_data: there is no data for the checked interval, but there is a baseline (e.g. the data stopped arriving).
+//   - flat_baseline: the baseline values are all equal and the value differs from them.
+//
+// The output fields are:
+//   - metrics_view: the metrics view name
+//   - measure: the measure name
+//   - time_start: the start of the checked interval
+//   - time_end: the end of the checked interval
+//   - value: the observed value of the measure in the checked interval (null if there is no data)
+//   - expected: the mean of the baseline values
+//   - expected_min: the lower bound of the expected range
+//   - expected_max: the upper bound of the expected range
+//   - z_score: the number of standard deviations the observed value is from the baseline mean (null if it can't be computed)
+//   - baseline_periods: the number of baseline values that were available
+//   - reason: why the value is anomalous; one of "z_score", "missing_data" or "flat_baseline"
+type metricsAnomalyResolver struct {
+	runtime    *runtime.Runtime
+	instanceID string
+	mv         *runtimev1.MetricsViewSpec
+	props      *metricsAnomalyProps
+	args       *metricsResolverArgs
+	claims     *runtime.SecurityClaims
+	tz         *time.Location
+}
+
+// metricsAnomalyProps declares the properties for the "metrics_anomaly" resolver.
+type metricsAnomalyProps struct {
+	// MetricsView is the metrics view to query.
+	MetricsView string `mapstructure:"metrics_view"`
+	// Measure is the measure to check for anomalies.
+	Measure string `mapstructure:"measure"`
+	// TimeDimension optionally overrides the metrics view's default time dimension.
+	TimeDimension string `mapstructure:"time_dimension"`
+	// TimeGrain is the size of the checked interval. Defaults to "day".
+	TimeGrain metricsview.TimeGrain `mapstructure:"time_grain"`
+	// Seasonality is the offset between the checked interval and each baseline interval. Defaults to "week".
+	Seasonality metricsview.TimeGrain `mapstructure:"seasonality"`
+	// Periods is the number of seasons to use for the baseline. Defaults to 4.
+	Periods int `mapstructure:"periods"`
+	// Threshold is the z-score above which the value is considered anomalous. Defaults to 3.
+	Threshold float64 `mapstructure:"threshold"`
+	// Where is an optional filter to apply to the measure.
+	Where *metricsview.Expression `mapstructure:"where"`
+	// TimeZone is the time zone to use when truncating times to the time grain.
+	TimeZone     string         `mapstructure:"time_zone"`
+	UnusedFields map[string]any `mapstructure:",remain"`
+}
+
+func newMetricsAnomaly(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	props := &metricsAnomalyProps{}
+	if err := mapstructureutil.WeakDecode(opts.Properties, props); err != nil {
+		return nil, err
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		span.SetAttributes(attribute.String("metrics_view", props.MetricsView))
+	}
+
+	if props.MetricsView == "" {
+		return nil, errors.New(`missing required property "metrics_view"`)
+	}
+	if props.Measure == "" {
+		return nil, errors.New(`missing required property "measure"`)
+	}
+	if props.TimeGrain == metricsview.TimeGrainUnspecified {
+		props.TimeGrain = metricsview.TimeGrainDay
+	}
+	if !props.TimeGrain.Valid() {
+		return nil, fmt.Errorf("invalid time grain %q", props.TimeGrain)
+	}
+	if props.Seasonality == metricsview.TimeGrainUnspecified {
+		props.Seasonality = metricsview.TimeGrainWeek
+	}
+	if !props.Seasonality.Valid() {
+		return nil, fmt.Errorf("invalid seasonality %q", props.Seasonality)
+	}
+	if props.Periods == 0 {
+		props.Periods = defaultAnomalyPeriods
+	}
+	if props.Periods < 2 || props.Periods > maxAnomalyPeriods {
+		return nil, fmt.Errorf("periods must be between 2 and %d", maxAnomalyPeriods)
+	}
+	if props.Threshold == 0 {
+		props.Threshold = defaultAnomalyThreshold
+	}
+	if props.Threshold < 0 {
+		return nil, errors.New("threshold must be a positive number")
+	}
+
+	tz := time.UTC
+	if props.TimeZone != "" {
+		var err error
+		tz, err = time.LoadLocation(props.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", props.TimeZone, err)
+		}
+	}
+
+	args := &metricsResolverArgs{}
+	if err := mapstructureutil.WeakDecode(opts.Args, args); err != nil {
+		return nil, err
+	}
+
+	ctrl, err := opts.Runtime.Controller(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: props.MetricsView}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	mv := res.GetMetricsView().State.ValidSpec
+	if mv == nil {
+		return nil, fmt.Errorf("metrics view %q is invalid", res.Meta.Name.Name)
+	}
+
+	found := false
+	for _, m := range mv.Measures {
+		if m.Name == props.Measure {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("measure %q not found in metrics view %q", props.Measure, props.MetricsView)
+	}
+	if props.TimeDimension == "" && mv.TimeDimension == "" {
+		return nil, fmt.Errorf("metrics view %q does not have a time dimension", props.MetricsView)
+	}
+
+	return &metricsAnomalyResolver{
+		runtime:    opts.Runtime,
+		instanceID: opts.InstanceID,
+		mv:         mv,
+		props:      props,
+		args:       args,
+		claims:     opts.Claims,
+		tz:         tz,
+	}, nil
+}
+
+func (r *metricsAnomalyResolver) Close() error {
+	return nil
+}
+
+func (r *metricsAnomalyResolver) CacheKey(ctx context.Context) ([]byte, bool, error) {
+	// The underlying metrics queries are cached individually.
+	return nil, false, nil
+}
+
+func (r *metricsAnomalyResolver) Refs() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.props.MetricsView}}
+}
+
+func (r *metricsAnomalyResolver) Validate(ctx context.Context) error {
+	if len(r.props.UnusedFields) > 0 {
+		return &runtime.ResolverUnusedFieldsError{
+			Name:   "metrics_anomaly",
+			Fields: maps.Keys(r.props.UnusedFields),
+		}
+	}
+	return nil
+}
+
+func (r *metricsAnomalyResolver) ResolveInteractive(ctx context.Context) (runtime.ResolverResult, error) {
+	// Anchor on the execution time if provided (as it is for alerts), otherwise on the metrics view's watermark.
+	var anchor time.Time
+	if r.args.ExecutionTime != nil {
+		anchor = *r.args.ExecutionTime
+	} else {
+		ts, err := resolveTimestampResult(ctx, r.runtime, r.instanceID, r.props.MetricsView, r.props.TimeDimension, r.claims, r.args.Priority)
+		if err != nil {
+			return nil, err
+		}
+		anchor = ts.Watermark
+	}
+	if anchor.IsZero() {
+		return runtime.NewMapsResolverResult(nil, &runtimev1.StructType{}), nil
+	}
+
+	// Check the latest complete interval before the anchor.
+	grain := r.props.TimeGrain.ToTimeutil()
+	end := timeutil.TruncateTime(anchor, grain, r.tz, int(r.mv.FirstDayOfWeek), int(r.mv.FirstMonthOfYear))
+	start := timeutil.OffsetTime(end, grain, -1, r.tz)
+
+	// Compute the observed value and the baseline values, one season at a time.
+	// Every query returns the observed value, so we only use it from the first one.
+	var value *float64
+	baseline := make([]float64, 0, r.props.Periods)
+	for i := 1; i <= r.props.Periods; i++ {
+		cur, prev, err := r.queryComparison(ctx, start, end, i)
+		if err != nil {
+			return nil, err
+		}
+		if i == 1 {
+			value = cur
+		}
+		if prev != nil {
+			baseline = append(baseline, *prev)
+		}
+	}
+
+	a, ok := detectAnomaly(value, baseline, r.props.Threshold)
+	if !ok {
+		return runtime.NewMapsResolverResult(nil, &runtimev1.StructType{}), nil
+	}
+
+	row := map[string]any{
+		"metrics_view":     r.props.MetricsView,
+		"measure":          r.props.Measure,
+		"time_start":       start.Format(time.RFC3339),
+		"time_end":         end.Format(time.RFC3339),
+		"value":            nil,
+		"expected":         a.mean,
+		"expected_min":     a.min,
+		"expected_max":     a.max,
+		"z_score":          nil,
+		"baseline_periods": len(baseline),
+		"reason":           a.reason,
+	}
+	if value != nil {
+		row["value"] = *value
+	}
+	if a.zScore != nil {
+		row["z_score"] = *a.zScore
+	}
+	return runtime.NewMapsResolverResult([]map[string]any{row}, metricsAnomalySchema), nil
+}
+
+// metricsAnomalySchema is the schema of the row returned by the metrics_anomaly resolver.
+var metricsAnomalySchema = &runtimev1.StructType{
+	Fields: []*runtimev1.StructType_Field{
+		{Name: "metrics_view", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "measure", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "time_start", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "time_end", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "value", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: true}},
+		{Name: "expected", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+		{Name: "expected_min", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+		{Name: "expected_max", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+		{Name: "z_score", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: true}},
+		{Name: "baseline_periods", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+		{Name: "reason", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+	},
+}
+
+func (r *metricsAnomalyResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return errors.New("not implemented")
+}
+
+func (r *metricsAnomalyResolver) InferRequiredSecurityRules() ([]*runtimev1.SecurityRule, error) {
+	var rules []*runtimev1.SecurityRule
+
+	if r.props.Where != nil {
+		rules = append(rules, &runtimev1.SecurityRule{
+			Rule: &runtimev1.SecurityRule_RowFilter{
+				RowFilter: &runtimev1.SecurityRuleRowFilter{
+					ConditionResources: []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.props.MetricsView}},
+					Expression:         metricsview.ExpressionToProto(r.props.Where),
+				},
+			},
+		})
+	}
+
+	fields := metricsview.AnalyzeQueryFields(r.query(time.Time{}, time.Time{}, 0))
+	if len(fields) > 0 {
+		rules = append(rules, &runtimev1.SecurityRule{
+			Rule: &runtimev1.SecurityRule_FieldAccess{
+				FieldAccess: &runtimev1.SecurityRuleFieldAccess{
+					ConditionResources: []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.props.MetricsView}},
+					Fields:             fields,
+					Allow:              true,
+					Exclusive:          true,
+				},
+			},
+		})
+	}
+
+	return rules, nil
+}
+
+// query builds a metrics query for the measure in the interval [start, end) compared to the same interval n seasons earlier.
+func (r *metricsAnomalyResolver) query(start, end time.Time, n int) *metricsview.Query {
+	season := r.props.Seasonality.ToTimeutil()
+	return &metricsview.Query{
+		MetricsView: r.props.MetricsView,
+		Measures: []metricsview.Measure{
+			{Name: r.props.Measure},
+			{
+				Name:    r.baselineMeasureName(),
+				Compute: &metricsview.MeasureCompute{ComparisonValue: &metricsview.MeasureComputeComparisonValue{Measure: r.props.Measure}},
+			},
+		},
+		TimeRange: &metricsview.TimeRange{
+			Start:         start,
+			End:           end,
+			TimeDimension: r.props.TimeDimension,
+		},
+		ComparisonTimeRange: &metricsview.TimeRange{
+			Start:         timeutil.OffsetTime(start, season, -n, r.tz),
+			End:           timeutil.OffsetTime(end, season, -n, r.tz),
+			TimeDimension: r.props.TimeDimension,
+		},
+		Where:    r.props.Where,
+		TimeZone: r.props.TimeZone,
+	}
+}
+
+// queryComparison returns the measure's value in [start, end) and its value in the same interval n seasons earlier.
+// Either value is nil if there is no data for it.
+func (r *metricsAnomalyResolver) queryComparison(ctx context.Context, start, end time.Time, n int) (*float64, *float64, error) {
+	props, err := r.query(start, end, n).AsMap()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, _, err := r.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.instanceID,
+		Resolver:           "metrics",
+		ResolverProperties: props,
+		Args: map[string]any{
+			"priority": r.args.Priority,
+		},
+		Claims: r.claims,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Close()
+
+	// Round-trip through JSON to normalize numeric types across drivers.
+	data, err := res.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows []map[string]any
+	err = json.Unmarshal(data, &rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil, nil
+	}
+
+	cur, err := anomalyValue(rows[0][r.props.Measure])
+	if err != nil {
+		return nil, nil, fmt.Errorf("measure %q: %w", r.props.Measure, err)
+	}
+	prev, err := anomalyValue(rows[0][r.baselineMeasureName()])
+	if err != nil {
+		return nil, nil, fmt.Errorf("measure %q: %w", r.props.Measure, err)
+	}
+	return cur, prev, nil
+}
+
+func (r *metricsAnomalyResolver) baselineMeasureName() string {
+	return r.props.Measure + "__baseline"
+}
+
+// anomaly describes how an observed value relates to its baseline.
+type anomaly struct {
+	mean   float64
+	min    float64
+	max    float64
+	zScore *float64
+	reason string
+}
+
+// detectAnomaly computes the expected range of a value from its baseline values using a z-score threshold.
+// It returns true if the value falls outside the expected range.
+// At least two baseline values are required; with fewer, it never reports an anomaly.
+//
+// A nil value means there was no data for the checked interval. It is always anomalous when there is a baseline.
+// If the baseline is flat (its standard deviation is zero), the z-score is undefined and any deviation from it is anomalous.
+func detectAnomaly(value *float64, baseline []float64, threshold float64) (anomaly, bool) {
+	n := len(baseline)
+	if n < 2 {
+		return anomaly{}, false
+	}
+
+	var sum float64
+	for _, v := range baseline {
+		sum += v
+	}
+	mean := sum / float64(n)
+
+	var sq float64
+	for _, v := range baseline {
+		sq += (v - mean) * (v - mean)
+	}
+	stddev := math.Sqrt(sq / float64(n-1))
+
+	a := anomaly{
+		mean: mean,
+		min:  mean - threshold*stddev,
+		max:  mean + threshold*stddev,
+	}
+	if value == nil {
+		a.reason = "missing_data"
+		return a, true
+	}
+	if stddev == 0 {
+		a.reason = "flat_baseline"
+		return a, *value != mean
+	}
+
+	z := (*value - mean) / stddev
+	a.zScore = &z
+	a.reason = "z_score"
+	return a, math.Abs(z) > threshold
+}
+
+// anomalyValue converts a JSON-decoded measure value to a float.
+func anomalyValue(v any) (*float64, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return &v, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
@@ -0,0 +1,52 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectAnomaly(t *testing.T) {
+	baseline := []float64{100, 110, 90, 100}
+	val := func(v float64) *float64 { return &v }
+
+	// Within the expected range
+	a, ok := detectAnomaly(val(105), baseline, 3)
+	require.False(t, ok)
+	require.Equal(t, 100.0, a.mean)
+	require.InDelta(t, 75.505, a.min, 0.001)
+	require.InDelta(t, 124.495, a.max, 0.001)
+
+	// Above the expected range
+	a, ok = detectAnomaly(val(200), baseline, 3)
+	require.True(t, ok)
+	require.Equal(t, "z_score", a.reason)
+	require.InDelta(t, 12.247, *a.zScore, 0.001)
+
+	// Below the expected range
+	a, ok = detectAnomaly(val(10), baseline, 3)
+	require.True(t, ok)
+	require.Less(t, *a.zScore, -3.0)
+
+	// Flat baseline
+	_, ok = detectAnomaly(val(100), []float64{100, 100, 100}, 3)
+	require.False(t, ok)
+	a, ok = detectAnomaly(val(101), []float64{100, 100, 100}, 3)
+	require.True(t, ok)
+	require.Equal(t, "flat_baseline", a.reason)
+	require.Nil(t, a.zScore)
+	require.Equal(t, 100.0, a.min)
+	require.Equal(t, 100.0, a.max)
+
+	// Missing data
+	a, ok = detectAnomaly(nil, baseline, 3)
+	require.True(t, ok)
+	require.Equal(t, "missing_data", a.reason)
+	require.Nil(t, a.zScore)
+
+	// Not enough history
+	_, ok = detectAnomaly(val(1000), []float64{100}, 3)
+	require.False(t, ok)
+	_, ok = detectAnomaly(nil, []float64{100}, 3)
+	require.False(t, ok)
+}
@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/mitchellh/mapstructure"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -30,6 +31,8 @@ type metricsSQLProps struct {
 	AdditionalWhereByMetricsView map[string]*metricsview.Expression `mapstructure:"additional_where_by_metrics_view"`
 	// AdditionalTimeRange is a time range filter to apply to the metrics SQL.
 	AdditionalTimeRange *metricsview.TimeRange `mapstructure:"additional_time_range"`
+	// Pagination optionally restricts the result to a single page of sorted rows.
+	Pagination *paginationProps `mapstructure:"pagination"`
 }
 
 type metricsSQLArgs struct {
@@ -132,6 +135,11 @@ func newMetricsSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.
 		query.TimeZone = props.TimeZone
 	}
 
+	// Apply pagination if provided
+	if props.Pagination != nil {
+		applyPagination(query, props.Pagination)
+	}
+
 	// Build the options for the metrics resolver
 	metricProps, err := query.AsMap()
 	if err != nil {
@@ -212,3 +220,33 @@ func applyAdditionalTimeRange(current, additional *metricsview.TimeRange) *metri
 
 	return timeRange
 }
+
+// applyPagination restricts a metrics query to a single page of its results.
+// The sort keys are added after the query's own sort fields, so they only act as tie-breakers that make the order stable.
+// The page is a window into the rows that are within the query's own limit and offset.
+func applyPagination(query *metricsview.Query, p *paginationProps) {
+	for _, k := range p.SortKeys {
+		if !slices.ContainsFunc(query.Sort, func(s metricsview.Sort) bool { return s.Name == k }) {
+			query.Sort = append(query.Sort, metricsview.Sort{Name: k})
+		}
+	}
+
+	offset := p.Offset
+	if query.Offset != nil {
+		offset += *query.Offset
+	}
+	if offset > 0 {
+		query.Offset = &offset
+	}
+
+	limit := p.Limit
+	if query.Limit != nil {
+		remaining := max(*query.Limit-p.Offset, 0)
+		if limit <= 0 || remaining < limit {
+			limit = remaining
+		}
+	}
+	if limit > 0 || query.Limit != nil {
+		query.Limit = &limit
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
 )
@@ -281,3 +282,25 @@ func TestMetricsSQLWithAdditionalTimeZone(t *testing.T) {
 
 	require.Error(t, err, "Should error for invalid timezone")
 }
+
+func TestApplyPagination(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+
+	// Sort keys are added as tie-breakers after the query's own sort
+	q := &metricsview.Query{Sort: []metricsview.Sort{{Name: "total", Desc: true}, {Name: "id"}}}
+	applyPagination(q, &paginationProps{SortKeys: []string{"id", "name"}, Limit: 11, Offset: 20})
+	require.Equal(t, []metricsview.Sort{{Name: "total", Desc: true}, {Name: "id"}, {Name: "name"}}, q.Sort)
+	require.Equal(t, ptr(11), q.Limit)
+	require.Equal(t, ptr(20), q.Offset)
+
+	// The page is a window into the query's own limit and offset
+	q = &metricsview.Query{Limit: ptr(25), Offset: ptr(5)}
+	applyPagination(q, &paginationProps{SortKeys: []string{"id"}, Limit: 11, Offset: 20})
+	require.Equal(t, ptr(5), q.Limit)
+	require.Equal(t, ptr(25), q.Offset)
+
+	// Pages past the query's limit are empty
+	q = &metricsview.Query{Limit: ptr(10)}
+	applyPagination(q, &paginationProps{SortKeys: []string{"id"}, Limit: 11, Offset: 20})
+	require.Equal(t, ptr(0), q.Limit)
+}
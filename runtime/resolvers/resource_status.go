@@ -33,7 +33,9 @@ type resourceStatusResolver struct {
 // resourceStatusProps declares the properties for the "resource_status" resolver.
 type resourceStatusProps struct {
 	// WhereError is a flag to only return resources that are in the error state.
-	WhereError   bool           `mapstructure:"where_error"`
+	WhereError bool `mapstructure:"where_error"`
+	// WhereStale is a flag to only return models that are stale according to their freshness SLA.
+	WhereStale   bool           `mapstructure:"where_stale"`
 	UnusedFields map[string]any `mapstructure:",remain"`
 }
 
@@ -84,19 +86,19 @@ func (r *resourceStatusResolver) ResolveInteractive(ctx context.Context) (runtim
 		return nil, err
 	}
 
-	if r.props.WhereError {
-		// In-place trim resources that are not in error state
+	if r.props.WhereError || r.props.WhereStale {
+		// In-place trim resources that don't match the filters
 		i := 0
 		for i < len(rs) {
-			r := rs[i]
-			if r.Meta.ReconcileError == "" {
+			res := rs[i]
+			if (r.props.WhereError && res.Meta.ReconcileError == "") || (r.props.WhereStale && !res.GetModel().GetState().GetStale()) {
 				// Remove from the slice
 				rs[i] = rs[len(rs)-1]
 				rs[len(rs)-1] = nil
 				rs = rs[:len(rs)-1]
 				continue
 			}
-			rs[i] = r
+			rs[i] = res
 			i++
 		}
 	}
@@ -15,6 +15,7 @@ func TestResourceStatus(t *testing.T) {
 		name       string
 		files      map[string]string
 		whereError bool
+		whereStale bool
 		expected   []map[string]any
 	}{
 		{
@@ -78,6 +79,31 @@ func TestResourceStatus(t *testing.T) {
 				{"type": "Model", "name": "m2", "status": "Idle", "error": "booom!"},
 			},
 		},
+		{
+			name: "stale model with where_stale",
+			files: map[string]string{
+				"rill.yaml": ``,
+				"m1.sql":    `SELECT 314`,
+				"m2.yaml": `
+type: model
+sql: SELECT TIMESTAMP '2020-01-01 00:00:00' AS updated_at
+freshness:
+  max_staleness: 1h
+  watermark: MAX(updated_at)
+`,
+				"m3.yaml": `
+type: model
+sql: SELECT now() AS updated_at
+freshness:
+  max_staleness: 1h
+  watermark: MAX(updated_at)
+`,
+			},
+			whereStale: true,
+			expected: []map[string]any{
+				{"type": "Model", "name": "m2", "status": "Idle", "error": "model is stale: data was last updated at 2020-01-01T00:00:00Z"},
+			},
+		},
 		{
 			name: "parse errors",
 			files: map[string]string{
@@ -99,7 +125,7 @@ func TestResourceStatus(t *testing.T) {
 			res, _, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
 				InstanceID:         instanceID,
 				Resolver:           "resource_status",
-				ResolverProperties: map[string]any{"where_error": tc.whereError},
+				ResolverProperties: map[string]any{"where_error": tc.whereError, "where_stale": tc.whereStale},
 				Args:               nil,
 				Claims:             &runtime.SecurityClaims{},
 			})
@@ -7,12 +7,14 @@ import (
 	"io"
 	"strings"
 	"time"
+	"unicode"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/drivers/duckdb"
 	"github.com/rilldata/rill/runtime/parser"
+	"github.com/rilldata/rill/runtime/pkg/driverutil"
 	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
 	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
 	"github.com/rilldata/rill/runtime/queries"
@@ -35,9 +37,21 @@ type sqlResolver struct {
 }
 
 type sqlProps struct {
-	Connector string `mapstructure:"connector"`
-	SQL       string `mapstructure:"sql"`
-	Limit     int64  `mapstructure:"limit"`
+	Connector  string           `mapstructure:"connector"`
+	SQL        string           `mapstructure:"sql"`
+	Limit      int64            `mapstructure:"limit"`
+	Pagination *paginationProps `mapstructure:"pagination"`
+}
+
+// paginationProps configure the sql and metrics_sql resolvers to return a single page of sorted results.
+// They are set by the API handler for APIs with pagination enabled.
+type paginationProps struct {
+	// SortKeys are the columns to sort the results by.
+	SortKeys []string `mapstructure:"sort_keys"`
+	// Limit is the maximum number of rows in the page.
+	Limit int64 `mapstructure:"limit"`
+	// Offset is the number of rows to skip before the page.
+	Offset int64 `mapstructure:"offset"`
 }
 
 type sqlArgs struct {
@@ -111,22 +125,75 @@ func newSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolve
 
 	// Compute limit (maximum number of rows to return; unlike cap, this doesn't error if exceeded).
 	limit := props.Limit
+	hasLimit := limit > 0
+
+	// Apply pagination. The page is a window into the rows that are within the limit.
+	var offset int64
+	var sortKeys []string
+	if p := props.Pagination; p != nil {
+		sortKeys = p.SortKeys
+		offset = p.Offset
+		if hasLimit {
+			limit = max(limit-offset, 0)
+		}
+		if p.Limit > 0 && (!hasLimit || p.Limit < limit) {
+			limit = p.Limit
+			hasLimit = true
+		}
+	}
+
 	if rowCap != 0 {
 		if limit > rowCap {
 			return nil, fmt.Errorf("requested row limit %d exceeds the maximum interactive limit of %d", limit, rowCap)
-		} else if limit <= 0 {
+		} else if !hasLimit {
 			limit = rowCap + 1 // Optimization so the DB doesn't compute more rows than necessary to detect that we hit the cap.
+			hasLimit = true
 		}
 	}
 
-	// Wrap the SQL with an outer SELECT to apply the limit.
-	if limit > 0 {
+	// Append the sort keys to the query's own ORDER BY (if any), so they only act as tie-breakers that make the order stable.
+	sortOuter := len(sortKeys) > 0
+	if sortOuter {
+		if before, orderBy, after, ok := splitOrderBy(sql); ok {
+			var b strings.Builder
+			b.WriteString(before)
+			b.WriteString(orderBy)
+			for _, k := range sortKeys {
+				b.WriteString(", ")
+				b.WriteString(olap.Dialect().EscapeIdentifier(k))
+			}
+			b.WriteString(after)
+			sql = b.String()
+			sortOuter = false
+		}
+	}
+
+	// Wrap the SQL with an outer SELECT to apply the sort, limit and offset.
+	if hasLimit || offset > 0 || sortOuter {
+		var b strings.Builder
 		if olap.Dialect().String() == drivers.DialectNameMySQL {
 			// subqueries in MySQL require an alias
-			sql = fmt.Sprintf("SELECT * FROM (\n%s\n) AS subquery LIMIT %d", sql, limit)
+			fmt.Fprintf(&b, "SELECT * FROM (\n%s\n) AS subquery", sql)
 		} else {
-			sql = fmt.Sprintf("SELECT * FROM (%s\n) LIMIT %d", sql, limit)
+			fmt.Fprintf(&b, "SELECT * FROM (%s\n)", sql)
 		}
+		if sortOuter {
+			for i, k := range sortKeys {
+				if i == 0 {
+					b.WriteString(" ORDER BY ")
+				} else {
+					b.WriteString(", ")
+				}
+				b.WriteString(olap.Dialect().EscapeIdentifier(k))
+			}
+		}
+		if hasLimit {
+			fmt.Fprintf(&b, " LIMIT %d", limit)
+		}
+		if offset > 0 {
+			fmt.Fprintf(&b, " OFFSET %d", offset)
+		}
+		sql = b.String()
 	}
 
 	return &sqlResolver{
@@ -212,6 +279,24 @@ func (r *sqlResolver) generalExport(ctx context.Context, w io.Writer, filename s
 	}
 	defer res.Close()
 
+	// JSON Lines and Arrow can be streamed directly from the result without buffering it in memory
+	var streamFormat drivers.FileFormat
+	switch opts.Format {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		streamFormat = drivers.FileFormatJSONL
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		streamFormat = drivers.FileFormatArrow
+	}
+	if streamFormat != drivers.FileFormatUnspecified {
+		if opts.PreWriteHook != nil {
+			err = opts.PreWriteHook(filename)
+			if err != nil {
+				return err
+			}
+		}
+		return driverutil.ResultToFile(res, w, streamFormat, nil)
+	}
+
 	meta := make([]*runtimev1.MetricsViewColumn, len(res.Schema.Fields))
 	for i, f := range res.Schema.Fields {
 		meta[i] = &runtimev1.MetricsViewColumn{
@@ -287,3 +372,83 @@ func resolveTemplate(sqlTemplate string, args map[string]any, inst *drivers.Inst
 	}
 	return sql, refs, nil
 }
+
+// splitOrderBy splits a SQL query around the expressions of its top-level ORDER BY clause.
+// It ignores ORDER BY clauses in parentheses (subqueries, window functions), string literals, quoted identifiers and comments.
+// The returned orderBy includes the ORDER BY keyword, and after contains the clauses that follow it (e.g. LIMIT).
+func splitOrderBy(sql string) (before, orderBy, after string, ok bool) {
+	start, end := -1, -1
+	depth := 0
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// Skip to the closing quote. An escaped quote ('') is skipped as two adjacent literals.
+			j := strings.IndexByte(sql[i+1:], c)
+			if j < 0 {
+				return "", "", "", false
+			}
+			i += j + 1
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				i = len(sql)
+			} else {
+				i += j
+			}
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			j := strings.Index(sql[i+2:], "*/")
+			if j < 0 {
+				return "", "", "", false
+			}
+			i += j + 3
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && isKeywordAt(sql, i, "ORDER"):
+			j := skipSpace(sql, i+len("ORDER"))
+			if isKeywordAt(sql, j, "BY") {
+				start, end = i, -1
+				i = j + len("BY") - 1
+			}
+		case depth == 0 && start >= 0 && end < 0:
+			for _, kw := range []string{"LIMIT", "OFFSET", "FETCH", "SETTINGS", "FORMAT"} {
+				if isKeywordAt(sql, i, kw) {
+					end = i
+					break
+				}
+			}
+		}
+	}
+	if start < 0 {
+		return "", "", "", false
+	}
+	if end < 0 {
+		end = len(sql)
+	}
+	orderBy = strings.TrimRightFunc(sql[start:end], unicode.IsSpace)
+	return sql[:start], orderBy, sql[start+len(orderBy):], true
+}
+
+// isKeywordAt returns true if the keyword appears as a whole word at position i in s (case insensitive).
+func isKeywordAt(s string, i int, keyword string) bool {
+	if i < 0 || i+len(keyword) > len(s) || !strings.EqualFold(s[i:i+len(keyword)], keyword) {
+		return false
+	}
+	if i > 0 && isIdentChar(s[i-1]) {
+		return false
+	}
+	return i+len(keyword) == len(s) || !isIdentChar(s[i+len(keyword)])
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '.' || c == '$' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func skipSpace(s string, i int) int {
+	for i < len(s) && unicode.IsSpace(rune(s[i])) {
+		i++
+	}
+	return i
+}
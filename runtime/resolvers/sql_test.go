@@ -90,6 +90,106 @@ func TestSQLLimit(t *testing.T) {
 	}
 }
 
+func TestSQLPagination(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"foo.sql": "SELECT range AS id, range % 3 AS grp FROM range(10)",
+		},
+	})
+
+	cases := []struct {
+		name       string
+		sql        string
+		limit      int
+		pagination map[string]any
+		want       []int
+	}{
+		{
+			name:       "sort keys",
+			sql:        "SELECT * FROM foo",
+			pagination: map[string]any{"sort_keys": []string{"id"}, "limit": 3, "offset": 2},
+			want:       []int{2, 3, 4},
+		},
+		{
+			name:       "keeps inner order",
+			sql:        "SELECT * FROM foo ORDER BY grp DESC",
+			pagination: map[string]any{"sort_keys": []string{"id"}, "limit": 4},
+			want:       []int{2, 5, 8, 1},
+		},
+		{
+			name:       "keeps inner order and limit",
+			sql:        "SELECT * FROM foo ORDER BY grp LIMIT 5",
+			pagination: map[string]any{"sort_keys": []string{"id"}, "limit": 2, "offset": 3},
+			want:       []int{9, 1},
+		},
+		{
+			name:       "page within limit",
+			sql:        "SELECT * FROM foo",
+			limit:      5,
+			pagination: map[string]any{"sort_keys": []string{"id"}, "limit": 3, "offset": 3},
+			want:       []int{3, 4},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, _, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+				InstanceID:         instanceID,
+				Resolver:           "sql",
+				ResolverProperties: map[string]any{"sql": tc.sql, "limit": tc.limit, "pagination": tc.pagination},
+				Claims:             &runtime.SecurityClaims{SkipChecks: true},
+			})
+			require.NoError(t, err)
+			defer res.Close()
+
+			var ids []int
+			for {
+				row, err := res.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err)
+				ids = append(ids, int(row["id"].(int64)))
+			}
+			require.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestSplitOrderBy(t *testing.T) {
+	cases := []struct {
+		sql     string
+		ok      bool
+		orderBy string
+		after   string
+	}{
+		{sql: "SELECT * FROM foo"},
+		{sql: "SELECT * FROM foo WHERE x = 'ORDER BY y'"},
+		{sql: "SELECT row_number() OVER (ORDER BY x) FROM foo"},
+		{sql: "SELECT * FROM (SELECT * FROM foo ORDER BY x)"},
+		{sql: "SELECT * FROM foo -- ORDER BY x"},
+		{sql: "SELECT \"order by\" FROM foo"},
+		{sql: "SELECT * FROM foo ORDER BY x", ok: true, orderBy: "ORDER BY x"},
+		{sql: "SELECT * FROM foo order  by x DESC, y\n", ok: true, orderBy: "order  by x DESC, y", after: "\n"},
+		{sql: "SELECT * FROM foo ORDER BY x LIMIT 10", ok: true, orderBy: "ORDER BY x", after: " LIMIT 10"},
+		{sql: "SELECT * FROM foo ORDER BY limit_x OFFSET 10", ok: true, orderBy: "ORDER BY limit_x", after: " OFFSET 10"},
+		{sql: "SELECT 'it''s' AS x FROM foo ORDER BY x", ok: true, orderBy: "ORDER BY x"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.sql, func(t *testing.T) {
+			before, orderBy, after, ok := splitOrderBy(tc.sql)
+			require.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, tc.orderBy, orderBy)
+			require.Equal(t, tc.after, after)
+			require.Equal(t, tc.sql, before+orderBy+after)
+		})
+	}
+}
+
 func TestSimpleSQLApi(t *testing.T) {
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
@@ -158,6 +158,7 @@ type securityClaimsJSON struct {
 type ResolvedSecurity struct {
 	access      *bool
 	fieldAccess map[string]bool
+	fieldMasks  map[string]*runtimev1.SecurityRuleFieldMask
 	rowFilter   string
 	queryFilter *runtimev1.Expression
 }
@@ -190,6 +191,13 @@ func (r *ResolvedSecurity) CanAccessField(field string) bool {
 	return r.fieldAccess[field]
 }
 
+// FieldMask returns the mask to apply to the values of a field in the resource.
+// It returns nil if the field's values should not be masked.
+// Field names are matched case-insensitively, consistent with the validation of the metrics view's security rules.
+func (r *ResolvedSecurity) FieldMask(field string) *runtimev1.SecurityRuleFieldMask {
+	return r.fieldMasks[strings.ToLower(field)]
+}
+
 // RowFilter returns a raw SQL expression to apply to the WHERE clause when querying the resource.
 func (r *ResolvedSecurity) RowFilter() string {
 	return r.rowFilter
@@ -207,6 +215,7 @@ var truth = true
 var ResolvedSecurityOpen = &ResolvedSecurity{
 	access:      &truth,
 	fieldAccess: nil,
+	fieldMasks:  nil,
 	rowFilter:   "",
 	queryFilter: nil,
 }
@@ -215,6 +224,7 @@ var ResolvedSecurityOpen = &ResolvedSecurity{
 var ResolvedSecurityClosed = &ResolvedSecurity{
 	access:      nil,
 	fieldAccess: nil,
+	fieldMasks:  nil,
 	rowFilter:   "",
 	queryFilter: nil,
 }
@@ -327,12 +337,18 @@ func (p *securityEngine) resolveSecurity(ctx context.Context, instanceID, enviro
 			if err != nil {
 				return nil, fmt.Errorf("security policy: failed to resolve row filter: %w", err)
 			}
+		case *runtimev1.SecurityRule_FieldMask:
+			err := p.applySecurityRuleFieldMask(res, r, rule.FieldMask, templateData)
+			if err != nil {
+				return nil, fmt.Errorf("security policy: failed to resolve field mask: %w", err)
+			}
 		}
 	}
 
 	// Due to the optimization that we skip rules if access is denied, we clear the other fields to ensure consistent output regardless of rule order.
 	if res.access != nil && !*res.access {
 		res.fieldAccess = nil
+		res.fieldMasks = nil
 		res.rowFilter = ""
 		res.queryFilter = nil
 	}
@@ -742,6 +758,39 @@ func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *
 	return nil
 }
 
+// applySecurityRuleFieldMask applies a field mask rule to the resolved security.
+func (p *securityEngine) applySecurityRuleFieldMask(res *ResolvedSecurity, r *runtimev1.Resource, rule *runtimev1.SecurityRuleFieldMask, td parser.TemplateData) error {
+	// Masks are applied to the dimension expressions of a metrics view, so the rule only applies to metrics views.
+	// Explores query their metrics view, which resolves its own security.
+	if r.Meta.Name.Kind != ResourceKindMetricsView {
+		return nil
+	}
+
+	apply, err := evaluateConditions(r, rule.ConditionExpression, rule.ConditionKinds, rule.ConditionResources, td)
+	if err != nil {
+		return err
+	}
+	if apply != nil && !*apply {
+		// Conditions are present but not satisfied.
+		return nil
+	}
+
+	if res.fieldMasks == nil {
+		res.fieldMasks = make(map[string]*runtimev1.SecurityRuleFieldMask)
+	}
+	for _, f := range rule.Fields {
+		// Keys are lower-cased since field names are case-insensitive (see FieldMask).
+		f = strings.ToLower(f)
+		// If several rules mask the same field, the first one takes precedence.
+		if _, ok := res.fieldMasks[f]; ok {
+			continue
+		}
+		res.fieldMasks[f] = rule
+	}
+
+	return nil
+}
+
 // applySecurityRuleRowFilter applies a row filter rule to the resolved security.
 func (p *securityEngine) applySecurityRuleRowFilter(res *ResolvedSecurity, r *runtimev1.Resource, rule *runtimev1.SecurityRuleRowFilter, td parser.TemplateData) error {
 	// Determine if the rule should be applied
@@ -576,3 +576,41 @@ func TestResolveMetricsView(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveMetricsViewFieldMaskMixedCase(t *testing.T) {
+	hash := &runtimev1.SecurityRuleFieldMask{Fields: []string{"Email"}, Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_HASH}
+	redact := &runtimev1.SecurityRuleFieldMask{Fields: []string{"EMAIL", "phone"}, Type: runtimev1.FieldMaskType_FIELD_MASK_TYPE_REDACT}
+	mv := &runtimev1.MetricsViewSpec{
+		Dimensions: []*runtimev1.MetricsViewSpec_Dimension{
+			{Name: "email", Column: "email"},
+			{Name: "Phone", Column: "phone"},
+		},
+		SecurityRules: []*runtimev1.SecurityRule{
+			{Rule: &runtimev1.SecurityRule_Access{Access: &runtimev1.SecurityRuleAccess{Allow: true}}},
+			{Rule: &runtimev1.SecurityRule_FieldMask{FieldMask: hash}},
+			{Rule: &runtimev1.SecurityRule_FieldMask{FieldMask: redact}},
+		},
+	}
+	r := &runtimev1.Resource{
+		Meta: &runtimev1.ResourceMeta{
+			Name:           &runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: "test"},
+			StateUpdatedOn: timestamppb.Now(),
+		},
+		Resource: &runtimev1.Resource_MetricsView{
+			MetricsView: &runtimev1.MetricsView{
+				Spec:  mv,
+				State: &runtimev1.MetricsViewState{ValidSpec: mv},
+			},
+		},
+	}
+
+	p := newSecurityEngine(1, zap.NewNop(), nil)
+	got, err := p.resolveSecurity(t.Context(), "", "test", map[string]string{}, &SecurityClaims{}, r)
+	require.NoError(t, err)
+
+	// Masks apply regardless of the casing used in the rule or the dimension name, and the first rule wins.
+	require.Equal(t, hash, got.FieldMask("email"))
+	require.Equal(t, hash, got.FieldMask("EMAIL"))
+	require.Equal(t, redact, got.FieldMask("Phone"))
+	require.Nil(t, got.FieldMask("other"))
+}
@@ -6,16 +6,23 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-redis/redis_rate/v10"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/openapiutil"
+	"github.com/rilldata/rill/runtime/pkg/pagination"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
@@ -68,16 +75,38 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 		return httputil.Error(http.StatusInternalServerError, err)
 	}
 
+	// Enforce the API's rate limit
+	err = s.checkAPIRateLimit(ctx, w, req, instanceID, apiName, api.Spec.RateLimit, claims)
+	if err != nil {
+		return err
+	}
+
 	// Rewrite the claims before passing them to the resolver
 	if api.Spec.SkipNestedSecurity {
 		claims.SkipChecks = true
 	}
 
+	// Apply pagination.
+	// We request one more row than the page size to detect if there is a next page.
+	resolverProps := api.Spec.ResolverProperties.AsMap()
+	var page *apiPage
+	if api.Spec.Pagination != nil {
+		page, err = parseAPIPage(api.Spec.Pagination, args)
+		if err != nil {
+			return httputil.Error(http.StatusBadRequest, err)
+		}
+		resolverProps["pagination"] = map[string]any{
+			"sort_keys": api.Spec.Pagination.SortKeys,
+			"limit":     page.size + 1,
+			"offset":    page.offset,
+		}
+	}
+
 	// Resolve the API to JSON data
 	res, _, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
 		InstanceID:         instanceID,
 		Resolver:           api.Spec.Resolver,
-		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+		ResolverProperties: resolverProps,
 		Args:               args,
 		Claims:             claims,
 	})
@@ -91,6 +120,12 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 	if err != nil {
 		return httputil.Error(http.StatusInternalServerError, err)
 	}
+	if page != nil {
+		data, err = page.marshalResponse(api.Spec.Pagination, data)
+		if err != nil {
+			return httputil.Error(http.StatusInternalServerError, err)
+		}
+	}
 	w.Header().Set("Content-Type", "application/json")
 	_, err = w.Write(data)
 	if err != nil {
@@ -100,6 +135,130 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 	return nil
 }
 
+// checkAPIRateLimit enforces an API's rate limit for the caller identified by the claims and records the caller's usage.
+// If the limit is exceeded, it sets the Retry-After header and returns an error with status 429.
+func (s *Server) checkAPIRateLimit(ctx context.Context, w http.ResponseWriter, req *http.Request, instanceID, apiName string, rl *runtimev1.APIRateLimit, claims *runtime.SecurityClaims) error {
+	if rl == nil {
+		return nil
+	}
+
+	// Identify the caller
+	method := fmt.Sprintf("api:%s:%s", instanceID, apiName)
+	var caller, limitKey string
+	if v := claims.UserAttributes[rl.KeyAttribute]; rl.KeyAttribute != "" && v != nil {
+		caller = fmt.Sprintf("%s:%v", rl.KeyAttribute, v)
+		limitKey = ratelimit.AuthLimitKey(method, caller)
+	} else if claims.UserID != "" {
+		caller = claims.UserID
+		limitKey = ratelimit.AuthLimitKey(method, caller)
+	} else {
+		caller = observability.HTTPPeer(req)
+		limitKey = ratelimit.AnonLimitKey(method, caller)
+	}
+
+	limit := redis_rate.Limit{
+		Rate:   int(rl.Requests),
+		Burst:  int(rl.Requests),
+		Period: time.Duration(rl.PeriodSeconds) * time.Second,
+	}
+	err := s.limiter.Limit(ctx, limitKey, limit)
+	var quotaErr ratelimit.QuotaExceededError
+	limited := errors.As(err, &quotaErr)
+
+	// Record per-caller usage
+	attrs := append(s.runtime.GetInstanceAttributes(ctx, instanceID),
+		attribute.String("api", apiName),
+		attribute.String("caller", caller),
+		attribute.Bool("rate_limited", limited),
+	)
+	s.activity.RecordMetric(ctx, "api_request", 1, attrs...)
+
+	if limited {
+		if d := quotaErr.RetryAfter(); d > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+		}
+		return httputil.Error(http.StatusTooManyRequests, err)
+	}
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// apiPage is a page of results requested from an API with pagination enabled.
+type apiPage struct {
+	size   int64
+	offset int64
+}
+
+// parseAPIPage parses the "page_size" and "page_token" args of a request to an API with pagination enabled.
+// It removes the args so they are not passed to the API's resolver.
+func parseAPIPage(cfg *runtimev1.APIPagination, args map[string]any) (*apiPage, error) {
+	page := &apiPage{size: int64(cfg.PageSize)}
+
+	if v, ok := args["page_size"]; ok {
+		delete(args, "page_size")
+		n, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid page_size %v: must be a positive integer", v)
+		}
+		if n > int64(cfg.MaxPageSize) {
+			return nil, fmt.Errorf("page_size %d exceeds the maximum page size of %d", n, cfg.MaxPageSize)
+		}
+		page.size = n
+	}
+
+	if v, ok := args["page_token"]; ok {
+		delete(args, "page_token")
+		token, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid page_token: must be a string")
+		}
+		if token != "" {
+			var sortKeys []string
+			err := pagination.UnmarshalPageToken(token, &sortKeys, &page.offset)
+			if err != nil {
+				return nil, fmt.Errorf("invalid page_token: %w", err)
+			}
+			// The token is only valid for the sort order it was created for
+			if !slices.Equal(sortKeys, cfg.SortKeys) || page.offset < 0 {
+				return nil, fmt.Errorf("invalid page_token: it was not created for the current version of this API")
+			}
+		}
+	}
+
+	return page, nil
+}
+
+// apiPageResponse is the response body of a request to an API with pagination enabled.
+type apiPageResponse struct {
+	Data          []json.RawMessage `json:"data"`
+	NextPageToken string            `json:"next_page_token"`
+}
+
+// marshalResponse wraps the JSON rows of the page in an apiPageResponse.
+// The rows should contain one row more than the page size if there is a next page.
+func (p *apiPage) marshalResponse(cfg *runtimev1.APIPagination, rows []byte) ([]byte, error) {
+	var data []json.RawMessage
+	if err := json.Unmarshal(rows, &data); err != nil {
+		return nil, err
+	}
+	if data == nil {
+		data = []json.RawMessage{}
+	}
+
+	var nextPageToken string
+	if int64(len(data)) > p.size {
+		data = data[:p.size]
+		nextPageToken = pagination.MarshalPageToken(cfg.SortKeys, p.offset+p.size)
+	}
+
+	return json.Marshal(&apiPageResponse{
+		Data:          data,
+		NextPageToken: nextPageToken,
+	})
+}
+
 func (s *Server) combinedOpenAPISpec(w http.ResponseWriter, req *http.Request) error {
 	// Parse path parameters
 	ctx := req.Context()
@@ -264,6 +423,17 @@ func (s *Server) generatePathItemSpec(name string, api *runtimev1.API) (*openapi
 		}
 	}
 
+	if api.Spec.Pagination != nil {
+		parameters = append(parameters,
+			&openapi3.ParameterRef{Value: openapi3.NewQueryParameter("page_size").
+				WithDescription(fmt.Sprintf("Number of rows to return (default %d, max %d)", api.Spec.Pagination.PageSize, api.Spec.Pagination.MaxPageSize)).
+				WithSchema(openapi3.NewIntegerSchema().WithMin(1).WithMax(float64(api.Spec.Pagination.MaxPageSize)))},
+			&openapi3.ParameterRef{Value: openapi3.NewQueryParameter("page_token").
+				WithDescription("Token from the next_page_token of the previous response").
+				WithSchema(openapi3.NewStringSchema())},
+		)
+	}
+
 	components := make(map[string]*openapi3.SchemaRef)
 
 	var requestBody *openapi3.RequestBodyRef
@@ -302,6 +472,30 @@ func (s *Server) generatePathItemSpec(name string, api *runtimev1.API) (*openapi
 		}
 	}
 
+	successSchema := &openapi3.Schema{
+		Type: &openapi3.Types{"array"},
+		Items: &openapi3.SchemaRef{
+			Value: responseSchema,
+		},
+	}
+	if api.Spec.Pagination != nil {
+		successSchema = &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: map[string]*openapi3.SchemaRef{
+				"data": {
+					Value: successSchema,
+				},
+				"next_page_token": {
+					Value: &openapi3.Schema{
+						Type:        &openapi3.Types{"string"},
+						Description: "Token for fetching the next page. Empty if there are no more pages.",
+					},
+				},
+			},
+			Required: []string{"data", "next_page_token"},
+		}
+	}
+
 	op := &openapi3.Operation{
 		Summary:     summary,
 		Parameters:  parameters,
@@ -311,12 +505,7 @@ func (s *Server) generatePathItemSpec(name string, api *runtimev1.API) (*openapi
 				Value: openapi3.NewResponse().WithDescription(
 					fmt.Sprintf("Successful response of %s resolver", name),
 				).WithContent(
-					openapi3.NewContentWithJSONSchema(&openapi3.Schema{
-						Type: &openapi3.Types{"array"},
-						Items: &openapi3.SchemaRef{
-							Value: responseSchema,
-						},
-					}),
+					openapi3.NewContentWithJSONSchema(successSchema),
 				),
 			}),
 			openapi3.WithStatus(400, &openapi3.ResponseRef{
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/pagination"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAPIRateLimit(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"apis/limited.yaml": `
+type: api
+sql: SELECT 1 AS one
+rate_limit:
+  requests: 1
+  period: 1h
+`,
+			"apis/unlimited.yaml": `
+type: api
+sql: SELECT 1 AS one
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	limiter := ratelimit.NewRedis(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+
+	srv, err := NewServer(context.Background(), &Options{}, rt, zap.NewNop(), limiter, activity.NewNoopClient())
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/instances/{instance_id}/api/{name...}", auth.HTTPMiddleware(srv.aud, httputil.Handler(srv.apiHandler)))
+	httpSrv := httptest.NewServer(mux)
+	defer httpSrv.Close()
+
+	get := func(api string) *http.Response {
+		res, err := http.Get(httpSrv.URL + "/v1/instances/" + instanceID + "/api/" + api)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res
+	}
+
+	// The first request is within the limit
+	res := get("limited")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Empty(t, res.Header.Get("Retry-After"))
+
+	// The second request exceeds the limit and tells the caller when to retry
+	res = get("limited")
+	require.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	retryAfter, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	require.NoError(t, err)
+	require.Greater(t, retryAfter, 0)
+	require.LessOrEqual(t, retryAfter, 3600)
+
+	// APIs without a rate limit are not affected
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, get("unlimited").StatusCode)
+	}
+}
+
+func TestParseAPIPage(t *testing.T) {
+	cfg := &runtimev1.APIPagination{SortKeys: []string{"id"}, PageSize: 10, MaxPageSize: 100}
+
+	cases := []struct {
+		name      string
+		args      map[string]any
+		want      *apiPage
+		wantError string
+	}{
+		{
+			name: "defaults",
+			args: map[string]any{},
+			want: &apiPage{size: 10},
+		},
+		{
+			name: "page size",
+			args: map[string]any{"page_size": "25"},
+			want: &apiPage{size: 25},
+		},
+		{
+			name: "page token",
+			args: map[string]any{"page_size": 5, "page_token": pagination.MarshalPageToken([]string{"id"}, int64(20))},
+			want: &apiPage{size: 5, offset: 20},
+		},
+		{
+			name: "empty page token",
+			args: map[string]any{"page_token": ""},
+			want: &apiPage{size: 10},
+		},
+		{
+			name:      "invalid page size",
+			args:      map[string]any{"page_size": "0"},
+			wantError: "must be a positive integer",
+		},
+		{
+			name:      "page size above max",
+			args:      map[string]any{"page_size": 101},
+			wantError: "exceeds the maximum page size of 100",
+		},
+		{
+			name:      "invalid page token",
+			args:      map[string]any{"page_token": "not a token"},
+			wantError: "invalid page_token",
+		},
+		{
+			name:      "page token for other sort keys",
+			args:      map[string]any{"page_token": pagination.MarshalPageToken([]string{"name"}, int64(20))},
+			wantError: "not created for the current version of this API",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := parseAPIPage(cfg, tc.args)
+			if tc.wantError != "" {
+				require.ErrorContains(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, page)
+
+			// The pagination args are not passed on to the resolver
+			require.NotContains(t, tc.args, "page_size")
+			require.NotContains(t, tc.args, "page_token")
+		})
+	}
+}
+
+func TestAPIPageMarshalResponse(t *testing.T) {
+	cfg := &runtimev1.APIPagination{SortKeys: []string{"id"}, PageSize: 2, MaxPageSize: 100}
+
+	// Fetching one row more than the page size returns a token for the next page
+	page := &apiPage{size: 2, offset: 4}
+	data, err := page.marshalResponse(cfg, []byte(`[{"id":5},{"id":6},{"id":7}]`))
+	require.NoError(t, err)
+
+	var res apiPageResponse
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Len(t, res.Data, 2)
+	require.JSONEq(t, `{"id":6}`, string(res.Data[1]))
+	require.NotEmpty(t, res.NextPageToken)
+
+	next, err := parseAPIPage(cfg, map[string]any{"page_token": res.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, &apiPage{size: 2, offset: 6}, next)
+
+	// The last page has no next page token
+	data, err = next.marshalResponse(cfg, []byte(`[{"id":7}]`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":[{"id":7}],"next_page_token":""}`, string(data))
+
+	// An empty page returns an empty list
+	data, err = next.marshalResponse(cfg, []byte(`null`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":[],"next_page_token":""}`, string(data))
+}
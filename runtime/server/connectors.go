@@ -176,6 +176,14 @@ func (s *Server) ListNotifierConnectors(ctx context.Context, req *runtimev1.List
 		}
 	}
 
+	// Teams doesn't take any connector config since it's configured entirely with webhook URLs, so it's always available.
+	if _, ok := res["teams"]; !ok {
+		res["teams"] = &runtimev1.Connector{
+			Type: "teams",
+			Name: "teams",
+		}
+	}
+
 	return &runtimev1.ListNotifierConnectorsResponse{
 		Connectors: maps.Values(res),
 	}, nil
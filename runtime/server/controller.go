@@ -420,6 +420,38 @@ func (s *Server) SkipModelPartitions(ctx context.Context, req *runtimev1.SkipMod
 	return &runtimev1.SkipModelPartitionsResponse{}, nil
 }
 
+// GetColumnLineage implements runtimev1.RuntimeServiceServer
+func (s *Server) GetColumnLineage(ctx context.Context, req *runtimev1.GetColumnLineageRequest) (*runtimev1.GetColumnLineageResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.resource.kind", req.Resource.GetKind()),
+		attribute.String("args.resource.name", req.Resource.GetName()),
+		attribute.String("args.column", req.Column),
+	)
+
+	// The lineage is derived from the project's source code and covers all resources, so we require repo access instead of applying security policies per resource.
+	claims := auth.GetClaims(ctx, req.InstanceId)
+	if !claims.Can(runtime.ReadObjects) || !claims.Can(runtime.ReadRepo) {
+		return nil, ErrForbidden
+	}
+
+	if req.Resource != nil && req.Column == "" {
+		return nil, status.Error(codes.InvalidArgument, "column must be specified when resource is set")
+	}
+
+	edges, err := s.runtime.ColumnLineage(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Resource != nil {
+		edges = runtime.ColumnLineageDownstream(edges, req.Resource, req.Column)
+	}
+
+	return &runtimev1.GetColumnLineageResponse{Edges: edges}, nil
+}
+
 // CreateTrigger implements runtimev1.RuntimeServiceServer
 func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTriggerRequest) (*runtimev1.CreateTriggerResponse, error) {
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
@@ -503,6 +535,63 @@ func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTrigger
 	return &runtimev1.CreateTriggerResponse{}, nil
 }
 
+// InvalidateQueryCache implements runtimev1.RuntimeServiceServer
+func (s *Server) InvalidateQueryCache(ctx context.Context, req *runtimev1.InvalidateQueryCacheRequest) (*runtimev1.InvalidateQueryCacheResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metrics_view", req.MetricsView),
+	)
+
+	// Invalidation is similar to a refresh in effect, so it requires the same permissions as creating a trigger.
+	if !auth.GetClaims(ctx, req.InstanceId).Can(runtime.EditTrigger) {
+		return nil, ErrForbidden
+	}
+
+	if req.MetricsView != "" {
+		ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: req.MetricsView}, false)
+		if err != nil {
+			if errors.Is(err, drivers.ErrResourceNotFound) {
+				return nil, status.Errorf(codes.NotFound, "metrics view %q not found", req.MetricsView)
+			}
+			return nil, err
+		}
+	}
+
+	s.runtime.InvalidateQueryCache(ctx, req.InstanceId, req.MetricsView)
+
+	return &runtimev1.InvalidateQueryCacheResponse{}, nil
+}
+
+// PlanProject implements runtimev1.RuntimeServiceServer
+func (s *Server) PlanProject(ctx context.Context, req *runtimev1.PlanProjectRequest) (*runtimev1.PlanProjectResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Int("args.files", len(req.Files)),
+	)
+
+	claims := auth.GetClaims(ctx, req.InstanceId)
+	if !claims.Can(runtime.ReadObjects) || !claims.Can(runtime.ReadRepo) {
+		return nil, ErrForbidden
+	}
+
+	plan, err := s.runtime.PlanProject(ctx, req.InstanceId, req.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.PlanProjectResponse{
+		Changes:     plan.Changes,
+		ParseErrors: plan.ParseErrors,
+	}, nil
+}
+
 // modelPartitionsToPB converts a slice of drivers.ModelPartition to a slice of runtimev1.ModelPartition.
 func modelPartitionsToPB(partitions []drivers.ModelPartition) []*runtimev1.ModelPartition {
 	pbs := make([]*runtimev1.ModelPartition, len(partitions))
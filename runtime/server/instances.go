@@ -197,6 +197,7 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 func (s *Server) DeleteInstance(ctx context.Context, req *runtimev1.DeleteInstanceRequest) (*runtimev1.DeleteInstanceResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Bool("args.keep_storage", req.KeepStorage),
 	)
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
 
@@ -204,7 +205,7 @@ func (s *Server) DeleteInstance(ctx context.Context, req *runtimev1.DeleteInstan
 		return nil, ErrForbidden
 	}
 
-	err := s.runtime.DeleteInstance(ctx, req.InstanceId)
+	err := s.runtime.DeleteInstance(ctx, req.InstanceId, req.KeepStorage)
 	if err != nil {
 		return nil, err
 	}
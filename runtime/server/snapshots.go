@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateSnapshot implements runtimev1.RuntimeServiceServer
+func (s *Server) CreateSnapshot(ctx context.Context, req *runtimev1.CreateSnapshotRequest) (*runtimev1.CreateSnapshotResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.snapshot_id", req.SnapshotId),
+	)
+
+	if !auth.GetClaims(ctx, req.InstanceId).Can(runtime.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	info, err := s.runtime.CreateSnapshot(ctx, req.InstanceId, req.SnapshotId)
+	if err != nil {
+		return nil, snapshotErr(err)
+	}
+
+	return &runtimev1.CreateSnapshotResponse{
+		Tables:    info.Tables,
+		SizeBytes: info.SizeBytes,
+		Resources: int32(info.Resources),
+		CreatedOn: timestamppb.New(info.CreatedOn),
+	}, nil
+}
+
+// RestoreSnapshot implements runtimev1.RuntimeServiceServer
+func (s *Server) RestoreSnapshot(ctx context.Context, req *runtimev1.RestoreSnapshotRequest) (*runtimev1.RestoreSnapshotResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.snapshot_id", req.SnapshotId),
+	)
+
+	if !auth.GetClaims(ctx, req.InstanceId).Can(runtime.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	err := s.runtime.RestoreSnapshot(ctx, req.InstanceId, req.SnapshotId)
+	if err != nil {
+		return nil, snapshotErr(err)
+	}
+
+	return &runtimev1.RestoreSnapshotResponse{}, nil
+}
+
+// DeleteSnapshot implements runtimev1.RuntimeServiceServer
+func (s *Server) DeleteSnapshot(ctx context.Context, req *runtimev1.DeleteSnapshotRequest) (*runtimev1.DeleteSnapshotResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.snapshot_id", req.SnapshotId),
+	)
+
+	if !auth.GetClaims(ctx, req.InstanceId).Can(runtime.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	err := s.runtime.DeleteSnapshot(ctx, req.InstanceId, req.SnapshotId)
+	if err != nil {
+		return nil, snapshotErr(err)
+	}
+
+	return &runtimev1.DeleteSnapshotResponse{}, nil
+}
+
+// snapshotErr maps errors from the runtime's snapshot functions to gRPC status errors.
+func snapshotErr(err error) error {
+	if errors.Is(err, runtime.ErrSnapshotsNotSupported) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, runtime.ErrSnapshotNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,357 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/rduckdb"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrSnapshotsNotSupported is returned when snapshots are requested for an instance that does not support them.
+// Snapshots are currently only supported for instances that use DuckDB as the OLAP connector with backups enabled.
+var ErrSnapshotsNotSupported = errors.New("snapshots are not supported for this instance")
+
+// ErrSnapshotNotFound is returned when restoring a snapshot that does not exist.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// snapshotsPrefix is the path in the instance's bucket under which snapshots are stored.
+// Snapshots are removed together with the rest of the instance's data when the instance is deleted.
+const snapshotsPrefix = "_snapshots"
+
+// snapshotCatalogKey is the key of the serialized catalog in a snapshot.
+const snapshotCatalogKey = "catalog.json"
+
+var snapshotIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// SnapshotInfo describes a snapshot created by CreateSnapshot.
+type SnapshotInfo struct {
+	ID        string
+	Tables    []string
+	SizeBytes int64
+	Resources int
+	CreatedOn time.Time
+}
+
+// snapshotCatalog is the serialized catalog stored in a snapshot.
+type snapshotCatalog struct {
+	Resources       []*snapshotResource                  `json:"resources"`
+	ModelPartitions map[string][]*drivers.ModelPartition `json:"model_partitions"`
+}
+
+type snapshotResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Data []byte `json:"data"`
+}
+
+// CreateSnapshot takes a point-in-time snapshot of an instance's OLAP data and catalog and stores it in the instance's bucket.
+// The snapshot can be restored with RestoreSnapshot.
+// The snapshot ID is chosen by the caller and must only contain letters, digits, underscores and dashes.
+func (r *Runtime) CreateSnapshot(ctx context.Context, instanceID, snapshotID string) (*SnapshotInfo, error) {
+	src, release, err := r.openSnapshotSource(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	dst, err := r.openSnapshotBucket(ctx, instanceID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	// Capture the catalog before the data.
+	// Tables written after the catalog is captured will be re-executed when the snapshot is restored,
+	// since the restored resources won't reference their new versions.
+	catalog, release, err := r.Catalog(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	sc := &snapshotCatalog{ModelPartitions: make(map[string][]*drivers.ModelPartition)}
+	rs, err := catalog.FindResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range rs {
+		sc.Resources = append(sc.Resources, &snapshotResource{Kind: res.Kind, Name: res.Name, Data: res.Data})
+
+		if res.Kind != ResourceKindModel {
+			continue
+		}
+		pb := &runtimev1.Resource{}
+		err = proto.Unmarshal(res.Data, pb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal resource %q: %w", res.Name, err)
+		}
+		modelID := pb.GetModel().GetState().GetPartitionsModelId()
+		if modelID == "" {
+			continue
+		}
+		partitions, err := catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: modelID})
+		if err != nil {
+			return nil, err
+		}
+		for i := range partitions {
+			sc.ModelPartitions[modelID] = append(sc.ModelPartitions[modelID], &partitions[i])
+		}
+	}
+
+	backup, err := rduckdb.Backup(ctx, src, dst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot OLAP data: %w", err)
+	}
+
+	data, err := json.Marshal(sc)
+	if err != nil {
+		return nil, err
+	}
+	err = dst.WriteAll(ctx, snapshotCatalogKey, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write snapshot catalog: %w", err)
+	}
+
+	return &SnapshotInfo{
+		ID:        snapshotID,
+		Tables:    backup.Tables,
+		SizeBytes: backup.SizeBytes,
+		Resources: len(sc.Resources),
+		CreatedOn: backup.CreatedOn,
+	}, nil
+}
+
+// RestoreSnapshot replaces an instance's OLAP data and catalog with a snapshot created by CreateSnapshot.
+// It stops the instance's controller for the duration of the restore and restarts it afterwards.
+// Since the instance's repo is not changed, resources whose spec differs from the snapshot are reconciled when the controller restarts.
+// The snapshot's catalog is validated before anything is changed, and the catalog is replaced in a single transaction after the OLAP data has been restored.
+//
+// The OLAP data is restored one table at a time, so the restore is not atomic.
+// If it fails midway, the catalog is left unchanged, but some tables may already have been restored, so the instance can serve a mix of old and restored data.
+// Retrying the restore completes it, since tables that were already restored are skipped.
+//
+// Once started, the restore runs to completion even if ctx is cancelled. In that case, ctx's error is returned and the outcome is only logged.
+func (r *Runtime) RestoreSnapshot(ctx context.Context, instanceID, snapshotID string) error {
+	// Check the snapshot exists and is valid before stopping the controller.
+	_, release, err := r.openSnapshotSource(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	release()
+	sc, err := r.readSnapshotCatalog(ctx, instanceID, snapshotID)
+	if err != nil {
+		return err
+	}
+	err = sc.validate()
+	if err != nil {
+		return fmt.Errorf("invalid snapshot %q: %w", snapshotID, err)
+	}
+
+	// The restore runs in the background if ctx is cancelled, so it must not use resources that are released when this function returns.
+	return r.registryCache.runWithControllerStopped(ctx, instanceID, func(ctx context.Context) error {
+		err := r.restoreSnapshot(ctx, instanceID, snapshotID, sc)
+		if err != nil {
+			r.Logger.Warn("failed to restore snapshot", zap.String("instance_id", instanceID), zap.String("snapshot_id", snapshotID), zap.Error(err))
+			return err
+		}
+
+		r.InvalidateQueryCache(ctx, instanceID, "")
+		r.Logger.Info("restored snapshot", zap.String("instance_id", instanceID), zap.String("snapshot_id", snapshotID))
+		return nil
+	})
+}
+
+// restoreSnapshot replaces the OLAP data and catalog of an instance with a snapshot. See RestoreSnapshot for details.
+// It must be called while the instance's controller is stopped.
+func (r *Runtime) restoreSnapshot(ctx context.Context, instanceID, snapshotID string, sc *snapshotCatalog) error {
+	remote, release, err := r.openSnapshotSource(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	src, err := r.openSnapshotBucket(ctx, instanceID, snapshotID)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	_, err = rduckdb.Restore(ctx, src, remote)
+	if err != nil {
+		if errors.Is(err, rduckdb.ErrBackupNotFound) {
+			return ErrSnapshotNotFound
+		}
+		return fmt.Errorf("failed to restore OLAP data: %w", err)
+	}
+
+	catalog, release, err := r.Catalog(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// Replace the current catalog, including the partitions of current models, with the snapshot's catalog.
+	v, err := catalog.NextControllerVersion(ctx)
+	if err != nil {
+		return err
+	}
+	rs := make([]drivers.Resource, len(sc.Resources))
+	for i, res := range sc.Resources {
+		rs[i] = drivers.Resource{Kind: res.Kind, Name: res.Name, Data: res.Data}
+	}
+	partitions := make(map[string][]drivers.ModelPartition, len(sc.ModelPartitions))
+	for modelID, ps := range sc.ModelPartitions {
+		for _, p := range ps {
+			partitions[modelID] = append(partitions[modelID], *p)
+		}
+	}
+	err = catalog.ReplaceResources(ctx, v, rs, partitions)
+	if err != nil {
+		return fmt.Errorf("failed to restore catalog: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshot deletes a snapshot created by CreateSnapshot. It does not return an error if the snapshot does not exist.
+func (r *Runtime) DeleteSnapshot(ctx context.Context, instanceID, snapshotID string) error {
+	bkt, err := r.openSnapshotBucket(ctx, instanceID, snapshotID)
+	if err != nil {
+		return err
+	}
+	defer bkt.Close()
+
+	iter := bkt.List(&blob.ListOptions{})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		err = bkt.Delete(ctx, obj.Key)
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+	}
+}
+
+// readSnapshotCatalog reads the catalog stored in a snapshot.
+func (r *Runtime) readSnapshotCatalog(ctx context.Context, instanceID, snapshotID string) (*snapshotCatalog, error) {
+	bkt, err := r.openSnapshotBucket(ctx, instanceID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	defer bkt.Close()
+
+	data, err := bkt.ReadAll(ctx, snapshotCatalogKey)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, err
+	}
+	sc := &snapshotCatalog{}
+	err = json.Unmarshal(data, sc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot catalog: %w", err)
+	}
+	return sc, nil
+}
+
+// validate checks that the snapshot's catalog can be restored.
+func (sc *snapshotCatalog) validate() error {
+	names := make(map[string]bool, len(sc.Resources))
+	models := make(map[string]bool)
+	for _, res := range sc.Resources {
+		if res.Kind == "" || res.Name == "" {
+			return fmt.Errorf("resource without kind or name")
+		}
+		key := res.Kind + "/" + strings.ToLower(res.Name)
+		if names[key] {
+			return fmt.Errorf("duplicate resource %q", res.Name)
+		}
+		names[key] = true
+
+		pb := &runtimev1.Resource{}
+		err := proto.Unmarshal(res.Data, pb)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal resource %q: %w", res.Name, err)
+		}
+		if modelID := pb.GetModel().GetState().GetPartitionsModelId(); modelID != "" {
+			models[modelID] = true
+		}
+	}
+	for modelID, partitions := range sc.ModelPartitions {
+		if !models[modelID] {
+			return fmt.Errorf("partitions of unknown model %q", modelID)
+		}
+		for _, p := range partitions {
+			if p == nil || p.Key == "" {
+				return fmt.Errorf("partition without key for model %q", modelID)
+			}
+		}
+	}
+	return nil
+}
+
+// openSnapshotSource opens the remote bucket that the instance's OLAP connector backs up its tables to.
+// It returns ErrSnapshotsNotSupported if the OLAP connector is not DuckDB or does not have backups enabled.
+func (r *Runtime) openSnapshotSource(ctx context.Context, instanceID string) (*blob.Bucket, func(), error) {
+	inst, err := r.Instance(ctx, instanceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	olapConnector := inst.ResolveOLAPConnector()
+	cfg, err := r.ConnectorConfig(ctx, instanceID, olapConnector)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg.Driver != "duckdb" {
+		return nil, nil, ErrSnapshotsNotSupported
+	}
+	enabled, _ := strconv.ParseBool(fmt.Sprint(cfg.Resolve()["enable_backups"]))
+	if !enabled {
+		return nil, nil, ErrSnapshotsNotSupported
+	}
+
+	bkt, ok, err := r.storage.WithPrefix(instanceID, olapConnector).OpenBucket(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, ErrSnapshotsNotSupported
+	}
+	return bkt, func() { _ = bkt.Close() }, nil
+}
+
+// openSnapshotBucket opens the bucket that a snapshot is stored in.
+func (r *Runtime) openSnapshotBucket(ctx context.Context, instanceID, snapshotID string) (*blob.Bucket, error) {
+	if !snapshotIDRegexp.MatchString(snapshotID) {
+		return nil, fmt.Errorf("invalid snapshot ID %q", snapshotID)
+	}
+
+	bkt, ok, err := r.storage.WithPrefix(instanceID).OpenBucket(ctx, snapshotsPrefix, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSnapshotsNotSupported
+	}
+	return bkt, nil
+}
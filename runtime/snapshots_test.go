@@ -0,0 +1,87 @@
+package runtime_test
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotCreateRestore(t *testing.T) {
+	files := map[string]string{
+		"models/foo.yaml": `
+type: model
+materialize: true
+sql: SELECT range AS id FROM range(3)
+`,
+	}
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:         files,
+		EnableBackups: true,
+	})
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 3)
+	foo := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "foo")
+
+	ctx := t.Context()
+	info, err := rt.CreateSnapshot(ctx, id, "before")
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo"}, info.Tables)
+	require.Equal(t, 2, info.Resources)
+
+	// Change the model's data and add a model
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"models/foo.yaml": `
+type: model
+materialize: true
+sql: SELECT range AS id FROM range(5)
+`,
+		"models/bar.yaml": `
+type: model
+materialize: true
+sql: SELECT 1 AS id
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 5)
+	testruntime.RequireOLAPTable(t, rt, id, "bar")
+
+	// Revert the repo so that the restored resources match the project files and are not re-executed
+	testruntime.PutFiles(t, rt, id, files)
+	testruntime.DeleteFiles(t, rt, id, "models/bar.yaml")
+
+	// Restoring a snapshot that doesn't exist fails without changing anything
+	err = rt.RestoreSnapshot(ctx, id, "missing")
+	require.ErrorIs(t, err, runtime.ErrSnapshotNotFound)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 5)
+
+	err = rt.RestoreSnapshot(ctx, id, "before")
+	require.NoError(t, err)
+	ctrl, err := rt.Controller(ctx, id)
+	require.NoError(t, err)
+	require.NoError(t, ctrl.WaitUntilIdle(ctx, false))
+
+	// Check the catalog was restored
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	restored := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "foo")
+	require.Equal(t, foo.GetModel().State.ResultTable, restored.GetModel().State.ResultTable)
+	require.Equal(t, foo.GetModel().State.RefreshedOn.AsTime(), restored.GetModel().State.RefreshedOn.AsTime())
+	_, err = ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}, false)
+	require.Error(t, err)
+
+	// Check the data was restored
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 3)
+	testruntime.RequireNoOLAPTable(t, rt, id, "bar")
+
+	// Restoring again is a no-op
+	err = rt.RestoreSnapshot(ctx, id, "before")
+	require.NoError(t, err)
+	ctrl, err = rt.Controller(ctx, id)
+	require.NoError(t, err)
+	require.NoError(t, ctrl.WaitUntilIdle(ctx, false))
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 3)
+}
@@ -19,9 +19,13 @@ type Client struct {
 	dataDirPath  string
 	tempDirPath  string
 	bucketConfig *gcsBucketConfig
+	openBucket   BucketOpener
 	prefixes     []string
 }
 
+// BucketOpener opens the bucket used as a client's remote storage.
+type BucketOpener func(ctx context.Context) (*blob.Bucket, error)
+
 func New(dataDir string, bucketCfg map[string]any) (*Client, error) {
 	tempDirPath, err := os.MkdirTemp("", "rill")
 	if err != nil {
@@ -39,10 +43,22 @@ func New(dataDir string, bucketCfg map[string]any) (*Client, error) {
 			return nil, err
 		}
 		c.bucketConfig = gcsBucketConfig
+		c.openBucket = c.openGCSBucket
 	}
 	return c, nil
 }
 
+// NewWithBucket returns a client that uses the bucket opened by openBucket as its remote storage.
+// It is useful for tests that need a bucket without access to GCS.
+func NewWithBucket(dataDir string, openBucket BucketOpener) (*Client, error) {
+	c, err := New(dataDir, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.openBucket = openBucket
+	return c, nil
+}
+
 func MustNew(dataDir string, bucketCfg map[string]any) *Client {
 	c, err := New(dataDir, bucketCfg)
 	if err != nil {
@@ -56,6 +72,7 @@ func (c *Client) WithPrefix(prefix ...string) *Client {
 		dataDirPath:  c.dataDirPath,
 		tempDirPath:  c.tempDirPath,
 		bucketConfig: c.bucketConfig,
+		openBucket:   c.openBucket,
 	}
 	newClient.prefixes = append(newClient.prefixes, c.prefixes...)
 	newClient.prefixes = append(newClient.prefixes, prefix...)
@@ -67,11 +84,8 @@ func (c *Client) RemovePrefix(ctx context.Context, prefix ...string) error {
 		return fmt.Errorf("storage: RemovePrefix is not supported for prefixed client")
 	}
 
-	// clean data dir
-	removeErr := os.RemoveAll(c.path(c.dataDirPath, prefix...))
-
-	// clean temp dir
-	removeErr = errors.Join(removeErr, os.RemoveAll(c.path(c.tempDirPath, prefix...)))
+	// clean data and temp dirs
+	removeErr := c.RemoveLocalPrefix(prefix...)
 
 	// clean bucket
 	bkt, ok, err := c.OpenBucket(ctx, prefix...)
@@ -100,6 +114,19 @@ func (c *Client) RemovePrefix(ctx context.Context, prefix ...string) error {
 	return removeErr
 }
 
+// RemoveLocalPrefix removes the data and temp dirs for the prefix, but keeps the data in the remote bucket (if any).
+func (c *Client) RemoveLocalPrefix(prefix ...string) error {
+	if c.prefixes != nil {
+		return fmt.Errorf("storage: RemoveLocalPrefix is not supported for prefixed client")
+	}
+
+	// clean data dir
+	removeErr := os.RemoveAll(c.path(c.dataDirPath, prefix...))
+
+	// clean temp dir
+	return errors.Join(removeErr, os.RemoveAll(c.path(c.tempDirPath, prefix...)))
+}
+
 func (c *Client) DataDir(elem ...string) (string, error) {
 	path := c.path(c.dataDirPath, elem...)
 	err := os.MkdirAll(path, os.ModePerm)
@@ -131,18 +158,12 @@ func (c *Client) RandomTempDir(pattern string, elem ...string) (string, error) {
 }
 
 func (c *Client) OpenBucket(ctx context.Context, elem ...string) (*blob.Bucket, bool, error) {
-	if c.bucketConfig == nil {
+	if c.openBucket == nil {
 		return nil, false, nil
 	}
-	// Init dataBucket
-	client, err := c.newGCPClient(ctx)
+	bucket, err := c.openBucket(ctx)
 	if err != nil {
-		return nil, false, fmt.Errorf("could not create GCP client: %w", err)
-	}
-
-	bucket, err := gcsblob.OpenBucket(ctx, client, c.bucketConfig.Bucket, nil)
-	if err != nil {
-		return nil, false, fmt.Errorf("failed to open bucket %q: %w", c.bucketConfig.Bucket, err)
+		return nil, false, err
 	}
 	var prefix string
 	for _, p := range c.prefixes {
@@ -166,6 +187,19 @@ func (c *Client) path(base string, elem ...string) string {
 	return filepath.Join(paths...)
 }
 
+func (c *Client) openGCSBucket(ctx context.Context) (*blob.Bucket, error) {
+	client, err := c.newGCPClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not create GCP client: %w", err)
+	}
+
+	bucket, err := gcsblob.OpenBucket(ctx, client, c.bucketConfig.Bucket, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bucket %q: %w", c.bucketConfig.Bucket, err)
+	}
+	return bucket, nil
+}
+
 func (c *Client) newGCPClient(ctx context.Context) (*gcp.HTTPClient, error) {
 	creds, err := gcputil.Credentials(ctx, c.bucketConfig.GoogleApplicationCredentialsJSON, false)
 	if err != nil {
@@ -1,11 +1,14 @@
 package storage
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
 )
 
 func TestClient_DataDir(t *testing.T) {
@@ -109,3 +112,36 @@ func TestClient_RandomTempDir(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_OpenBucket(t *testing.T) {
+	ctx := t.Context()
+
+	// Without a bucket
+	client, err := New(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, ok, err := client.OpenBucket(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// With a bucket
+	bucketDir := t.TempDir()
+	client, err = NewWithBucket(t.TempDir(), func(ctx context.Context) (*blob.Bucket, error) {
+		return fileblob.OpenBucket(bucketDir, &fileblob.Options{NoTempDir: true})
+	})
+	require.NoError(t, err)
+
+	bkt, ok, err := client.WithPrefix("instance").OpenBucket(ctx, "data")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, bkt.WriteAll(ctx, "file.txt", []byte("hello"), nil))
+	require.NoError(t, bkt.Close())
+
+	data, err := os.ReadFile(filepath.Join(bucketDir, "instance", "data", "file.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(data))
+
+	// Removing the prefix removes the data in the bucket
+	require.NoError(t, client.RemovePrefix(ctx, "instance"))
+	_, err = os.Stat(filepath.Join(bucketDir, "instance", "data", "file.txt"))
+	require.True(t, os.IsNotExist(err))
+}
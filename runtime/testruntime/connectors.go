@@ -1,9 +1,14 @@
 package testruntime
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"database/sql"
+	"encoding/pem"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -22,6 +27,7 @@ import (
 	"github.com/testcontainers/testcontainers-go/modules/azure/azurite"
 	"github.com/testcontainers/testcontainers-go/modules/mysql"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"golang.org/x/crypto/ssh"
 )
 
 // AcquireConnector acquires a test connector by name.
@@ -305,6 +311,82 @@ var Connectors = map[string]ConnectorAcquireFunc{
 
 		return map[string]string{"dsn": dsn}
 	},
+	// trino starts a single-node Trino test container.
+	// It uses the built-in "memory" catalog, so tests can create the tables they need, and also has the read-only "tpch" catalog.
+	"trino": func(t TestingT) map[string]string {
+		ctx := context.Background()
+		trino, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+			ContainerRequest: testcontainers.ContainerRequest{
+				Image:        "trinodb/trino:476",
+				ExposedPorts: []string{"8080/tcp"},
+				WaitingFor:   wait.ForLog("SERVER STARTED").WithStartupTimeout(3 * time.Minute),
+			},
+			Started: true,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := trino.Terminate(ctx)
+			require.NoError(t, err)
+		})
+
+		host, err := trino.Host(ctx)
+		require.NoError(t, err)
+		port, err := trino.MappedPort(ctx, "8080/tcp")
+		require.NoError(t, err)
+
+		return map[string]string{
+			"dsn": fmt.Sprintf("http://test@%s:%s?catalog=memory&schema=default", host, port.Port()),
+		}
+	},
+	// kafka starts a single-node Redpanda cluster, which is Kafka API-compatible and includes a schema registry.
+	"kafka": func(t TestingT) map[string]string {
+		ctx := context.Background()
+		redpanda, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+			ContainerRequest: testcontainers.ContainerRequest{
+				Image:        "docker.redpanda.com/redpandadata/redpanda:v24.2.4",
+				ExposedPorts: []string{"9092/tcp", "8081/tcp"},
+				// The broker must advertise the mapped port, which is only known after the container starts.
+				// So we wait for a start script that is copied into the container after it has started.
+				Entrypoint: []string{"/bin/sh"},
+				Cmd:        []string{"-c", "while [ ! -f /start.sh ]; do sleep 0.1; done; /start.sh"},
+				LifecycleHooks: []testcontainers.ContainerLifecycleHooks{{
+					PostStarts: []testcontainers.ContainerHook{
+						func(ctx context.Context, c testcontainers.Container) error {
+							host, err := c.Host(ctx)
+							if err != nil {
+								return err
+							}
+							port, err := c.MappedPort(ctx, "9092/tcp")
+							if err != nil {
+								return err
+							}
+							script := fmt.Sprintf("#!/bin/sh\nrpk redpanda start --mode dev-container --smp 1 --kafka-addr 0.0.0.0:9092 --advertise-kafka-addr %s:%s --schema-registry-addr 0.0.0.0:8081\n", host, port.Port())
+							return c.CopyToContainer(ctx, []byte(script), "/start.sh", 0o755)
+						},
+					},
+				}},
+				WaitingFor: wait.ForLog("Successfully started Redpanda!").WithStartupTimeout(2 * time.Minute),
+			},
+			Started: true,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := redpanda.Terminate(ctx)
+			require.NoError(t, err)
+		})
+
+		host, err := redpanda.Host(ctx)
+		require.NoError(t, err)
+		brokerPort, err := redpanda.MappedPort(ctx, "9092/tcp")
+		require.NoError(t, err)
+		registryPort, err := redpanda.MappedPort(ctx, "8081/tcp")
+		require.NoError(t, err)
+
+		return map[string]string{
+			"brokers":             fmt.Sprintf("%s:%s", host, brokerPort.Port()),
+			"schema_registry_url": fmt.Sprintf("http://%s:%s", host, registryPort.Port()),
+		}
+	},
 	"https": func(t TestingT) map[string]string {
 		_, currentFile, _, _ := goruntime.Caller(0)
 		testdataPath := filepath.Join(currentFile, "..", "testdata")
@@ -326,6 +408,80 @@ var Connectors = map[string]ConnectorAcquireFunc{
 			"endpoint": server.URL + pagePath,
 		}
 	},
+	// sftp starts an SFTP server with the files in testdata/init_data/azure and a writable "upload" directory.
+	// The returned properties include both password and private key credentials along with the server's host key.
+	"sftp": func(t TestingT) map[string]string {
+		ctx := context.Background()
+
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		signer, err := ssh.NewSignerFromKey(privateKey)
+		require.NoError(t, err)
+		privateKeyPEM, err := ssh.MarshalPrivateKey(privateKey, "")
+		require.NoError(t, err)
+
+		_, currentFile, _, _ := goruntime.Caller(0)
+		testdataPath := filepath.Join(currentFile, "..", "testdata")
+		initDataPath := filepath.Join(testdataPath, "init_data", "azure")
+		entries, err := os.ReadDir(initDataPath)
+		require.NoError(t, err)
+		files := []testcontainers.ContainerFile{{
+			Reader:            bytes.NewReader(ssh.MarshalAuthorizedKey(signer.PublicKey())),
+			ContainerFilePath: "/home/rill/.ssh/keys/id.pub",
+			FileMode:          0o644,
+		}}
+		for _, e := range entries {
+			files = append(files, testcontainers.ContainerFile{
+				HostFilePath:      filepath.Join(initDataPath, e.Name()),
+				ContainerFilePath: "/home/rill/" + e.Name(),
+				FileMode:          0o755,
+			})
+		}
+
+		sftpContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+			ContainerRequest: testcontainers.ContainerRequest{
+				Image:        "atmoz/sftp:alpine",
+				ExposedPorts: []string{"22/tcp"},
+				Cmd:          []string{"rill:rill:::upload"},
+				Files:        files,
+				WaitingFor:   wait.ForListeningPort("22/tcp").WithStartupTimeout(time.Minute),
+			},
+			Started: true,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := sftpContainer.Terminate(ctx)
+			require.NoError(t, err)
+		})
+
+		host, err := sftpContainer.Host(ctx)
+		require.NoError(t, err)
+		port, err := sftpContainer.MappedPort(ctx, "22/tcp")
+		require.NoError(t, err)
+
+		// Capture the host key generated by the server on startup.
+		var hostKey ssh.PublicKey
+		client, err := ssh.Dial("tcp", net.JoinHostPort(host, port.Port()), &ssh.ClientConfig{
+			User: "rill",
+			Auth: []ssh.AuthMethod{ssh.Password("rill")},
+			HostKeyCallback: func(_ string, _ net.Addr, key ssh.PublicKey) error {
+				hostKey = key
+				return nil
+			},
+			Timeout: 10 * time.Second,
+		})
+		require.NoError(t, err)
+		require.NoError(t, client.Close())
+
+		return map[string]string{
+			"host":        host,
+			"port":        port.Port(),
+			"user":        "rill",
+			"password":    "rill",
+			"private_key": string(pem.EncodeToMemory(privateKeyPEM)),
+			"host_key":    string(ssh.MarshalAuthorizedKey(hostKey)),
+		}
+	},
 	"openai": func(t TestingT) map[string]string {
 		loadDotEnv(t)
 		apiKey := os.Getenv("RILL_RUNTIME_OPENAI_TEST_API_KEY")
@@ -24,6 +24,8 @@ import (
 	"github.com/rilldata/rill/runtime/testruntime/testmode"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	// Load database drivers for testing.
@@ -33,20 +35,24 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/claude"
 	_ "github.com/rilldata/rill/runtime/drivers/clickhouse"
 	_ "github.com/rilldata/rill/runtime/drivers/databricks"
+	_ "github.com/rilldata/rill/runtime/drivers/doris"
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/gemini"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/kafka"
 	_ "github.com/rilldata/rill/runtime/drivers/mock/ai"
 	_ "github.com/rilldata/rill/runtime/drivers/openai"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/redshift"
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
+	_ "github.com/rilldata/rill/runtime/drivers/sftp"
 	_ "github.com/rilldata/rill/runtime/drivers/snowflake"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 	_ "github.com/rilldata/rill/runtime/drivers/starrocks"
+	_ "github.com/rilldata/rill/runtime/drivers/trino"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 )
 
@@ -63,6 +69,11 @@ type TestingT interface {
 
 // New returns a runtime configured for use in tests.
 func New(t TestingT, allowHostAccess bool) *runtime.Runtime {
+	return newWithStorage(t, allowHostAccess, storage.MustNew(t.TempDir(), nil))
+}
+
+// newWithStorage returns a runtime configured for use in tests that uses the given storage client.
+func newWithStorage(t TestingT, allowHostAccess bool, st *storage.Client) *runtime.Runtime {
 	ctx := t.Context()
 	opts := &runtime.Options{
 		MetastoreConnector: "metastore",
@@ -91,7 +102,7 @@ func New(t TestingT, allowHostAccess bool) *runtime.Runtime {
 		require.NoError(t, err)
 	}
 
-	rt, err := runtime.New(ctx, opts, logger, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), email.New(email.NewTestSender()))
+	rt, err := runtime.New(ctx, opts, logger, st, activity.NewNoopClient(), email.New(email.NewTestSender()))
 	require.NoError(t, err)
 	t.Cleanup(func() { rt.Close() })
 
@@ -108,12 +119,23 @@ type InstanceOptions struct {
 	AIConnector       string // Options: "" (none), "openai", "claude"
 	TestConnectors    []string
 	FrontendURL       string
+	// EnableBackups configures the runtime's storage with a bucket in a local temp directory and enables backups of the DuckDB OLAP connector.
+	EnableBackups bool
 }
 
 // NewInstanceWithOptions creates a runtime and an instance for use in tests.
 // The instance's repo is a temp directory that will be cleared when the tests finish.
 func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime, string) {
-	rt := New(t, !opts.DisableHostAccess)
+	st := storage.MustNew(t.TempDir(), nil)
+	if opts.EnableBackups {
+		bucketDir := t.TempDir()
+		var err error
+		st, err = storage.NewWithBucket(t.TempDir(), func(ctx context.Context) (*blob.Bucket, error) {
+			return fileblob.OpenBucket(bucketDir, &fileblob.Options{NoTempDir: true})
+		})
+		require.NoError(t, err)
+	}
+	rt := newWithStorage(t, !opts.DisableHostAccess, st)
 	ctx := t.Context()
 
 	olapDriver := os.Getenv("RILL_RUNTIME_TEST_OLAP_DRIVER")
@@ -124,6 +146,10 @@ func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime,
 	if olapDSN == "" {
 		olapDSN = ":memory:"
 	}
+	olapConfig := map[string]any{"dsn": olapDSN, "mode": "readwrite"}
+	if opts.EnableBackups {
+		olapConfig["enable_backups"] = true
+	}
 
 	vars := make(map[string]string)
 	maps.Copy(vars, opts.Variables)
@@ -178,7 +204,7 @@ func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime,
 			{
 				Type:   olapDriver,
 				Name:   olapDriver,
-				Config: Must(structpb.NewStruct(map[string]any{"dsn": olapDSN, "mode": "readwrite"})),
+				Config: Must(structpb.NewStruct(olapConfig)),
 			},
 			{
 				Type: "sqlite",
@@ -13,7 +13,7 @@ import (
 )
 
 // DuckDB extensions Rill depends on
-var extensions = []string{"json", "icu", "parquet", "httpfs", "sqlite_scanner", "spatial", "motherduck"}
+var extensions = []string{"json", "icu", "parquet", "httpfs", "sqlite_scanner", "spatial", "motherduck", "delta"}
 
 // DuckDB platforms to download extensions for
 var platforms = []string{"linux_amd64", "linux_arm64", "osx_amd64", "osx_arm64"}
@@ -37,10 +37,12 @@ import type {
   AdminServiceCreateManagedGitRepoBody,
   AdminServiceCreatePersonalFileBody,
   AdminServiceCreateProjectBody,
+  AdminServiceCreateProjectSnapshotBodyBody,
   AdminServiceCreateProjectWhitelistedDomainBodyBody,
   AdminServiceCreateReportBodyBody,
   AdminServiceCreateServiceBody,
   AdminServiceCreateUsergroupBody,
+  AdminServiceDeleteOrganizationBudgetParams,
   AdminServiceDeleteUserParams,
   AdminServiceDeleteVirtualFileParams,
   AdminServiceEditPersonalFileBody,
@@ -52,6 +54,7 @@ import type {
   AdminServiceGetDeploymentCredentialsBody,
   AdminServiceGetGithubRepoStatusParams,
   AdminServiceGetIFrameBody,
+  AdminServiceGetOrganizationBudgetParams,
   AdminServiceGetOrganizationParams,
   AdminServiceGetPaymentsPortalURLParams,
   AdminServiceGetProjectParams,
@@ -63,6 +66,7 @@ import type {
   AdminServiceHibernateProjectParams,
   AdminServiceIssueMagicAuthTokenBody,
   AdminServiceIssueUserAuthTokenBody,
+  AdminServiceListAuditEventsParams,
   AdminServiceListBookmarksParams,
   AdminServiceListDeploymentsParams,
   AdminServiceListMagicAuthTokensParams,
@@ -74,6 +78,7 @@ import type {
   AdminServiceListProjectInvitesParams,
   AdminServiceListProjectMemberUsergroupsParams,
   AdminServiceListProjectMemberUsersParams,
+  AdminServiceListProjectSnapshotsParams,
   AdminServiceListProjectsForFingerprintParams,
   AdminServiceListProjectsForOrganizationAndUserParams,
   AdminServiceListProjectsForOrganizationParams,
@@ -99,6 +104,7 @@ import type {
   AdminServiceUnsubscribeAlertBodyBody,
   AdminServiceUpdateBillingSubscriptionBodyBody,
   AdminServiceUpdateOrganizationBody,
+  AdminServiceUpdateOrganizationBudgetBody,
   AdminServiceUpdateOrganizationMemberUserAttributesBody,
   AdminServiceUpdateProjectBody,
   AdminServiceUpdateProjectVariablesBody,
@@ -126,6 +132,7 @@ import type {
   V1CreateOrganizationResponse,
   V1CreatePersonalFileResponse,
   V1CreateProjectResponse,
+  V1CreateProjectSnapshotResponse,
   V1CreateProjectWhitelistedDomainResponse,
   V1CreateReportResponse,
   V1CreateServiceResponse,
@@ -133,6 +140,7 @@ import type {
   V1CreateWhitelistedDomainResponse,
   V1DeleteAlertResponse,
   V1DeleteDeploymentResponse,
+  V1DeleteOrganizationBudgetResponse,
   V1DeleteOrganizationResponse,
   V1DeletePersonalFileResponse,
   V1DeleteProjectResponse,
@@ -164,6 +172,7 @@ import type {
   V1GetGithubRepoStatusResponse,
   V1GetGithubUserStatusResponse,
   V1GetIFrameResponse,
+  V1GetOrganizationBudgetResponse,
   V1GetOrganizationMemberUserResponse,
   V1GetOrganizationNameForDomainResponse,
   V1GetOrganizationResponse,
@@ -187,6 +196,7 @@ import type {
   V1IssueServiceAuthTokenResponse,
   V1IssueUserAuthTokenResponse,
   V1LeaveOrganizationResponse,
+  V1ListAuditEventsResponse,
   V1ListBookmarksResponse,
   V1ListDeploymentsResponse,
   V1ListGithubUserReposResponse,
@@ -201,6 +211,7 @@ import type {
   V1ListProjectMemberServicesResponse,
   V1ListProjectMemberUsergroupsResponse,
   V1ListProjectMemberUsersResponse,
+  V1ListProjectSnapshotsResponse,
   V1ListProjectWhitelistedDomainsResponse,
   V1ListProjectsForFingerprintResponse,
   V1ListProjectsForOrganizationAndUserResponse,
@@ -234,6 +245,7 @@ import type {
   V1RemoveWhitelistedDomainResponse,
   V1RenewBillingSubscriptionResponse,
   V1RequestProjectAccessResponse,
+  V1RestoreProjectSnapshotResponse,
   V1RevokeAllUserAuthTokensResponse,
   V1RevokeCurrentAuthTokenResponse,
   V1RevokeMagicAuthTokenResponse,
@@ -262,6 +274,7 @@ import type {
   V1SudoGrantTrialCreditsResponse,
   V1SudoIssueRuntimeManagerTokenRequest,
   V1SudoIssueRuntimeManagerTokenResponse,
+  V1SudoListRuntimeHostsResponse,
   V1SudoReportUsageRequest,
   V1SudoReportUsageResponse,
   V1SudoTriggerBillingRepairRequest,
@@ -275,6 +288,8 @@ import type {
   V1SudoUpdateOrganizationCustomDomainResponse,
   V1SudoUpdateOrganizationQuotasRequest,
   V1SudoUpdateOrganizationQuotasResponse,
+  V1SudoUpdateRuntimeHostRequest,
+  V1SudoUpdateRuntimeHostResponse,
   V1SudoUpdateUserQuotasRequest,
   V1SudoUpdateUserQuotasResponse,
   V1TriggerReconcileResponse,
@@ -287,6 +302,7 @@ import type {
   V1UpdateBillingSubscriptionResponse,
   V1UpdateBookmarkRequest,
   V1UpdateBookmarkResponse,
+  V1UpdateOrganizationBudgetResponse,
   V1UpdateOrganizationMemberUserAttributesResponse,
   V1UpdateOrganizationResponse,
   V1UpdateProjectResponse,
@@ -2254,6 +2270,111 @@ export const createAdminServiceUpdateOrganization = <
 
   return createMutation(mutationOptions, queryClient);
 };
+/**
+ * @summary ListAuditEvents lists the audit log of an organization, ordered from newest to oldest.
+ */
+export const adminServiceListAuditEvents = (
+  org: string,
+  params?: AdminServiceListAuditEventsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListAuditEventsResponse>({
+    url: `/v1/orgs/${org}/audit-events`,
+    method: "GET",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListAuditEventsQueryKey = (
+  org?: string,
+  params?: AdminServiceListAuditEventsParams,
+) => {
+  return [`/v1/orgs/${org}/audit-events`, ...(params ? [params] : [])] as const;
+};
+
+export const getAdminServiceListAuditEventsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus,
+>(
+  org: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+        TError,
+        TData
+      >
+    >;
+  },
+) => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListAuditEventsQueryKey(org, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+  > = ({ signal }) => adminServiceListAuditEvents(org, params, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!org,
+    ...queryOptions,
+  } as CreateQueryOptions<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+    TError,
+    TData
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+};
+
+export type AdminServiceListAuditEventsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+>;
+export type AdminServiceListAuditEventsQueryError = RpcStatus;
+
+/**
+ * @summary ListAuditEvents lists the audit log of an organization, ordered from newest to oldest.
+ */
+
+export function createAdminServiceListAuditEvents<
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus,
+>(
+  org: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+        TError,
+        TData
+      >
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+} {
+  const queryOptions = getAdminServiceListAuditEventsQueryOptions(
+    org,
+    params,
+    options,
+  );
+
+  const query = createQuery(queryOptions, queryClient) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+}
+
 /**
  * @summary GetBillingCreditBalance returns the organization's remaining trial credit balance
  */
@@ -2946,37 +3067,141 @@ export const createAdminServiceRenewBillingSubscription = <
   return createMutation(mutationOptions, queryClient);
 };
 /**
- * @summary CreateManagedGitRepo creates a new rill managed git repo for the organization.
+ * @summary GetOrganizationBudget returns the organization's monthly budget, if one is configured
  */
-export const adminServiceCreateManagedGitRepo = (
+export const adminServiceGetOrganizationBudget = (
   org: string,
-  adminServiceCreateManagedGitRepoBody: AdminServiceCreateManagedGitRepoBody,
+  params?: AdminServiceGetOrganizationBudgetParams,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1CreateManagedGitRepoResponse>({
-    url: `/v1/orgs/${org}/create-managed-git-repo`,
-    method: "PUT",
-    headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateManagedGitRepoBody,
+  return httpClient<V1GetOrganizationBudgetResponse>({
+    url: `/v1/orgs/${org}/budget`,
+    method: "GET",
+    params,
+    signal,
   });
 };
 
-export const getAdminServiceCreateManagedGitRepoMutationOptions = <
+export const getAdminServiceGetOrganizationBudgetQueryKey = (
+  org?: string,
+  params?: AdminServiceGetOrganizationBudgetParams,
+) => {
+  return [`/v1/orgs/${org}/budget`, ...(params ? [params] : [])] as const;
+};
+
+export const getAdminServiceGetOrganizationBudgetQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>,
+  TError = RpcStatus,
+>(
+  org: string,
+  params?: AdminServiceGetOrganizationBudgetParams,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>,
+        TError,
+        TData
+      >
+    >;
+  },
+) => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetOrganizationBudgetQueryKey(org, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>
+  > = ({ signal }) => adminServiceGetOrganizationBudget(org, params, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!org,
+    ...queryOptions,
+  } as CreateQueryOptions<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>,
+    TError,
+    TData
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+};
+
+export type AdminServiceGetOrganizationBudgetQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>
+>;
+export type AdminServiceGetOrganizationBudgetQueryError = RpcStatus;
+
+/**
+ * @summary GetOrganizationBudget returns the organization's monthly budget, if one is configured
+ */
+
+export function createAdminServiceGetOrganizationBudget<
+  TData = Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>,
+  TError = RpcStatus,
+>(
+  org: string,
+  params?: AdminServiceGetOrganizationBudgetParams,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceGetOrganizationBudget>>,
+        TError,
+        TData
+      >
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+} {
+  const queryOptions = getAdminServiceGetOrganizationBudgetQueryOptions(
+    org,
+    params,
+    options,
+  );
+
+  const query = createQuery(queryOptions, queryClient) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+}
+
+/**
+ * @summary DeleteOrganizationBudget removes the organization's monthly budget
+ */
+export const adminServiceDeleteOrganizationBudget = (
+  org: string,
+  params?: AdminServiceDeleteOrganizationBudgetParams,
+) => {
+  return httpClient<V1DeleteOrganizationBudgetResponse>({
+    url: `/v1/orgs/${org}/budget`,
+    method: "DELETE",
+    params,
+  });
+};
+
+export const getAdminServiceDeleteOrganizationBudgetMutationOptions = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationBudget>>,
     TError,
-    { org: string; data: AdminServiceCreateManagedGitRepoBody },
+    { org: string; params?: AdminServiceDeleteOrganizationBudgetParams },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+  Awaited<ReturnType<typeof adminServiceDeleteOrganizationBudget>>,
   TError,
-  { org: string; data: AdminServiceCreateManagedGitRepoBody },
+  { org: string; params?: AdminServiceDeleteOrganizationBudgetParams },
   TContext
 > => {
-  const mutationKey = ["adminServiceCreateManagedGitRepo"];
+  const mutationKey = ["adminServiceDeleteOrganizationBudget"];
   const { mutation: mutationOptions } = options
     ? options.mutation &&
       "mutationKey" in options.mutation &&
@@ -2986,85 +3211,82 @@ export const getAdminServiceCreateManagedGitRepoMutationOptions = <
     : { mutation: { mutationKey } };
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
-    { org: string; data: AdminServiceCreateManagedGitRepoBody }
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationBudget>>,
+    { org: string; params?: AdminServiceDeleteOrganizationBudgetParams }
   > = (props) => {
-    const { org, data } = props ?? {};
+    const { org, params } = props ?? {};
 
-    return adminServiceCreateManagedGitRepo(org, data);
+    return adminServiceDeleteOrganizationBudget(org, params);
   };
 
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceCreateManagedGitRepoMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>
+export type AdminServiceDeleteOrganizationBudgetMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteOrganizationBudget>>
 >;
-export type AdminServiceCreateManagedGitRepoMutationBody =
-  AdminServiceCreateManagedGitRepoBody;
-export type AdminServiceCreateManagedGitRepoMutationError = RpcStatus;
+
+export type AdminServiceDeleteOrganizationBudgetMutationError = RpcStatus;
 
 /**
- * @summary CreateManagedGitRepo creates a new rill managed git repo for the organization.
+ * @summary DeleteOrganizationBudget removes the organization's monthly budget
  */
-export const createAdminServiceCreateManagedGitRepo = <
+export const createAdminServiceDeleteOrganizationBudget = <
   TError = RpcStatus,
   TContext = unknown,
 >(
   options?: {
     mutation?: CreateMutationOptions<
-      Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+      Awaited<ReturnType<typeof adminServiceDeleteOrganizationBudget>>,
       TError,
-      { org: string; data: AdminServiceCreateManagedGitRepoBody },
+      { org: string; params?: AdminServiceDeleteOrganizationBudgetParams },
       TContext
     >;
   },
   queryClient?: QueryClient,
 ): CreateMutationResult<
-  Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+  Awaited<ReturnType<typeof adminServiceDeleteOrganizationBudget>>,
   TError,
-  { org: string; data: AdminServiceCreateManagedGitRepoBody },
+  { org: string; params?: AdminServiceDeleteOrganizationBudgetParams },
   TContext
 > => {
   const mutationOptions =
-    getAdminServiceCreateManagedGitRepoMutationOptions(options);
+    getAdminServiceDeleteOrganizationBudgetMutationOptions(options);
 
   return createMutation(mutationOptions, queryClient);
 };
 /**
- * @summary CreateAsset returns a one time signed URL using which any asset can be uploaded.
+ * @summary UpdateOrganizationBudget creates or replaces the organization's monthly budget
  */
-export const adminServiceCreateAsset = (
+export const adminServiceUpdateOrganizationBudget = (
   org: string,
-  adminServiceCreateAssetBody: AdminServiceCreateAssetBody,
-  signal?: AbortSignal,
+  adminServiceUpdateOrganizationBudgetBody: AdminServiceUpdateOrganizationBudgetBody,
 ) => {
-  return httpClient<V1CreateAssetResponse>({
-    url: `/v1/orgs/${org}/create_asset`,
-    method: "POST",
+  return httpClient<V1UpdateOrganizationBudgetResponse>({
+    url: `/v1/orgs/${org}/budget`,
+    method: "PUT",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateAssetBody,
-    signal,
+    data: adminServiceUpdateOrganizationBudgetBody,
   });
 };
 
-export const getAdminServiceCreateAssetMutationOptions = <
+export const getAdminServiceUpdateOrganizationBudgetMutationOptions = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationBudget>>,
     TError,
-    { org: string; data: AdminServiceCreateAssetBody },
+    { org: string; data: AdminServiceUpdateOrganizationBudgetBody },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+  Awaited<ReturnType<typeof adminServiceUpdateOrganizationBudget>>,
   TError,
-  { org: string; data: AdminServiceCreateAssetBody },
+  { org: string; data: AdminServiceUpdateOrganizationBudgetBody },
   TContext
 > => {
-  const mutationKey = ["adminServiceCreateAsset"];
+  const mutationKey = ["adminServiceUpdateOrganizationBudget"];
   const { mutation: mutationOptions } = options
     ? options.mutation &&
       "mutationKey" in options.mutation &&
@@ -3074,79 +3296,253 @@ export const getAdminServiceCreateAssetMutationOptions = <
     : { mutation: { mutationKey } };
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceCreateAsset>>,
-    { org: string; data: AdminServiceCreateAssetBody }
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationBudget>>,
+    { org: string; data: AdminServiceUpdateOrganizationBudgetBody }
   > = (props) => {
     const { org, data } = props ?? {};
 
-    return adminServiceCreateAsset(org, data);
+    return adminServiceUpdateOrganizationBudget(org, data);
   };
 
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceCreateAssetMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceCreateAsset>>
+export type AdminServiceUpdateOrganizationBudgetMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateOrganizationBudget>>
 >;
-export type AdminServiceCreateAssetMutationBody = AdminServiceCreateAssetBody;
-export type AdminServiceCreateAssetMutationError = RpcStatus;
+export type AdminServiceUpdateOrganizationBudgetMutationBody =
+  AdminServiceUpdateOrganizationBudgetBody;
+export type AdminServiceUpdateOrganizationBudgetMutationError = RpcStatus;
 
 /**
- * @summary CreateAsset returns a one time signed URL using which any asset can be uploaded.
+ * @summary UpdateOrganizationBudget creates or replaces the organization's monthly budget
  */
-export const createAdminServiceCreateAsset = <
+export const createAdminServiceUpdateOrganizationBudget = <
   TError = RpcStatus,
   TContext = unknown,
 >(
   options?: {
     mutation?: CreateMutationOptions<
-      Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+      Awaited<ReturnType<typeof adminServiceUpdateOrganizationBudget>>,
       TError,
-      { org: string; data: AdminServiceCreateAssetBody },
+      { org: string; data: AdminServiceUpdateOrganizationBudgetBody },
       TContext
     >;
   },
   queryClient?: QueryClient,
 ): CreateMutationResult<
-  Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+  Awaited<ReturnType<typeof adminServiceUpdateOrganizationBudget>>,
   TError,
-  { org: string; data: AdminServiceCreateAssetBody },
+  { org: string; data: AdminServiceUpdateOrganizationBudgetBody },
   TContext
 > => {
-  const mutationOptions = getAdminServiceCreateAssetMutationOptions(options);
+  const mutationOptions =
+    getAdminServiceUpdateOrganizationBudgetMutationOptions(options);
 
   return createMutation(mutationOptions, queryClient);
 };
 /**
- * @summary ListOrganizationInvites lists all the org invites
+ * @summary CreateManagedGitRepo creates a new rill managed git repo for the organization.
  */
-export const adminServiceListOrganizationInvites = (
+export const adminServiceCreateManagedGitRepo = (
   org: string,
-  params?: AdminServiceListOrganizationInvitesParams,
-  signal?: AbortSignal,
+  adminServiceCreateManagedGitRepoBody: AdminServiceCreateManagedGitRepoBody,
 ) => {
-  return httpClient<V1ListOrganizationInvitesResponse>({
-    url: `/v1/orgs/${org}/invites`,
-    method: "GET",
-    params,
-    signal,
+  return httpClient<V1CreateManagedGitRepoResponse>({
+    url: `/v1/orgs/${org}/create-managed-git-repo`,
+    method: "PUT",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateManagedGitRepoBody,
   });
 };
 
-export const getAdminServiceListOrganizationInvitesInfiniteQueryKey = (
-  org?: string,
-  params?: AdminServiceListOrganizationInvitesParams,
-) => {
-  return [
-    "infinite",
-    `/v1/orgs/${org}/invites`,
-    ...(params ? [params] : []),
-  ] as const;
-};
-
-export const getAdminServiceListOrganizationInvitesQueryKey = (
-  org?: string,
-  params?: AdminServiceListOrganizationInvitesParams,
+export const getAdminServiceCreateManagedGitRepoMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+    TError,
+    { org: string; data: AdminServiceCreateManagedGitRepoBody },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+  TError,
+  { org: string; data: AdminServiceCreateManagedGitRepoBody },
+  TContext
+> => {
+  const mutationKey = ["adminServiceCreateManagedGitRepo"];
+  const { mutation: mutationOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey } };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+    { org: string; data: AdminServiceCreateManagedGitRepoBody }
+  > = (props) => {
+    const { org, data } = props ?? {};
+
+    return adminServiceCreateManagedGitRepo(org, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceCreateManagedGitRepoMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>
+>;
+export type AdminServiceCreateManagedGitRepoMutationBody =
+  AdminServiceCreateManagedGitRepoBody;
+export type AdminServiceCreateManagedGitRepoMutationError = RpcStatus;
+
+/**
+ * @summary CreateManagedGitRepo creates a new rill managed git repo for the organization.
+ */
+export const createAdminServiceCreateManagedGitRepo = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: CreateMutationOptions<
+      Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+      TError,
+      { org: string; data: AdminServiceCreateManagedGitRepoBody },
+      TContext
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  Awaited<ReturnType<typeof adminServiceCreateManagedGitRepo>>,
+  TError,
+  { org: string; data: AdminServiceCreateManagedGitRepoBody },
+  TContext
+> => {
+  const mutationOptions =
+    getAdminServiceCreateManagedGitRepoMutationOptions(options);
+
+  return createMutation(mutationOptions, queryClient);
+};
+/**
+ * @summary CreateAsset returns a one time signed URL using which any asset can be uploaded.
+ */
+export const adminServiceCreateAsset = (
+  org: string,
+  adminServiceCreateAssetBody: AdminServiceCreateAssetBody,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1CreateAssetResponse>({
+    url: `/v1/orgs/${org}/create_asset`,
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateAssetBody,
+    signal,
+  });
+};
+
+export const getAdminServiceCreateAssetMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+    TError,
+    { org: string; data: AdminServiceCreateAssetBody },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+  TError,
+  { org: string; data: AdminServiceCreateAssetBody },
+  TContext
+> => {
+  const mutationKey = ["adminServiceCreateAsset"];
+  const { mutation: mutationOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey } };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+    { org: string; data: AdminServiceCreateAssetBody }
+  > = (props) => {
+    const { org, data } = props ?? {};
+
+    return adminServiceCreateAsset(org, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceCreateAssetMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateAsset>>
+>;
+export type AdminServiceCreateAssetMutationBody = AdminServiceCreateAssetBody;
+export type AdminServiceCreateAssetMutationError = RpcStatus;
+
+/**
+ * @summary CreateAsset returns a one time signed URL using which any asset can be uploaded.
+ */
+export const createAdminServiceCreateAsset = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: CreateMutationOptions<
+      Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+      TError,
+      { org: string; data: AdminServiceCreateAssetBody },
+      TContext
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  Awaited<ReturnType<typeof adminServiceCreateAsset>>,
+  TError,
+  { org: string; data: AdminServiceCreateAssetBody },
+  TContext
+> => {
+  const mutationOptions = getAdminServiceCreateAssetMutationOptions(options);
+
+  return createMutation(mutationOptions, queryClient);
+};
+/**
+ * @summary ListOrganizationInvites lists all the org invites
+ */
+export const adminServiceListOrganizationInvites = (
+  org: string,
+  params?: AdminServiceListOrganizationInvitesParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListOrganizationInvitesResponse>({
+    url: `/v1/orgs/${org}/invites`,
+    method: "GET",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListOrganizationInvitesInfiniteQueryKey = (
+  org?: string,
+  params?: AdminServiceListOrganizationInvitesParams,
+) => {
+  return [
+    "infinite",
+    `/v1/orgs/${org}/invites`,
+    ...(params ? [params] : []),
+  ] as const;
+};
+
+export const getAdminServiceListOrganizationInvitesQueryKey = (
+  org?: string,
+  params?: AdminServiceListOrganizationInvitesParams,
 ) => {
   return [`/v1/orgs/${org}/invites`, ...(params ? [params] : [])] as const;
 };
@@ -8356,24 +8752,325 @@ export const getAdminServiceRequestProjectAccessMutationOptions = <
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceRequestProjectAccessMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceRequestProjectAccess>>
+export type AdminServiceRequestProjectAccessMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRequestProjectAccess>>
+>;
+export type AdminServiceRequestProjectAccessMutationBody =
+  AdminServiceRequestProjectAccessBodyBody;
+export type AdminServiceRequestProjectAccessMutationError = RpcStatus;
+
+export const createAdminServiceRequestProjectAccess = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: CreateMutationOptions<
+      Awaited<ReturnType<typeof adminServiceRequestProjectAccess>>,
+      TError,
+      {
+        org: string;
+        project: string;
+        data: AdminServiceRequestProjectAccessBodyBody;
+      },
+      TContext
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  Awaited<ReturnType<typeof adminServiceRequestProjectAccess>>,
+  TError,
+  {
+    org: string;
+    project: string;
+    data: AdminServiceRequestProjectAccessBodyBody;
+  },
+  TContext
+> => {
+  const mutationOptions =
+    getAdminServiceRequestProjectAccessMutationOptions(options);
+
+  return createMutation(mutationOptions, queryClient);
+};
+/**
+ * @summary ListProjectMemberServices returns all the services for the project for an organization
+ */
+export const adminServiceListProjectMemberServices = (
+  org: string,
+  project: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectMemberServicesResponse>({
+    url: `/v1/orgs/${org}/projects/${project}/services`,
+    method: "GET",
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectMemberServicesQueryKey = (
+  org?: string,
+  project?: string,
+) => {
+  return [`/v1/orgs/${org}/projects/${project}/services`] as const;
+};
+
+export const getAdminServiceListProjectMemberServicesQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+  TError = RpcStatus,
+>(
+  org: string,
+  project: string,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+        TError,
+        TData
+      >
+    >;
+  },
+) => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectMemberServicesQueryKey(org, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>
+  > = ({ signal }) =>
+    adminServiceListProjectMemberServices(org, project, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(org && project),
+    ...queryOptions,
+  } as CreateQueryOptions<
+    Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+    TError,
+    TData
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+};
+
+export type AdminServiceListProjectMemberServicesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>
+>;
+export type AdminServiceListProjectMemberServicesQueryError = RpcStatus;
+
+/**
+ * @summary ListProjectMemberServices returns all the services for the project for an organization
+ */
+
+export function createAdminServiceListProjectMemberServices<
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+  TError = RpcStatus,
+>(
+  org: string,
+  project: string,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+        TError,
+        TData
+      >
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+} {
+  const queryOptions = getAdminServiceListProjectMemberServicesQueryOptions(
+    org,
+    project,
+    options,
+  );
+
+  const query = createQuery(queryOptions, queryClient) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+}
+
+/**
+ * @summary RemoveProjectMemberService removes the service from the project
+ */
+export const adminServiceRemoveProjectMemberService = (
+  org: string,
+  project: string,
+  name: string,
+) => {
+  return httpClient<V1RemoveProjectMemberServiceResponse>({
+    url: `/v1/orgs/${org}/projects/${project}/services/${name}`,
+    method: "DELETE",
+  });
+};
+
+export const getAdminServiceRemoveProjectMemberServiceMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+    TError,
+    { org: string; project: string; name: string },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+  TError,
+  { org: string; project: string; name: string },
+  TContext
+> => {
+  const mutationKey = ["adminServiceRemoveProjectMemberService"];
+  const { mutation: mutationOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey } };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+    { org: string; project: string; name: string }
+  > = (props) => {
+    const { org, project, name } = props ?? {};
+
+    return adminServiceRemoveProjectMemberService(org, project, name);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceRemoveProjectMemberServiceMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>
+>;
+
+export type AdminServiceRemoveProjectMemberServiceMutationError = RpcStatus;
+
+/**
+ * @summary RemoveProjectMemberService removes the service from the project
+ */
+export const createAdminServiceRemoveProjectMemberService = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: CreateMutationOptions<
+      Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+      TError,
+      { org: string; project: string; name: string },
+      TContext
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+  TError,
+  { org: string; project: string; name: string },
+  TContext
+> => {
+  const mutationOptions =
+    getAdminServiceRemoveProjectMemberServiceMutationOptions(options);
+
+  return createMutation(mutationOptions, queryClient);
+};
+/**
+ * @summary SetProjectMemberServiceRole updates the project role for the service
+ */
+export const adminServiceSetProjectMemberServiceRole = (
+  org: string,
+  project: string,
+  name: string,
+  adminServiceRequestProjectAccessBodyBody: AdminServiceRequestProjectAccessBodyBody,
+) => {
+  return httpClient<V1SetProjectMemberServiceRoleResponse>({
+    url: `/v1/orgs/${org}/projects/${project}/services/${name}/role`,
+    method: "PUT",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceRequestProjectAccessBodyBody,
+  });
+};
+
+export const getAdminServiceSetProjectMemberServiceRoleMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+    TError,
+    {
+      org: string;
+      project: string;
+      name: string;
+      data: AdminServiceRequestProjectAccessBodyBody;
+    },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+  TError,
+  {
+    org: string;
+    project: string;
+    name: string;
+    data: AdminServiceRequestProjectAccessBodyBody;
+  },
+  TContext
+> => {
+  const mutationKey = ["adminServiceSetProjectMemberServiceRole"];
+  const { mutation: mutationOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey } };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+    {
+      org: string;
+      project: string;
+      name: string;
+      data: AdminServiceRequestProjectAccessBodyBody;
+    }
+  > = (props) => {
+    const { org, project, name, data } = props ?? {};
+
+    return adminServiceSetProjectMemberServiceRole(org, project, name, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceSetProjectMemberServiceRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>
 >;
-export type AdminServiceRequestProjectAccessMutationBody =
+export type AdminServiceSetProjectMemberServiceRoleMutationBody =
   AdminServiceRequestProjectAccessBodyBody;
-export type AdminServiceRequestProjectAccessMutationError = RpcStatus;
+export type AdminServiceSetProjectMemberServiceRoleMutationError = RpcStatus;
 
-export const createAdminServiceRequestProjectAccess = <
+/**
+ * @summary SetProjectMemberServiceRole updates the project role for the service
+ */
+export const createAdminServiceSetProjectMemberServiceRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(
   options?: {
     mutation?: CreateMutationOptions<
-      Awaited<ReturnType<typeof adminServiceRequestProjectAccess>>,
+      Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
       TError,
       {
         org: string;
         project: string;
+        name: string;
         data: AdminServiceRequestProjectAccessBodyBody;
       },
       TContext
@@ -8381,52 +9078,60 @@ export const createAdminServiceRequestProjectAccess = <
   },
   queryClient?: QueryClient,
 ): CreateMutationResult<
-  Awaited<ReturnType<typeof adminServiceRequestProjectAccess>>,
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
   TError,
   {
     org: string;
     project: string;
+    name: string;
     data: AdminServiceRequestProjectAccessBodyBody;
   },
   TContext
 > => {
   const mutationOptions =
-    getAdminServiceRequestProjectAccessMutationOptions(options);
+    getAdminServiceSetProjectMemberServiceRoleMutationOptions(options);
 
   return createMutation(mutationOptions, queryClient);
 };
 /**
- * @summary ListProjectMemberServices returns all the services for the project for an organization
+ * @summary ListProjectSnapshots lists the snapshots of a project's data, ordered from newest to oldest.
  */
-export const adminServiceListProjectMemberServices = (
+export const adminServiceListProjectSnapshots = (
   org: string,
   project: string,
+  params?: AdminServiceListProjectSnapshotsParams,
   signal?: AbortSignal,
 ) => {
-  return httpClient<V1ListProjectMemberServicesResponse>({
-    url: `/v1/orgs/${org}/projects/${project}/services`,
+  return httpClient<V1ListProjectSnapshotsResponse>({
+    url: `/v1/orgs/${org}/projects/${project}/snapshots`,
     method: "GET",
+    params,
     signal,
   });
 };
 
-export const getAdminServiceListProjectMemberServicesQueryKey = (
+export const getAdminServiceListProjectSnapshotsQueryKey = (
   org?: string,
   project?: string,
+  params?: AdminServiceListProjectSnapshotsParams,
 ) => {
-  return [`/v1/orgs/${org}/projects/${project}/services`] as const;
+  return [
+    `/v1/orgs/${org}/projects/${project}/snapshots`,
+    ...(params ? [params] : []),
+  ] as const;
 };
 
-export const getAdminServiceListProjectMemberServicesQueryOptions = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+export const getAdminServiceListProjectSnapshotsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>,
   TError = RpcStatus,
 >(
   org: string,
   project: string,
+  params?: AdminServiceListProjectSnapshotsParams,
   options?: {
     query?: Partial<
       CreateQueryOptions<
-        Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+        Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>,
         TError,
         TData
       >
@@ -8437,12 +9142,12 @@ export const getAdminServiceListProjectMemberServicesQueryOptions = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceListProjectMemberServicesQueryKey(org, project);
+    getAdminServiceListProjectSnapshotsQueryKey(org, project, params);
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>
+    Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>
   > = ({ signal }) =>
-    adminServiceListProjectMemberServices(org, project, signal);
+    adminServiceListProjectSnapshots(org, project, params, signal);
 
   return {
     queryKey,
@@ -8450,31 +9155,32 @@ export const getAdminServiceListProjectMemberServicesQueryOptions = <
     enabled: !!(org && project),
     ...queryOptions,
   } as CreateQueryOptions<
-    Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+    Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>,
     TError,
     TData
   > & { queryKey: DataTag<QueryKey, TData, TError> };
 };
 
-export type AdminServiceListProjectMemberServicesQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>
+export type AdminServiceListProjectSnapshotsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>
 >;
-export type AdminServiceListProjectMemberServicesQueryError = RpcStatus;
+export type AdminServiceListProjectSnapshotsQueryError = RpcStatus;
 
 /**
- * @summary ListProjectMemberServices returns all the services for the project for an organization
+ * @summary ListProjectSnapshots lists the snapshots of a project's data, ordered from newest to oldest.
  */
 
-export function createAdminServiceListProjectMemberServices<
-  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+export function createAdminServiceListProjectSnapshots<
+  TData = Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>,
   TError = RpcStatus,
 >(
   org: string,
   project: string,
+  params?: AdminServiceListProjectSnapshotsParams,
   options?: {
     query?: Partial<
       CreateQueryOptions<
-        Awaited<ReturnType<typeof adminServiceListProjectMemberServices>>,
+        Awaited<ReturnType<typeof adminServiceListProjectSnapshots>>,
         TError,
         TData
       >
@@ -8484,9 +9190,10 @@ export function createAdminServiceListProjectMemberServices<
 ): CreateQueryResult<TData, TError> & {
   queryKey: DataTag<QueryKey, TData, TError>;
 } {
-  const queryOptions = getAdminServiceListProjectMemberServicesQueryOptions(
+  const queryOptions = getAdminServiceListProjectSnapshotsQueryOptions(
     org,
     project,
+    params,
     options,
   );
 
@@ -8501,36 +9208,49 @@ export function createAdminServiceListProjectMemberServices<
 }
 
 /**
- * @summary RemoveProjectMemberService removes the service from the project
+ * @summary CreateProjectSnapshot schedules a snapshot of the data of a project's production deployment.
+The snapshot is taken in the background. It has status PENDING until it completes.
  */
-export const adminServiceRemoveProjectMemberService = (
+export const adminServiceCreateProjectSnapshot = (
   org: string,
   project: string,
-  name: string,
+  adminServiceCreateProjectSnapshotBodyBody: AdminServiceCreateProjectSnapshotBodyBody,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1RemoveProjectMemberServiceResponse>({
-    url: `/v1/orgs/${org}/projects/${project}/services/${name}`,
-    method: "DELETE",
+  return httpClient<V1CreateProjectSnapshotResponse>({
+    url: `/v1/orgs/${org}/projects/${project}/snapshots`,
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateProjectSnapshotBodyBody,
+    signal,
   });
 };
 
-export const getAdminServiceRemoveProjectMemberServiceMutationOptions = <
+export const getAdminServiceCreateProjectSnapshotMutationOptions = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+    Awaited<ReturnType<typeof adminServiceCreateProjectSnapshot>>,
     TError,
-    { org: string; project: string; name: string },
+    {
+      org: string;
+      project: string;
+      data: AdminServiceCreateProjectSnapshotBodyBody;
+    },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+  Awaited<ReturnType<typeof adminServiceCreateProjectSnapshot>>,
   TError,
-  { org: string; project: string; name: string },
+  {
+    org: string;
+    project: string;
+    data: AdminServiceCreateProjectSnapshotBodyBody;
+  },
   TContext
 > => {
-  const mutationKey = ["adminServiceRemoveProjectMemberService"];
+  const mutationKey = ["adminServiceCreateProjectSnapshot"];
   const { mutation: mutationOptions } = options
     ? options.mutation &&
       "mutationKey" in options.mutation &&
@@ -8540,94 +9260,111 @@ export const getAdminServiceRemoveProjectMemberServiceMutationOptions = <
     : { mutation: { mutationKey } };
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
-    { org: string; project: string; name: string }
+    Awaited<ReturnType<typeof adminServiceCreateProjectSnapshot>>,
+    {
+      org: string;
+      project: string;
+      data: AdminServiceCreateProjectSnapshotBodyBody;
+    }
   > = (props) => {
-    const { org, project, name } = props ?? {};
+    const { org, project, data } = props ?? {};
 
-    return adminServiceRemoveProjectMemberService(org, project, name);
+    return adminServiceCreateProjectSnapshot(org, project, data);
   };
 
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceRemoveProjectMemberServiceMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>
+export type AdminServiceCreateProjectSnapshotMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateProjectSnapshot>>
 >;
-
-export type AdminServiceRemoveProjectMemberServiceMutationError = RpcStatus;
+export type AdminServiceCreateProjectSnapshotMutationBody =
+  AdminServiceCreateProjectSnapshotBodyBody;
+export type AdminServiceCreateProjectSnapshotMutationError = RpcStatus;
 
 /**
- * @summary RemoveProjectMemberService removes the service from the project
+ * @summary CreateProjectSnapshot schedules a snapshot of the data of a project's production deployment.
+The snapshot is taken in the background. It has status PENDING until it completes.
  */
-export const createAdminServiceRemoveProjectMemberService = <
+export const createAdminServiceCreateProjectSnapshot = <
   TError = RpcStatus,
   TContext = unknown,
 >(
   options?: {
     mutation?: CreateMutationOptions<
-      Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+      Awaited<ReturnType<typeof adminServiceCreateProjectSnapshot>>,
       TError,
-      { org: string; project: string; name: string },
+      {
+        org: string;
+        project: string;
+        data: AdminServiceCreateProjectSnapshotBodyBody;
+      },
       TContext
     >;
   },
   queryClient?: QueryClient,
 ): CreateMutationResult<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMemberService>>,
+  Awaited<ReturnType<typeof adminServiceCreateProjectSnapshot>>,
   TError,
-  { org: string; project: string; name: string },
+  {
+    org: string;
+    project: string;
+    data: AdminServiceCreateProjectSnapshotBodyBody;
+  },
   TContext
 > => {
   const mutationOptions =
-    getAdminServiceRemoveProjectMemberServiceMutationOptions(options);
+    getAdminServiceCreateProjectSnapshotMutationOptions(options);
 
   return createMutation(mutationOptions, queryClient);
 };
 /**
- * @summary SetProjectMemberServiceRole updates the project role for the service
+ * @summary RestoreProjectSnapshot restores the data of a project's production deployment to a snapshot.
+Only snapshots taken on the current production deployment can be restored.
  */
-export const adminServiceSetProjectMemberServiceRole = (
+export const adminServiceRestoreProjectSnapshot = (
   org: string,
   project: string,
-  name: string,
-  adminServiceRequestProjectAccessBodyBody: AdminServiceRequestProjectAccessBodyBody,
+  snapshotId: string,
+  adminServiceCreateProjectSnapshotBodyBody: AdminServiceCreateProjectSnapshotBodyBody,
+  signal?: AbortSignal,
 ) => {
-  return httpClient<V1SetProjectMemberServiceRoleResponse>({
-    url: `/v1/orgs/${org}/projects/${project}/services/${name}/role`,
-    method: "PUT",
+  return httpClient<V1RestoreProjectSnapshotResponse>({
+    url: `/v1/orgs/${org}/projects/${project}/snapshots/${snapshotId}/restore`,
+    method: "POST",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceRequestProjectAccessBodyBody,
+    data: adminServiceCreateProjectSnapshotBodyBody,
+    signal,
   });
 };
 
-export const getAdminServiceSetProjectMemberServiceRoleMutationOptions = <
+export const getAdminServiceRestoreProjectSnapshotMutationOptions = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+    Awaited<ReturnType<typeof adminServiceRestoreProjectSnapshot>>,
     TError,
     {
       org: string;
       project: string;
-      name: string;
-      data: AdminServiceRequestProjectAccessBodyBody;
+      snapshotId: string;
+      data: AdminServiceCreateProjectSnapshotBodyBody;
     },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+  Awaited<ReturnType<typeof adminServiceRestoreProjectSnapshot>>,
   TError,
   {
     org: string;
     project: string;
-    name: string;
-    data: AdminServiceRequestProjectAccessBodyBody;
+    snapshotId: string;
+    data: AdminServiceCreateProjectSnapshotBodyBody;
   },
   TContext
 > => {
-  const mutationKey = ["adminServiceSetProjectMemberServiceRole"];
+  const mutationKey = ["adminServiceRestoreProjectSnapshot"];
   const { mutation: mutationOptions } = options
     ? options.mutation &&
       "mutationKey" in options.mutation &&
@@ -8637,63 +9374,64 @@ export const getAdminServiceSetProjectMemberServiceRoleMutationOptions = <
     : { mutation: { mutationKey } };
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+    Awaited<ReturnType<typeof adminServiceRestoreProjectSnapshot>>,
     {
       org: string;
       project: string;
-      name: string;
-      data: AdminServiceRequestProjectAccessBodyBody;
+      snapshotId: string;
+      data: AdminServiceCreateProjectSnapshotBodyBody;
     }
   > = (props) => {
-    const { org, project, name, data } = props ?? {};
+    const { org, project, snapshotId, data } = props ?? {};
 
-    return adminServiceSetProjectMemberServiceRole(org, project, name, data);
+    return adminServiceRestoreProjectSnapshot(org, project, snapshotId, data);
   };
 
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceSetProjectMemberServiceRoleMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>
+export type AdminServiceRestoreProjectSnapshotMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRestoreProjectSnapshot>>
 >;
-export type AdminServiceSetProjectMemberServiceRoleMutationBody =
-  AdminServiceRequestProjectAccessBodyBody;
-export type AdminServiceSetProjectMemberServiceRoleMutationError = RpcStatus;
+export type AdminServiceRestoreProjectSnapshotMutationBody =
+  AdminServiceCreateProjectSnapshotBodyBody;
+export type AdminServiceRestoreProjectSnapshotMutationError = RpcStatus;
 
 /**
- * @summary SetProjectMemberServiceRole updates the project role for the service
+ * @summary RestoreProjectSnapshot restores the data of a project's production deployment to a snapshot.
+Only snapshots taken on the current production deployment can be restored.
  */
-export const createAdminServiceSetProjectMemberServiceRole = <
+export const createAdminServiceRestoreProjectSnapshot = <
   TError = RpcStatus,
   TContext = unknown,
 >(
   options?: {
     mutation?: CreateMutationOptions<
-      Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+      Awaited<ReturnType<typeof adminServiceRestoreProjectSnapshot>>,
       TError,
       {
         org: string;
         project: string;
-        name: string;
-        data: AdminServiceRequestProjectAccessBodyBody;
+        snapshotId: string;
+        data: AdminServiceCreateProjectSnapshotBodyBody;
       },
       TContext
     >;
   },
   queryClient?: QueryClient,
 ): CreateMutationResult<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberServiceRole>>,
+  Awaited<ReturnType<typeof adminServiceRestoreProjectSnapshot>>,
   TError,
   {
     org: string;
     project: string;
-    name: string;
-    data: AdminServiceRequestProjectAccessBodyBody;
+    snapshotId: string;
+    data: AdminServiceCreateProjectSnapshotBodyBody;
   },
   TContext
 > => {
   const mutationOptions =
-    getAdminServiceSetProjectMemberServiceRoleMutationOptions(options);
+    getAdminServiceRestoreProjectSnapshotMutationOptions(options);
 
   return createMutation(mutationOptions, queryClient);
 };
@@ -15604,6 +16342,174 @@ export function createAdminServiceSudoGetResource<
   return query;
 }
 
+/**
+ * @summary SudoListRuntimeHosts lists the runtime hosts in the pools managed by "vm" provisioners, including their health and resource usage.
+ */
+export const adminServiceSudoListRuntimeHosts = (signal?: AbortSignal) => {
+  return httpClient<V1SudoListRuntimeHostsResponse>({
+    url: `/v1/superuser/runtime-hosts`,
+    method: "GET",
+    signal,
+  });
+};
+
+export const getAdminServiceSudoListRuntimeHostsQueryKey = () => {
+  return [`/v1/superuser/runtime-hosts`] as const;
+};
+
+export const getAdminServiceSudoListRuntimeHostsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>,
+  TError = RpcStatus,
+>(options?: {
+  query?: Partial<
+    CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>,
+      TError,
+      TData
+    >
+  >;
+}) => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ?? getAdminServiceSudoListRuntimeHostsQueryKey();
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>
+  > = ({ signal }) => adminServiceSudoListRuntimeHosts(signal);
+
+  return { queryKey, queryFn, ...queryOptions } as CreateQueryOptions<
+    Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>,
+    TError,
+    TData
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+};
+
+export type AdminServiceSudoListRuntimeHostsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>
+>;
+export type AdminServiceSudoListRuntimeHostsQueryError = RpcStatus;
+
+/**
+ * @summary SudoListRuntimeHosts lists the runtime hosts in the pools managed by "vm" provisioners, including their health and resource usage.
+ */
+
+export function createAdminServiceSudoListRuntimeHosts<
+  TData = Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>,
+  TError = RpcStatus,
+>(
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        Awaited<ReturnType<typeof adminServiceSudoListRuntimeHosts>>,
+        TError,
+        TData
+      >
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateQueryResult<TData, TError> & {
+  queryKey: DataTag<QueryKey, TData, TError>;
+} {
+  const queryOptions = getAdminServiceSudoListRuntimeHostsQueryOptions(options);
+
+  const query = createQuery(queryOptions, queryClient) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+}
+
+/**
+ * @summary SudoUpdateRuntimeHost updates a runtime host managed by a "vm" provisioner.
+A draining host receives no new instances, and its existing instances are moved to other hosts.
+ */
+export const adminServiceSudoUpdateRuntimeHost = (
+  v1SudoUpdateRuntimeHostRequest: V1SudoUpdateRuntimeHostRequest,
+) => {
+  return httpClient<V1SudoUpdateRuntimeHostResponse>({
+    url: `/v1/superuser/runtime-hosts`,
+    method: "PATCH",
+    headers: { "Content-Type": "application/json" },
+    data: v1SudoUpdateRuntimeHostRequest,
+  });
+};
+
+export const getAdminServiceSudoUpdateRuntimeHostMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSudoUpdateRuntimeHost>>,
+    TError,
+    { data: V1SudoUpdateRuntimeHostRequest },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceSudoUpdateRuntimeHost>>,
+  TError,
+  { data: V1SudoUpdateRuntimeHostRequest },
+  TContext
+> => {
+  const mutationKey = ["adminServiceSudoUpdateRuntimeHost"];
+  const { mutation: mutationOptions } = options
+    ? options.mutation &&
+      "mutationKey" in options.mutation &&
+      options.mutation.mutationKey
+      ? options
+      : { ...options, mutation: { ...options.mutation, mutationKey } }
+    : { mutation: { mutationKey } };
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSudoUpdateRuntimeHost>>,
+    { data: V1SudoUpdateRuntimeHostRequest }
+  > = (props) => {
+    const { data } = props ?? {};
+
+    return adminServiceSudoUpdateRuntimeHost(data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceSudoUpdateRuntimeHostMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSudoUpdateRuntimeHost>>
+>;
+export type AdminServiceSudoUpdateRuntimeHostMutationBody =
+  V1SudoUpdateRuntimeHostRequest;
+export type AdminServiceSudoUpdateRuntimeHostMutationError = RpcStatus;
+
+/**
+ * @summary SudoUpdateRuntimeHost updates a runtime host managed by a "vm" provisioner.
+A draining host receives no new instances, and its existing instances are moved to other hosts.
+ */
+export const createAdminServiceSudoUpdateRuntimeHost = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(
+  options?: {
+    mutation?: CreateMutationOptions<
+      Awaited<ReturnType<typeof adminServiceSudoUpdateRuntimeHost>>,
+      TError,
+      { data: V1SudoUpdateRuntimeHostRequest },
+      TContext
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  Awaited<ReturnType<typeof adminServiceSudoUpdateRuntimeHost>>,
+  TError,
+  { data: V1SudoUpdateRuntimeHostRequest },
+  TContext
+> => {
+  const mutationOptions =
+    getAdminServiceSudoUpdateRuntimeHostMutationOptions(options);
+
+  return createMutation(mutationOptions, queryClient);
+};
 /**
  * @summary RecordEvents sends a batch of telemetry events.
 The events must conform to the schema described in rill/runtime/pkg/activity/README.md.
@@ -135,12 +135,39 @@ export interface V1AlertOptions {
   webOpenPath?: string;
   /** Annotation for the base64-encoded UI state to open for the report. */
   webOpenState?: string;
+  /** If set, the alert's notifications are suppressed until this time. */
+  snoozeUntil?: string;
 }
 
 export interface V1ApproveProjectAccessResponse {
   [key: string]: unknown;
 }
 
+export type V1AuditEventBefore = { [key: string]: unknown };
+
+export type V1AuditEventAfter = { [key: string]: unknown };
+
+export interface V1AuditEvent {
+  id?: string;
+  orgId?: string;
+  projectId?: string;
+  /** Type of caller that performed the action, such as "user", "service" or "system" */
+  actorType?: string;
+  actorId?: string;
+  actorEmail?: string;
+  /** Set if a superuser performed the action while assuming the actor's identity */
+  assumedByUserId?: string;
+  action?: string;
+  targetType?: string;
+  targetId?: string;
+  targetName?: string;
+  before?: V1AuditEventBefore;
+  after?: V1AuditEventAfter;
+  clientIp?: string;
+  userAgent?: string;
+  createdOn?: string;
+}
+
 export interface V1BillingIssue {
   org?: string;
   type?: V1BillingIssueType;
@@ -401,6 +428,10 @@ export interface V1CreateProjectResponse {
   project?: V1Project;
 }
 
+export interface V1CreateProjectSnapshotResponse {
+  snapshot?: V1ProjectSnapshot;
+}
+
 export interface V1CreateProjectWhitelistedDomainResponse {
   [key: string]: unknown;
 }
@@ -429,6 +460,10 @@ export interface V1DeleteDeploymentResponse {
   deploymentId?: string;
 }
 
+export interface V1DeleteOrganizationBudgetResponse {
+  [key: string]: unknown;
+}
+
 export interface V1DeleteOrganizationResponse {
   [key: string]: unknown;
 }
@@ -519,6 +554,8 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
   EXPORT_FORMAT_PDF: "EXPORT_FORMAT_PDF",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
 } as const;
 
 export interface V1Expression {
@@ -687,6 +724,10 @@ export interface V1GetIFrameResponse {
   ttlSeconds?: number;
 }
 
+export interface V1GetOrganizationBudgetResponse {
+  budget?: V1OrganizationBudget;
+}
+
 export interface V1GetOrganizationMemberUserResponse {
   member?: V1OrganizationMemberUser;
 }
@@ -773,8 +814,17 @@ export type V1GetReportMetaResponseDeliveryMeta = {
   [key: string]: GetReportMetaResponseDeliveryMeta;
 };
 
+/**
+ * user attributes of the report's owner, will be empty if the report has no owner
+ */
+export type V1GetReportMetaResponseOwnerAttrs = {
+  [key: string]: unknown;
+};
+
 export interface V1GetReportMetaResponse {
   deliveryMeta?: V1GetReportMetaResponseDeliveryMeta;
+  /** user attributes of the report's owner, will be empty if the report has no owner */
+  ownerAttrs?: V1GetReportMetaResponseOwnerAttrs;
 }
 
 export interface V1GetServiceResponse {
@@ -836,6 +886,11 @@ export interface V1LeaveOrganizationResponse {
   [key: string]: unknown;
 }
 
+export interface V1ListAuditEventsResponse {
+  events?: V1AuditEvent[];
+  nextPageToken?: string;
+}
+
 export interface V1ListBookmarksResponse {
   bookmarks?: V1Bookmark[];
 }
@@ -902,6 +957,11 @@ export interface V1ListProjectMemberUsersResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListProjectSnapshotsResponse {
+  snapshots?: V1ProjectSnapshot[];
+  nextPageToken?: string;
+}
+
 export interface V1ListProjectWhitelistedDomainsResponse {
   domains?: V1WhitelistedDomain[];
 }
@@ -1040,6 +1100,24 @@ export interface V1Organization {
   updatedOn?: string;
 }
 
+export interface V1OrganizationBudget {
+  /** Usage metric the budget applies to. Empty for budgets on the organization's spend. */
+  metric?: string;
+  amount?: number;
+  warningThresholds?: number[];
+  hardCap?: boolean;
+  /** Spend or usage in the current period as of the last evaluation. */
+  currentValue?: number;
+  /** Highest warning threshold that has been notified in the current period. */
+  notifiedThreshold?: number;
+  /** True if the hard cap has been enforced in the current period. */
+  capped?: boolean;
+  periodStart?: string;
+  evaluatedOn?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export type V1OrganizationInviteAttributes = { [key: string]: unknown };
 
 export interface V1OrganizationInvite {
@@ -1214,6 +1292,31 @@ export interface V1ProjectRole {
   permissions?: V1ProjectPermissions;
 }
 
+export interface V1ProjectSnapshot {
+  id?: string;
+  projectId?: string;
+  deploymentId?: string;
+  status?: V1ProjectSnapshotStatus;
+  statusMessage?: string;
+  /** ID of the user who requested the snapshot. Empty for scheduled snapshots. */
+  createdByUserId?: string;
+  sizeBytes?: string;
+  tables?: string[];
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+export type V1ProjectSnapshotStatus =
+  (typeof V1ProjectSnapshotStatus)[keyof typeof V1ProjectSnapshotStatus];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1ProjectSnapshotStatus = {
+  PROJECT_SNAPSHOT_STATUS_UNSPECIFIED: "PROJECT_SNAPSHOT_STATUS_UNSPECIFIED",
+  PROJECT_SNAPSHOT_STATUS_PENDING: "PROJECT_SNAPSHOT_STATUS_PENDING",
+  PROJECT_SNAPSHOT_STATUS_OK: "PROJECT_SNAPSHOT_STATUS_OK",
+  PROJECT_SNAPSHOT_STATUS_ERRORED: "PROJECT_SNAPSHOT_STATUS_ERRORED",
+} as const;
+
 export interface V1ProjectVariable {
   /** Internal ID. */
   id?: string;
@@ -1372,6 +1475,10 @@ export interface V1ResourceName {
   name?: string;
 }
 
+export interface V1RestoreProjectSnapshotResponse {
+  [key: string]: unknown;
+}
+
 export interface V1RevokeAllUserAuthTokensResponse {
   /** Number of tokens revoked. */
   tokensRevoked?: number;
@@ -1397,6 +1504,26 @@ export interface V1RevokeUserAuthTokenResponse {
   [key: string]: unknown;
 }
 
+export interface V1RuntimeHost {
+  id?: string;
+  host?: string;
+  audience?: string;
+  cpu?: number;
+  memoryGb?: number;
+  cpuUsed?: number;
+  memoryGbUsed?: number;
+  instances?: number;
+  /** True if the host has been drained for maintenance. Draining hosts receive no new instances. */
+  draining?: boolean;
+  /** False if the host has failed too many consecutive health checks. */
+  healthy?: boolean;
+  failedChecks?: number;
+  statusMessage?: string;
+  checkedOn?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1SearchProjectNamesResponse {
   names?: string[];
   nextPageToken?: string;
@@ -1535,6 +1662,10 @@ export interface V1SudoIssueRuntimeManagerTokenResponse {
   token?: string;
 }
 
+export interface V1SudoListRuntimeHostsResponse {
+  hosts?: V1RuntimeHost[];
+}
+
 export interface V1SudoReportUsageRequest {
   org?: string;
   eventName?: string;
@@ -1614,6 +1745,15 @@ export interface V1SudoUpdateOrganizationQuotasResponse {
   organization?: V1Organization;
 }
 
+export interface V1SudoUpdateRuntimeHostRequest {
+  host?: string;
+  draining?: boolean;
+}
+
+export interface V1SudoUpdateRuntimeHostResponse {
+  host?: V1RuntimeHost;
+}
+
 export interface V1SudoUpdateUserQuotasRequest {
   email?: string;
   singleuserOrgs?: number;
@@ -1697,6 +1837,10 @@ export interface V1UpdateBookmarkResponse {
   [key: string]: unknown;
 }
 
+export interface V1UpdateOrganizationBudgetResponse {
+  budget?: V1OrganizationBudget;
+}
+
 export interface V1UpdateOrganizationMemberUserAttributesResponse {
   [key: string]: unknown;
 }
@@ -1831,6 +1975,10 @@ export type AdminServiceCreateReportBodyBody = {
   options?: V1ReportOptions;
 };
 
+export type AdminServiceCreateProjectSnapshotBodyBody = {
+  superuserForceAccess?: boolean;
+};
+
 /**
  * If set, will use the provided attributes outright.
  */
@@ -1898,6 +2046,40 @@ export type AdminServiceUpdateOrganizationBody = {
   billingEmail?: string;
 };
 
+export type AdminServiceListAuditEventsParams = {
+  /**
+   * Optionally filter by project name
+   */
+  project?: string;
+  /**
+   * Optionally filter by the email of the user who performed the action
+   */
+  actorEmail?: string;
+  /**
+   * Optionally filter by actions, such as "org.member.add"
+   */
+  actions?: string[];
+  /**
+   * Optionally filter by target type, such as "user" or "deployment"
+   */
+  targetType?: string;
+  /**
+   * Optionally filter by target ID
+   */
+  targetId?: string;
+  /**
+   * Optionally only include events created at or after this time
+   */
+  since?: string;
+  /**
+   * Optionally only include events created before this time
+   */
+  until?: string;
+  pageSize?: number;
+  pageToken?: string;
+  superuserForceAccess?: boolean;
+};
+
 export type AdminServiceGetBillingCreditBalanceParams = {
   superuserForceAccess?: boolean;
 };
@@ -1923,6 +2105,25 @@ export type AdminServiceCancelBillingSubscriptionParams = {
   superuserForceAccess?: boolean;
 };
 
+export type AdminServiceGetOrganizationBudgetParams = {
+  superuserForceAccess?: boolean;
+};
+
+export type AdminServiceDeleteOrganizationBudgetParams = {
+  superuserForceAccess?: boolean;
+};
+
+export type AdminServiceUpdateOrganizationBudgetBody = {
+  /** Usage metric to budget. If empty, the budget applies to the organization's spend. */
+  metric?: string;
+  amount?: number;
+  /** Percentages of the amount at which the organization's admins are notified. */
+  warningThresholds?: number[];
+  /** If true, the organization's non-production deployments are hibernated when the budget is exceeded. */
+  hardCap?: boolean;
+  superuserForceAccess?: boolean;
+};
+
 export type AdminServiceCreateManagedGitRepoBody = {
   /** name of the repo to create. 
 Note: The final name will be suffixed with a random string to ensure uniqueness. */
@@ -2223,6 +2424,12 @@ export type AdminServiceRedeployProjectParams = {
   superuserForceAccess?: boolean;
 };
 
+export type AdminServiceListProjectSnapshotsParams = {
+  pageSize?: number;
+  pageToken?: string;
+  superuserForceAccess?: boolean;
+};
+
 export type AdminServiceListMagicAuthTokensParams = {
   pageSize?: number;
   pageToken?: string;
@@ -96,6 +96,38 @@ proto3.util.setEnumType(DeploymentStatus, "rill.admin.v1.DeploymentStatus", [
   { no: 9, name: "DEPLOYMENT_STATUS_DELETED" },
 ]);
 
+/**
+ * @generated from enum rill.admin.v1.ProjectSnapshotStatus
+ */
+export enum ProjectSnapshotStatus {
+  /**
+   * @generated from enum value: PROJECT_SNAPSHOT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROJECT_SNAPSHOT_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: PROJECT_SNAPSHOT_STATUS_OK = 2;
+   */
+  OK = 2,
+
+  /**
+   * @generated from enum value: PROJECT_SNAPSHOT_STATUS_ERRORED = 3;
+   */
+  ERRORED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ProjectSnapshotStatus)
+proto3.util.setEnumType(ProjectSnapshotStatus, "rill.admin.v1.ProjectSnapshotStatus", [
+  { no: 0, name: "PROJECT_SNAPSHOT_STATUS_UNSPECIFIED" },
+  { no: 1, name: "PROJECT_SNAPSHOT_STATUS_PENDING" },
+  { no: 2, name: "PROJECT_SNAPSHOT_STATUS_OK" },
+  { no: 3, name: "PROJECT_SNAPSHOT_STATUS_ERRORED" },
+]);
+
 /**
  * @generated from enum rill.admin.v1.BillingPlanType
  */
@@ -860,6 +892,160 @@ export class UpdateOrganizationResponse extends Message<UpdateOrganizationRespon
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: string org = 1;
+   */
+  org = "";
+
+  /**
+   * Optionally filter by project name
+   *
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * Optionally filter by the email of the user who performed the action
+   *
+   * @generated from field: string actor_email = 3;
+   */
+  actorEmail = "";
+
+  /**
+   * Optionally filter by actions, such as "org.member.add"
+   *
+   * @generated from field: repeated string actions = 4;
+   */
+  actions: string[] = [];
+
+  /**
+   * Optionally filter by target type, such as "user" or "deployment"
+   *
+   * @generated from field: string target_type = 5;
+   */
+  targetType = "";
+
+  /**
+   * Optionally filter by target ID
+   *
+   * @generated from field: string target_id = 6;
+   */
+  targetId = "";
+
+  /**
+   * Optionally only include events created at or after this time
+   *
+   * @generated from field: google.protobuf.Timestamp since = 7;
+   */
+  since?: Timestamp;
+
+  /**
+   * Optionally only include events created before this time
+   *
+   * @generated from field: google.protobuf.Timestamp until = 8;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: uint32 page_size = 9;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 10;
+   */
+  pageToken = "";
+
+  /**
+   * @generated from field: bool superuser_force_access = 11;
+   */
+  superuserForceAccess = false;
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "target_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "target_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "since", kind: "message", T: Timestamp },
+    { no: 8, name: "until", kind: "message", T: Timestamp },
+    { no: 9, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.ListProjectsForOrganizationRequest
  */
@@ -4419,9 +4605,9 @@ export class RedeployProjectResponse extends Message<RedeployProjectResponse> {
 }
 
 /**
- * @generated from message rill.admin.v1.HibernateProjectRequest
+ * @generated from message rill.admin.v1.ListProjectSnapshotsRequest
  */
-export class HibernateProjectRequest extends Message<HibernateProjectRequest> {
+export class ListProjectSnapshotsRequest extends Message<ListProjectSnapshotsRequest> {
   /**
    * @generated from field: string org = 1;
    */
@@ -4433,217 +4619,493 @@ export class HibernateProjectRequest extends Message<HibernateProjectRequest> {
   project = "";
 
   /**
-   * @generated from field: bool superuser_force_access = 3;
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  /**
+   * @generated from field: bool superuser_force_access = 5;
    */
   superuserForceAccess = false;
 
-  constructor(data?: PartialMessage<HibernateProjectRequest>) {
+  constructor(data?: PartialMessage<ListProjectSnapshotsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.HibernateProjectRequest";
+  static readonly typeName = "rill.admin.v1.ListProjectSnapshotsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HibernateProjectRequest {
-    return new HibernateProjectRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectSnapshotsRequest {
+    return new ListProjectSnapshotsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HibernateProjectRequest {
-    return new HibernateProjectRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectSnapshotsRequest {
+    return new ListProjectSnapshotsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HibernateProjectRequest {
-    return new HibernateProjectRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectSnapshotsRequest {
+    return new ListProjectSnapshotsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: HibernateProjectRequest | PlainMessage<HibernateProjectRequest> | undefined, b: HibernateProjectRequest | PlainMessage<HibernateProjectRequest> | undefined): boolean {
-    return proto3.util.equals(HibernateProjectRequest, a, b);
+  static equals(a: ListProjectSnapshotsRequest | PlainMessage<ListProjectSnapshotsRequest> | undefined, b: ListProjectSnapshotsRequest | PlainMessage<ListProjectSnapshotsRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectSnapshotsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.HibernateProjectResponse
+ * @generated from message rill.admin.v1.ListProjectSnapshotsResponse
  */
-export class HibernateProjectResponse extends Message<HibernateProjectResponse> {
-  constructor(data?: PartialMessage<HibernateProjectResponse>) {
+export class ListProjectSnapshotsResponse extends Message<ListProjectSnapshotsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.ProjectSnapshot snapshots = 1;
+   */
+  snapshots: ProjectSnapshot[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectSnapshotsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.HibernateProjectResponse";
+  static readonly typeName = "rill.admin.v1.ListProjectSnapshotsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "snapshots", kind: "message", T: ProjectSnapshot, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HibernateProjectResponse {
-    return new HibernateProjectResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectSnapshotsResponse {
+    return new ListProjectSnapshotsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HibernateProjectResponse {
-    return new HibernateProjectResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectSnapshotsResponse {
+    return new ListProjectSnapshotsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HibernateProjectResponse {
-    return new HibernateProjectResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectSnapshotsResponse {
+    return new ListProjectSnapshotsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: HibernateProjectResponse | PlainMessage<HibernateProjectResponse> | undefined, b: HibernateProjectResponse | PlainMessage<HibernateProjectResponse> | undefined): boolean {
-    return proto3.util.equals(HibernateProjectResponse, a, b);
+  static equals(a: ListProjectSnapshotsResponse | PlainMessage<ListProjectSnapshotsResponse> | undefined, b: ListProjectSnapshotsResponse | PlainMessage<ListProjectSnapshotsResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectSnapshotsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerReconcileRequest
+ * @generated from message rill.admin.v1.CreateProjectSnapshotRequest
  */
-export class TriggerReconcileRequest extends Message<TriggerReconcileRequest> {
+export class CreateProjectSnapshotRequest extends Message<CreateProjectSnapshotRequest> {
   /**
-   * @generated from field: string deployment_id = 1;
+   * @generated from field: string org = 1;
    */
-  deploymentId = "";
+  org = "";
 
-  constructor(data?: PartialMessage<TriggerReconcileRequest>) {
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: bool superuser_force_access = 3;
+   */
+  superuserForceAccess = false;
+
+  constructor(data?: PartialMessage<CreateProjectSnapshotRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TriggerReconcileRequest";
+  static readonly typeName = "rill.admin.v1.CreateProjectSnapshotRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReconcileRequest {
-    return new TriggerReconcileRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectSnapshotRequest {
+    return new CreateProjectSnapshotRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReconcileRequest {
-    return new TriggerReconcileRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectSnapshotRequest {
+    return new CreateProjectSnapshotRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReconcileRequest {
-    return new TriggerReconcileRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectSnapshotRequest {
+    return new CreateProjectSnapshotRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TriggerReconcileRequest | PlainMessage<TriggerReconcileRequest> | undefined, b: TriggerReconcileRequest | PlainMessage<TriggerReconcileRequest> | undefined): boolean {
-    return proto3.util.equals(TriggerReconcileRequest, a, b);
+  static equals(a: CreateProjectSnapshotRequest | PlainMessage<CreateProjectSnapshotRequest> | undefined, b: CreateProjectSnapshotRequest | PlainMessage<CreateProjectSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(CreateProjectSnapshotRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerReconcileResponse
+ * @generated from message rill.admin.v1.CreateProjectSnapshotResponse
  */
-export class TriggerReconcileResponse extends Message<TriggerReconcileResponse> {
-  constructor(data?: PartialMessage<TriggerReconcileResponse>) {
+export class CreateProjectSnapshotResponse extends Message<CreateProjectSnapshotResponse> {
+  /**
+   * @generated from field: rill.admin.v1.ProjectSnapshot snapshot = 1;
+   */
+  snapshot?: ProjectSnapshot;
+
+  constructor(data?: PartialMessage<CreateProjectSnapshotResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TriggerReconcileResponse";
+  static readonly typeName = "rill.admin.v1.CreateProjectSnapshotResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "snapshot", kind: "message", T: ProjectSnapshot },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReconcileResponse {
-    return new TriggerReconcileResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectSnapshotResponse {
+    return new CreateProjectSnapshotResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReconcileResponse {
-    return new TriggerReconcileResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectSnapshotResponse {
+    return new CreateProjectSnapshotResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReconcileResponse {
-    return new TriggerReconcileResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectSnapshotResponse {
+    return new CreateProjectSnapshotResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TriggerReconcileResponse | PlainMessage<TriggerReconcileResponse> | undefined, b: TriggerReconcileResponse | PlainMessage<TriggerReconcileResponse> | undefined): boolean {
-    return proto3.util.equals(TriggerReconcileResponse, a, b);
+  static equals(a: CreateProjectSnapshotResponse | PlainMessage<CreateProjectSnapshotResponse> | undefined, b: CreateProjectSnapshotResponse | PlainMessage<CreateProjectSnapshotResponse> | undefined): boolean {
+    return proto3.util.equals(CreateProjectSnapshotResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerRefreshSourcesRequest
+ * @generated from message rill.admin.v1.RestoreProjectSnapshotRequest
  */
-export class TriggerRefreshSourcesRequest extends Message<TriggerRefreshSourcesRequest> {
+export class RestoreProjectSnapshotRequest extends Message<RestoreProjectSnapshotRequest> {
   /**
-   * @generated from field: string deployment_id = 1;
+   * @generated from field: string org = 1;
    */
-  deploymentId = "";
+  org = "";
 
   /**
-   * @generated from field: repeated string sources = 2;
+   * @generated from field: string project = 2;
    */
-  sources: string[] = [];
+  project = "";
 
-  constructor(data?: PartialMessage<TriggerRefreshSourcesRequest>) {
+  /**
+   * @generated from field: string snapshot_id = 3;
+   */
+  snapshotId = "";
+
+  /**
+   * @generated from field: bool superuser_force_access = 4;
+   */
+  superuserForceAccess = false;
+
+  constructor(data?: PartialMessage<RestoreProjectSnapshotRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TriggerRefreshSourcesRequest";
+  static readonly typeName = "rill.admin.v1.RestoreProjectSnapshotRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "sources", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerRefreshSourcesRequest {
-    return new TriggerRefreshSourcesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreProjectSnapshotRequest {
+    return new RestoreProjectSnapshotRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesRequest {
-    return new TriggerRefreshSourcesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreProjectSnapshotRequest {
+    return new RestoreProjectSnapshotRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesRequest {
-    return new TriggerRefreshSourcesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreProjectSnapshotRequest {
+    return new RestoreProjectSnapshotRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TriggerRefreshSourcesRequest | PlainMessage<TriggerRefreshSourcesRequest> | undefined, b: TriggerRefreshSourcesRequest | PlainMessage<TriggerRefreshSourcesRequest> | undefined): boolean {
-    return proto3.util.equals(TriggerRefreshSourcesRequest, a, b);
+  static equals(a: RestoreProjectSnapshotRequest | PlainMessage<RestoreProjectSnapshotRequest> | undefined, b: RestoreProjectSnapshotRequest | PlainMessage<RestoreProjectSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(RestoreProjectSnapshotRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerRefreshSourcesResponse
+ * @generated from message rill.admin.v1.RestoreProjectSnapshotResponse
  */
-export class TriggerRefreshSourcesResponse extends Message<TriggerRefreshSourcesResponse> {
-  constructor(data?: PartialMessage<TriggerRefreshSourcesResponse>) {
+export class RestoreProjectSnapshotResponse extends Message<RestoreProjectSnapshotResponse> {
+  constructor(data?: PartialMessage<RestoreProjectSnapshotResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TriggerRefreshSourcesResponse";
+  static readonly typeName = "rill.admin.v1.RestoreProjectSnapshotResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerRefreshSourcesResponse {
-    return new TriggerRefreshSourcesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreProjectSnapshotResponse {
+    return new RestoreProjectSnapshotResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesResponse {
-    return new TriggerRefreshSourcesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreProjectSnapshotResponse {
+    return new RestoreProjectSnapshotResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesResponse {
-    return new TriggerRefreshSourcesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreProjectSnapshotResponse {
+    return new RestoreProjectSnapshotResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TriggerRefreshSourcesResponse | PlainMessage<TriggerRefreshSourcesResponse> | undefined, b: TriggerRefreshSourcesResponse | PlainMessage<TriggerRefreshSourcesResponse> | undefined): boolean {
-    return proto3.util.equals(TriggerRefreshSourcesResponse, a, b);
+  static equals(a: RestoreProjectSnapshotResponse | PlainMessage<RestoreProjectSnapshotResponse> | undefined, b: RestoreProjectSnapshotResponse | PlainMessage<RestoreProjectSnapshotResponse> | undefined): boolean {
+    return proto3.util.equals(RestoreProjectSnapshotResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TriggerRedeployRequest
+ * @generated from message rill.admin.v1.HibernateProjectRequest
  */
-export class TriggerRedeployRequest extends Message<TriggerRedeployRequest> {
+export class HibernateProjectRequest extends Message<HibernateProjectRequest> {
+  /**
+   * @generated from field: string org = 1;
+   */
+  org = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: bool superuser_force_access = 3;
+   */
+  superuserForceAccess = false;
+
+  constructor(data?: PartialMessage<HibernateProjectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.HibernateProjectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HibernateProjectRequest {
+    return new HibernateProjectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HibernateProjectRequest {
+    return new HibernateProjectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HibernateProjectRequest {
+    return new HibernateProjectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HibernateProjectRequest | PlainMessage<HibernateProjectRequest> | undefined, b: HibernateProjectRequest | PlainMessage<HibernateProjectRequest> | undefined): boolean {
+    return proto3.util.equals(HibernateProjectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.HibernateProjectResponse
+ */
+export class HibernateProjectResponse extends Message<HibernateProjectResponse> {
+  constructor(data?: PartialMessage<HibernateProjectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.HibernateProjectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HibernateProjectResponse {
+    return new HibernateProjectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HibernateProjectResponse {
+    return new HibernateProjectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HibernateProjectResponse {
+    return new HibernateProjectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HibernateProjectResponse | PlainMessage<HibernateProjectResponse> | undefined, b: HibernateProjectResponse | PlainMessage<HibernateProjectResponse> | undefined): boolean {
+    return proto3.util.equals(HibernateProjectResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerReconcileRequest
+ */
+export class TriggerReconcileRequest extends Message<TriggerReconcileRequest> {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId = "";
+
+  constructor(data?: PartialMessage<TriggerReconcileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TriggerReconcileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReconcileRequest {
+    return new TriggerReconcileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReconcileRequest {
+    return new TriggerReconcileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReconcileRequest {
+    return new TriggerReconcileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerReconcileRequest | PlainMessage<TriggerReconcileRequest> | undefined, b: TriggerReconcileRequest | PlainMessage<TriggerReconcileRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerReconcileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerReconcileResponse
+ */
+export class TriggerReconcileResponse extends Message<TriggerReconcileResponse> {
+  constructor(data?: PartialMessage<TriggerReconcileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TriggerReconcileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerReconcileResponse {
+    return new TriggerReconcileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerReconcileResponse {
+    return new TriggerReconcileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerReconcileResponse {
+    return new TriggerReconcileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerReconcileResponse | PlainMessage<TriggerReconcileResponse> | undefined, b: TriggerReconcileResponse | PlainMessage<TriggerReconcileResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerReconcileResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerRefreshSourcesRequest
+ */
+export class TriggerRefreshSourcesRequest extends Message<TriggerRefreshSourcesRequest> {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId = "";
+
+  /**
+   * @generated from field: repeated string sources = 2;
+   */
+  sources: string[] = [];
+
+  constructor(data?: PartialMessage<TriggerRefreshSourcesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TriggerRefreshSourcesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sources", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerRefreshSourcesRequest {
+    return new TriggerRefreshSourcesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesRequest {
+    return new TriggerRefreshSourcesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesRequest {
+    return new TriggerRefreshSourcesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerRefreshSourcesRequest | PlainMessage<TriggerRefreshSourcesRequest> | undefined, b: TriggerRefreshSourcesRequest | PlainMessage<TriggerRefreshSourcesRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerRefreshSourcesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerRefreshSourcesResponse
+ */
+export class TriggerRefreshSourcesResponse extends Message<TriggerRefreshSourcesResponse> {
+  constructor(data?: PartialMessage<TriggerRefreshSourcesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TriggerRefreshSourcesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerRefreshSourcesResponse {
+    return new TriggerRefreshSourcesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesResponse {
+    return new TriggerRefreshSourcesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerRefreshSourcesResponse {
+    return new TriggerRefreshSourcesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerRefreshSourcesResponse | PlainMessage<TriggerRefreshSourcesResponse> | undefined, b: TriggerRefreshSourcesResponse | PlainMessage<TriggerRefreshSourcesResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerRefreshSourcesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TriggerRedeployRequest
+ */
+export class TriggerRedeployRequest extends Message<TriggerRedeployRequest> {
   /**
    * It's sufficient to pass org/project name OR deployment_id.
    * (To enable rehydrating hibernated projects.)
@@ -7047,64 +7509,212 @@ export class SudoIssueRuntimeManagerTokenResponse extends Message<SudoIssueRunti
 }
 
 /**
- * @generated from message rill.admin.v1.SudoDeleteOrganizationBillingIssueRequest
+ * @generated from message rill.admin.v1.SudoListRuntimeHostsRequest
  */
-export class SudoDeleteOrganizationBillingIssueRequest extends Message<SudoDeleteOrganizationBillingIssueRequest> {
-  /**
-   * @generated from field: string org = 1;
-   */
-  org = "";
-
-  /**
-   * @generated from field: rill.admin.v1.BillingIssueType type = 2;
-   */
-  type = BillingIssueType.UNSPECIFIED;
-
-  constructor(data?: PartialMessage<SudoDeleteOrganizationBillingIssueRequest>) {
+export class SudoListRuntimeHostsRequest extends Message<SudoListRuntimeHostsRequest> {
+  constructor(data?: PartialMessage<SudoListRuntimeHostsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoDeleteOrganizationBillingIssueRequest";
+  static readonly typeName = "rill.admin.v1.SudoListRuntimeHostsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(BillingIssueType) },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoDeleteOrganizationBillingIssueRequest {
-    return new SudoDeleteOrganizationBillingIssueRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoListRuntimeHostsRequest {
+    return new SudoListRuntimeHostsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoDeleteOrganizationBillingIssueRequest {
-    return new SudoDeleteOrganizationBillingIssueRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoListRuntimeHostsRequest {
+    return new SudoListRuntimeHostsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoDeleteOrganizationBillingIssueRequest {
-    return new SudoDeleteOrganizationBillingIssueRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoListRuntimeHostsRequest {
+    return new SudoListRuntimeHostsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoDeleteOrganizationBillingIssueRequest | PlainMessage<SudoDeleteOrganizationBillingIssueRequest> | undefined, b: SudoDeleteOrganizationBillingIssueRequest | PlainMessage<SudoDeleteOrganizationBillingIssueRequest> | undefined): boolean {
-    return proto3.util.equals(SudoDeleteOrganizationBillingIssueRequest, a, b);
+  static equals(a: SudoListRuntimeHostsRequest | PlainMessage<SudoListRuntimeHostsRequest> | undefined, b: SudoListRuntimeHostsRequest | PlainMessage<SudoListRuntimeHostsRequest> | undefined): boolean {
+    return proto3.util.equals(SudoListRuntimeHostsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SudoDeleteOrganizationBillingIssueResponse
+ * @generated from message rill.admin.v1.SudoListRuntimeHostsResponse
  */
-export class SudoDeleteOrganizationBillingIssueResponse extends Message<SudoDeleteOrganizationBillingIssueResponse> {
-  constructor(data?: PartialMessage<SudoDeleteOrganizationBillingIssueResponse>) {
+export class SudoListRuntimeHostsResponse extends Message<SudoListRuntimeHostsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.RuntimeHost hosts = 1;
+   */
+  hosts: RuntimeHost[] = [];
+
+  constructor(data?: PartialMessage<SudoListRuntimeHostsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoDeleteOrganizationBillingIssueResponse";
+  static readonly typeName = "rill.admin.v1.SudoListRuntimeHostsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hosts", kind: "message", T: RuntimeHost, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoDeleteOrganizationBillingIssueResponse {
-    return new SudoDeleteOrganizationBillingIssueResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoListRuntimeHostsResponse {
+    return new SudoListRuntimeHostsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoListRuntimeHostsResponse {
+    return new SudoListRuntimeHostsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoListRuntimeHostsResponse {
+    return new SudoListRuntimeHostsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoListRuntimeHostsResponse | PlainMessage<SudoListRuntimeHostsResponse> | undefined, b: SudoListRuntimeHostsResponse | PlainMessage<SudoListRuntimeHostsResponse> | undefined): boolean {
+    return proto3.util.equals(SudoListRuntimeHostsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SudoUpdateRuntimeHostRequest
+ */
+export class SudoUpdateRuntimeHostRequest extends Message<SudoUpdateRuntimeHostRequest> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * @generated from field: bool draining = 2;
+   */
+  draining = false;
+
+  constructor(data?: PartialMessage<SudoUpdateRuntimeHostRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoUpdateRuntimeHostRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "draining", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateRuntimeHostRequest {
+    return new SudoUpdateRuntimeHostRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateRuntimeHostRequest {
+    return new SudoUpdateRuntimeHostRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateRuntimeHostRequest {
+    return new SudoUpdateRuntimeHostRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoUpdateRuntimeHostRequest | PlainMessage<SudoUpdateRuntimeHostRequest> | undefined, b: SudoUpdateRuntimeHostRequest | PlainMessage<SudoUpdateRuntimeHostRequest> | undefined): boolean {
+    return proto3.util.equals(SudoUpdateRuntimeHostRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SudoUpdateRuntimeHostResponse
+ */
+export class SudoUpdateRuntimeHostResponse extends Message<SudoUpdateRuntimeHostResponse> {
+  /**
+   * @generated from field: rill.admin.v1.RuntimeHost host = 1;
+   */
+  host?: RuntimeHost;
+
+  constructor(data?: PartialMessage<SudoUpdateRuntimeHostResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoUpdateRuntimeHostResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "message", T: RuntimeHost },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateRuntimeHostResponse {
+    return new SudoUpdateRuntimeHostResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateRuntimeHostResponse {
+    return new SudoUpdateRuntimeHostResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateRuntimeHostResponse {
+    return new SudoUpdateRuntimeHostResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoUpdateRuntimeHostResponse | PlainMessage<SudoUpdateRuntimeHostResponse> | undefined, b: SudoUpdateRuntimeHostResponse | PlainMessage<SudoUpdateRuntimeHostResponse> | undefined): boolean {
+    return proto3.util.equals(SudoUpdateRuntimeHostResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SudoDeleteOrganizationBillingIssueRequest
+ */
+export class SudoDeleteOrganizationBillingIssueRequest extends Message<SudoDeleteOrganizationBillingIssueRequest> {
+  /**
+   * @generated from field: string org = 1;
+   */
+  org = "";
+
+  /**
+   * @generated from field: rill.admin.v1.BillingIssueType type = 2;
+   */
+  type = BillingIssueType.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SudoDeleteOrganizationBillingIssueRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoDeleteOrganizationBillingIssueRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(BillingIssueType) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoDeleteOrganizationBillingIssueRequest {
+    return new SudoDeleteOrganizationBillingIssueRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoDeleteOrganizationBillingIssueRequest {
+    return new SudoDeleteOrganizationBillingIssueRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoDeleteOrganizationBillingIssueRequest {
+    return new SudoDeleteOrganizationBillingIssueRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoDeleteOrganizationBillingIssueRequest | PlainMessage<SudoDeleteOrganizationBillingIssueRequest> | undefined, b: SudoDeleteOrganizationBillingIssueRequest | PlainMessage<SudoDeleteOrganizationBillingIssueRequest> | undefined): boolean {
+    return proto3.util.equals(SudoDeleteOrganizationBillingIssueRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SudoDeleteOrganizationBillingIssueResponse
+ */
+export class SudoDeleteOrganizationBillingIssueResponse extends Message<SudoDeleteOrganizationBillingIssueResponse> {
+  constructor(data?: PartialMessage<SudoDeleteOrganizationBillingIssueResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoDeleteOrganizationBillingIssueResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoDeleteOrganizationBillingIssueResponse {
+    return new SudoDeleteOrganizationBillingIssueResponse().fromBinary(bytes, options);
   }
 
   static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoDeleteOrganizationBillingIssueResponse {
@@ -13337,6 +13947,13 @@ export class GetReportMetaResponse extends Message<GetReportMetaResponse> {
    */
   deliveryMeta: { [key: string]: GetReportMetaResponse_DeliveryMeta } = {};
 
+  /**
+   * user attributes of the report's owner, will be empty if the report has no owner
+   *
+   * @generated from field: google.protobuf.Struct owner_attrs = 3;
+   */
+  ownerAttrs?: Struct;
+
   constructor(data?: PartialMessage<GetReportMetaResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -13346,6 +13963,7 @@ export class GetReportMetaResponse extends Message<GetReportMetaResponse> {
   static readonly typeName = "rill.admin.v1.GetReportMetaResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "delivery_meta", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: GetReportMetaResponse_DeliveryMeta} },
+    { no: 3, name: "owner_attrs", kind: "message", T: Struct },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaResponse {
@@ -15607,201 +16225,467 @@ export class GetBillingCreditBalanceResponse extends Message<GetBillingCreditBal
 }
 
 /**
- * @generated from message rill.admin.v1.ListPublicBillingPlansRequest
+ * @generated from message rill.admin.v1.GetOrganizationBudgetRequest
  */
-export class ListPublicBillingPlansRequest extends Message<ListPublicBillingPlansRequest> {
-  constructor(data?: PartialMessage<ListPublicBillingPlansRequest>) {
+export class GetOrganizationBudgetRequest extends Message<GetOrganizationBudgetRequest> {
+  /**
+   * @generated from field: string org = 1;
+   */
+  org = "";
+
+  /**
+   * @generated from field: bool superuser_force_access = 2;
+   */
+  superuserForceAccess = false;
+
+  constructor(data?: PartialMessage<GetOrganizationBudgetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListPublicBillingPlansRequest";
+  static readonly typeName = "rill.admin.v1.GetOrganizationBudgetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPublicBillingPlansRequest {
-    return new ListPublicBillingPlansRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationBudgetRequest {
+    return new GetOrganizationBudgetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPublicBillingPlansRequest {
-    return new ListPublicBillingPlansRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationBudgetRequest {
+    return new GetOrganizationBudgetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPublicBillingPlansRequest {
-    return new ListPublicBillingPlansRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationBudgetRequest {
+    return new GetOrganizationBudgetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListPublicBillingPlansRequest | PlainMessage<ListPublicBillingPlansRequest> | undefined, b: ListPublicBillingPlansRequest | PlainMessage<ListPublicBillingPlansRequest> | undefined): boolean {
-    return proto3.util.equals(ListPublicBillingPlansRequest, a, b);
+  static equals(a: GetOrganizationBudgetRequest | PlainMessage<GetOrganizationBudgetRequest> | undefined, b: GetOrganizationBudgetRequest | PlainMessage<GetOrganizationBudgetRequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationBudgetRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListPublicBillingPlansResponse
+ * @generated from message rill.admin.v1.GetOrganizationBudgetResponse
  */
-export class ListPublicBillingPlansResponse extends Message<ListPublicBillingPlansResponse> {
+export class GetOrganizationBudgetResponse extends Message<GetOrganizationBudgetResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.BillingPlan plans = 1;
+   * Not set if the organization doesn't have a budget.
+   *
+   * @generated from field: rill.admin.v1.OrganizationBudget budget = 1;
    */
-  plans: BillingPlan[] = [];
+  budget?: OrganizationBudget;
 
-  constructor(data?: PartialMessage<ListPublicBillingPlansResponse>) {
+  constructor(data?: PartialMessage<GetOrganizationBudgetResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListPublicBillingPlansResponse";
+  static readonly typeName = "rill.admin.v1.GetOrganizationBudgetResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "plans", kind: "message", T: BillingPlan, repeated: true },
+    { no: 1, name: "budget", kind: "message", T: OrganizationBudget },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPublicBillingPlansResponse {
-    return new ListPublicBillingPlansResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationBudgetResponse {
+    return new GetOrganizationBudgetResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPublicBillingPlansResponse {
-    return new ListPublicBillingPlansResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationBudgetResponse {
+    return new GetOrganizationBudgetResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPublicBillingPlansResponse {
-    return new ListPublicBillingPlansResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationBudgetResponse {
+    return new GetOrganizationBudgetResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListPublicBillingPlansResponse | PlainMessage<ListPublicBillingPlansResponse> | undefined, b: ListPublicBillingPlansResponse | PlainMessage<ListPublicBillingPlansResponse> | undefined): boolean {
-    return proto3.util.equals(ListPublicBillingPlansResponse, a, b);
+  static equals(a: GetOrganizationBudgetResponse | PlainMessage<GetOrganizationBudgetResponse> | undefined, b: GetOrganizationBudgetResponse | PlainMessage<GetOrganizationBudgetResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationBudgetResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetBillingProjectCredentialsRequest
+ * @generated from message rill.admin.v1.UpdateOrganizationBudgetRequest
  */
-export class GetBillingProjectCredentialsRequest extends Message<GetBillingProjectCredentialsRequest> {
+export class UpdateOrganizationBudgetRequest extends Message<UpdateOrganizationBudgetRequest> {
   /**
    * @generated from field: string org = 1;
    */
   org = "";
 
-  constructor(data?: PartialMessage<GetBillingProjectCredentialsRequest>) {
+  /**
+   * Usage metric to budget. If empty, the budget applies to the organization's spend.
+   *
+   * @generated from field: string metric = 2;
+   */
+  metric = "";
+
+  /**
+   * @generated from field: double amount = 3;
+   */
+  amount = 0;
+
+  /**
+   * Percentages of the amount at which the organization's admins are notified.
+   *
+   * @generated from field: repeated int32 warning_thresholds = 4;
+   */
+  warningThresholds: number[] = [];
+
+  /**
+   * If true, the organization's non-production deployments are hibernated when the budget is exceeded.
+   *
+   * @generated from field: bool hard_cap = 5;
+   */
+  hardCap = false;
+
+  /**
+   * @generated from field: bool superuser_force_access = 6;
+   */
+  superuserForceAccess = false;
+
+  constructor(data?: PartialMessage<UpdateOrganizationBudgetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetBillingProjectCredentialsRequest";
+  static readonly typeName = "rill.admin.v1.UpdateOrganizationBudgetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "metric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "amount", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "warning_thresholds", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 5, name: "hard_cap", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBillingProjectCredentialsRequest {
-    return new GetBillingProjectCredentialsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationBudgetRequest {
+    return new UpdateOrganizationBudgetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsRequest {
-    return new GetBillingProjectCredentialsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationBudgetRequest {
+    return new UpdateOrganizationBudgetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsRequest {
-    return new GetBillingProjectCredentialsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationBudgetRequest {
+    return new UpdateOrganizationBudgetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBillingProjectCredentialsRequest | PlainMessage<GetBillingProjectCredentialsRequest> | undefined, b: GetBillingProjectCredentialsRequest | PlainMessage<GetBillingProjectCredentialsRequest> | undefined): boolean {
-    return proto3.util.equals(GetBillingProjectCredentialsRequest, a, b);
+  static equals(a: UpdateOrganizationBudgetRequest | PlainMessage<UpdateOrganizationBudgetRequest> | undefined, b: UpdateOrganizationBudgetRequest | PlainMessage<UpdateOrganizationBudgetRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationBudgetRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetBillingProjectCredentialsResponse
+ * @generated from message rill.admin.v1.UpdateOrganizationBudgetResponse
  */
-export class GetBillingProjectCredentialsResponse extends Message<GetBillingProjectCredentialsResponse> {
-  /**
-   * @generated from field: string runtime_host = 1;
-   */
-  runtimeHost = "";
-
-  /**
-   * @generated from field: string instance_id = 2;
-   */
-  instanceId = "";
-
-  /**
-   * @generated from field: string access_token = 3;
-   */
-  accessToken = "";
-
+export class UpdateOrganizationBudgetResponse extends Message<UpdateOrganizationBudgetResponse> {
   /**
-   * @generated from field: uint32 ttl_seconds = 4;
+   * @generated from field: rill.admin.v1.OrganizationBudget budget = 1;
    */
-  ttlSeconds = 0;
+  budget?: OrganizationBudget;
 
-  constructor(data?: PartialMessage<GetBillingProjectCredentialsResponse>) {
+  constructor(data?: PartialMessage<UpdateOrganizationBudgetResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetBillingProjectCredentialsResponse";
+  static readonly typeName = "rill.admin.v1.UpdateOrganizationBudgetResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "runtime_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "budget", kind: "message", T: OrganizationBudget },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBillingProjectCredentialsResponse {
-    return new GetBillingProjectCredentialsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationBudgetResponse {
+    return new UpdateOrganizationBudgetResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsResponse {
-    return new GetBillingProjectCredentialsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationBudgetResponse {
+    return new UpdateOrganizationBudgetResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsResponse {
-    return new GetBillingProjectCredentialsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationBudgetResponse {
+    return new UpdateOrganizationBudgetResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBillingProjectCredentialsResponse | PlainMessage<GetBillingProjectCredentialsResponse> | undefined, b: GetBillingProjectCredentialsResponse | PlainMessage<GetBillingProjectCredentialsResponse> | undefined): boolean {
-    return proto3.util.equals(GetBillingProjectCredentialsResponse, a, b);
+  static equals(a: UpdateOrganizationBudgetResponse | PlainMessage<UpdateOrganizationBudgetResponse> | undefined, b: UpdateOrganizationBudgetResponse | PlainMessage<UpdateOrganizationBudgetResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationBudgetResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.TelemetryRequest
+ * @generated from message rill.admin.v1.DeleteOrganizationBudgetRequest
  */
-export class TelemetryRequest extends Message<TelemetryRequest> {
-  /**
-   * Name passed to activity module's name arg
-   *
-   * @generated from field: string name = 1;
-   */
-  name = "";
-
+export class DeleteOrganizationBudgetRequest extends Message<DeleteOrganizationBudgetRequest> {
   /**
-   * Value passed to activity module's value arg
-   *
-   * @generated from field: float value = 2;
+   * @generated from field: string org = 1;
    */
-  value = 0;
+  org = "";
 
   /**
-   * Free form struct of the actual event
-   *
-   * @generated from field: google.protobuf.Struct event = 3;
+   * @generated from field: bool superuser_force_access = 2;
    */
-  event?: Struct;
+  superuserForceAccess = false;
 
-  constructor(data?: PartialMessage<TelemetryRequest>) {
+  constructor(data?: PartialMessage<DeleteOrganizationBudgetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.TelemetryRequest";
+  static readonly typeName = "rill.admin.v1.DeleteOrganizationBudgetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
-    { no: 3, name: "event", kind: "message", T: Struct },
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "superuser_force_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationBudgetRequest {
+    return new DeleteOrganizationBudgetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationBudgetRequest {
+    return new DeleteOrganizationBudgetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationBudgetRequest {
+    return new DeleteOrganizationBudgetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOrganizationBudgetRequest | PlainMessage<DeleteOrganizationBudgetRequest> | undefined, b: DeleteOrganizationBudgetRequest | PlainMessage<DeleteOrganizationBudgetRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationBudgetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteOrganizationBudgetResponse
+ */
+export class DeleteOrganizationBudgetResponse extends Message<DeleteOrganizationBudgetResponse> {
+  constructor(data?: PartialMessage<DeleteOrganizationBudgetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteOrganizationBudgetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationBudgetResponse {
+    return new DeleteOrganizationBudgetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationBudgetResponse {
+    return new DeleteOrganizationBudgetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationBudgetResponse {
+    return new DeleteOrganizationBudgetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOrganizationBudgetResponse | PlainMessage<DeleteOrganizationBudgetResponse> | undefined, b: DeleteOrganizationBudgetResponse | PlainMessage<DeleteOrganizationBudgetResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationBudgetResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListPublicBillingPlansRequest
+ */
+export class ListPublicBillingPlansRequest extends Message<ListPublicBillingPlansRequest> {
+  constructor(data?: PartialMessage<ListPublicBillingPlansRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListPublicBillingPlansRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPublicBillingPlansRequest {
+    return new ListPublicBillingPlansRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPublicBillingPlansRequest {
+    return new ListPublicBillingPlansRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPublicBillingPlansRequest {
+    return new ListPublicBillingPlansRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPublicBillingPlansRequest | PlainMessage<ListPublicBillingPlansRequest> | undefined, b: ListPublicBillingPlansRequest | PlainMessage<ListPublicBillingPlansRequest> | undefined): boolean {
+    return proto3.util.equals(ListPublicBillingPlansRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListPublicBillingPlansResponse
+ */
+export class ListPublicBillingPlansResponse extends Message<ListPublicBillingPlansResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.BillingPlan plans = 1;
+   */
+  plans: BillingPlan[] = [];
+
+  constructor(data?: PartialMessage<ListPublicBillingPlansResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListPublicBillingPlansResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plans", kind: "message", T: BillingPlan, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPublicBillingPlansResponse {
+    return new ListPublicBillingPlansResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPublicBillingPlansResponse {
+    return new ListPublicBillingPlansResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPublicBillingPlansResponse {
+    return new ListPublicBillingPlansResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPublicBillingPlansResponse | PlainMessage<ListPublicBillingPlansResponse> | undefined, b: ListPublicBillingPlansResponse | PlainMessage<ListPublicBillingPlansResponse> | undefined): boolean {
+    return proto3.util.equals(ListPublicBillingPlansResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetBillingProjectCredentialsRequest
+ */
+export class GetBillingProjectCredentialsRequest extends Message<GetBillingProjectCredentialsRequest> {
+  /**
+   * @generated from field: string org = 1;
+   */
+  org = "";
+
+  constructor(data?: PartialMessage<GetBillingProjectCredentialsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetBillingProjectCredentialsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBillingProjectCredentialsRequest {
+    return new GetBillingProjectCredentialsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsRequest {
+    return new GetBillingProjectCredentialsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsRequest {
+    return new GetBillingProjectCredentialsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBillingProjectCredentialsRequest | PlainMessage<GetBillingProjectCredentialsRequest> | undefined, b: GetBillingProjectCredentialsRequest | PlainMessage<GetBillingProjectCredentialsRequest> | undefined): boolean {
+    return proto3.util.equals(GetBillingProjectCredentialsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetBillingProjectCredentialsResponse
+ */
+export class GetBillingProjectCredentialsResponse extends Message<GetBillingProjectCredentialsResponse> {
+  /**
+   * @generated from field: string runtime_host = 1;
+   */
+  runtimeHost = "";
+
+  /**
+   * @generated from field: string instance_id = 2;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string access_token = 3;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: uint32 ttl_seconds = 4;
+   */
+  ttlSeconds = 0;
+
+  constructor(data?: PartialMessage<GetBillingProjectCredentialsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetBillingProjectCredentialsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "runtime_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBillingProjectCredentialsResponse {
+    return new GetBillingProjectCredentialsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsResponse {
+    return new GetBillingProjectCredentialsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBillingProjectCredentialsResponse {
+    return new GetBillingProjectCredentialsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBillingProjectCredentialsResponse | PlainMessage<GetBillingProjectCredentialsResponse> | undefined, b: GetBillingProjectCredentialsResponse | PlainMessage<GetBillingProjectCredentialsResponse> | undefined): boolean {
+    return proto3.util.equals(GetBillingProjectCredentialsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.TelemetryRequest
+ */
+export class TelemetryRequest extends Message<TelemetryRequest> {
+  /**
+   * Name passed to activity module's name arg
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Value passed to activity module's value arg
+   *
+   * @generated from field: float value = 2;
+   */
+  value = 0;
+
+  /**
+   * Free form struct of the actual event
+   *
+   * @generated from field: google.protobuf.Struct event = 3;
+   */
+  event?: Struct;
+
+  constructor(data?: PartialMessage<TelemetryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.TelemetryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "event", kind: "message", T: Struct },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TelemetryRequest {
@@ -17190,6 +18074,204 @@ export class Deployment extends Message<Deployment> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.ProjectSnapshot
+ */
+export class ProjectSnapshot extends Message<ProjectSnapshot> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string deployment_id = 3;
+   */
+  deploymentId = "";
+
+  /**
+   * @generated from field: rill.admin.v1.ProjectSnapshotStatus status = 4;
+   */
+  status = ProjectSnapshotStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string status_message = 5;
+   */
+  statusMessage = "";
+
+  /**
+   * ID of the user who requested the snapshot. Empty for scheduled snapshots.
+   *
+   * @generated from field: string created_by_user_id = 6;
+   */
+  createdByUserId = "";
+
+  /**
+   * @generated from field: int64 size_bytes = 7;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated string tables = 8;
+   */
+  tables: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 9;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 10;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<ProjectSnapshot>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ProjectSnapshot";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(ProjectSnapshotStatus) },
+    { no: 5, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "tables", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "created_on", kind: "message", T: Timestamp },
+    { no: 10, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectSnapshot {
+    return new ProjectSnapshot().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectSnapshot {
+    return new ProjectSnapshot().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectSnapshot {
+    return new ProjectSnapshot().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectSnapshot | PlainMessage<ProjectSnapshot> | undefined, b: ProjectSnapshot | PlainMessage<ProjectSnapshot> | undefined): boolean {
+    return proto3.util.equals(ProjectSnapshot, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.OrganizationBudget
+ */
+export class OrganizationBudget extends Message<OrganizationBudget> {
+  /**
+   * Usage metric the budget applies to. Empty for budgets on the organization's spend.
+   *
+   * @generated from field: string metric = 1;
+   */
+  metric = "";
+
+  /**
+   * @generated from field: double amount = 2;
+   */
+  amount = 0;
+
+  /**
+   * @generated from field: repeated int32 warning_thresholds = 3;
+   */
+  warningThresholds: number[] = [];
+
+  /**
+   * @generated from field: bool hard_cap = 4;
+   */
+  hardCap = false;
+
+  /**
+   * Spend or usage in the current period as of the last evaluation.
+   *
+   * @generated from field: double current_value = 5;
+   */
+  currentValue = 0;
+
+  /**
+   * Highest warning threshold that has been notified in the current period.
+   *
+   * @generated from field: int32 notified_threshold = 6;
+   */
+  notifiedThreshold = 0;
+
+  /**
+   * True if the hard cap has been enforced in the current period.
+   *
+   * @generated from field: bool capped = 7;
+   */
+  capped = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp period_start = 8;
+   */
+  periodStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp evaluated_on = 9;
+   */
+  evaluatedOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 10;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 11;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<OrganizationBudget>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.OrganizationBudget";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "amount", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "warning_thresholds", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 4, name: "hard_cap", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "current_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "notified_threshold", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "capped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "period_start", kind: "message", T: Timestamp },
+    { no: 9, name: "evaluated_on", kind: "message", T: Timestamp },
+    { no: 10, name: "created_on", kind: "message", T: Timestamp },
+    { no: 11, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationBudget {
+    return new OrganizationBudget().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationBudget {
+    return new OrganizationBudget().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationBudget {
+    return new OrganizationBudget().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationBudget | PlainMessage<OrganizationBudget> | undefined, b: OrganizationBudget | PlainMessage<OrganizationBudget> | undefined): boolean {
+    return proto3.util.equals(OrganizationBudget, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.ProvisionerResource
  */
@@ -17257,6 +18339,131 @@ export class ProvisionerResource extends Message<ProvisionerResource> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.RuntimeHost
+ */
+export class RuntimeHost extends Message<RuntimeHost> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string host = 2;
+   */
+  host = "";
+
+  /**
+   * @generated from field: string audience = 3;
+   */
+  audience = "";
+
+  /**
+   * @generated from field: int32 cpu = 4;
+   */
+  cpu = 0;
+
+  /**
+   * @generated from field: int32 memory_gb = 5;
+   */
+  memoryGb = 0;
+
+  /**
+   * @generated from field: int32 cpu_used = 6;
+   */
+  cpuUsed = 0;
+
+  /**
+   * @generated from field: int32 memory_gb_used = 7;
+   */
+  memoryGbUsed = 0;
+
+  /**
+   * @generated from field: int32 instances = 8;
+   */
+  instances = 0;
+
+  /**
+   * True if the host has been drained for maintenance. Draining hosts receive no new instances.
+   *
+   * @generated from field: bool draining = 9;
+   */
+  draining = false;
+
+  /**
+   * False if the host has failed too many consecutive health checks.
+   *
+   * @generated from field: bool healthy = 10;
+   */
+  healthy = false;
+
+  /**
+   * @generated from field: int32 failed_checks = 11;
+   */
+  failedChecks = 0;
+
+  /**
+   * @generated from field: string status_message = 12;
+   */
+  statusMessage = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_on = 13;
+   */
+  checkedOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 14;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 15;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<RuntimeHost>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RuntimeHost";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "audience", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "cpu", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "memory_gb", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "cpu_used", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "memory_gb_used", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "instances", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "draining", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "healthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "failed_checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "checked_on", kind: "message", T: Timestamp },
+    { no: 14, name: "created_on", kind: "message", T: Timestamp },
+    { no: 15, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RuntimeHost {
+    return new RuntimeHost().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RuntimeHost {
+    return new RuntimeHost().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RuntimeHost {
+    return new RuntimeHost().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RuntimeHost | PlainMessage<RuntimeHost> | undefined, b: RuntimeHost | PlainMessage<RuntimeHost> | undefined): boolean {
+    return proto3.util.equals(RuntimeHost, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.OrganizationPermissions
  */
@@ -17858,6 +19065,137 @@ export class UsergroupMemberUser extends Message<UsergroupMemberUser> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string org_id = 2;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId = "";
+
+  /**
+   * Type of caller that performed the action, such as "user", "service" or "system"
+   *
+   * @generated from field: string actor_type = 4;
+   */
+  actorType = "";
+
+  /**
+   * @generated from field: string actor_id = 5;
+   */
+  actorId = "";
+
+  /**
+   * @generated from field: string actor_email = 6;
+   */
+  actorEmail = "";
+
+  /**
+   * Set if a superuser performed the action while assuming the actor's identity
+   *
+   * @generated from field: string assumed_by_user_id = 7;
+   */
+  assumedByUserId = "";
+
+  /**
+   * @generated from field: string action = 8;
+   */
+  action = "";
+
+  /**
+   * @generated from field: string target_type = 9;
+   */
+  targetType = "";
+
+  /**
+   * @generated from field: string target_id = 10;
+   */
+  targetId = "";
+
+  /**
+   * @generated from field: string target_name = 11;
+   */
+  targetName = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct before = 12;
+   */
+  before?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Struct after = 13;
+   */
+  after?: Struct;
+
+  /**
+   * @generated from field: string client_ip = 14;
+   */
+  clientIp = "";
+
+  /**
+   * @generated from field: string user_agent = 15;
+   */
+  userAgent = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 16;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actor_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "actor_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "assumed_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "target_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "target_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "target_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "before", kind: "message", T: Struct },
+    { no: 13, name: "after", kind: "message", T: Struct },
+    { no: 14, name: "client_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "user_agent", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.OrganizationInvite
  */
@@ -18748,6 +20086,13 @@ export class AlertOptions extends Message<AlertOptions> {
    */
   webOpenState = "";
 
+  /**
+   * If set, the alert's notifications are suppressed until this time.
+   *
+   * @generated from field: google.protobuf.Timestamp snooze_until = 18;
+   */
+  snoozeUntil?: Timestamp;
+
   constructor(data?: PartialMessage<AlertOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -18773,6 +20118,7 @@ export class AlertOptions extends Message<AlertOptions> {
     { no: 11, name: "slack_webhooks", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 15, name: "web_open_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 12, name: "web_open_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "snooze_until", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertOptions {
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AnalyzeConnectorsRequest, AnalyzeConnectorsResponse, AnalyzeVariablesRequest, AnalyzeVariablesResponse, CompleteRequest, CompleteResponse, CompleteStreamingRequest, CompleteStreamingResponse, CreateDirectoryRequest, CreateDirectoryResponse, CreateInstanceRequest, CreateInstanceResponse, CreateTriggerRequest, CreateTriggerResponse, DeleteFileRequest, DeleteFileResponse, DeleteInstanceRequest, DeleteInstanceResponse, EditInstanceRequest, EditInstanceResponse, ForkConversationRequest, ForkConversationResponse, GenerateCanvasFileRequest, GenerateCanvasFileResponse, GenerateMetricsViewFileRequest, GenerateMetricsViewFileResponse, GetAIMessageRequest, GetAIMessageResponse, GetConversationRequest, GetConversationResponse, GetExploreRequest, GetExploreResponse, GetFileRequest, GetFileResponse, GetInstanceRequest, GetInstanceResponse, GetLogsRequest, GetLogsResponse, GetModelPartitionsRequest, GetModelPartitionsResponse, GetResourceRequest, GetResourceResponse, GitCommitRequest, GitCommitResponse, GitDiffRequest, GitDiffResponse, GitMergeToBranchRequest, GitMergeToBranchResponse, GitPullRequest, GitPullResponse, GitPushRequest, GitPushResponse, GitRevertRequest, GitRevertResponse, GitStatusRequest, GitStatusResponse, GitSwitchBranchRequest, GitSwitchBranchResponse, HealthRequest, HealthResponse, InstanceHealthRequest, InstanceHealthResponse, IssueDevJWTRequest, IssueDevJWTResponse, ListConnectorDriversRequest, ListConnectorDriversResponse, ListConversationsRequest, ListConversationsResponse, ListExamplesRequest, ListExamplesResponse, ListFilesRequest, ListFilesResponse, ListGitBranchesRequest, ListGitBranchesResponse, ListGitCommitsRequest, ListGitCommitsResponse, ListInstancesRequest, ListInstancesResponse, ListNotifierConnectorsRequest, ListNotifierConnectorsResponse, ListResourcesRequest, ListResourcesResponse, ListToolsRequest, ListToolsResponse, PingRequest, PingResponse, PushEnvRequest, PushEnvResponse, PutFileRequest, PutFileResponse, QueryResolverRequest, QueryResolverResponse, ReloadConfigRequest, ReloadConfigResponse, RenameFileRequest, RenameFileResponse, RestoreGitCommitRequest, RestoreGitCommitResponse, ShareConversationRequest, ShareConversationResponse, SkipModelPartitionsRequest, SkipModelPartitionsResponse, UnpackEmptyRequest, UnpackEmptyResponse, UnpackExampleRequest, UnpackExampleResponse, WatchFilesRequest, WatchFilesResponse, WatchLogsRequest, WatchLogsResponse, WatchResourcesRequest, WatchResourcesResponse } from "./api_pb.js";
+import { AnalyzeConnectorsRequest, AnalyzeConnectorsResponse, AnalyzeVariablesRequest, AnalyzeVariablesResponse, CompleteRequest, CompleteResponse, CompleteStreamingRequest, CompleteStreamingResponse, CreateDirectoryRequest, CreateDirectoryResponse, CreateInstanceRequest, CreateInstanceResponse, CreateSnapshotRequest, CreateSnapshotResponse, CreateTriggerRequest, CreateTriggerResponse, DeleteFileRequest, DeleteFileResponse, DeleteInstanceRequest, DeleteInstanceResponse, DeleteSnapshotRequest, DeleteSnapshotResponse, EditInstanceRequest, EditInstanceResponse, ForkConversationRequest, ForkConversationResponse, GenerateCanvasFileRequest, GenerateCanvasFileResponse, GenerateMetricsViewFileRequest, GenerateMetricsViewFileResponse, GetAIMessageRequest, GetAIMessageResponse, GetColumnLineageRequest, GetColumnLineageResponse, GetConversationRequest, GetConversationResponse, GetExploreRequest, GetExploreResponse, GetFileRequest, GetFileResponse, GetInstanceRequest, GetInstanceResponse, GetLogsRequest, GetLogsResponse, GetModelPartitionsRequest, GetModelPartitionsResponse, GetResourceRequest, GetResourceResponse, GitCommitRequest, GitCommitResponse, GitDiffRequest, GitDiffResponse, GitMergeToBranchRequest, GitMergeToBranchResponse, GitPullRequest, GitPullResponse, GitPushRequest, GitPushResponse, GitRevertRequest, GitRevertResponse, GitStatusRequest, GitStatusResponse, GitSwitchBranchRequest, GitSwitchBranchResponse, HealthRequest, HealthResponse, InstanceHealthRequest, InstanceHealthResponse, InvalidateQueryCacheRequest, InvalidateQueryCacheResponse, IssueDevJWTRequest, IssueDevJWTResponse, ListConnectorDriversRequest, ListConnectorDriversResponse, ListConversationsRequest, ListConversationsResponse, ListExamplesRequest, ListExamplesResponse, ListFilesRequest, ListFilesResponse, ListGitBranchesRequest, ListGitBranchesResponse, ListGitCommitsRequest, ListGitCommitsResponse, ListInstancesRequest, ListInstancesResponse, ListNotifierConnectorsRequest, ListNotifierConnectorsResponse, ListResourcesRequest, ListResourcesResponse, ListToolsRequest, ListToolsResponse, PingRequest, PingResponse, PlanProjectRequest, PlanProjectResponse, PushEnvRequest, PushEnvResponse, PutFileRequest, PutFileResponse, QueryResolverRequest, QueryResolverResponse, ReloadConfigRequest, ReloadConfigResponse, RenameFileRequest, RenameFileResponse, RestoreGitCommitRequest, RestoreGitCommitResponse, RestoreSnapshotRequest, RestoreSnapshotResponse, ShareConversationRequest, ShareConversationResponse, SkipModelPartitionsRequest, SkipModelPartitionsResponse, UnpackEmptyRequest, UnpackEmptyResponse, UnpackExampleRequest, UnpackExampleResponse, WatchFilesRequest, WatchFilesResponse, WatchLogsRequest, WatchLogsResponse, WatchResourcesRequest, WatchResourcesResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -348,6 +348,18 @@ export const RuntimeService = {
       O: SkipModelPartitionsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetColumnLineage returns the column-level lineage between models, metrics views and explores.
+     * If a resource and column are provided, only the edges downstream of that column are returned (e.g. to find which dashboards break if the column is dropped).
+     *
+     * @generated from rpc rill.runtime.v1.RuntimeService.GetColumnLineage
+     */
+    getColumnLineage: {
+      name: "GetColumnLineage",
+      I: GetColumnLineageRequest,
+      O: GetColumnLineageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * CreateTrigger submits a refresh trigger, which will asynchronously refresh the specified resources.
      * Triggers are ephemeral resources that will be cleaned up by the controller.
@@ -360,6 +372,65 @@ export const RuntimeService = {
       O: CreateTriggerResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * InvalidateQueryCache evicts cached query results for a metrics view or, if no metrics view is specified, for the whole instance.
+     * It is useful for forcing fresh results after data has been changed outside of Rill (e.g. a manual backfill).
+     *
+     * @generated from rpc rill.runtime.v1.RuntimeService.InvalidateQueryCache
+     */
+    invalidateQueryCache: {
+      name: "InvalidateQueryCache",
+      I: InvalidateQueryCacheRequest,
+      O: InvalidateQueryCacheResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateSnapshot takes a point-in-time snapshot of an instance's OLAP data and catalog and stores it in the runtime's object storage.
+     * It is currently only supported for instances that use DuckDB with backups enabled.
+     *
+     * @generated from rpc rill.runtime.v1.RuntimeService.CreateSnapshot
+     */
+    createSnapshot: {
+      name: "CreateSnapshot",
+      I: CreateSnapshotRequest,
+      O: CreateSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RestoreSnapshot replaces an instance's OLAP data and catalog with a snapshot created by CreateSnapshot.
+     * The instance's controller is stopped during the restore and restarted afterwards.
+     *
+     * @generated from rpc rill.runtime.v1.RuntimeService.RestoreSnapshot
+     */
+    restoreSnapshot: {
+      name: "RestoreSnapshot",
+      I: RestoreSnapshotRequest,
+      O: RestoreSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteSnapshot deletes a snapshot created by CreateSnapshot.
+     *
+     * @generated from rpc rill.runtime.v1.RuntimeService.DeleteSnapshot
+     */
+    deleteSnapshot: {
+      name: "DeleteSnapshot",
+      I: DeleteSnapshotRequest,
+      O: DeleteSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PlanProject parses the project files and reports the changes that reconciling them would make to the catalog, without applying them.
+     * It is similar to "terraform plan" and is useful for previewing the impact of a change before committing it.
+     *
+     * @generated from rpc rill.runtime.v1.RuntimeService.PlanProject
+     */
+    planProject: {
+      name: "PlanProject",
+      I: PlanProjectRequest,
+      O: PlanProjectResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ListConnectorDrivers returns a description of all the connector drivers registed in the runtime,
      * including their configuration specs and the capabilities they support.
@@ -4,9 +4,9 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message as Message$1, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Message as Message$1, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
 import { StructType } from "./schema_pb.js";
-import { RefreshModelTrigger, Resource, ResourceName } from "./resources_pb.js";
+import { ParseError, RefreshModelTrigger, Resource, ResourceName } from "./resources_pb.js";
 import { ContentBlock, Tool } from "../../ai/v1/ai_pb.js";
 import { Expression } from "./expression_pb.js";
 
@@ -974,6 +974,14 @@ export class DeleteInstanceRequest extends Message$1<DeleteInstanceRequest> {
    */
   instanceId = "";
 
+  /**
+   * If true, the instance's data in remote storage is kept and only its local data is removed.
+   * Used when moving an instance to another runtime host that shares the same remote storage.
+   *
+   * @generated from field: bool keep_storage = 2;
+   */
+  keepStorage = false;
+
   constructor(data?: PartialMessage<DeleteInstanceRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -983,6 +991,7 @@ export class DeleteInstanceRequest extends Message$1<DeleteInstanceRequest> {
   static readonly typeName = "rill.runtime.v1.DeleteInstanceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "keep_storage", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteInstanceRequest {
@@ -3486,6 +3495,155 @@ export class SkipModelPartitionsResponse extends Message$1<SkipModelPartitionsRe
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.GetColumnLineageRequest
+ */
+export class GetColumnLineageRequest extends Message$1<GetColumnLineageRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Optional resource to trace downstream lineage from. If not set, the full lineage graph is returned.
+   *
+   * @generated from field: rill.runtime.v1.ResourceName resource = 2;
+   */
+  resource?: ResourceName;
+
+  /**
+   * Optional column of the resource to trace downstream lineage from. Required if resource is set.
+   *
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  constructor(data?: PartialMessage<GetColumnLineageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetColumnLineageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource", kind: "message", T: ResourceName },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetColumnLineageRequest {
+    return new GetColumnLineageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetColumnLineageRequest {
+    return new GetColumnLineageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetColumnLineageRequest {
+    return new GetColumnLineageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetColumnLineageRequest | PlainMessage<GetColumnLineageRequest> | undefined, b: GetColumnLineageRequest | PlainMessage<GetColumnLineageRequest> | undefined): boolean {
+    return proto3.util.equals(GetColumnLineageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetColumnLineageResponse
+ */
+export class GetColumnLineageResponse extends Message$1<GetColumnLineageResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.ColumnLineageEdge edges = 1;
+   */
+  edges: ColumnLineageEdge[] = [];
+
+  constructor(data?: PartialMessage<GetColumnLineageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetColumnLineageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edges", kind: "message", T: ColumnLineageEdge, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetColumnLineageResponse {
+    return new GetColumnLineageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetColumnLineageResponse {
+    return new GetColumnLineageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetColumnLineageResponse {
+    return new GetColumnLineageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetColumnLineageResponse | PlainMessage<GetColumnLineageResponse> | undefined, b: GetColumnLineageResponse | PlainMessage<GetColumnLineageResponse> | undefined): boolean {
+    return proto3.util.equals(GetColumnLineageResponse, a, b);
+  }
+}
+
+/**
+ * ColumnLineageEdge indicates that a column of one resource is derived from a column of another resource.
+ * For metrics views and explores, the columns are dimension and measure names.
+ * A column of "*" indicates that all columns are passed through (e.g. for "SELECT *" or SQL that could not be analyzed).
+ *
+ * @generated from message rill.runtime.v1.ColumnLineageEdge
+ */
+export class ColumnLineageEdge extends Message$1<ColumnLineageEdge> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName from_resource = 1;
+   */
+  fromResource?: ResourceName;
+
+  /**
+   * @generated from field: string from_column = 2;
+   */
+  fromColumn = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName to_resource = 3;
+   */
+  toResource?: ResourceName;
+
+  /**
+   * @generated from field: string to_column = 4;
+   */
+  toColumn = "";
+
+  constructor(data?: PartialMessage<ColumnLineageEdge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ColumnLineageEdge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_resource", kind: "message", T: ResourceName },
+    { no: 2, name: "from_column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_resource", kind: "message", T: ResourceName },
+    { no: 4, name: "to_column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnLineageEdge {
+    return new ColumnLineageEdge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnLineageEdge {
+    return new ColumnLineageEdge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnLineageEdge {
+    return new ColumnLineageEdge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnLineageEdge | PlainMessage<ColumnLineageEdge> | undefined, b: ColumnLineageEdge | PlainMessage<ColumnLineageEdge> | undefined): boolean {
+    return proto3.util.equals(ColumnLineageEdge, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.CreateTriggerRequest
  */
@@ -3600,6 +3758,593 @@ export class CreateTriggerResponse extends Message$1<CreateTriggerResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.InvalidateQueryCacheRequest
+ */
+export class InvalidateQueryCacheRequest extends Message$1<InvalidateQueryCacheRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Metrics view to invalidate cached results for. If empty, all cached results for the instance are invalidated.
+   *
+   * @generated from field: string metrics_view = 2;
+   */
+  metricsView = "";
+
+  constructor(data?: PartialMessage<InvalidateQueryCacheRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.InvalidateQueryCacheRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "metrics_view", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InvalidateQueryCacheRequest {
+    return new InvalidateQueryCacheRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InvalidateQueryCacheRequest {
+    return new InvalidateQueryCacheRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InvalidateQueryCacheRequest {
+    return new InvalidateQueryCacheRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InvalidateQueryCacheRequest | PlainMessage<InvalidateQueryCacheRequest> | undefined, b: InvalidateQueryCacheRequest | PlainMessage<InvalidateQueryCacheRequest> | undefined): boolean {
+    return proto3.util.equals(InvalidateQueryCacheRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.InvalidateQueryCacheResponse
+ */
+export class InvalidateQueryCacheResponse extends Message$1<InvalidateQueryCacheResponse> {
+  constructor(data?: PartialMessage<InvalidateQueryCacheResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.InvalidateQueryCacheResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InvalidateQueryCacheResponse {
+    return new InvalidateQueryCacheResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InvalidateQueryCacheResponse {
+    return new InvalidateQueryCacheResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InvalidateQueryCacheResponse {
+    return new InvalidateQueryCacheResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InvalidateQueryCacheResponse | PlainMessage<InvalidateQueryCacheResponse> | undefined, b: InvalidateQueryCacheResponse | PlainMessage<InvalidateQueryCacheResponse> | undefined): boolean {
+    return proto3.util.equals(InvalidateQueryCacheResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.CreateSnapshotRequest
+ */
+export class CreateSnapshotRequest extends Message$1<CreateSnapshotRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * ID to store the snapshot under. It may only contain letters, digits, underscores and dashes.
+   *
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  constructor(data?: PartialMessage<CreateSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.CreateSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSnapshotRequest {
+    return new CreateSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSnapshotRequest {
+    return new CreateSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSnapshotRequest {
+    return new CreateSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSnapshotRequest | PlainMessage<CreateSnapshotRequest> | undefined, b: CreateSnapshotRequest | PlainMessage<CreateSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.CreateSnapshotResponse
+ */
+export class CreateSnapshotResponse extends Message$1<CreateSnapshotResponse> {
+  /**
+   * Names of the tables and views in the snapshot.
+   *
+   * @generated from field: repeated string tables = 1;
+   */
+  tables: string[] = [];
+
+  /**
+   * Total size of the table data in the snapshot.
+   *
+   * @generated from field: int64 size_bytes = 2;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * Number of catalog resources in the snapshot.
+   *
+   * @generated from field: int32 resources = 3;
+   */
+  resources = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<CreateSnapshotResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.CreateSnapshotResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tables", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "resources", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSnapshotResponse {
+    return new CreateSnapshotResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSnapshotResponse {
+    return new CreateSnapshotResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSnapshotResponse {
+    return new CreateSnapshotResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSnapshotResponse | PlainMessage<CreateSnapshotResponse> | undefined, b: CreateSnapshotResponse | PlainMessage<CreateSnapshotResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSnapshotResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.RestoreSnapshotRequest
+ */
+export class RestoreSnapshotRequest extends Message$1<RestoreSnapshotRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  constructor(data?: PartialMessage<RestoreSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.RestoreSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreSnapshotRequest {
+    return new RestoreSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreSnapshotRequest {
+    return new RestoreSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreSnapshotRequest {
+    return new RestoreSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreSnapshotRequest | PlainMessage<RestoreSnapshotRequest> | undefined, b: RestoreSnapshotRequest | PlainMessage<RestoreSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(RestoreSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.RestoreSnapshotResponse
+ */
+export class RestoreSnapshotResponse extends Message$1<RestoreSnapshotResponse> {
+  constructor(data?: PartialMessage<RestoreSnapshotResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.RestoreSnapshotResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreSnapshotResponse {
+    return new RestoreSnapshotResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreSnapshotResponse {
+    return new RestoreSnapshotResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreSnapshotResponse {
+    return new RestoreSnapshotResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreSnapshotResponse | PlainMessage<RestoreSnapshotResponse> | undefined, b: RestoreSnapshotResponse | PlainMessage<RestoreSnapshotResponse> | undefined): boolean {
+    return proto3.util.equals(RestoreSnapshotResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.DeleteSnapshotRequest
+ */
+export class DeleteSnapshotRequest extends Message$1<DeleteSnapshotRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  constructor(data?: PartialMessage<DeleteSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.DeleteSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSnapshotRequest {
+    return new DeleteSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSnapshotRequest {
+    return new DeleteSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSnapshotRequest {
+    return new DeleteSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSnapshotRequest | PlainMessage<DeleteSnapshotRequest> | undefined, b: DeleteSnapshotRequest | PlainMessage<DeleteSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.DeleteSnapshotResponse
+ */
+export class DeleteSnapshotResponse extends Message$1<DeleteSnapshotResponse> {
+  constructor(data?: PartialMessage<DeleteSnapshotResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.DeleteSnapshotResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSnapshotResponse {
+    return new DeleteSnapshotResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSnapshotResponse {
+    return new DeleteSnapshotResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSnapshotResponse {
+    return new DeleteSnapshotResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSnapshotResponse | PlainMessage<DeleteSnapshotResponse> | undefined, b: DeleteSnapshotResponse | PlainMessage<DeleteSnapshotResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSnapshotResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.PlanProjectRequest
+ */
+export class PlanProjectRequest extends Message$1<PlanProjectRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Optional project files to plan, keyed by path (e.g. "/models/foo.sql").
+   * If set, they are planned instead of the files in the instance's repo.
+   * Data files (e.g. CSV files referenced by local file models) do not need to be included.
+   *
+   * @generated from field: map<string, string> files = 2;
+   */
+  files: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<PlanProjectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PlanProjectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "files", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanProjectRequest {
+    return new PlanProjectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanProjectRequest {
+    return new PlanProjectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanProjectRequest {
+    return new PlanProjectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanProjectRequest | PlainMessage<PlanProjectRequest> | undefined, b: PlanProjectRequest | PlainMessage<PlanProjectRequest> | undefined): boolean {
+    return proto3.util.equals(PlanProjectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.PlanProjectResponse
+ */
+export class PlanProjectResponse extends Message$1<PlanProjectResponse> {
+  /**
+   * Changes that would be made to resources in the catalog, in DAG order.
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceChange changes = 1;
+   */
+  changes: ResourceChange[] = [];
+
+  /**
+   * Errors encountered while parsing the planned files.
+   *
+   * @generated from field: repeated rill.runtime.v1.ParseError parse_errors = 2;
+   */
+  parseErrors: ParseError[] = [];
+
+  constructor(data?: PartialMessage<PlanProjectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PlanProjectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: ResourceChange, repeated: true },
+    { no: 2, name: "parse_errors", kind: "message", T: ParseError, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanProjectResponse {
+    return new PlanProjectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanProjectResponse {
+    return new PlanProjectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanProjectResponse {
+    return new PlanProjectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanProjectResponse | PlainMessage<PlanProjectResponse> | undefined, b: PlanProjectResponse | PlainMessage<PlanProjectResponse> | undefined): boolean {
+    return proto3.util.equals(PlanProjectResponse, a, b);
+  }
+}
+
+/**
+ * ResourceChange describes a change that reconciling a project would make to a resource.
+ *
+ * @generated from message rill.runtime.v1.ResourceChange
+ */
+export class ResourceChange extends Message$1<ResourceChange> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName name = 1;
+   */
+  name?: ResourceName;
+
+  /**
+   * @generated from field: rill.runtime.v1.ResourceChange.Action action = 2;
+   */
+  action = ResourceChange_Action.UNSPECIFIED;
+
+  /**
+   * The resource's current name if it will be renamed.
+   *
+   * @generated from field: rill.runtime.v1.ResourceName renamed_from = 3;
+   */
+  renamedFrom?: ResourceName;
+
+  /**
+   * How the resource will be refreshed. Only set for models.
+   *
+   * @generated from field: rill.runtime.v1.ResourceChange.ModelRefresh model_refresh = 4;
+   */
+  modelRefresh = ResourceChange_ModelRefresh.UNSPECIFIED;
+
+  /**
+   * Paths of the files that define the resource.
+   *
+   * @generated from field: repeated string file_paths = 5;
+   */
+  filePaths: string[] = [];
+
+  constructor(data?: PartialMessage<ResourceChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ResourceChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "message", T: ResourceName },
+    { no: 2, name: "action", kind: "enum", T: proto3.getEnumType(ResourceChange_Action) },
+    { no: 3, name: "renamed_from", kind: "message", T: ResourceName },
+    { no: 4, name: "model_refresh", kind: "enum", T: proto3.getEnumType(ResourceChange_ModelRefresh) },
+    { no: 5, name: "file_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceChange {
+    return new ResourceChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceChange {
+    return new ResourceChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceChange {
+    return new ResourceChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceChange | PlainMessage<ResourceChange> | undefined, b: ResourceChange | PlainMessage<ResourceChange> | undefined): boolean {
+    return proto3.util.equals(ResourceChange, a, b);
+  }
+}
+
+/**
+ * @generated from enum rill.runtime.v1.ResourceChange.Action
+ */
+export enum ResourceChange_Action {
+  /**
+   * @generated from enum value: ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The resource will be created.
+   *
+   * @generated from enum value: ACTION_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * The resource's spec or refs will be updated.
+   *
+   * @generated from enum value: ACTION_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * The resource will be deleted.
+   *
+   * @generated from enum value: ACTION_DELETE = 3;
+   */
+  DELETE = 3,
+
+  /**
+   * The resource will be renamed without changes to its spec.
+   *
+   * @generated from enum value: ACTION_RENAME = 4;
+   */
+  RENAME = 4,
+
+  /**
+   * The resource is unchanged, but it will be re-executed because a resource it depends on changed.
+   *
+   * @generated from enum value: ACTION_REEXECUTE = 5;
+   */
+  REEXECUTE = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ResourceChange_Action)
+proto3.util.setEnumType(ResourceChange_Action, "rill.runtime.v1.ResourceChange.Action", [
+  { no: 0, name: "ACTION_UNSPECIFIED" },
+  { no: 1, name: "ACTION_CREATE" },
+  { no: 2, name: "ACTION_UPDATE" },
+  { no: 3, name: "ACTION_DELETE" },
+  { no: 4, name: "ACTION_RENAME" },
+  { no: 5, name: "ACTION_REEXECUTE" },
+]);
+
+/**
+ * @generated from enum rill.runtime.v1.ResourceChange.ModelRefresh
+ */
+export enum ResourceChange_ModelRefresh {
+  /**
+   * The model will not be executed.
+   *
+   * @generated from enum value: MODEL_REFRESH_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The model will be fully refreshed, discarding any existing data.
+   *
+   * @generated from enum value: MODEL_REFRESH_FULL = 1;
+   */
+  FULL = 1,
+
+  /**
+   * The model will be incrementally refreshed.
+   *
+   * @generated from enum value: MODEL_REFRESH_INCREMENTAL = 2;
+   */
+  INCREMENTAL = 2,
+
+  /**
+   * The model will not be executed until it is manually triggered because of its change_mode.
+   *
+   * @generated from enum value: MODEL_REFRESH_MANUAL = 3;
+   */
+  MANUAL = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ResourceChange_ModelRefresh)
+proto3.util.setEnumType(ResourceChange_ModelRefresh, "rill.runtime.v1.ResourceChange.ModelRefresh", [
+  { no: 0, name: "MODEL_REFRESH_UNSPECIFIED" },
+  { no: 1, name: "MODEL_REFRESH_FULL" },
+  { no: 2, name: "MODEL_REFRESH_INCREMENTAL" },
+  { no: 3, name: "MODEL_REFRESH_MANUAL" },
+]);
+
 /**
  * ConnectorDriver represents a connector driver available in the runtime.
  *
@@ -33,6 +33,16 @@ export enum ExportFormat {
    * @generated from enum value: EXPORT_FORMAT_PDF = 4;
    */
   PDF = 4,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_JSONL = 5;
+   */
+  JSONL = 5,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_ARROW = 6;
+   */
+  ARROW = 6,
 }
 // Retrieve enum metadata with: proto3.getEnumType(ExportFormat)
 proto3.util.setEnumType(ExportFormat, "rill.runtime.v1.ExportFormat", [
@@ -41,5 +51,7 @@ proto3.util.setEnumType(ExportFormat, "rill.runtime.v1.ExportFormat", [
   { no: 2, name: "EXPORT_FORMAT_XLSX" },
   { no: 3, name: "EXPORT_FORMAT_PARQUET" },
   { no: 4, name: "EXPORT_FORMAT_PDF" },
+  { no: 5, name: "EXPORT_FORMAT_JSONL" },
+  { no: 6, name: "EXPORT_FORMAT_ARROW" },
 ]);
 
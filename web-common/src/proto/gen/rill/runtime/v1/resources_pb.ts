@@ -75,6 +75,44 @@ proto3.util.setEnumType(ModelChangeMode, "rill.runtime.v1.ModelChangeMode", [
   { no: 3, name: "MODEL_CHANGE_MODE_PATCH" },
 ]);
 
+/**
+ * @generated from enum rill.runtime.v1.FieldMaskType
+ */
+export enum FieldMaskType {
+  /**
+   * @generated from enum value: FIELD_MASK_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Replaces values with a hash of the value. Equal values have equal hashes, so they can still be grouped and joined on.
+   *
+   * @generated from enum value: FIELD_MASK_TYPE_HASH = 1;
+   */
+  HASH = 1,
+
+  /**
+   * Replaces all but the last `keep_last` characters of values.
+   *
+   * @generated from enum value: FIELD_MASK_TYPE_REDACT = 2;
+   */
+  REDACT = 2,
+
+  /**
+   * Rounds numeric values down to a multiple of `bucket_size`.
+   *
+   * @generated from enum value: FIELD_MASK_TYPE_BUCKET = 3;
+   */
+  BUCKET = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FieldMaskType)
+proto3.util.setEnumType(FieldMaskType, "rill.runtime.v1.FieldMaskType", [
+  { no: 0, name: "FIELD_MASK_TYPE_UNSPECIFIED" },
+  { no: 1, name: "FIELD_MASK_TYPE_HASH" },
+  { no: 2, name: "FIELD_MASK_TYPE_REDACT" },
+  { no: 3, name: "FIELD_MASK_TYPE_BUCKET" },
+]);
+
 /**
  * @generated from enum rill.runtime.v1.ExploreComparisonMode
  */
@@ -1011,6 +1049,13 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   tests: ModelTest[] = [];
 
+  /**
+   * freshness is an optional SLA for how recently the model's data must have been updated.
+   *
+   * @generated from field: rill.runtime.v1.ModelFreshness freshness = 31;
+   */
+  freshness?: ModelFreshness;
+
   /**
    * trigger indicates a normal refresh (incremental or full depending on the model type).
    *
@@ -1068,6 +1113,7 @@ export class ModelSpec extends Message<ModelSpec> {
     { no: 29, name: "retry_if_error_matches", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 24, name: "change_mode", kind: "enum", T: proto3.getEnumType(ModelChangeMode) },
     { no: 25, name: "tests", kind: "message", T: ModelTest, repeated: true },
+    { no: 31, name: "freshness", kind: "message", T: ModelFreshness },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 22, name: "trigger_full", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 30, name: "trigger_partitions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
@@ -1230,6 +1276,21 @@ export class ModelState extends Message<ModelState> {
    */
   bytesTotal = protoInt64.zero;
 
+  /**
+   * freshness_watermark is the time the model's data was last updated, as evaluated for its freshness SLA.
+   * It is only set if the model has a freshness SLA.
+   *
+   * @generated from field: google.protobuf.Timestamp freshness_watermark = 31;
+   */
+  freshnessWatermark?: Timestamp;
+
+  /**
+   * stale is true if the model's data is older than the max staleness allowed by its freshness SLA.
+   *
+   * @generated from field: bool stale = 32;
+   */
+  stale = false;
+
   constructor(data?: PartialMessage<ModelState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1257,6 +1318,8 @@ export class ModelState extends Message<ModelState> {
     { no: 13, name: "latest_execution_duration_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 14, name: "rows_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 15, name: "bytes_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 31, name: "freshness_watermark", kind: "message", T: Timestamp },
+    { no: 32, name: "stale", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelState {
@@ -1325,6 +1388,60 @@ export class ModelTest extends Message<ModelTest> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.ModelFreshness
+ */
+export class ModelFreshness extends Message<ModelFreshness> {
+  /**
+   * max_staleness_seconds is the maximum allowed time since the model's data was last updated.
+   *
+   * @generated from field: uint32 max_staleness_seconds = 1;
+   */
+  maxStalenessSeconds = 0;
+
+  /**
+   * watermark_resolver optionally resolves the time the model's data was last updated (e.g. the max of an updated_at column).
+   * If not set, the time of the model's last refresh is used.
+   *
+   * @generated from field: string watermark_resolver = 2;
+   */
+  watermarkResolver = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct watermark_resolver_properties = 3;
+   */
+  watermarkResolverProperties?: Struct;
+
+  constructor(data?: PartialMessage<ModelFreshness>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelFreshness";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_staleness_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "watermark_resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "watermark_resolver_properties", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelFreshness {
+    return new ModelFreshness().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelFreshness {
+    return new ModelFreshness().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelFreshness {
+    return new ModelFreshness().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelFreshness | PlainMessage<ModelFreshness> | undefined, b: ModelFreshness | PlainMessage<ModelFreshness> | undefined): boolean {
+    return proto3.util.equals(ModelFreshness, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsView
  */
@@ -2336,6 +2453,12 @@ export class SecurityRule extends Message<SecurityRule> {
      */
     value: SecurityRuleTransitiveAccess;
     case: "transitiveAccess";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.SecurityRuleFieldMask field_mask = 5;
+     */
+    value: SecurityRuleFieldMask;
+    case: "fieldMask";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<SecurityRule>) {
@@ -2350,6 +2473,7 @@ export class SecurityRule extends Message<SecurityRule> {
     { no: 2, name: "field_access", kind: "message", T: SecurityRuleFieldAccess, oneof: "rule" },
     { no: 3, name: "row_filter", kind: "message", T: SecurityRuleRowFilter, oneof: "rule" },
     { no: 4, name: "transitive_access", kind: "message", T: SecurityRuleTransitiveAccess, oneof: "rule" },
+    { no: 5, name: "field_mask", kind: "message", T: SecurityRuleFieldMask, oneof: "rule" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecurityRule {
@@ -2598,6 +2722,96 @@ export class SecurityRuleRowFilter extends Message<SecurityRuleRowFilter> {
   }
 }
 
+/**
+ * SecurityRuleFieldMask masks the values of dimensions instead of hiding them.
+ * Masked dimensions can still be selected, grouped by and filtered on, but only their masked values are visible.
+ *
+ * @generated from message rill.runtime.v1.SecurityRuleFieldMask
+ */
+export class SecurityRuleFieldMask extends Message<SecurityRuleFieldMask> {
+  /**
+   * The condition under which this rule applies.
+   * It is ANDed together with the condition_kinds and condition_resources.
+   *
+   * @generated from field: string condition_expression = 1;
+   */
+  conditionExpression = "";
+
+  /**
+   * The resource kinds the rule applies to. If empty, it defaults to all resource kinds.
+   *
+   * @generated from field: repeated string condition_kinds = 2;
+   */
+  conditionKinds: string[] = [];
+
+  /**
+   * The resources the rule applies to. If empty, it defaults to all resources in scope covered by `resource_kinds`.
+   * It is ORed together with the condition_kinds.
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceName condition_resources = 3;
+   */
+  conditionResources: ResourceName[] = [];
+
+  /**
+   * The dimensions to mask.
+   *
+   * @generated from field: repeated string fields = 4;
+   */
+  fields: string[] = [];
+
+  /**
+   * @generated from field: rill.runtime.v1.FieldMaskType type = 5;
+   */
+  type = FieldMaskType.UNSPECIFIED;
+
+  /**
+   * Number of trailing characters to leave visible for FIELD_MASK_TYPE_REDACT.
+   *
+   * @generated from field: uint32 keep_last = 6;
+   */
+  keepLast = 0;
+
+  /**
+   * Width of the buckets that values are rounded down to for FIELD_MASK_TYPE_BUCKET.
+   *
+   * @generated from field: double bucket_size = 7;
+   */
+  bucketSize = 0;
+
+  constructor(data?: PartialMessage<SecurityRuleFieldMask>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.SecurityRuleFieldMask";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "condition_expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "condition_kinds", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "condition_resources", kind: "message", T: ResourceName, repeated: true },
+    { no: 4, name: "fields", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "type", kind: "enum", T: proto3.getEnumType(FieldMaskType) },
+    { no: 6, name: "keep_last", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "bucket_size", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecurityRuleFieldMask {
+    return new SecurityRuleFieldMask().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecurityRuleFieldMask {
+    return new SecurityRuleFieldMask().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecurityRuleFieldMask {
+    return new SecurityRuleFieldMask().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecurityRuleFieldMask | PlainMessage<SecurityRuleFieldMask> | undefined, b: SecurityRuleFieldMask | PlainMessage<SecurityRuleFieldMask> | undefined): boolean {
+    return proto3.util.equals(SecurityRuleFieldMask, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.SecurityRuleTransitiveAccess
  */
@@ -3711,6 +3925,20 @@ export class ReportSpec extends Message<ReportSpec> {
    */
   intervalsCheckUnclosed = false;
 
+  /**
+   * Connector of an object store to write the exported file to on each run (optional).
+   *
+   * @generated from field: string export_output_connector = 19;
+   */
+  exportOutputConnector = "";
+
+  /**
+   * Properties for writing to the output connector. The "path" property is a template that can reference the report time.
+   *
+   * @generated from field: google.protobuf.Struct export_output_properties = 20;
+   */
+  exportOutputProperties?: Struct;
+
   constructor(data?: PartialMessage<ReportSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3736,6 +3964,8 @@ export class ReportSpec extends Message<ReportSpec> {
     { no: 13, name: "intervals_iso_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 14, name: "intervals_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 15, name: "intervals_check_unclosed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 19, name: "export_output_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "export_output_properties", kind: "message", T: Struct },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSpec {
@@ -3844,6 +4074,13 @@ export class ReportExecution extends Message<ReportExecution> {
    */
   finishedOn?: Timestamp;
 
+  /**
+   * URI of the object that the exported file was written to (if the report has an output connector).
+   *
+   * @generated from field: string output_uri = 7;
+   */
+  outputUri = "";
+
   constructor(data?: PartialMessage<ReportExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3858,6 +4095,7 @@ export class ReportExecution extends Message<ReportExecution> {
     { no: 3, name: "report_time", kind: "message", T: Timestamp },
     { no: 4, name: "started_on", kind: "message", T: Timestamp },
     { no: 5, name: "finished_on", kind: "message", T: Timestamp },
+    { no: 7, name: "output_uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportExecution {
@@ -4046,6 +4284,28 @@ export class AlertSpec extends Message<AlertSpec> {
    */
   annotations: { [key: string]: string } = {};
 
+  /**
+   * Recurring windows during which notifications are suppressed.
+   *
+   * @generated from field: repeated rill.runtime.v1.AlertMuteWindow mute_windows = 24;
+   */
+  muteWindows: AlertMuteWindow[] = [];
+
+  /**
+   * If set, notifications are suppressed until this time.
+   *
+   * @generated from field: google.protobuf.Timestamp snooze_until = 25;
+   */
+  snoozeUntil?: Timestamp;
+
+  /**
+   * Fields in the alert's result rows that identify a group.
+   * If set, each group is tracked separately, so notifications, renotifications and recoveries apply per group.
+   *
+   * @generated from field: repeated string group_by = 26;
+   */
+  groupBy: string[] = [];
+
   constructor(data?: PartialMessage<AlertSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4076,6 +4336,9 @@ export class AlertSpec extends Message<AlertSpec> {
     { no: 19, name: "renotify_after_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 21, name: "notifiers", kind: "message", T: Notifier, repeated: true },
     { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 24, name: "mute_windows", kind: "message", T: AlertMuteWindow, repeated: true },
+    { no: 25, name: "snooze_until", kind: "message", T: Timestamp },
+    { no: 26, name: "group_by", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertSpec {
@@ -4138,6 +4401,63 @@ export class Notifier extends Message<Notifier> {
   }
 }
 
+/**
+ * AlertMuteWindow is a recurring window during which an alert's notifications are suppressed.
+ *
+ * @generated from message rill.runtime.v1.AlertMuteWindow
+ */
+export class AlertMuteWindow extends Message<AlertMuteWindow> {
+  /**
+   * Cron expression for when the window starts.
+   *
+   * @generated from field: string cron = 1;
+   */
+  cron = "";
+
+  /**
+   * Time zone to evaluate the cron expression in.
+   *
+   * @generated from field: string time_zone = 2;
+   */
+  timeZone = "";
+
+  /**
+   * Length of the window.
+   *
+   * @generated from field: uint32 duration_seconds = 3;
+   */
+  durationSeconds = 0;
+
+  constructor(data?: PartialMessage<AlertMuteWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.AlertMuteWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "duration_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertMuteWindow {
+    return new AlertMuteWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertMuteWindow {
+    return new AlertMuteWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertMuteWindow {
+    return new AlertMuteWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertMuteWindow | PlainMessage<AlertMuteWindow> | undefined, b: AlertMuteWindow | PlainMessage<AlertMuteWindow> | undefined): boolean {
+    return proto3.util.equals(AlertMuteWindow, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.AlertState
  */
@@ -4172,6 +4492,13 @@ export class AlertState extends Message<AlertState> {
    */
   executionCount = 0;
 
+  /**
+   * State of each currently failing group for alerts with group_by. Keyed by the group key.
+   *
+   * @generated from field: map<string, rill.runtime.v1.AlertGroupState> groups = 7;
+   */
+  groups: { [key: string]: AlertGroupState } = {};
+
   constructor(data?: PartialMessage<AlertState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4186,6 +4513,7 @@ export class AlertState extends Message<AlertState> {
     { no: 4, name: "current_execution", kind: "message", T: AlertExecution },
     { no: 5, name: "execution_history", kind: "message", T: AlertExecution, repeated: true },
     { no: 6, name: "execution_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "groups", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: AlertGroupState} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertState {
@@ -4205,6 +4533,77 @@ export class AlertState extends Message<AlertState> {
   }
 }
 
+/**
+ * AlertGroupState tracks the state of a single group of an alert with group_by.
+ *
+ * @generated from message rill.runtime.v1.AlertGroupState
+ */
+export class AlertGroupState extends Message<AlertGroupState> {
+  /**
+   * Values of the group_by fields for the group.
+   *
+   * @generated from field: google.protobuf.Struct key = 1;
+   */
+  key?: Struct;
+
+  /**
+   * @generated from field: rill.runtime.v1.AssertionStatus status = 2;
+   */
+  status = AssertionStatus.UNSPECIFIED;
+
+  /**
+   * The group's row in the latest failing execution.
+   *
+   * @generated from field: google.protobuf.Struct fail_row = 3;
+   */
+  failRow?: Struct;
+
+  /**
+   * When the group started failing.
+   *
+   * @generated from field: google.protobuf.Timestamp failing_since = 4;
+   */
+  failingSince?: Timestamp;
+
+  /**
+   * When a notification was last sent for the group.
+   *
+   * @generated from field: google.protobuf.Timestamp last_notified_on = 5;
+   */
+  lastNotifiedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<AlertGroupState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.AlertGroupState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "message", T: Struct },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(AssertionStatus) },
+    { no: 3, name: "fail_row", kind: "message", T: Struct },
+    { no: 4, name: "failing_since", kind: "message", T: Timestamp },
+    { no: 5, name: "last_notified_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertGroupState {
+    return new AlertGroupState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertGroupState {
+    return new AlertGroupState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertGroupState {
+    return new AlertGroupState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertGroupState | PlainMessage<AlertGroupState> | undefined, b: AlertGroupState | PlainMessage<AlertGroupState> | undefined): boolean {
+    return proto3.util.equals(AlertGroupState, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.AlertExecution
  */
@@ -4246,6 +4645,13 @@ export class AlertExecution extends Message<AlertExecution> {
    */
   suppressedSince?: Timestamp;
 
+  /**
+   * If notifications were suppressed by a mute window or snooze, the time the mute ends.
+   *
+   * @generated from field: google.protobuf.Timestamp muted_until = 8;
+   */
+  mutedUntil?: Timestamp;
+
   constructor(data?: PartialMessage<AlertExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4261,6 +4667,7 @@ export class AlertExecution extends Message<AlertExecution> {
     { no: 5, name: "started_on", kind: "message", T: Timestamp },
     { no: 6, name: "finished_on", kind: "message", T: Timestamp },
     { no: 7, name: "suppressed_since", kind: "message", T: Timestamp },
+    { no: 8, name: "muted_until", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertExecution {
@@ -4304,6 +4711,20 @@ export class AssertionResult extends Message<AssertionResult> {
    */
   warnings: string[] = [];
 
+  /**
+   * For alerts with group_by, the failing row of each group.
+   *
+   * @generated from field: repeated google.protobuf.Struct fail_rows = 5;
+   */
+  failRows: Struct[] = [];
+
+  /**
+   * True if more groups failed than are tracked in fail_rows.
+   *
+   * @generated from field: bool fail_rows_truncated = 6;
+   */
+  failRowsTruncated = false;
+
   constructor(data?: PartialMessage<AssertionResult>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4316,6 +4737,8 @@ export class AssertionResult extends Message<AssertionResult> {
     { no: 2, name: "fail_row", kind: "message", T: Struct },
     { no: 3, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "fail_rows", kind: "message", T: Struct, repeated: true },
+    { no: 6, name: "fail_rows_truncated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssertionResult {
@@ -5633,6 +6056,16 @@ export class APISpec extends Message<APISpec> {
    */
   skipNestedSecurity = false;
 
+  /**
+   * @generated from field: rill.runtime.v1.APIRateLimit rate_limit = 12;
+   */
+  rateLimit?: APIRateLimit;
+
+  /**
+   * @generated from field: rill.runtime.v1.APIPagination pagination = 13;
+   */
+  pagination?: APIPagination;
+
   constructor(data?: PartialMessage<APISpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -5650,6 +6083,8 @@ export class APISpec extends Message<APISpec> {
     { no: 11, name: "openapi_defs_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "security_rules", kind: "message", T: SecurityRule, repeated: true },
     { no: 7, name: "skip_nested_security", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "rate_limit", kind: "message", T: APIRateLimit },
+    { no: 13, name: "pagination", kind: "message", T: APIPagination },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APISpec {
@@ -5669,6 +6104,121 @@ export class APISpec extends Message<APISpec> {
   }
 }
 
+/**
+ * APIRateLimit limits the number of requests each caller can make to an API.
+ *
+ * @generated from message rill.runtime.v1.APIRateLimit
+ */
+export class APIRateLimit extends Message<APIRateLimit> {
+  /**
+   * Maximum number of requests a caller can make per period.
+   *
+   * @generated from field: uint32 requests = 1;
+   */
+  requests = 0;
+
+  /**
+   * Length of the period in seconds.
+   *
+   * @generated from field: uint32 period_seconds = 2;
+   */
+  periodSeconds = 0;
+
+  /**
+   * Optional user attribute to identify callers by.
+   * If not set, callers are identified by their user or service ID, or by their IP address for anonymous requests.
+   *
+   * @generated from field: string key_attribute = 3;
+   */
+  keyAttribute = "";
+
+  constructor(data?: PartialMessage<APIRateLimit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.APIRateLimit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "requests", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "period_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "key_attribute", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIRateLimit {
+    return new APIRateLimit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIRateLimit {
+    return new APIRateLimit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIRateLimit {
+    return new APIRateLimit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIRateLimit | PlainMessage<APIRateLimit> | undefined, b: APIRateLimit | PlainMessage<APIRateLimit> | undefined): boolean {
+    return proto3.util.equals(APIRateLimit, a, b);
+  }
+}
+
+/**
+ * APIPagination enables cursor pagination of an API's results.
+ *
+ * @generated from message rill.runtime.v1.APIPagination
+ */
+export class APIPagination extends Message<APIPagination> {
+  /**
+   * Columns to sort the results by. They should uniquely identify each row to make pages stable.
+   *
+   * @generated from field: repeated string sort_keys = 1;
+   */
+  sortKeys: string[] = [];
+
+  /**
+   * Number of rows to return per page if the caller doesn't specify a page size.
+   *
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * Maximum page size a caller can request.
+   *
+   * @generated from field: uint32 max_page_size = 3;
+   */
+  maxPageSize = 0;
+
+  constructor(data?: PartialMessage<APIPagination>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.APIPagination";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sort_keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "max_page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIPagination {
+    return new APIPagination().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIPagination {
+    return new APIPagination().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIPagination {
+    return new APIPagination().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIPagination | PlainMessage<APIPagination> | undefined, b: APIPagination | PlainMessage<APIPagination> | undefined): boolean {
+    return proto3.util.equals(APIPagination, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.APIState
  */
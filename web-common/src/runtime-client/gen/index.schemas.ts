@@ -238,6 +238,31 @@ export interface V1API {
 
 export type V1APISpecResolverProperties = { [key: string]: unknown };
 
+/**
+ * APIPagination enables cursor pagination of an API's results.
+ */
+export interface V1APIPagination {
+  /** Columns to sort the results by. They should uniquely identify each row to make pages stable. */
+  sortKeys?: string[];
+  /** Number of rows to return per page if the caller doesn't specify a page size. */
+  pageSize?: number;
+  /** Maximum page size a caller can request. */
+  maxPageSize?: number;
+}
+
+/**
+ * APIRateLimit limits the number of requests each caller can make to an API.
+ */
+export interface V1APIRateLimit {
+  /** Maximum number of requests a caller can make per period. */
+  requests?: number;
+  /** Length of the period in seconds. */
+  periodSeconds?: number;
+  /** Optional user attribute to identify callers by.
+If not set, callers are identified by their user or service ID, or by their IP address for anonymous requests. */
+  keyAttribute?: string;
+}
+
 export interface V1APISpec {
   resolver?: string;
   resolverProperties?: V1APISpecResolverProperties;
@@ -248,6 +273,8 @@ export interface V1APISpec {
   openapiDefsPrefix?: string;
   securityRules?: V1SecurityRule[];
   skipNestedSecurity?: boolean;
+  rateLimit?: V1APIRateLimit;
+  pagination?: V1APIPagination;
 }
 
 export interface V1APIState {
@@ -267,6 +294,45 @@ export interface V1AlertExecution {
   startedOn?: string;
   finishedOn?: string;
   suppressedSince?: string;
+  /** If notifications were suppressed by a mute window or snooze, the time the mute ends. */
+  mutedUntil?: string;
+}
+
+/**
+ * Values of the group_by fields for the group.
+ */
+export type V1AlertGroupStateKey = { [key: string]: unknown };
+
+/**
+ * The group's row in the latest failing execution.
+ */
+export type V1AlertGroupStateFailRow = { [key: string]: unknown };
+
+/**
+ * AlertGroupState tracks the state of a single group of an alert with group_by.
+ */
+export interface V1AlertGroupState {
+  /** Values of the group_by fields for the group. */
+  key?: V1AlertGroupStateKey;
+  status?: V1AssertionStatus;
+  /** The group's row in the latest failing execution. */
+  failRow?: V1AlertGroupStateFailRow;
+  /** When the group started failing. */
+  failingSince?: string;
+  /** When a notification was last sent for the group. */
+  lastNotifiedOn?: string;
+}
+
+/**
+ * AlertMuteWindow is a recurring window during which an alert's notifications are suppressed.
+ */
+export interface V1AlertMuteWindow {
+  /** Cron expression for when the window starts. */
+  cron?: string;
+  /** Time zone to evaluate the cron expression in. */
+  timeZone?: string;
+  /** Length of the window. */
+  durationSeconds?: number;
 }
 
 export type V1AlertSpecResolverProperties = { [key: string]: unknown };
@@ -299,8 +365,20 @@ export interface V1AlertSpec {
   renotifyAfterSeconds?: number;
   notifiers?: V1Notifier[];
   annotations?: V1AlertSpecAnnotations;
+  /** Recurring windows during which notifications are suppressed. */
+  muteWindows?: V1AlertMuteWindow[];
+  /** If set, notifications are suppressed until this time. */
+  snoozeUntil?: string;
+  /** Fields in the alert's result rows that identify a group.
+If set, each group is tracked separately, so notifications, renotifications and recoveries apply per group. */
+  groupBy?: string[];
 }
 
+/**
+ * State of each currently failing group for alerts with group_by. Keyed by the group key.
+ */
+export type V1AlertStateGroups = { [key: string]: V1AlertGroupState };
+
 export interface V1AlertState {
   specHash?: string;
   refsHash?: string;
@@ -308,6 +386,8 @@ export interface V1AlertState {
   currentExecution?: V1AlertExecution;
   executionHistory?: V1AlertExecution[];
   executionCount?: number;
+  /** State of each currently failing group for alerts with group_by. Keyed by the group key. */
+  groups?: V1AlertStateGroups;
 }
 
 export type V1AnalystAgentContextWherePerMetricsView = {
@@ -382,10 +462,17 @@ export interface V1AnalyzedVariable {
 
 export type V1AssertionResultFailRow = { [key: string]: unknown };
 
+export type V1AssertionResultFailRowsItem = { [key: string]: unknown };
+
 export interface V1AssertionResult {
   status?: V1AssertionStatus;
   failRow?: V1AssertionResultFailRow;
   errorMessage?: string;
+  warnings?: string[];
+  /** For alerts with group_by, the failing row of each group. */
+  failRows?: V1AssertionResultFailRowsItem[];
+  /** True if more groups failed than are tracked in fail_rows. */
+  failRowsTruncated?: boolean;
 }
 
 export type V1AssertionStatus =
@@ -1033,6 +1120,8 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
   EXPORT_FORMAT_PDF: "EXPORT_FORMAT_PDF",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
 } as const;
 
 export interface V1ExportReportResponse {
@@ -1065,6 +1154,22 @@ export interface V1FeedbackAgentContext {
   comment?: string;
 }
 
+/**
+ * - FIELD_MASK_TYPE_HASH: Replaces values with a hash of the value. Equal values have equal hashes, so they can still be grouped and joined on.
+ - FIELD_MASK_TYPE_REDACT: Replaces all but the last `keep_last` characters of values.
+ - FIELD_MASK_TYPE_BUCKET: Rounds numeric values down to a multiple of `bucket_size`.
+ */
+export type V1FieldMaskType =
+  (typeof V1FieldMaskType)[keyof typeof V1FieldMaskType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1FieldMaskType = {
+  FIELD_MASK_TYPE_UNSPECIFIED: "FIELD_MASK_TYPE_UNSPECIFIED",
+  FIELD_MASK_TYPE_HASH: "FIELD_MASK_TYPE_HASH",
+  FIELD_MASK_TYPE_REDACT: "FIELD_MASK_TYPE_REDACT",
+  FIELD_MASK_TYPE_BUCKET: "FIELD_MASK_TYPE_BUCKET",
+} as const;
+
 /**
  * FieldSelector describes logic for selecting a list of fields.
 It is useful for dynamically evaluating fields when the list of potential fields is not known at parse time.
@@ -2242,12 +2347,19 @@ export interface V1ReportExecution {
   reportTime?: string;
   startedOn?: string;
   finishedOn?: string;
+  /** URI of the object that the exported file was written to (if the report has an output connector). */
+  outputUri?: string;
 }
 
 export type V1ReportSpecResolverProperties = { [key: string]: unknown };
 
 export type V1ReportSpecAnnotations = { [key: string]: string };
 
+/**
+ * Properties for writing to the output connector. The "path" property is a template that can reference the report time.
+ */
+export type V1ReportSpecExportOutputProperties = { [key: string]: unknown };
+
 export interface V1ReportSpec {
   displayName?: string;
   trigger?: boolean;
@@ -2267,6 +2379,10 @@ export interface V1ReportSpec {
   intervalsIsoDuration?: string;
   intervalsLimit?: number;
   intervalsCheckUnclosed?: boolean;
+  /** Connector of an object store to write the exported file to on each run (optional). */
+  exportOutputConnector?: string;
+  /** Properties for writing to the output connector. The "path" property is a template that can reference the report time. */
+  exportOutputProperties?: V1ReportSpecExportOutputProperties;
 }
 
 export interface V1ReportState {
@@ -2400,6 +2516,7 @@ export interface V1SecurityRule {
   fieldAccess?: V1SecurityRuleFieldAccess;
   rowFilter?: V1SecurityRuleRowFilter;
   transitiveAccess?: V1SecurityRuleTransitiveAccess;
+  fieldMask?: V1SecurityRuleFieldMask;
 }
 
 export interface V1SecurityRuleAccess {
@@ -2433,6 +2550,28 @@ It is ORed together with the condition_kinds. */
   allFields?: boolean;
 }
 
+/**
+ * SecurityRuleFieldMask masks the values of dimensions instead of hiding them.
+Masked dimensions can still be selected, grouped by and filtered on, but only their masked values are visible.
+ */
+export interface V1SecurityRuleFieldMask {
+  /** The condition under which this rule applies.
+It is ANDed together with the condition_kinds and condition_resources. */
+  conditionExpression?: string;
+  /** The resource kinds the rule applies to. If empty, it defaults to all resource kinds. */
+  conditionKinds?: string[];
+  /** The resources the rule applies to. If empty, it defaults to all resources in scope covered by `resource_kinds`.
+It is ORed together with the condition_kinds. */
+  conditionResources?: V1ResourceName[];
+  /** The dimensions to mask. */
+  fields?: string[];
+  type?: V1FieldMaskType;
+  /** Number of trailing characters to leave visible for FIELD_MASK_TYPE_REDACT. */
+  keepLast?: number;
+  /** Width of the buckets that values are rounded down to for FIELD_MASK_TYPE_BUCKET. */
+  bucketSize?: number;
+}
+
 export interface V1SecurityRuleRowFilter {
   /** The condition under which this rule applies.
 It is ANDed together with the condition_kinds and condition_resources. */
@@ -2746,7 +2885,14 @@ export type RuntimeServiceGetInstanceParams = {
   sensitive?: boolean;
 };
 
-export type RuntimeServiceDeleteInstanceBody = { [key: string]: unknown };
+/**
+ * Request message for RuntimeService.DeleteInstance
+ */
+export type RuntimeServiceDeleteInstanceBody = {
+  /** If true, the instance's data in remote storage is kept and only its local data is removed.
+Used when moving an instance to another runtime host that shares the same remote storage. */
+  keepStorage?: boolean;
+};
 
 /**
  * Request message for RuntimeService.EditInstance.
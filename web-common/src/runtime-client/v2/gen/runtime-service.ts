@@ -22,12 +22,18 @@ import {
   AnalyzeVariablesRequest,
   CompleteRequest,
   CreateDirectoryRequest,
+  CreateSnapshotRequest,
+  CreateSnapshotResponse,
   CreateTriggerRequest,
   DeleteFileRequest,
+  DeleteSnapshotRequest,
+  DeleteSnapshotResponse,
   ForkConversationRequest,
   GenerateCanvasFileRequest,
   GenerateMetricsViewFileRequest,
   GetAIMessageRequest,
+  GetColumnLineageRequest,
+  GetColumnLineageResponse,
   GetConversationRequest,
   GetExploreRequest,
   GetFileRequest,
@@ -47,6 +53,8 @@ import {
   GitSwitchBranchRequest,
   HealthRequest,
   InstanceHealthRequest,
+  InvalidateQueryCacheRequest,
+  InvalidateQueryCacheResponse,
   IssueDevJWTRequest,
   ListConnectorDriversRequest,
   ListConversationsRequest,
@@ -59,6 +67,8 @@ import {
   ListResourcesRequest,
   ListToolsRequest,
   PingRequest,
+  PlanProjectRequest,
+  PlanProjectResponse,
   PushEnvRequest,
   PushEnvResponse,
   PutFileRequest,
@@ -66,6 +76,8 @@ import {
   ReloadConfigRequest,
   RenameFileRequest,
   RestoreGitCommitRequest,
+  RestoreSnapshotRequest,
+  RestoreSnapshotResponse,
   ShareConversationRequest,
   SkipModelPartitionsRequest,
   SkipModelPartitionsResponse,
@@ -1164,6 +1176,103 @@ export function createRuntimeServiceGetModelPartitions<
   return createQuery(queryOptions, queryClient);
 }
 
+/**
+ * Raw RPC call: RuntimeService.GetColumnLineage
+ */
+export async function runtimeServiceGetColumnLineage(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<GetColumnLineageRequest>, "instanceId">,
+  options?: { signal?: AbortSignal },
+): Promise<PartialMessage<GetColumnLineageResponse>> {
+  const r = await client.runtimeService.getColumnLineage(
+    GetColumnLineageRequest.fromJson(
+      stripUndefined({
+        instanceId: client.instanceId,
+        ...request,
+      }) as unknown as JsonValue,
+    ),
+    { signal: options?.signal },
+  );
+  return r.toJson({
+    emitDefaultValues: true,
+  }) as unknown as PartialMessage<GetColumnLineageResponse>;
+}
+
+export function getRuntimeServiceGetColumnLineageQueryKey(
+  instanceId: string,
+  request?: Omit<PartialMessage<GetColumnLineageRequest>, "instanceId">,
+): QueryKey {
+  return [
+    "RuntimeService",
+    "getColumnLineage",
+    instanceId,
+    request ?? {},
+  ] as const;
+}
+
+export function getRuntimeServiceGetColumnLineageQueryOptions<
+  TData = PartialMessage<GetColumnLineageResponse>,
+>(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<GetColumnLineageRequest>, "instanceId">,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        PartialMessage<GetColumnLineageResponse>,
+        ConnectError,
+        TData
+      >
+    >;
+  },
+): CreateQueryOptions<
+  PartialMessage<GetColumnLineageResponse>,
+  ConnectError,
+  TData
+> & { queryKey: QueryKey } {
+  const queryKey = getRuntimeServiceGetColumnLineageQueryKey(
+    client.instanceId,
+    request,
+  );
+  const queryFn: QueryFunction<
+    PartialMessage<GetColumnLineageResponse>
+  > = ({ signal }) =>
+    runtimeServiceGetColumnLineage(client, request, { signal });
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!client.instanceId,
+    ...options?.query,
+  } as CreateQueryOptions<
+    PartialMessage<GetColumnLineageResponse>,
+    ConnectError,
+    TData
+  > & { queryKey: QueryKey };
+}
+
+export function createRuntimeServiceGetColumnLineage<
+  TData = PartialMessage<GetColumnLineageResponse>,
+>(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<GetColumnLineageRequest>, "instanceId">,
+  options?: {
+    query?: Partial<
+      CreateQueryOptions<
+        PartialMessage<GetColumnLineageResponse>,
+        ConnectError,
+        TData
+      >
+    >;
+  },
+  queryClient?: QueryClient,
+): CreateQueryResult<TData, ConnectError> {
+  const queryOptions = getRuntimeServiceGetColumnLineageQueryOptions(
+    client,
+    request,
+    options,
+  );
+  return createQuery(queryOptions, queryClient);
+}
+
 /**
  * Raw RPC call: RuntimeService.ListConnectorDrivers
  */
@@ -3266,6 +3375,327 @@ export function createRuntimeServiceCreateTriggerMutation(
   return createMutation(mutationOptions, queryClient);
 }
 
+/**
+ * Raw RPC call: RuntimeService.InvalidateQueryCache
+ */
+export async function runtimeServiceInvalidateQueryCache(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<InvalidateQueryCacheRequest>, "instanceId">,
+  options?: { signal?: AbortSignal },
+): Promise<PartialMessage<InvalidateQueryCacheResponse>> {
+  const r = await client.runtimeService.invalidateQueryCache(
+    InvalidateQueryCacheRequest.fromJson(
+      stripUndefined({
+        instanceId: client.instanceId,
+        ...request,
+      }) as unknown as JsonValue,
+    ),
+    { signal: options?.signal },
+  );
+  return r.toJson({
+    emitDefaultValues: true,
+  }) as unknown as PartialMessage<InvalidateQueryCacheResponse>;
+}
+
+export function getRuntimeServiceInvalidateQueryCacheMutationOptions(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<InvalidateQueryCacheResponse>,
+      unknown,
+      Omit<PartialMessage<InvalidateQueryCacheRequest>, "instanceId">
+    >
+  >,
+): CreateMutationOptions<
+  PartialMessage<InvalidateQueryCacheResponse>,
+  unknown,
+  Omit<PartialMessage<InvalidateQueryCacheRequest>, "instanceId">
+> {
+  return {
+    mutationFn: (request) =>
+      runtimeServiceInvalidateQueryCache(client, request),
+    ...options,
+  };
+}
+
+export function createRuntimeServiceInvalidateQueryCacheMutation(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<InvalidateQueryCacheResponse>,
+      unknown,
+      Omit<PartialMessage<InvalidateQueryCacheRequest>, "instanceId">
+    >
+  >,
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  PartialMessage<InvalidateQueryCacheResponse>,
+  unknown,
+  Omit<PartialMessage<InvalidateQueryCacheRequest>, "instanceId">
+> {
+  const mutationOptions = getRuntimeServiceInvalidateQueryCacheMutationOptions(
+    client,
+    options,
+  );
+  return createMutation(mutationOptions, queryClient);
+}
+
+/**
+ * Raw RPC call: RuntimeService.CreateSnapshot
+ */
+export async function runtimeServiceCreateSnapshot(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<CreateSnapshotRequest>, "instanceId">,
+  options?: { signal?: AbortSignal },
+): Promise<PartialMessage<CreateSnapshotResponse>> {
+  const r = await client.runtimeService.createSnapshot(
+    CreateSnapshotRequest.fromJson(
+      stripUndefined({
+        instanceId: client.instanceId,
+        ...request,
+      }) as unknown as JsonValue,
+    ),
+    { signal: options?.signal },
+  );
+  return r.toJson({
+    emitDefaultValues: true,
+  }) as unknown as PartialMessage<CreateSnapshotResponse>;
+}
+
+export function getRuntimeServiceCreateSnapshotMutationOptions(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<CreateSnapshotResponse>,
+      unknown,
+      Omit<PartialMessage<CreateSnapshotRequest>, "instanceId">
+    >
+  >,
+): CreateMutationOptions<
+  PartialMessage<CreateSnapshotResponse>,
+  unknown,
+  Omit<PartialMessage<CreateSnapshotRequest>, "instanceId">
+> {
+  return {
+    mutationFn: (request) => runtimeServiceCreateSnapshot(client, request),
+    ...options,
+  };
+}
+
+export function createRuntimeServiceCreateSnapshotMutation(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<CreateSnapshotResponse>,
+      unknown,
+      Omit<PartialMessage<CreateSnapshotRequest>, "instanceId">
+    >
+  >,
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  PartialMessage<CreateSnapshotResponse>,
+  unknown,
+  Omit<PartialMessage<CreateSnapshotRequest>, "instanceId">
+> {
+  const mutationOptions = getRuntimeServiceCreateSnapshotMutationOptions(
+    client,
+    options,
+  );
+  return createMutation(mutationOptions, queryClient);
+}
+
+/**
+ * Raw RPC call: RuntimeService.RestoreSnapshot
+ */
+export async function runtimeServiceRestoreSnapshot(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<RestoreSnapshotRequest>, "instanceId">,
+  options?: { signal?: AbortSignal },
+): Promise<PartialMessage<RestoreSnapshotResponse>> {
+  const r = await client.runtimeService.restoreSnapshot(
+    RestoreSnapshotRequest.fromJson(
+      stripUndefined({
+        instanceId: client.instanceId,
+        ...request,
+      }) as unknown as JsonValue,
+    ),
+    { signal: options?.signal },
+  );
+  return r.toJson({
+    emitDefaultValues: true,
+  }) as unknown as PartialMessage<RestoreSnapshotResponse>;
+}
+
+export function getRuntimeServiceRestoreSnapshotMutationOptions(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<RestoreSnapshotResponse>,
+      unknown,
+      Omit<PartialMessage<RestoreSnapshotRequest>, "instanceId">
+    >
+  >,
+): CreateMutationOptions<
+  PartialMessage<RestoreSnapshotResponse>,
+  unknown,
+  Omit<PartialMessage<RestoreSnapshotRequest>, "instanceId">
+> {
+  return {
+    mutationFn: (request) => runtimeServiceRestoreSnapshot(client, request),
+    ...options,
+  };
+}
+
+export function createRuntimeServiceRestoreSnapshotMutation(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<RestoreSnapshotResponse>,
+      unknown,
+      Omit<PartialMessage<RestoreSnapshotRequest>, "instanceId">
+    >
+  >,
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  PartialMessage<RestoreSnapshotResponse>,
+  unknown,
+  Omit<PartialMessage<RestoreSnapshotRequest>, "instanceId">
+> {
+  const mutationOptions = getRuntimeServiceRestoreSnapshotMutationOptions(
+    client,
+    options,
+  );
+  return createMutation(mutationOptions, queryClient);
+}
+
+/**
+ * Raw RPC call: RuntimeService.DeleteSnapshot
+ */
+export async function runtimeServiceDeleteSnapshot(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<DeleteSnapshotRequest>, "instanceId">,
+  options?: { signal?: AbortSignal },
+): Promise<PartialMessage<DeleteSnapshotResponse>> {
+  const r = await client.runtimeService.deleteSnapshot(
+    DeleteSnapshotRequest.fromJson(
+      stripUndefined({
+        instanceId: client.instanceId,
+        ...request,
+      }) as unknown as JsonValue,
+    ),
+    { signal: options?.signal },
+  );
+  return r.toJson({
+    emitDefaultValues: true,
+  }) as unknown as PartialMessage<DeleteSnapshotResponse>;
+}
+
+export function getRuntimeServiceDeleteSnapshotMutationOptions(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<DeleteSnapshotResponse>,
+      unknown,
+      Omit<PartialMessage<DeleteSnapshotRequest>, "instanceId">
+    >
+  >,
+): CreateMutationOptions<
+  PartialMessage<DeleteSnapshotResponse>,
+  unknown,
+  Omit<PartialMessage<DeleteSnapshotRequest>, "instanceId">
+> {
+  return {
+    mutationFn: (request) => runtimeServiceDeleteSnapshot(client, request),
+    ...options,
+  };
+}
+
+export function createRuntimeServiceDeleteSnapshotMutation(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<DeleteSnapshotResponse>,
+      unknown,
+      Omit<PartialMessage<DeleteSnapshotRequest>, "instanceId">
+    >
+  >,
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  PartialMessage<DeleteSnapshotResponse>,
+  unknown,
+  Omit<PartialMessage<DeleteSnapshotRequest>, "instanceId">
+> {
+  const mutationOptions = getRuntimeServiceDeleteSnapshotMutationOptions(
+    client,
+    options,
+  );
+  return createMutation(mutationOptions, queryClient);
+}
+
+/**
+ * Raw RPC call: RuntimeService.PlanProject
+ */
+export async function runtimeServicePlanProject(
+  client: RuntimeClient,
+  request: Omit<PartialMessage<PlanProjectRequest>, "instanceId">,
+  options?: { signal?: AbortSignal },
+): Promise<PartialMessage<PlanProjectResponse>> {
+  const r = await client.runtimeService.planProject(
+    PlanProjectRequest.fromJson(
+      stripUndefined({
+        instanceId: client.instanceId,
+        ...request,
+      }) as unknown as JsonValue,
+    ),
+    { signal: options?.signal },
+  );
+  return r.toJson({
+    emitDefaultValues: true,
+  }) as unknown as PartialMessage<PlanProjectResponse>;
+}
+
+export function getRuntimeServicePlanProjectMutationOptions(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<PlanProjectResponse>,
+      unknown,
+      Omit<PartialMessage<PlanProjectRequest>, "instanceId">
+    >
+  >,
+): CreateMutationOptions<
+  PartialMessage<PlanProjectResponse>,
+  unknown,
+  Omit<PartialMessage<PlanProjectRequest>, "instanceId">
+> {
+  return {
+    mutationFn: (request) => runtimeServicePlanProject(client, request),
+    ...options,
+  };
+}
+
+export function createRuntimeServicePlanProjectMutation(
+  client: RuntimeClient,
+  options?: Partial<
+    CreateMutationOptions<
+      PartialMessage<PlanProjectResponse>,
+      unknown,
+      Omit<PartialMessage<PlanProjectRequest>, "instanceId">
+    >
+  >,
+  queryClient?: QueryClient,
+): CreateMutationResult<
+  PartialMessage<PlanProjectResponse>,
+  unknown,
+  Omit<PartialMessage<PlanProjectRequest>, "instanceId">
+> {
+  const mutationOptions = getRuntimeServicePlanProjectMutationOptions(
+    client,
+    options,
+  );
+  return createMutation(mutationOptions, queryClient);
+}
+
 /**
  * Raw RPC call: RuntimeService.ShareConversation
  */